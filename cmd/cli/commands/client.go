@@ -96,6 +96,15 @@ func newBlacklistClient(ctx context.Context) (sonm.BlacklistClient, error) {
 	return sonm.NewBlacklistClient(cc), nil
 }
 
+func newRegistryCredentialsClient(ctx context.Context) (sonm.RegistryCredentialsClient, error) {
+	cc, err := newClientConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return sonm.NewRegistryCredentialsClient(cc), nil
+}
+
 func newProfilesClient(ctx context.Context) (sonm.ProfilesClient, error) {
 	cc, err := newClientConn(ctx)
 	if err != nil {
@@ -46,6 +46,7 @@ func init() {
 		dealQuickBuyCmd,
 		dealCloseCmd,
 		dealPurgeCmd,
+		dealVolumesCmd,
 		changeRequestsRoot,
 	)
 }
@@ -444,3 +445,31 @@ var changeRequestCancelCmd = &cobra.Command{
 		return nil
 	},
 }
+
+var dealVolumesCmd = &cobra.Command{
+	Use:   "volumes <deal_id>",
+	Short: "Show local volumes that persist between tasks of the deal",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		dealID, err := sonm.NewBigIntFromString(args[0])
+		if err != nil {
+			return err
+		}
+
+		node, err := newTaskClient(ctx)
+		if err != nil {
+			return fmt.Errorf("cannot create client connection: %v", err)
+		}
+
+		reply, err := node.DealVolumes(newDealContext(ctx, dealID.Unwrap().String()), &sonm.ID{Id: dealID.Unwrap().String()})
+		if err != nil {
+			return fmt.Errorf("cannot get deal volumes: %v", err)
+		}
+
+		printDealVolumes(cmd, reply)
+		return nil
+	},
+}
@@ -57,6 +57,9 @@ func printTaskStatus(cmd Printer, id string, taskStatus *sonm.TaskStatusReply) {
 		cmd.Printf("ID: %s\r\n", id)
 		cmd.Printf("  Image:  %s\r\n", taskStatus.GetImageName())
 		cmd.Printf("  Status: %s\r\n", taskStatus.GetStatus().String())
+		if groupID := taskStatus.GetGroupID(); len(groupID) != 0 {
+			cmd.Printf("  Group:  %s\r\n", groupID)
+		}
 		if tag := taskStatus.GetTag(); len(tag.GetData()) != 0 {
 			tagData, err := yaml.Marshal(tag)
 			if err != nil {
@@ -66,6 +69,28 @@ func printTaskStatus(cmd Printer, id string, taskStatus *sonm.TaskStatusReply) {
 			}
 		}
 		cmd.Printf("  Uptime: %s\r\n", time.Duration(taskStatus.GetUptime()).String())
+		if progress := taskStatus.GetSpoolProgress(); progress != nil {
+			cmd.Printf("  Pulling image: %s\r\n", formatImagePullProgress(progress))
+		}
+		if checkpoint := taskStatus.GetLastCheckpoint(); checkpoint != nil {
+			cmd.Printf("  Last checkpoint: %s (%s)\r\n", checkpoint.GetImage(), checkpoint.GetTimestamp().Unix().Format(time.RFC3339))
+		}
+		if restarts := taskStatus.GetRestarts(); restarts > 0 {
+			cmd.Printf("  Restarts: %d\r\n", restarts)
+		}
+		if lastExit := taskStatus.GetLastExit(); lastExit != nil {
+			cmd.Printf("  Last exit: code %d", lastExit.GetExitCode())
+			if lastExit.GetFinishedAt() != nil {
+				cmd.Printf(" at %s", lastExit.GetFinishedAt().Unix().Format(time.RFC3339))
+			}
+			if lastExit.GetOomKilled() {
+				cmd.Printf(", killed by OOM")
+			}
+			if lastExit.GetCrashLoop() {
+				cmd.Printf(", crash loop detected")
+			}
+			cmd.Printf("\r\n")
+		}
 
 		if taskStatus.GetUsage() != nil {
 			cmd.Println("  Resources:")
@@ -81,13 +106,30 @@ func printTaskStatus(cmd Printer, id string, taskStatus *sonm.TaskStatusReply) {
 					cmd.Printf("        Tx/Rx dropped: %d/%d\r\n", net.TxDropped, net.RxDropped)
 				}
 			}
+			if violations := taskStatus.GetUsage().GetEgressViolations(); violations > 0 {
+				cmd.Printf("    Egress violations: %d\r\n", violations)
+			}
 		}
 
 		if len(taskStatus.GetPortMap()) > 0 {
 			cmd.Printf("  Ports:\r\n")
 			for containerPort, portBindings := range taskStatus.GetPortMap() {
 				for _, portBinding := range portBindings.GetEndpoints() {
-					cmd.Printf("    %s: %s:%d\r\n", containerPort, portBinding.GetAddr(), portBinding.GetPort())
+					cmd.Printf("    %s: %s\r\n", containerPort, portBinding.HostPort())
+				}
+			}
+			for _, port := range taskStatus.GetPortForwarding().GetPorts() {
+				cmd.Printf("    %s: %s\r\n", port, formatForwardedEndpoint(taskStatus.GetPortForwarding()))
+			}
+		}
+
+		if len(taskStatus.GetHealthLog()) > 0 {
+			cmd.Printf("  Health probes:\r\n")
+			for _, probe := range taskStatus.GetHealthLog() {
+				cmd.Printf("    %s (%s): exit code %d\r\n", probe.GetStart().Unix().Format(time.RFC3339),
+					probe.GetEnd().Unix().Sub(probe.GetStart().Unix()).String(), probe.GetExitCode())
+				if output := strings.TrimSpace(probe.GetOutput()); len(output) > 0 {
+					cmd.Printf("      %s\r\n", output)
 				}
 			}
 		}
@@ -103,6 +145,22 @@ func printTaskStatus(cmd Printer, id string, taskStatus *sonm.TaskStatusReply) {
 			v["cpu"] = fmt.Sprintf("%d", taskStatus.GetUsage().GetCpu().GetTotal())
 			v["mem"] = fmt.Sprintf("%d", taskStatus.GetUsage().GetMemory().GetMaxUsage())
 			v["net"] = taskStatus.GetUsage().GetNetwork()
+			v["egress_violations"] = taskStatus.GetUsage().GetEgressViolations()
+		}
+		if len(taskStatus.GetHealthLog()) > 0 {
+			v["health_log"] = taskStatus.GetHealthLog()
+		}
+		if taskStatus.GetLastCheckpoint() != nil {
+			v["last_checkpoint"] = taskStatus.GetLastCheckpoint()
+		}
+		if taskStatus.GetRestarts() > 0 {
+			v["restarts"] = taskStatus.GetRestarts()
+		}
+		if taskStatus.GetLastExit() != nil {
+			v["last_exit"] = taskStatus.GetLastExit()
+		}
+		if taskStatus.GetSpoolProgress() != nil {
+			v["spool_progress"] = taskStatus.GetSpoolProgress()
 		}
 
 		showJSON(cmd, v)
@@ -118,6 +176,40 @@ func printNetworkSpec(cmd *cobra.Command, spec *sonm.NetworkSpec) {
 	}
 }
 
+func printTaskMetrics(cmd *cobra.Command, reply *sonm.TaskMetricsReply) {
+	if !isSimpleFormat() {
+		showJSON(cmd, reply)
+		return
+	}
+
+	if len(reply.GetSamples()) == 0 {
+		cmd.Println("No metrics collected yet")
+		return
+	}
+
+	rate := func(v float64) string {
+		return datasize.NewByteSize(uint64(v)).HumanReadable() + "/s"
+	}
+
+	w := tablewriter.NewWriter(cmd.OutOrStdout())
+	w.SetHeader([]string{"time", "CPU", "memory", "net in", "net out", "disk read", "disk write", "GPU"})
+	w.SetBorder(false)
+	for _, sample := range reply.GetSamples() {
+		w.Append([]string{
+			sample.GetTimestamp().Unix().Local().Format(time.RFC3339),
+			fmt.Sprintf("%.1f%%", sample.GetCpu()),
+			datasize.NewByteSize(sample.GetMemory()).HumanReadable(),
+			rate(sample.GetRxBytesRate()),
+			rate(sample.GetTxBytesRate()),
+			rate(sample.GetBlockReadRate()),
+			rate(sample.GetBlockWriteRate()),
+			fmt.Sprintf("%.1f%%", sample.GetGpu()),
+		})
+	}
+
+	w.Render()
+}
+
 func printTaskStatuses(printer Printer, statuses map[string]*sonm.TaskStatusReply) {
 	if isSimpleFormat() {
 		if len(statuses) == 0 {
@@ -163,6 +255,17 @@ func printWorkerStatus(cmd *cobra.Command, stat *sonm.StatusReply) {
 		cmd.Printf("Task count:        %d\r\n", stat.GetTaskCount())
 		cmd.Printf("DWH status:        %s\r\n", stat.GetDWHStatus())
 		cmd.Printf("Rendezvous status: %s\r\n", stat.GetRendezvousStatus())
+		if len(stat.GetStorageQuotaStatus()) > 0 {
+			cmd.Printf("Storage quota:     %s\r\n", stat.GetStorageQuotaStatus())
+		}
+		if drain := stat.GetDrain(); drain != nil {
+			if drain.GetDone() {
+				cmd.Printf("Drain:             done\r\n")
+			} else {
+				cmd.Printf("Drain:             %d orders, %d forward deals, %d spot deals left\r\n",
+					drain.GetOrders(), drain.GetForwardDeals(), drain.GetSpotDeals())
+			}
+		}
 		if !stat.GetIsBenchmarkFinished() {
 			cmd.Printf("[WARN] Worker is benchmarking now\r\n")
 		}
@@ -195,6 +298,9 @@ func printDeviceList(cmd Printer, dev *sonm.DevicesReply) {
 			cmd.Printf("GPUs:\r\n")
 			for i, gpu := range GPUs {
 				cmd.Printf("  %s (index=%d: hash=%s)\r\n", gpu.Device.GetDeviceName(), i, gpu.GetDevice().GetHash())
+				if reason, ok := dev.GetFaultyGPUs()[gpu.GetDevice().GetHash()]; ok {
+					cmd.Printf("    Faulty: %s\r\n", reason)
+				}
 				printBenchmarkGroup(cmd, gpu.Benchmarks)
 			}
 		}
@@ -361,6 +467,102 @@ func printAskList(cmd *cobra.Command, slots *sonm.AskPlansReply) {
 	}
 }
 
+func printAskPlanHistory(cmd *cobra.Command, history *sonm.AskPlanHistoryReply) {
+	if isSimpleFormat() {
+		if len(history.GetChanges()) == 0 {
+			cmd.Println("No price changes found")
+			return
+		}
+
+		w := tablewriter.NewWriter(cmd.OutOrStdout())
+		w.SetHeader([]string{"time", "old price", "new price", "reason"})
+		w.SetBorder(false)
+
+		for _, change := range history.GetChanges() {
+			w.Append([]string{
+				change.GetTime().Unix().Format(time.RFC3339),
+				change.GetOldPrice().GetPerSecond().PricePerHour(),
+				change.GetNewPrice().GetPerSecond().PricePerHour(),
+				change.GetReason(),
+			})
+		}
+
+		w.Render()
+	} else {
+		showJSON(cmd, history)
+	}
+}
+
+func printMaintenanceWindows(cmd *cobra.Command, reply *sonm.MaintenanceReply) {
+	if isSimpleFormat() {
+		if len(reply.GetWindows()) == 0 {
+			cmd.Println("No maintenance scheduled")
+			return
+		}
+
+		w := tablewriter.NewWriter(cmd.OutOrStdout())
+		w.SetHeader([]string{"id", "schedule", "duration", "next start", "next end"})
+		w.SetBorder(false)
+
+		for _, window := range reply.GetWindows() {
+			schedule := window.GetSchedule()
+			if len(schedule) == 0 {
+				schedule = "once"
+			}
+
+			duration, end := "until cancelled", "-"
+			if window.GetNextEnd() != nil {
+				duration = window.GetDuration().Unwrap().String()
+				end = window.GetNextEnd().Unix().Format(time.RFC3339)
+			}
+
+			w.Append([]string{
+				window.GetID(),
+				schedule,
+				duration,
+				window.GetNextStart().Unix().Format(time.RFC3339),
+				end,
+			})
+		}
+
+		w.Render()
+	} else {
+		showJSON(cmd, reply)
+	}
+}
+
+func printBenchmarkReport(cmd *cobra.Command, report *sonm.BenchmarkReportReply) {
+	if isSimpleFormat() {
+		cmd.Printf("Worker:      %s\r\n", report.GetWorker().Unwrap().Hex())
+		cmd.Printf("Fingerprint: %s\r\n", report.GetFingerprint())
+		cmd.Printf("Signed at:   %s\r\n", report.GetTimestamp().Unix().Format(time.RFC3339))
+
+		w := tablewriter.NewWriter(cmd.OutOrStdout())
+		w.SetHeader([]string{"id", "code", "device", "result", "runner", "measured"})
+		w.SetBorder(false)
+
+		for _, result := range report.GetResults() {
+			measured := "-"
+			if result.GetTimestamp() != nil {
+				measured = result.GetTimestamp().Unix().Format(time.RFC3339)
+			}
+
+			w.Append([]string{
+				fmt.Sprintf("%d", result.GetID()),
+				result.GetCode(),
+				result.GetDevice(),
+				fmt.Sprintf("%d", result.GetResult()),
+				result.GetRunner(),
+				measured,
+			})
+		}
+
+		w.Render()
+	} else {
+		showJSON(cmd, report)
+	}
+}
+
 func printVersion(cmd *cobra.Command, v string) {
 	if isSimpleFormat() {
 		cmd.Printf("sonmcli %s (%s)\r\n", v, util.GetPlatformName())
@@ -634,16 +836,26 @@ func printID(cmd *cobra.Command, id string) {
 	}
 }
 
+// formatForwardedEndpoint formats the endpoint of ports published through
+// NPP, which are reachable using "task port-forward" command only.
+func formatForwardedEndpoint(forwarding *sonm.PortForwarding) string {
+	return fmt.Sprintf("NPP %s (use \"task port-forward\")", forwarding.GetAddr().Unwrap().Hex())
+}
+
 func printTaskStart(cmd *cobra.Command, start *sonm.StartTaskReply) {
 	if isSimpleFormat() {
 		cmd.Printf("Task ID:    %s\r\n", start.Id)
 
 		for containerPort, portBindings := range start.GetPortMap() {
 			for _, portBinding := range portBindings.GetEndpoints() {
-				cmd.Printf("  Endpoint: %s: %s:%d\r\n", containerPort, portBinding.GetAddr(), portBinding.GetPort())
+				cmd.Printf("  Endpoint: %s: %s\r\n", containerPort, portBinding.HostPort())
 			}
 		}
 
+		for _, port := range start.GetPortForwarding().GetPorts() {
+			cmd.Printf("  Endpoint: %s: %s\r\n", port, formatForwardedEndpoint(start.GetPortForwarding()))
+		}
+
 		for _, end := range start.GetNetworkIDs() {
 			cmd.Printf("  Network:  %s\r\n", end)
 		}
@@ -652,6 +864,34 @@ func printTaskStart(cmd *cobra.Command, start *sonm.StartTaskReply) {
 	}
 }
 
+func printTaskGroupStart(cmd *cobra.Command, start *sonm.StartTaskGroupReply) {
+	if isSimpleFormat() {
+		cmd.Printf("Group ID:   %s\r\n", start.GetId())
+		for _, task := range start.GetTasks() {
+			printTaskStart(cmd, task)
+		}
+	} else {
+		showJSON(cmd, start)
+	}
+}
+
+func printTaskGroupStatus(cmd *cobra.Command, id string, reply *sonm.TaskGroupStatusReply) {
+	if isSimpleFormat() {
+		cmd.Printf("Group ID: %s\r\n", id)
+		cmd.Printf("Status:   %s\r\n", reply.GetStatus().String())
+		printer := &IndentPrinter{
+			Subprinter: cmd,
+			IdentCount: 2,
+			Ident:      ' ',
+		}
+		for _, taskID := range reply.GetTaskIDs() {
+			printTaskStatus(printer, taskID, reply.GetTasks()[taskID])
+		}
+	} else {
+		showJSON(cmd, reply)
+	}
+}
+
 func printBalanceInfo(cmd *cobra.Command, reply *sonm.BalanceReply) {
 	sideSNM := reply.GetSideBalance().ToPriceString()
 	liveSNM := reply.GetLiveBalance().ToPriceString()
@@ -699,6 +939,95 @@ func printBlacklist(cmd *cobra.Command, list *sonm.BlacklistReply) {
 	}
 }
 
+func printRegistryCredentials(cmd *cobra.Command, list *sonm.RegistryCredentialsReply) {
+	if isSimpleFormat() {
+		if len(list.GetCredentials()) == 0 {
+			cmd.Println("No registry credentials stored")
+			return
+		}
+
+		for _, credential := range list.GetCredentials() {
+			cmd.Printf("%s\r\n", credential.GetName())
+			if username := credential.GetUsername(); len(username) > 0 {
+				cmd.Printf("  Username:   %s\r\n", username)
+			}
+			if expiresAt := credential.GetExpiresAt(); expiresAt != nil {
+				cmd.Printf("  Expires at: %s\r\n", expiresAt.Unix().Format(time.RFC3339))
+			}
+		}
+	} else {
+		showJSON(cmd, list)
+	}
+}
+
+func formatImagePullProgress(progress *sonm.ImagePullProgress) string {
+	return fmt.Sprintf("%s/%s, %d/%d layers",
+		datasize.NewByteSize(progress.GetCurrent()).HumanReadable(),
+		datasize.NewByteSize(progress.GetTotal()).HumanReadable(),
+		progress.GetLayersDone(), progress.GetLayers())
+}
+
+func printImages(cmd *cobra.Command, reply *sonm.ImagesReply) {
+	if !isSimpleFormat() {
+		showJSON(cmd, reply)
+		return
+	}
+
+	cmd.Printf("Disk usage: %s", datasize.NewByteSize(reply.GetDiskUsage()).HumanReadable())
+	if budget := reply.GetDiskBudget(); budget > 0 {
+		cmd.Printf(" of %s", datasize.NewByteSize(budget).HumanReadable())
+	}
+	cmd.Printf("\r\n")
+
+	if len(reply.GetImages()) == 0 {
+		cmd.Println("No cached images")
+		return
+	}
+
+	for _, image := range reply.GetImages() {
+		cmd.Printf("%s\r\n", image.GetImage())
+		if image.GetSize() > 0 {
+			cmd.Printf("  Size:      %s\r\n", datasize.NewByteSize(image.GetSize()).HumanReadable())
+		}
+		if lastUsed := image.GetLastUsed(); lastUsed != nil {
+			cmd.Printf("  Last used: %s\r\n", lastUsed.Unix().Format(time.RFC3339))
+		}
+		if image.GetPinned() {
+			cmd.Printf("  Pinned:    yes\r\n")
+		}
+		if progress := image.GetProgress(); progress != nil {
+			cmd.Printf("  Pulling:   %s\r\n", formatImagePullProgress(progress))
+		}
+		if err := image.GetError(); len(err) > 0 {
+			cmd.Printf("  Error:     %s\r\n", err)
+		}
+	}
+}
+
+func printDealVolumes(cmd *cobra.Command, reply *sonm.DealVolumesReply) {
+	if !isSimpleFormat() {
+		showJSON(cmd, reply)
+		return
+	}
+
+	if len(reply.GetVolumes()) == 0 {
+		cmd.Println("No deal volumes")
+		return
+	}
+
+	usage := uint64(0)
+	for _, v := range reply.GetVolumes() {
+		usage += v.GetSize()
+		cmd.Printf("%s: %s\r\n", v.GetName(), datasize.NewByteSize(v.GetSize()).HumanReadable())
+	}
+
+	cmd.Printf("Total: %s", datasize.NewByteSize(usage).HumanReadable())
+	if quota := reply.GetQuota(); quota > 0 {
+		cmd.Printf(" of %s", datasize.NewByteSize(quota).HumanReadable())
+	}
+	cmd.Printf("\r\n")
+}
+
 func printWorkersList(cmd *cobra.Command, list *sonm.WorkerListReply) {
 	if isSimpleFormat() {
 		if len(list.GetWorkers()) == 0 {
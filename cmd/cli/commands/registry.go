@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/howeyc/gopass"
+	"github.com/sonm-io/core/proto"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const (
+	registrySecretPassword      = "password"
+	registrySecretIdentityToken = "identity-token"
+	registrySecretRegistryToken = "registry-token"
+)
+
+var (
+	registryUsernameFlag   string
+	registrySecretTypeFlag string
+	registryExpiresInFlag  time.Duration
+)
+
+func init() {
+	registrySetCmd.Flags().StringVar(&registryUsernameFlag, "username", "", "registry username")
+	registrySetCmd.Flags().StringVar(&registrySecretTypeFlag, "type", registrySecretPassword,
+		fmt.Sprintf("secret type, one of %q, %q or %q", registrySecretPassword, registrySecretIdentityToken, registrySecretRegistryToken))
+	registrySetCmd.Flags().DurationVar(&registryExpiresInFlag, "expires-in", 0, "lifetime of the registry token, unlimited if zero")
+
+	registryRootCmd.AddCommand(
+		registryListCmd,
+		registrySetCmd,
+		registryRemoveCmd,
+	)
+}
+
+var registryRootCmd = &cobra.Command{
+	Use:               "registry",
+	Short:             "Manage container registry credentials stored on the node",
+	PersistentPreRunE: loadKeyStoreWrapper,
+}
+
+var registryListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Show stored registry credentials",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		registry, err := newRegistryCredentialsClient(ctx)
+		if err != nil {
+			return fmt.Errorf("cannot create client connection: %v", err)
+		}
+
+		list, err := registry.List(ctx, &sonm.Empty{})
+		if err != nil {
+			return fmt.Errorf("cannot get registry credentials: %v", err)
+		}
+
+		printRegistryCredentials(cmd, list)
+		return nil
+	},
+}
+
+var registrySetCmd = &cobra.Command{
+	Use:   "set <name>",
+	Short: "Store registry credential",
+	Long: `Store registry credential, replacing existing one with the same name.
+
+The secret is read from stdin, prompting for it when stdin is a terminal, so
+it doesn't appear in the shell history. Tasks reference stored credentials
+using "registry.credential" or "push_registry.credential" fields of the task
+spec.`,
+	Example: `  sonmcli registry set private --username user
+  cat token.txt | sonmcli registry set gcr --type registry-token --expires-in 1h`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		credential := &sonm.RegistryCredential{
+			Name:     args[0],
+			Username: registryUsernameFlag,
+		}
+
+		secret, err := readRegistrySecret()
+		if err != nil {
+			return fmt.Errorf("cannot read secret: %v", err)
+		}
+
+		switch registrySecretTypeFlag {
+		case registrySecretPassword:
+			credential.Password = secret
+		case registrySecretIdentityToken:
+			credential.IdentityToken = secret
+		case registrySecretRegistryToken:
+			credential.RegistryToken = secret
+		default:
+			return fmt.Errorf("unknown secret type: %s", registrySecretTypeFlag)
+		}
+
+		if registryExpiresInFlag > 0 {
+			credential.ExpiresAt = sonm.NewTimestamp(time.Now().Add(registryExpiresInFlag))
+		}
+
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		registry, err := newRegistryCredentialsClient(ctx)
+		if err != nil {
+			return fmt.Errorf("cannot create client connection: %v", err)
+		}
+
+		if _, err := registry.Set(ctx, credential); err != nil {
+			return fmt.Errorf("cannot store registry credential: %v", err)
+		}
+
+		showOk(cmd)
+		return nil
+	},
+}
+
+var registryRemoveCmd = &cobra.Command{
+	Use:   "remove <name>",
+	Short: "Remove stored registry credential",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		registry, err := newRegistryCredentialsClient(ctx)
+		if err != nil {
+			return fmt.Errorf("cannot create client connection: %v", err)
+		}
+
+		if _, err := registry.Remove(ctx, &sonm.ID{Id: args[0]}); err != nil {
+			return fmt.Errorf("cannot remove registry credential: %v", err)
+		}
+
+		showOk(cmd)
+		return nil
+	},
+}
+
+func readRegistrySecret() (string, error) {
+	if terminal.IsTerminal(int(os.Stdin.Fd())) {
+		secret, err := gopass.GetPasswdPrompt("Secret: ", false, os.Stdin, os.Stdout)
+		if err != nil {
+			return "", err
+		}
+
+		return string(secret), nil
+	}
+
+	secret, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(string(secret), "\r\n"), nil
+}
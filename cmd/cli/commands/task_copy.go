@@ -0,0 +1,336 @@
+package commands
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/sonm-io/core/proto"
+	"github.com/spf13/cobra"
+)
+
+var taskCopyCmd = &cobra.Command{
+	Use:   "cp <src> <dst>",
+	Short: "Copy files between the running task and the local filesystem",
+	Long: `Copy files between the running task and the local filesystem.
+
+Task paths are specified as "<deal_id>:<task_id>:/path", exactly one of the
+source and the destination must be a task path. When copying into the task,
+the destination must be an existing directory. Use "-" as the local path to
+read a tar archive from stdin or to write it to stdout.`,
+	Example: `  sonmcli task cp ./app.conf 42:a7bc2312-f80b-4e4b-bd45-6a6b55bd7d2d:/etc/app
+  sonmcli task cp 42:a7bc2312-f80b-4e4b-bd45-6a6b55bd7d2d:/var/lib/app/result.csv .`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		src, err := parseTaskPath(args[0])
+		if err != nil {
+			return err
+		}
+		dst, err := parseTaskPath(args[1])
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		switch {
+		case src != nil && dst != nil:
+			return fmt.Errorf("copying between tasks is not supported")
+		case src != nil:
+			err = copyFromTask(ctx, src, args[1])
+		case dst != nil:
+			err = copyToTask(ctx, args[0], dst)
+		default:
+			return fmt.Errorf("either source or destination must be a task path")
+		}
+		if err != nil {
+			return err
+		}
+
+		showOk(cmd)
+		return nil
+	},
+}
+
+// taskPath is a path inside the task, specified as "deal_id:task_id:/path".
+type taskPath struct {
+	DealID string
+	TaskID string
+	Path   string
+}
+
+// parseTaskPath parses the given argument as a task path. Nil is returned
+// for local paths.
+func parseTaskPath(arg string) (*taskPath, error) {
+	parts := strings.SplitN(arg, ":", 3)
+	if len(parts) != 3 {
+		return nil, nil
+	}
+
+	// Local paths may contain colons too.
+	if _, err := sonm.NewBigIntFromString(parts[0]); err != nil {
+		return nil, nil
+	}
+
+	if len(parts[1]) == 0 {
+		return nil, fmt.Errorf("task ID is required in %s", arg)
+	}
+	if !path.IsAbs(parts[2]) {
+		return nil, fmt.Errorf("task path must be absolute in %s", arg)
+	}
+
+	return &taskPath{
+		DealID: parts[0],
+		TaskID: parts[1],
+		Path:   parts[2],
+	}, nil
+}
+
+func copyToTask(ctx context.Context, src string, dst *taskPath) error {
+	node, err := newTaskClient(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot create client connection: %v", err)
+	}
+
+	var archive io.Reader = os.Stdin
+	if src != "-" {
+		if _, err := os.Lstat(src); err != nil {
+			return fmt.Errorf("cannot copy %s: %v", src, err)
+		}
+
+		rd, wr := io.Pipe()
+		go func() {
+			wr.CloseWithError(writeTarArchive(wr, src))
+		}()
+		defer rd.Close()
+
+		archive = rd
+	}
+
+	client, err := node.CopyToTask(newDealContext(ctx, dst.DealID))
+	if err != nil {
+		return fmt.Errorf("cannot create copy client: %v", err)
+	}
+
+	sendErr := make(chan error, 1)
+	go func() {
+		sendErr <- sendTaskArchive(client, dst, archive)
+	}()
+
+	for {
+		if _, err := client.Recv(); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return fmt.Errorf("cannot copy into the task: %v", err)
+		}
+	}
+
+	return <-sendErr
+}
+
+func sendTaskArchive(client sonm.Worker_CopyToTaskClient, dst *taskPath, archive io.Reader) error {
+	request := &sonm.TaskCopyToRequest{
+		Id:   dst.TaskID,
+		Path: dst.Path,
+	}
+
+	buf := make([]byte, 1*1024*1024)
+	for {
+		n, err := archive.Read(buf)
+		if n > 0 || request.GetId() != "" {
+			request.Chunk = buf[:n]
+			if err := client.Send(request); err != nil {
+				return fmt.Errorf("cannot send archive: %v", err)
+			}
+			request = &sonm.TaskCopyToRequest{}
+		}
+		if err == io.EOF {
+			return client.CloseSend()
+		}
+		if err != nil {
+			client.CloseSend()
+			return fmt.Errorf("cannot read archive: %v", err)
+		}
+	}
+}
+
+func copyFromTask(ctx context.Context, src *taskPath, dst string) error {
+	node, err := newTaskClient(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot create client connection: %v", err)
+	}
+
+	client, err := node.CopyFromTask(newDealContext(ctx, src.DealID), &sonm.TaskCopyFromRequest{
+		Id:   src.TaskID,
+		Path: src.Path,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot create copy client: %v", err)
+	}
+
+	archive := &chunkReader{client: client}
+	if dst == "-" {
+		if _, err := io.Copy(os.Stdout, archive); err != nil {
+			return fmt.Errorf("cannot copy from the task: %v", err)
+		}
+		return nil
+	}
+
+	if err := extractTarArchive(archive, dst); err != nil {
+		return fmt.Errorf("cannot copy from the task: %v", err)
+	}
+
+	return nil
+}
+
+// chunkReader reads data from a stream of chunks.
+type chunkReader struct {
+	client interface {
+		Recv() (*sonm.Chunk, error)
+	}
+	buf []byte
+}
+
+func (m *chunkReader) Read(p []byte) (int, error) {
+	for len(m.buf) == 0 {
+		chunk, err := m.client.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		m.buf = chunk.GetChunk()
+	}
+
+	n := copy(p, m.buf)
+	m.buf = m.buf[n:]
+
+	return n, nil
+}
+
+// writeTarArchive writes a tar archive of the given file or directory,
+// placing it at the archive root.
+func writeTarArchive(wr io.Writer, src string) error {
+	archive := tar.NewWriter(wr)
+	base := filepath.Dir(filepath.Clean(src))
+
+	err := filepath.Walk(src, func(name string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		link := ""
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(name); err != nil {
+				return err
+			}
+		}
+
+		hdr, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+
+		relName, err := filepath.Rel(base, name)
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(relName)
+		if info.IsDir() {
+			hdr.Name += "/"
+		}
+
+		if err := archive.WriteHeader(hdr); err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		file, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(archive, file)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return archive.Close()
+}
+
+// extractTarArchive extracts the tar archive into the given directory,
+// refusing to write anything outside of it.
+func extractTarArchive(rd io.Reader, dst string) error {
+	dst, err := filepath.Abs(dst)
+	if err != nil {
+		return err
+	}
+
+	archive := tar.NewReader(rd)
+	symlinks := map[string]bool{}
+
+	for {
+		hdr, err := archive.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := filepath.Join(dst, filepath.FromSlash(hdr.Name))
+		if name != dst && !strings.HasPrefix(name, dst+string(filepath.Separator)) {
+			return fmt.Errorf("archive entry %s points outside of the destination", hdr.Name)
+		}
+		for dir := filepath.Dir(name); dir != dst && len(dir) > len(dst); dir = filepath.Dir(dir) {
+			if symlinks[dir] {
+				return fmt.Errorf("archive entry %s is placed under a symbolic link", hdr.Name)
+			}
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(name, os.FileMode(hdr.Mode)|0700); err != nil {
+				return err
+			}
+		case tar.TypeReg, tar.TypeRegA:
+			if err := extractTarFile(archive, name, os.FileMode(hdr.Mode)); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.Symlink(hdr.Linkname, name); err != nil {
+				return err
+			}
+			symlinks[name] = true
+		default:
+			// Devices, hard links and other special files are skipped.
+		}
+	}
+}
+
+func extractTarFile(rd io.Reader, name string, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(name, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, rd)
+	return err
+}
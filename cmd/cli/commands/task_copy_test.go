@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTaskPath(t *testing.T) {
+	path, err := parseTaskPath("42:a7bc2312:/etc/app")
+	require.NoError(t, err)
+	assert.Equal(t, &taskPath{DealID: "42", TaskID: "a7bc2312", Path: "/etc/app"}, path)
+
+	path, err = parseTaskPath("./result.csv")
+	require.NoError(t, err)
+	assert.Nil(t, path)
+
+	path, err = parseTaskPath("dir:with:colons")
+	require.NoError(t, err)
+	assert.Nil(t, path)
+
+	_, err = parseTaskPath("42::/etc/app")
+	assert.Error(t, err)
+
+	_, err = parseTaskPath("42:a7bc2312:etc/app")
+	assert.Error(t, err)
+}
+
+func TestTarArchiveRoundTrip(t *testing.T) {
+	src, err := ioutil.TempDir("", "sonm-cp-src")
+	require.NoError(t, err)
+	defer os.RemoveAll(src)
+
+	dst, err := ioutil.TempDir("", "sonm-cp-dst")
+	require.NoError(t, err)
+	defer os.RemoveAll(dst)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "app", "conf"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(src, "app", "conf", "app.yaml"), []byte("debug: true"), 0644))
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, writeTarArchive(buf, filepath.Join(src, "app")))
+	require.NoError(t, extractTarArchive(buf, dst))
+
+	data, err := ioutil.ReadFile(filepath.Join(dst, "app", "conf", "app.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "debug: true", string(data))
+}
+
+func TestTarArchiveSymlink(t *testing.T) {
+	src, err := ioutil.TempDir("", "sonm-cp-src")
+	require.NoError(t, err)
+	defer os.RemoveAll(src)
+
+	dst, err := ioutil.TempDir("", "sonm-cp-dst")
+	require.NoError(t, err)
+	defer os.RemoveAll(dst)
+
+	require.NoError(t, os.Symlink("/etc", filepath.Join(src, "etc")))
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, writeTarArchive(buf, filepath.Join(src, "etc")))
+	require.NoError(t, extractTarArchive(buf, dst))
+
+	link, err := os.Readlink(filepath.Join(dst, "etc"))
+	require.NoError(t, err)
+	assert.Equal(t, "/etc", link)
+}
+
+func TestExtractTarArchiveOutsideDestination(t *testing.T) {
+	dst, err := ioutil.TempDir("", "sonm-cp-dst")
+	require.NoError(t, err)
+	defer os.RemoveAll(dst)
+
+	for _, name := range []string{"../escape", "link/escape"} {
+		buf := bytes.NewBuffer(nil)
+		wr := tar.NewWriter(buf)
+		require.NoError(t, wr.WriteHeader(&tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "/tmp", Mode: 0777}))
+		require.NoError(t, wr.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0644}))
+		require.NoError(t, wr.Close())
+
+		assert.Error(t, extractTarArchive(buf, dst), name)
+		require.NoError(t, os.RemoveAll(filepath.Join(dst, "link")))
+	}
+}
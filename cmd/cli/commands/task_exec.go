@@ -0,0 +1,155 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/sonm-io/core/proto"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+var (
+	taskExecTTY bool
+	taskExecEnv []string
+)
+
+func init() {
+	taskExecCmd.Flags().BoolVarP(&taskExecTTY, "tty", "t", false, "allocate a pseudo-terminal")
+	taskExecCmd.Flags().StringArrayVarP(&taskExecEnv, "env", "e", nil, "set environment variables in KEY=VALUE form")
+	// Everything after the task ID belongs to the command.
+	taskExecCmd.Flags().SetInterspersed(false)
+}
+
+var taskExecCmd = &cobra.Command{
+	Use:   "exec <deal_id> <task_id> -- <command> [args...]",
+	Short: "Execute a command inside the running task",
+	Args:  cobra.MinimumNArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dealID := args[0]
+		if _, err := sonm.NewBigIntFromString(dealID); err != nil {
+			return err
+		}
+
+		exitCode, err := execTask(cmd, dealID, args[1], args[2:])
+		if err != nil {
+			return err
+		}
+
+		if exitCode != 0 {
+			os.Exit(int(exitCode))
+		}
+
+		return nil
+	},
+}
+
+func execTask(cmd *cobra.Command, dealID, taskID string, command []string) (int32, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := newTaskClient(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("cannot create client connection: %v", err)
+	}
+
+	execStream, err := node.ExecTask(newDealContext(ctx, dealID))
+	if err != nil {
+		return 0, fmt.Errorf("cannot execute command: %v", err)
+	}
+	stream := &taskExecStream{Worker_ExecTaskClient: execStream}
+
+	request := &sonm.TaskExecRequest{
+		Id:  taskID,
+		Cmd: command,
+		Env: taskExecEnv,
+		Tty: taskExecTTY,
+	}
+
+	fd := int(os.Stdin.Fd())
+	if taskExecTTY && terminal.IsTerminal(fd) {
+		state, err := terminal.MakeRaw(fd)
+		if err != nil {
+			return 0, fmt.Errorf("cannot switch terminal into raw mode: %v", err)
+		}
+		defer terminal.Restore(fd, state)
+
+		request.Window = terminalWindow(fd)
+		go watchTerminalWindow(ctx, fd, stream)
+	}
+
+	if err := stream.Send(request); err != nil {
+		return 0, fmt.Errorf("cannot execute command: %v", err)
+	}
+
+	go forwardTaskExecStdin(stream, os.Stdin)
+
+	for {
+		reply, err := stream.Recv()
+		if err != nil {
+			return 0, fmt.Errorf("failed to receive command output: %v", err)
+		}
+
+		if _, err := cmd.OutOrStdout().Write(reply.GetStdout()); err != nil {
+			return 0, err
+		}
+		if _, err := cmd.OutOrStderr().Write(reply.GetStderr()); err != nil {
+			return 0, err
+		}
+
+		if reply.GetExited() {
+			stream.CloseSend()
+			return reply.GetExitCode(), nil
+		}
+	}
+}
+
+// taskExecStream allows to send exec requests concurrently.
+type taskExecStream struct {
+	sonm.Worker_ExecTaskClient
+	mu sync.Mutex
+}
+
+func (m *taskExecStream) Send(request *sonm.TaskExecRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.Worker_ExecTaskClient.Send(request)
+}
+
+func (m *taskExecStream) CloseSend() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.Worker_ExecTaskClient.CloseSend()
+}
+
+// forwardTaskExecStdin sends everything read from the given reader as the
+// command input, closing it when the reader is exhausted.
+func forwardTaskExecStdin(stream *taskExecStream, rd io.Reader) {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := rd.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&sonm.TaskExecRequest{Stdin: buf[:n]}); err != nil {
+				return
+			}
+		}
+		if err != nil {
+			stream.Send(&sonm.TaskExecRequest{CloseStdin: true})
+			return
+		}
+	}
+}
+
+func terminalWindow(fd int) *sonm.TaskExecWindow {
+	width, height, err := terminal.GetSize(fd)
+	if err != nil {
+		return nil
+	}
+
+	return &sonm.TaskExecWindow{Width: uint32(width), Height: uint32(height)}
+}
@@ -0,0 +1,31 @@
+// +build !windows
+
+package commands
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/sonm-io/core/proto"
+)
+
+// watchTerminalWindow sends terminal size changes to the exec stream until
+// the context is canceled.
+func watchTerminalWindow(ctx context.Context, fd int, stream *taskExecStream) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGWINCH)
+	defer signal.Stop(signals)
+
+	for {
+		select {
+		case <-signals:
+			if window := terminalWindow(fd); window != nil {
+				stream.Send(&sonm.TaskExecRequest{Window: window})
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,11 @@
+// +build windows
+
+package commands
+
+import (
+	"context"
+)
+
+// watchTerminalWindow does nothing, because there is no way to be notified
+// about console size changes on Windows.
+func watchTerminalWindow(ctx context.Context, fd int, stream *taskExecStream) {}
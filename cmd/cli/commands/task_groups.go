@@ -0,0 +1,118 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sonm-io/core/cmd/cli/task_config"
+	"github.com/sonm-io/core/proto"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	taskGroupRootCmd.AddCommand(
+		taskGroupStartCmd,
+		taskGroupStatusCmd,
+		taskGroupStopCmd,
+	)
+}
+
+var taskGroupRootCmd = &cobra.Command{
+	Use:   "group",
+	Short: "Task groups management",
+}
+
+var taskGroupStartCmd = &cobra.Command{
+	Use:   "start <deal_id> <group.yaml>",
+	Short: "Start task group",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		node, err := newTaskClient(ctx)
+		if err != nil {
+			return fmt.Errorf("cannot create client connection: %v", err)
+		}
+
+		dealID := args[0]
+		spec, err := task_config.LoadGroupConfig(args[1])
+		if err != nil {
+			return fmt.Errorf("cannot load task group definition: %v", err)
+		}
+
+		bigDealID, err := sonm.NewBigIntFromString(dealID)
+		if err != nil {
+			return err
+		}
+
+		request := &sonm.StartTaskGroupRequest{
+			DealID: bigDealID,
+			Spec:   spec,
+		}
+
+		reply, err := node.StartTaskGroup(newDealContext(ctx, dealID), request)
+		if err != nil {
+			return fmt.Errorf("cannot start task group: %v", err)
+		}
+
+		printTaskGroupStart(cmd, reply)
+		return nil
+	},
+}
+
+var taskGroupStatusCmd = &cobra.Command{
+	Use:   "status <deal_id> <group_id>",
+	Short: "Show task group status",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		dealID := args[0]
+		if _, err := sonm.NewBigIntFromString(dealID); err != nil {
+			return err
+		}
+
+		node, err := newTaskClient(ctx)
+		if err != nil {
+			return fmt.Errorf("cannot create client connection: %v", err)
+		}
+
+		groupID := args[1]
+		status, err := node.TaskGroupStatus(newDealContext(ctx, dealID), &sonm.ID{Id: groupID})
+		if err != nil {
+			return fmt.Errorf("cannot get task group status: %v", err)
+		}
+
+		printTaskGroupStatus(cmd, groupID, status)
+		return nil
+	},
+}
+
+var taskGroupStopCmd = &cobra.Command{
+	Use:   "stop <deal_id> <group_id>",
+	Short: "Stop all tasks of the group",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		dealID := args[0]
+		if _, err := sonm.NewBigIntFromString(dealID); err != nil {
+			return err
+		}
+
+		node, err := newTaskClient(ctx)
+		if err != nil {
+			return fmt.Errorf("cannot create client connection: %v", err)
+		}
+
+		if _, err := node.StopTaskGroup(newDealContext(ctx, dealID), &sonm.ID{Id: args[1]}); err != nil {
+			return fmt.Errorf("cannot stop task group: %v", err)
+		}
+
+		showOk(cmd)
+		return nil
+	},
+}
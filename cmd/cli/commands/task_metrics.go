@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sonm-io/core/proto"
+	"github.com/spf13/cobra"
+)
+
+var (
+	taskMetricsFrom string
+	taskMetricsTo   string
+	taskMetricsStep time.Duration
+)
+
+func init() {
+	taskMetricsCmd.Flags().StringVar(&taskMetricsFrom, "from", "1h", "beginning of the time range, either a timestamp (e.g. 2018-06-01T12:00:00Z) or relative (e.g. 42m for 42 minutes ago)")
+	taskMetricsCmd.Flags().StringVar(&taskMetricsTo, "to", "", "end of the time range in the same format, now if empty")
+	taskMetricsCmd.Flags().DurationVar(&taskMetricsStep, "step", 5*time.Minute, "resolution of the series, samples within a step are averaged")
+}
+
+var taskMetricsCmd = &cobra.Command{
+	Use:   "metrics <deal_id> <task_id>",
+	Short: "Show resource usage history of the task",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		dealID := args[0]
+		if _, err := sonm.NewBigIntFromString(dealID); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		request := &sonm.TaskMetricsRequest{
+			Id:   args[1],
+			Step: &sonm.Duration{Nanoseconds: taskMetricsStep.Nanoseconds()},
+		}
+
+		from, err := parseTimeFlag(taskMetricsFrom, now)
+		if err != nil {
+			return fmt.Errorf("invalid --from value: %v", err)
+		}
+		if !from.IsZero() {
+			request.From = sonm.NewTimestamp(from)
+		}
+
+		to, err := parseTimeFlag(taskMetricsTo, now)
+		if err != nil {
+			return fmt.Errorf("invalid --to value: %v", err)
+		}
+		if !to.IsZero() {
+			request.To = sonm.NewTimestamp(to)
+		}
+
+		node, err := newTaskClient(ctx)
+		if err != nil {
+			return fmt.Errorf("cannot create client connection: %v", err)
+		}
+
+		reply, err := node.TaskMetrics(newDealContext(ctx, dealID), request)
+		if err != nil {
+			return fmt.Errorf("cannot get task metrics: %v", err)
+		}
+
+		printTaskMetrics(cmd, reply)
+		return nil
+	},
+}
+
+// parseTimeFlag parses either an RFC 3339 timestamp or a duration, which is
+// counted back from now. Empty value results in zero time.
+func parseTimeFlag(value string, now time.Time) (time.Time, error) {
+	if len(value) == 0 {
+		return time.Time{}, nil
+	}
+
+	if duration, err := time.ParseDuration(value); err == nil {
+		return now.Add(-duration), nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
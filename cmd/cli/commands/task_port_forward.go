@@ -0,0 +1,151 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sonm-io/core/insonmnia/auth"
+	"github.com/sonm-io/core/insonmnia/npp"
+	"github.com/sonm-io/core/insonmnia/portforward"
+	"github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util"
+	"github.com/sonm-io/core/util/xgrpc"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/credentials"
+)
+
+var taskPortForwardListen string
+
+func init() {
+	taskPortForwardCmd.Flags().StringVar(&taskPortForwardListen, "listen", "", "local address to listen on, \"localhost:<port>\" by default")
+}
+
+var taskPortForwardCmd = &cobra.Command{
+	Use:   "port-forward <deal_id> <task_id> <port>",
+	Short: "Forward local connections to the port published by the task",
+	Long: `Forward local connections to the port published by the task.
+
+Works for ports published by workers through NPP, which allows to reach tasks
+even when the worker has no public IP addresses. Ports are specified as they
+are exposed, "tcp" protocol is assumed when omitted. NPP settings are taken
+from the "npp" section of the CLI config.`,
+	Example: `  sonmcli task port-forward 42 a7bc2312-f80b-4e4b-bd45-6a6b55bd7d2d 8080
+  sonmcli task port-forward 42 a7bc2312-f80b-4e4b-bd45-6a6b55bd7d2d 80/tcp --listen localhost:8080`,
+	Args: cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dealID, taskID, port := args[0], args[1], portforward.NormalizePort(args[2])
+		if _, err := sonm.NewBigIntFromString(dealID); err != nil {
+			return err
+		}
+
+		addr, err := relayedPortAddr(dealID, taskID, port)
+		if err != nil {
+			return err
+		}
+
+		dialer, credentials, err := newPortForwardDialer()
+		if err != nil {
+			return fmt.Errorf("cannot create NPP dialer: %v", err)
+		}
+
+		listenAddr := taskPortForwardListen
+		if len(listenAddr) == 0 {
+			listenAddr = net.JoinHostPort("localhost", strings.Split(port, "/")[0])
+		}
+
+		listener, err := net.Listen("tcp", listenAddr)
+		if err != nil {
+			return fmt.Errorf("cannot listen on %s: %v", listenAddr, err)
+		}
+		defer listener.Close()
+
+		cmd.Printf("Forwarding %s -> %s:%s\r\n", listener.Addr(), taskID, port)
+
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return err
+			}
+
+			go forwardConn(cmd, dialer, credentials, addr, conn, taskID, port)
+		}
+	},
+}
+
+// relayedPortAddr returns the ETH address of the port forwarding service
+// the given port of the task is published through.
+func relayedPortAddr(dealID, taskID, port string) (common.Address, error) {
+	ctx, cancel := newTimeoutContext()
+	defer cancel()
+
+	node, err := newTaskClient(ctx)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("cannot create client connection: %v", err)
+	}
+
+	status, err := node.TaskStatus(newDealContext(ctx, dealID), &sonm.ID{Id: taskID})
+	if err != nil {
+		return common.Address{}, fmt.Errorf("cannot get task status: %v", err)
+	}
+
+	for _, forwardedPort := range status.GetPortForwarding().GetPorts() {
+		if forwardedPort == port {
+			return status.GetPortForwarding().GetAddr().Unwrap(), nil
+		}
+	}
+
+	if _, ok := status.GetPortMap()[port]; !ok {
+		return common.Address{}, fmt.Errorf("port %s is not exposed by the task", port)
+	}
+
+	return common.Address{}, fmt.Errorf("port %s is not published through NPP", port)
+}
+
+func newPortForwardDialer() (*npp.Dialer, credentials.TransportCredentials, error) {
+	key, err := getDefaultKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, TLSConfig, err := util.NewHitlessCertRotator(context.Background(), key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	credentials := xgrpc.NewTransportCredentials(TLSConfig)
+	dialer, err := npp.NewDialer(
+		npp.WithRendezvous(cfg.NPP.Rendezvous, credentials),
+		npp.WithRelay(cfg.NPP.Relay, key),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dialer, credentials, nil
+}
+
+func forwardConn(cmd Printer, dialer *npp.Dialer, credentials credentials.TransportCredentials, addr common.Address, conn net.Conn, taskID, port string) {
+	defer conn.Close()
+
+	ctx, cancel := newTimeoutContext()
+	defer cancel()
+
+	remote, err := dialer.DialContext(ctx, *auth.NewETHAddr(addr))
+	if err != nil {
+		cmd.Printf("Cannot connect to %s: %v\r\n", addr.Hex(), err)
+		return
+	}
+	defer remote.Close()
+
+	tunnel, err := portforward.Handshake(ctx, remote, credentials, addr, taskID, port)
+	if err != nil {
+		cmd.Printf("Cannot forward connection from %s: %v\r\n", conn.RemoteAddr(), err)
+		return
+	}
+	defer tunnel.Close()
+
+	portforward.Splice(conn, tunnel)
+}
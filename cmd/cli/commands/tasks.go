@@ -32,21 +32,31 @@ func init() {
 	taskLogsCmd.Flags().BoolVar(&prependStream, prependStreamFlag, false, "Show stream (stderr or stdout) for each line of logs")
 
 	taskPullCmd.Flags().StringVar(&taskPullOutput, "output", "", "file to output")
+	taskPullCmd.Flags().BoolVar(&taskPullCheckpoint, "checkpoint", false, "pull the latest checkpoint instead of the committed image")
 
 	taskRootCmd.AddCommand(
 		taskListCmd,
 		taskStartCmd,
+		taskRestoreCmd,
 		taskStatusCmd,
 		taskLogsCmd,
+		taskMetricsCmd,
+		taskExecCmd,
+		taskCopyCmd,
+		taskPortForwardCmd,
 		taskStopCmd,
 		taskPurgeCmd,
 		taskPullCmd,
 		taskPushCmd,
 		taskJoinNetworkCmd,
+		taskGroupRootCmd,
 	)
 }
 
-var taskPullOutput string
+var (
+	taskPullOutput     string
+	taskPullCheckpoint bool
+)
 
 var taskRootCmd = &cobra.Command{
 	Use:               "task",
@@ -195,6 +205,46 @@ var taskStartCmd = &cobra.Command{
 	},
 }
 
+var taskRestoreCmd = &cobra.Command{
+	Use:   "restore <deal_id> <task.yaml> <checkpoint>",
+	Short: "Start task from the checkpoint image",
+	Args:  cobra.MinimumNArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		node, err := newTaskClient(ctx)
+		if err != nil {
+			return fmt.Errorf("cannot create client connection: %v", err)
+		}
+
+		dealID := args[0]
+		spec, err := task_config.LoadConfig(args[1])
+		if err != nil {
+			return fmt.Errorf("cannot load task definition: %v", err)
+		}
+
+		bigDealID, err := sonm.NewBigIntFromString(dealID)
+		if err != nil {
+			return err
+		}
+
+		request := &sonm.RestoreTaskRequest{
+			DealID:     bigDealID,
+			Spec:       spec,
+			Checkpoint: args[2],
+		}
+
+		reply, err := node.RestoreTask(newDealContext(ctx, dealID), request)
+		if err != nil {
+			return fmt.Errorf("cannot restore task: %v", err)
+		}
+
+		printTaskStart(cmd, reply)
+		return nil
+	},
+}
+
 var taskStatusCmd = &cobra.Command{
 	Use:   "status <deal_id> <task_id>",
 	Short: "Show task status",
@@ -450,8 +500,9 @@ var taskPullCmd = &cobra.Command{
 		}
 
 		req := &sonm.PullTaskRequest{
-			DealId: dealID,
-			TaskId: taskID,
+			DealId:     dealID,
+			TaskId:     taskID,
+			Checkpoint: taskPullCheckpoint,
 		}
 
 		client, err := node.PullTask(ctx, req)
@@ -72,8 +72,15 @@ func workerPostRun(_ *cobra.Command, _ []string) {
 	}
 }
 
+var (
+	maintenanceDurationFlag time.Duration
+	maintenanceScheduleFlag string
+)
+
 func init() {
 	workerMgmtCmd.PersistentFlags().StringVar(&workerAddressFlag, "worker-address", "", "Use specified worker address instead of configured value")
+	workerScheduleMaintenanceCmd.Flags().DurationVar(&maintenanceDurationFlag, "duration", 0, "Maintenance duration, lasts until cancelled if not specified")
+	workerScheduleMaintenanceCmd.Flags().StringVar(&maintenanceScheduleFlag, "schedule", "", "Cron expression of recurring maintenance in UTC, like \"0 3 * * sun\"")
 	workerMgmtCmd.AddCommand(
 		workerStatusCmd,
 		askPlansRootCmd,
@@ -85,12 +92,17 @@ func init() {
 		workerCurrentCmd,
 		workerScheduleMaintenanceCmd,
 		workerNextMaintenanceCmd,
+		workerMaintenanceListCmd,
+		workerCancelMaintenanceCmd,
+		workerDrainCmd,
+		workerUndrainCmd,
 		workerDebugStateCmd,
 		workerLogs,
 		workerAddCapabilityCmd,
 		workerRemoveCapabilityCmd,
 		workerMetricsCmd,
 		workerConfigCmd,
+		imagesRootCmd,
 	)
 }
 
@@ -140,26 +152,72 @@ var workerSwitchCmd = &cobra.Command{
 }
 
 var workerScheduleMaintenanceCmd = &cobra.Command{
-	Use:   "maintenance <at or after>",
+	Use:   "maintenance [at or after]",
 	Short: "Schedule worker maintanance",
-	Args:  cobra.MinimumNArgs(1),
+	Long: `Schedule worker maintanance.
+
+The worker closes spot deals and does not place orders during the maintenance,
+while forward orders that would overlap it are not placed at all. Several
+maintenance windows can be scheduled, either one-off or recurring ones.`,
+	Example: `  sonmcli worker maintenance 2h --duration 30m
+  sonmcli worker maintenance 2019-03-05T10:00:00Z
+  sonmcli worker maintenance --schedule "0 3 * * sun" --duration 2h`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		var timePoint time.Time
-		timeData := []byte(args[0])
-		if err := timePoint.UnmarshalText(timeData); err != nil {
-			duration, err := time.ParseDuration(args[0])
-			if err != nil {
-				return fmt.Errorf("invalid time point or duration specified: %v", err)
+		window := &sonm.MaintenanceWindow{
+			Schedule: maintenanceScheduleFlag,
+			Duration: &sonm.Duration{Nanoseconds: maintenanceDurationFlag.Nanoseconds()},
+		}
+
+		if len(args) != 0 {
+			var timePoint time.Time
+			timeData := []byte(args[0])
+			if err := timePoint.UnmarshalText(timeData); err != nil {
+				duration, err := time.ParseDuration(args[0])
+				if err != nil {
+					return fmt.Errorf("invalid time point or duration specified: %v", err)
+				}
+
+				timePoint = time.Now()
+				timePoint = timePoint.Add(duration)
 			}
 
-			timePoint = time.Now()
-			timePoint = timePoint.Add(duration)
+			window.Start = &sonm.Timestamp{Seconds: timePoint.Unix()}
 		}
 
-		if _, err := worker.ScheduleMaintenance(workerCtx, &sonm.Timestamp{Seconds: timePoint.Unix()}); err != nil {
+		id, err := worker.AddMaintenance(workerCtx, window)
+		if err != nil {
 			return fmt.Errorf("failed to schedule maintenance: %v", err)
 		}
 
+		printID(cmd, id.GetId())
+		return nil
+	},
+}
+
+var workerMaintenanceListCmd = &cobra.Command{
+	Use:   "maintenance-list",
+	Short: "Show scheduled maintenance windows",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		reply, err := worker.Maintenance(workerCtx, &sonm.Empty{})
+		if err != nil {
+			return fmt.Errorf("failed to get maintenance windows: %v", err)
+		}
+
+		printMaintenanceWindows(cmd, reply)
+		return nil
+	},
+}
+
+var workerCancelMaintenanceCmd = &cobra.Command{
+	Use:   "maintenance-cancel <id>",
+	Short: "Cancel scheduled maintenance window",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if _, err := worker.CancelMaintenance(workerCtx, &sonm.ID{Id: args[0]}); err != nil {
+			return fmt.Errorf("failed to cancel maintenance: %v", err)
+		}
+
 		showOk(cmd)
 		return nil
 	},
@@ -185,6 +243,37 @@ var workerNextMaintenanceCmd = &cobra.Command{
 	},
 }
 
+var workerDrainCmd = &cobra.Command{
+	Use:   "drain",
+	Short: "Finish current deals without taking new ones",
+	Long: `Finish current deals without taking new ones.
+
+Active orders are cancelled and forward deals run to their term. Tasks of spot
+deals receive SIGTERM and the deals are closed after the notice period
+configured on the worker. Use "sonmcli worker status" to watch the progress.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if _, err := worker.Drain(workerCtx, &sonm.Empty{}); err != nil {
+			return fmt.Errorf("failed to drain worker: %v", err)
+		}
+
+		showOk(cmd)
+		return nil
+	},
+}
+
+var workerUndrainCmd = &cobra.Command{
+	Use:   "undrain",
+	Short: "Take new deals again after drain",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if _, err := worker.Undrain(workerCtx, &sonm.Empty{}); err != nil {
+			return fmt.Errorf("failed to undrain worker: %v", err)
+		}
+
+		showOk(cmd)
+		return nil
+	},
+}
+
 var workerCurrentCmd = &cobra.Command{
 	Use:   "current",
 	Short: "Show current worker's addr",
@@ -14,6 +14,7 @@ func init() {
 		askPlanCreateCmd,
 		askPlanRemoveCmd,
 		askPlanPurgeCmd,
+		askPlanHistoryCmd,
 	)
 }
 
@@ -86,3 +87,18 @@ var askPlanPurgeCmd = &cobra.Command{
 		return nil
 	},
 }
+
+var askPlanHistoryCmd = &cobra.Command{
+	Use:   "history <plan_id>",
+	Short: "Show price changes of the plan",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		history, err := worker.AskPlanHistory(workerCtx, &sonm.ID{Id: args[0]})
+		if err != nil {
+			return fmt.Errorf("cannot get ask plan history: %v", err)
+		}
+
+		printAskPlanHistory(cmd, history)
+		return nil
+	},
+}
@@ -12,6 +12,7 @@ func init() {
 	benchmarkRootCmd.AddCommand(
 		workerRemoveBenchmarksCmd,
 		workerPurgeBenchmarksCmd,
+		workerBenchmarkReportCmd,
 	)
 }
 
@@ -52,3 +53,21 @@ var workerRemoveBenchmarksCmd = &cobra.Command{
 		return nil
 	},
 }
+
+var workerBenchmarkReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Show signed benchmark results of the worker",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		report, err := sonm.NewWorkerClient(workerConn).BenchmarkReport(workerCtx, &sonm.Empty{})
+		if err != nil {
+			return fmt.Errorf("failed to get benchmark report: %v", err)
+		}
+
+		if err := report.Verify(); err != nil {
+			return fmt.Errorf("failed to verify benchmark report: %v", err)
+		}
+
+		printBenchmarkReport(cmd, report)
+		return nil
+	},
+}
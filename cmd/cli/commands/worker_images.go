@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/sonm-io/core/proto"
+	"github.com/spf13/cobra"
+)
+
+var (
+	imagesPinFlag        bool
+	imagesCredentialFlag string
+)
+
+func init() {
+	imagesPrefetchCmd.Flags().BoolVar(&imagesPinFlag, "pin", false, "never remove the images from the image cache")
+	imagesPrefetchCmd.Flags().StringVar(&imagesCredentialFlag, "credential", "", "name of the registry credential stored on the node")
+
+	imagesRootCmd.AddCommand(
+		imagesListCmd,
+		imagesPrefetchCmd,
+	)
+}
+
+var imagesRootCmd = &cobra.Command{
+	Use:   "images",
+	Short: "Manage Worker's image cache",
+}
+
+var imagesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Show cached images",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		images, err := worker.Images(workerCtx, &sonm.Empty{})
+		if err != nil {
+			return fmt.Errorf("cannot get cached images: %v", err)
+		}
+
+		printImages(cmd, images)
+		return nil
+	},
+}
+
+var imagesPrefetchCmd = &cobra.Command{
+	Use:   "prefetch <image>...",
+	Short: "Pull images in advance",
+	Long: `Pull images in advance, so tasks using them start without waiting for the pull.
+
+Images are pulled in the background, use "sonmcli worker images list" to
+watch the progress.`,
+	Example: `  sonmcli worker images prefetch tensorflow/tensorflow:latest-gpu --pin
+  sonmcli worker images prefetch registry.example.com/app:1.0 --credential private`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		request := &sonm.PrefetchImagesRequest{
+			Images: args,
+			Pin:    imagesPinFlag,
+		}
+		if len(imagesCredentialFlag) != 0 {
+			request.Registry = &sonm.Registry{Credential: imagesCredentialFlag}
+		}
+
+		errs, err := worker.PrefetchImages(workerCtx, request)
+		if err != nil {
+			return fmt.Errorf("cannot prefetch images: %v", err)
+		}
+
+		printErrorByID(cmd, newTupleFromString(errs))
+		return nil
+	},
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/jinzhu/configor"
 	"github.com/sonm-io/core/accounts"
 	"github.com/sonm-io/core/insonmnia/auth"
+	"github.com/sonm-io/core/insonmnia/npp"
 	"github.com/sonm-io/core/util"
 	"gopkg.in/yaml.v2"
 )
@@ -25,6 +26,7 @@ type Config struct {
 	OutFormat  string             `required:"false" default:"" yaml:"output_format"`
 	WorkerAddr string             `yaml:"worker_eth_addr"`
 	NodeAddr   string             `yaml:"node_addr"`
+	NPP        npp.Config         `yaml:"npp,omitempty"`
 	path       string
 }
 
@@ -19,3 +19,17 @@ func LoadConfig(path string) (*sonm.TaskSpec, error) {
 
 	return cfg, nil
 }
+
+// LoadGroupConfig loads the task group specification from the given path.
+func LoadGroupConfig(path string) (*sonm.TaskGroupSpec, error) {
+	cfg := &sonm.TaskGroupSpec{}
+	if err := config.LoadWith(cfg, path, config.SnakeToLower); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
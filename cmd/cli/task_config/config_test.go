@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/sonm-io/core/proto"
 	"github.com/stretchr/testify/assert"
@@ -90,6 +91,27 @@ container:
 	require.NotNil(t, cfg)
 }
 
+func TestTaskStoredRegistryCredentials(t *testing.T) {
+	createTestConfigFile(`
+container:
+  image: user/image:v1
+registry:
+  credential: private
+push_registry:
+  username: name
+  registry_token: token
+`)
+	defer deleteTestConfigFile()
+
+	cfg, err := LoadConfig(testCfgPath)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	assert.Equal(t, "private", cfg.GetRegistry().GetCredential())
+	assert.Equal(t, "name", cfg.GetPushRegistry().GetUsername())
+	assert.Equal(t, "token", cfg.GetPushRegistry().GetRegistryToken())
+}
+
 func TestTaskMinimal(t *testing.T) {
 	createTestConfigFile(`
 container:
@@ -201,6 +223,69 @@ container:
 	assert.Equal(t, []string{"80:80"}, cfg.GetContainer().GetExpose())
 }
 
+func TestTaskConfigHealthcheck(t *testing.T) {
+	createTestConfigFile(`
+container:
+  image: user/image:v1
+  healthcheck:
+    http: http://localhost:8080/health
+    interval: 10s
+    timeout: 2s
+    retries: 3
+    start_period: 1m
+`)
+	defer deleteTestConfigFile()
+
+	cfg, err := LoadConfig(testCfgPath)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	healthcheck := cfg.GetContainer().GetHealthcheck()
+	require.NotNil(t, healthcheck)
+	assert.Equal(t, "http://localhost:8080/health", healthcheck.GetHttp())
+	assert.Equal(t, 10*time.Second, healthcheck.GetInterval().Unwrap())
+	assert.Equal(t, 2*time.Second, healthcheck.GetTimeout().Unwrap())
+	assert.Equal(t, uint32(3), healthcheck.GetRetries())
+	assert.Equal(t, time.Minute, healthcheck.GetStartPeriod().Unwrap())
+}
+
+func TestTaskConfigHealthcheckMultipleProbes(t *testing.T) {
+	createTestConfigFile(`
+container:
+  image: user/image:v1
+  healthcheck:
+    command: ["pg_isready"]
+    tcp: localhost:5432
+`)
+	defer deleteTestConfigFile()
+
+	_, err := LoadConfig(testCfgPath)
+	require.Error(t, err)
+}
+
+func TestTaskConfigCheckpoint(t *testing.T) {
+	createTestConfigFile(`
+container:
+  image: user/image:v1
+  checkpoint:
+    interval: 1h
+    repository: registry.user.io/checkpoints
+    volumes:
+    - data
+`)
+	defer deleteTestConfigFile()
+
+	cfg, err := LoadConfig(testCfgPath)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	checkpoint := cfg.GetContainer().GetCheckpoint()
+	require.NotNil(t, checkpoint)
+	assert.Equal(t, time.Hour, checkpoint.GetInterval().Unwrap())
+	assert.Equal(t, "registry.user.io/checkpoints", checkpoint.GetRepository())
+	assert.Equal(t, []string{"data"}, checkpoint.GetVolumes())
+}
+
 func TestLoadConfigFailsOnUnknownKeys(t *testing.T) {
 	createTestConfigFile(`
 duration: 240h
@@ -235,3 +320,51 @@ resources:
 	assert.Contains(t, err.Error(), "field throughput_in not found")
 	assert.Contains(t, err.Error(), "field throughput_out not found")
 }
+
+func TestTaskGroupConfig(t *testing.T) {
+	createTestConfigFile(`
+tasks:
+  - container:
+      image: user/app:v1
+      expose:
+        - 80:80
+      mounts:
+        - data:/data:rw
+  - container:
+      image: user/exporter:v1
+      commit_on_stop: true
+      mounts:
+        - data:/data:ro
+volumes:
+  data:
+    type: cifs
+    options:
+      share: samba-host.ru/share
+`)
+	defer deleteTestConfigFile()
+
+	cfg, err := LoadGroupConfig(testCfgPath)
+	require.NoError(t, err)
+	require.Len(t, cfg.GetTasks(), 2)
+
+	assert.Equal(t, "user/app:v1", cfg.GetTasks()[0].GetContainer().GetImage())
+	assert.Equal(t, "user/exporter:v1", cfg.GetTasks()[1].GetContainer().GetImage())
+	assert.True(t, cfg.GetTasks()[1].GetContainer().GetCommitOnStop())
+	assert.Contains(t, cfg.GetVolumes(), "data")
+}
+
+func TestTaskGroupConfigExposeOnlyFirst(t *testing.T) {
+	createTestConfigFile(`
+tasks:
+  - container:
+      image: user/app:v1
+  - container:
+      image: user/proxy:v1
+      expose:
+        - 80:80
+`)
+	defer deleteTestConfigFile()
+
+	_, err := LoadGroupConfig(testCfgPath)
+	require.Error(t, err)
+}
@@ -187,4 +187,13 @@ type ContainerBenchmarkResultsJSON struct {
 
 type Config struct {
 	URL string `yaml:"url"`
+	// Runners are names of benchmark runners in order of preference, the
+	// first one supporting the benchmark measures it. Defaults to
+	// DefaultRunners.
+	Runners []string     `yaml:"runners"`
+	Native  NativeConfig `yaml:"native"`
 }
+
+// DefaultRunners prefers containered benchmarks, measuring natively only
+// values of the host and benchmarks without images.
+var DefaultRunners = []string{DockerRunnerName, NativeRunnerName}
@@ -0,0 +1,358 @@
+package benchmarks
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/sonm-io/core/insonmnia/hardware/disk"
+	sonm "github.com/sonm-io/core/proto"
+)
+
+const (
+	// benchmark IDs that are measured by running workloads in the worker's
+	// process.
+	CPUSysbenchMulti  = 0
+	CPUSysbenchSingle = 1
+	RamBandwidth      = 15
+	StorageThroughput = 16
+
+	// sysbenchMaxPrime is the upper bound of primes calculated per event by
+	// the sysbench CPU test, which is used by the containered benchmark.
+	sysbenchMaxPrime = 10000
+	// ramBufferSize is the size of buffers copied to measure the memory
+	// bandwidth, large enough not to fit into CPU caches.
+	ramBufferSize = 64 << 20
+	// storageChunkSize is the size of writes measuring the storage
+	// throughput.
+	storageChunkSize = 4 << 20
+	// storageMaxSize limits the amount of data written to the storage.
+	storageMaxSize = 1 << 30
+)
+
+type NativeConfig struct {
+	// CPUDuration is the time CPU benchmarks are run for.
+	CPUDuration time.Duration `yaml:"cpu_duration" default:"10s"`
+	// RAMDuration is the time the memory bandwidth benchmark is run for.
+	RAMDuration time.Duration `yaml:"ram_duration" default:"10s"`
+	// StorageDuration is the time the storage throughput benchmark is run
+	// for, unless storageMaxSize bytes are written earlier.
+	StorageDuration time.Duration `yaml:"storage_duration" default:"10s"`
+	// StorageDir is a directory on the storage sold to tasks, where the
+	// storage throughput benchmark writes its data.
+	StorageDir string `yaml:"storage_dir" default:"/var/lib/docker"`
+	// NetworkDuration is the time network benchmarks are run for.
+	NetworkDuration time.Duration `yaml:"network_duration" default:"10s"`
+	// DownloadURL is requested to measure the incoming bandwidth. Incoming
+	// network benchmark is not supported natively without it.
+	DownloadURL string `yaml:"download_url"`
+	// UploadURL is posted to to measure the outgoing bandwidth. Outgoing
+	// network benchmark is not supported natively without it.
+	UploadURL string `yaml:"upload_url"`
+}
+
+// nativeRunner takes values of the host and measures CPU, memory bandwidth,
+// storage throughput and network bandwidth without containers.
+//
+// Measured values are not exactly comparable with ones measured by benchmark
+// images, hence they are recorded along with the name of the runner.
+type nativeRunner struct {
+	cfg NativeConfig
+}
+
+// NewNativeRunner constructs a runner measuring benchmarks in the worker's
+// process.
+func NewNativeRunner(cfg NativeConfig) Runner {
+	return &nativeRunner{cfg: cfg}
+}
+
+func (m *nativeRunner) Name() string {
+	return NativeRunnerName
+}
+
+func (m *nativeRunner) Supports(bench *sonm.Benchmark) bool {
+	switch bench.GetID() {
+	case CPUSysbenchMulti, CPUSysbenchSingle:
+		return bench.GetType() == sonm.DeviceType_DEV_CPU
+	case RamBandwidth:
+		return bench.GetType() == sonm.DeviceType_DEV_RAM
+	case StorageThroughput:
+		return bench.GetType() == sonm.DeviceType_DEV_STORAGE
+	case NetworkIn:
+		return len(m.cfg.DownloadURL) != 0
+	case NetworkOut:
+		return len(m.cfg.UploadURL) != 0
+	default:
+		return IsHostValue(bench)
+	}
+}
+
+func (m *nativeRunner) Run(ctx context.Context, bench *sonm.Benchmark, device interface{}) (uint64, error) {
+	switch bench.GetID() {
+	case CPUSysbenchMulti:
+		cpu, ok := device.(*sonm.CPUDevice)
+		if !ok {
+			return 0, fmt.Errorf("invalid device for CPU benchmark")
+		}
+		return cpuEventsPerSecond(ctx, int(cpu.GetCores()), m.cfg.CPUDuration), nil
+	case CPUSysbenchSingle:
+		return cpuEventsPerSecond(ctx, 1, m.cfg.CPUDuration), nil
+	case RamBandwidth:
+		return ramBandwidth(ctx, m.cfg.RAMDuration), nil
+	case StorageThroughput:
+		return m.storageThroughput(ctx)
+	case CPUCores:
+		cpu, ok := device.(*sonm.CPUDevice)
+		if !ok {
+			return 0, fmt.Errorf("invalid device for CPU cores benchmark")
+		}
+		return uint64(cpu.GetCores()), nil
+	case RamSize:
+		ram, ok := device.(*sonm.RAMDevice)
+		if !ok {
+			return 0, fmt.Errorf("invalid device for RAM size benchmark")
+		}
+		return ram.GetTotal(), nil
+	case StorageSize:
+		info, err := disk.FreeDiskSpace(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return info.FreeBytes, nil
+	case NetworkIn:
+		return m.download(ctx)
+	case NetworkOut:
+		return m.upload(ctx)
+	}
+
+	gpuDevice, ok := device.(*sonm.GPUDevice)
+	if !ok {
+		return 0, fmt.Errorf("invalid device for GPU benchmark %s(%d)", bench.GetCode(), bench.GetID())
+	}
+
+	switch bench.GetID() {
+	case GPUCount:
+		// GPU count is always 1 for each GPU device.
+		return 1, nil
+	case GPUMem:
+		return gpuDevice.GetMemory(), nil
+	case GPURadeon:
+		return boolValue(gpuDevice.VendorType() == sonm.GPUVendorType_RADEON), nil
+	case GPUNVidia:
+		return boolValue(gpuDevice.VendorType() == sonm.GPUVendorType_NVIDIA), nil
+	default:
+		return 0, fmt.Errorf("benchmark %s(%d) is not supported natively", bench.GetCode(), bench.GetID())
+	}
+}
+
+// download returns the incoming bandwidth in bits per second.
+func (m *nativeRunner) download(ctx context.Context) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.cfg.NetworkDuration)
+	defer cancel()
+
+	request, err := http.NewRequest(http.MethodGet, m.cfg.DownloadURL, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	startTime := time.Now()
+	response, err := http.DefaultClient.Do(request.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("failed to download: got %s status", response.Status)
+	}
+
+	size, err := io.Copy(ioutil.Discard, response.Body)
+	if err != nil && ctx.Err() == nil {
+		return 0, err
+	}
+
+	return bitsPerSecond(uint64(size), time.Since(startTime)), nil
+}
+
+// upload returns the outgoing bandwidth in bits per second.
+func (m *nativeRunner) upload(ctx context.Context) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.cfg.NetworkDuration)
+	defer cancel()
+
+	body := &countingReader{Reader: rand.Reader}
+	request, err := http.NewRequest(http.MethodPost, m.cfg.UploadURL, body)
+	if err != nil {
+		return 0, err
+	}
+
+	startTime := time.Now()
+	response, err := http.DefaultClient.Do(request.WithContext(ctx))
+	if err != nil && ctx.Err() == nil {
+		return 0, err
+	}
+	if response != nil {
+		response.Body.Close()
+	}
+
+	return bitsPerSecond(body.Size(), time.Since(startTime)), nil
+}
+
+// storageThroughput returns the sequential write throughput of the storage
+// in bytes per second, including the time to sync written data.
+func (m *nativeRunner) storageThroughput(ctx context.Context) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.cfg.StorageDuration)
+	defer cancel()
+
+	file, err := ioutil.TempFile(m.cfg.StorageDir, "sonm-benchmark-")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	// Random data is not compressed or deduplicated by the filesystem.
+	chunk := make([]byte, storageChunkSize)
+	if _, err := rand.Read(chunk); err != nil {
+		return 0, err
+	}
+
+	startTime := time.Now()
+	size := uint64(0)
+	for ctx.Err() == nil && size < storageMaxSize {
+		if _, err := file.Write(chunk); err != nil {
+			return 0, err
+		}
+		size += storageChunkSize
+	}
+
+	if err := file.Sync(); err != nil {
+		return 0, err
+	}
+
+	return bytesPerSecond(size, time.Since(startTime)), nil
+}
+
+type countingReader struct {
+	io.Reader
+	mu   sync.Mutex
+	size uint64
+}
+
+func (m *countingReader) Read(p []byte) (int, error) {
+	n, err := m.Reader.Read(p)
+
+	m.mu.Lock()
+	m.size += uint64(n)
+	m.mu.Unlock()
+
+	return n, err
+}
+
+func (m *countingReader) Size() uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.size
+}
+
+func bitsPerSecond(size uint64, duration time.Duration) uint64 {
+	if duration <= 0 {
+		return 0
+	}
+
+	return uint64(float64(size*8) / duration.Seconds())
+}
+
+func bytesPerSecond(size uint64, duration time.Duration) uint64 {
+	if duration <= 0 {
+		return 0
+	}
+
+	return uint64(float64(size) / duration.Seconds())
+}
+
+func boolValue(v bool) uint64 {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+// cpuEventsPerSecond runs the sysbench-like CPU test in the given number of
+// threads, returning the total number of events per second.
+func cpuEventsPerSecond(ctx context.Context, threads int, duration time.Duration) uint64 {
+	if threads < 1 {
+		threads = runtime.NumCPU()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	startTime := time.Now()
+	events := make(chan uint64, threads)
+	for i := 0; i < threads; i++ {
+		go func() {
+			count := uint64(0)
+			for ctx.Err() == nil {
+				cpuEvent(sysbenchMaxPrime)
+				count++
+			}
+			events <- count
+		}()
+	}
+
+	total := uint64(0)
+	for i := 0; i < threads; i++ {
+		total += <-events
+	}
+
+	return uint64(float64(total) / time.Since(startTime).Seconds())
+}
+
+// cpuEvent verifies numbers up to maxPrime by trial division, the same way
+// sysbench does, returning the number of primes found starting from 3.
+func cpuEvent(maxPrime uint64) uint64 {
+	count := uint64(0)
+	for c := uint64(3); c < maxPrime; c++ {
+		t := uint64(math.Sqrt(float64(c)))
+		l := uint64(2)
+		for ; l <= t; l++ {
+			if c%l == 0 {
+				break
+			}
+		}
+		if l > t {
+			count++
+		}
+	}
+
+	return count
+}
+
+// ramBandwidth returns the memory bandwidth in bytes per second, measured by
+// copying buffers. Both read and written bytes are counted, the same way the
+// STREAM copy test does.
+func ramBandwidth(ctx context.Context, duration time.Duration) uint64 {
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	src := make([]byte, ramBufferSize)
+	dst := make([]byte, ramBufferSize)
+
+	startTime := time.Now()
+	size := uint64(0)
+	for ctx.Err() == nil {
+		copy(dst, src)
+		size += 2 * ramBufferSize
+	}
+
+	return bytesPerSecond(size, time.Since(startTime))
+}
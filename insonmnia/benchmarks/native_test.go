@@ -0,0 +1,92 @@
+package benchmarks
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	sonm "github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCPUEvent(t *testing.T) {
+	// There are 1229 primes below 10000, sysbench starts from 3.
+	assert.Equal(t, uint64(1228), cpuEvent(sysbenchMaxPrime))
+}
+
+func TestNativeRunnerSupports(t *testing.T) {
+	runner := NewNativeRunner(NativeConfig{DownloadURL: "http://localhost/download"})
+
+	assert.True(t, runner.Supports(&sonm.Benchmark{ID: CPUSysbenchMulti, Type: sonm.DeviceType_DEV_CPU}))
+	assert.True(t, runner.Supports(&sonm.Benchmark{ID: RamBandwidth, Type: sonm.DeviceType_DEV_RAM}))
+	assert.True(t, runner.Supports(&sonm.Benchmark{ID: StorageThroughput, Type: sonm.DeviceType_DEV_STORAGE}))
+	assert.False(t, runner.Supports(&sonm.Benchmark{ID: RamBandwidth, Type: sonm.DeviceType_DEV_GPU}))
+	assert.True(t, runner.Supports(&sonm.Benchmark{ID: CPUCores, Type: sonm.DeviceType_DEV_CPU}))
+	assert.True(t, runner.Supports(&sonm.Benchmark{ID: RamSize, Type: sonm.DeviceType_DEV_RAM}))
+	assert.True(t, runner.Supports(&sonm.Benchmark{ID: NetworkIn, Type: sonm.DeviceType_DEV_NETWORK_IN}))
+	assert.False(t, runner.Supports(&sonm.Benchmark{ID: NetworkOut, Type: sonm.DeviceType_DEV_NETWORK_OUT}))
+	assert.False(t, runner.Supports(&sonm.Benchmark{ID: 9, Type: sonm.DeviceType_DEV_GPU, Image: "sonm/gpu-eth-hashrate"}))
+}
+
+func TestNativeRunnerHostValues(t *testing.T) {
+	runner := NewNativeRunner(NativeConfig{})
+
+	value, err := runner.Run(context.Background(), &sonm.Benchmark{ID: CPUCores}, &sonm.CPUDevice{Cores: 8})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8), value)
+
+	value, err = runner.Run(context.Background(), &sonm.Benchmark{ID: GPUMem}, &sonm.GPUDevice{Memory: 1 << 30})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1<<30), value)
+
+	_, err = runner.Run(context.Background(), &sonm.Benchmark{ID: RamSize}, &sonm.CPUDevice{Cores: 8})
+	assert.Error(t, err)
+}
+
+func TestNativeRunnerDownload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(make([]byte, 1<<20))
+	}))
+	defer server.Close()
+
+	runner := NewNativeRunner(NativeConfig{NetworkDuration: time.Second, DownloadURL: server.URL})
+
+	value, err := runner.Run(context.Background(), &sonm.Benchmark{ID: NetworkIn}, &sonm.Network{})
+	require.NoError(t, err)
+	assert.True(t, value > 0)
+}
+
+func TestNativeRunnerWorkloads(t *testing.T) {
+	dir, err := ioutil.TempDir("", "benchmarks")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	runner := NewNativeRunner(NativeConfig{
+		CPUDuration:     100 * time.Millisecond,
+		RAMDuration:     100 * time.Millisecond,
+		StorageDuration: 100 * time.Millisecond,
+		StorageDir:      dir,
+	})
+
+	value, err := runner.Run(context.Background(), &sonm.Benchmark{ID: CPUSysbenchSingle}, &sonm.CPUDevice{Cores: 1})
+	require.NoError(t, err)
+	assert.True(t, value > 0)
+
+	value, err = runner.Run(context.Background(), &sonm.Benchmark{ID: RamBandwidth}, &sonm.RAMDevice{})
+	require.NoError(t, err)
+	assert.True(t, value > 0)
+
+	value, err = runner.Run(context.Background(), &sonm.Benchmark{ID: StorageThroughput}, &sonm.StorageDevice{})
+	require.NoError(t, err)
+	assert.True(t, value > 0)
+
+	// Benchmark data is removed.
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
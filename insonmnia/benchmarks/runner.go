@@ -0,0 +1,45 @@
+package benchmarks
+
+import (
+	"context"
+	"fmt"
+
+	sonm "github.com/sonm-io/core/proto"
+)
+
+const (
+	DockerRunnerName = "docker"
+	NativeRunnerName = "native"
+)
+
+// Runner measures benchmark values of the worker's devices.
+type Runner interface {
+	// Name identifies the runner in benchmark results.
+	Name() string
+	// Supports reports whether the runner is able to measure the benchmark.
+	Supports(bench *sonm.Benchmark) bool
+	// Run measures the value of the benchmark on the given device.
+	Run(ctx context.Context, bench *sonm.Benchmark, device interface{}) (uint64, error)
+}
+
+// IsHostValue reports whether the benchmark value is taken from the host as
+// is rather than measured. Such values are never cached.
+func IsHostValue(bench *sonm.Benchmark) bool {
+	switch bench.GetID() {
+	case CPUCores, RamSize, StorageSize, GPUCount, GPUMem, GPUNVidia, GPURadeon:
+		return true
+	default:
+		return false
+	}
+}
+
+// SelectRunner returns the first of runners supporting the benchmark.
+func SelectRunner(runners []Runner, bench *sonm.Benchmark) (Runner, error) {
+	for _, runner := range runners {
+		if runner.Supports(bench) {
+			return runner, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no runner supports benchmark %s(%d)", bench.GetCode(), bench.GetID())
+}
@@ -33,6 +33,7 @@ type Config struct {
 	Predictor         *optimus.PredictorConfig `yaml:"predictor"`
 	Debug             *debug.Config            `yaml:"debug"`
 	SSH               *ssh.ProxyServerConfig   `yaml:"ssh"`
+	Registry          registryConfig           `yaml:"registry_credentials"`
 }
 
 // NewConfig loads localNode config from given .yaml file
@@ -0,0 +1,290 @@
+package node
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sonm-io/core/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type registryConfig struct {
+	// Path to the file with encrypted registry credentials.
+	Path string `yaml:"path" default:"./registry_credentials"`
+}
+
+// registryCredentials is a file storage of named registry credentials,
+// encrypted with a key derived from the node's Ethereum key.
+type registryCredentials struct {
+	mu   sync.Mutex
+	path string
+	aead cipher.AEAD
+}
+
+func newRegistryCredentials(cfg registryConfig, key *ecdsa.PrivateKey) (*registryCredentials, error) {
+	if len(cfg.Path) == 0 {
+		return nil, errors.New("registry credentials path is required")
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte("sonm.registry_credentials"))
+	hash.Write(key.D.Bytes())
+
+	block, err := aes.NewCipher(hash.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &registryCredentials{
+		path: cfg.Path,
+		aead: aead,
+	}, nil
+}
+
+// List returns stored credentials sorted by name with secrets omitted.
+func (m *registryCredentials) List() ([]*sonm.RegistryCredential, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	credentials, err := m.load()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*sonm.RegistryCredential, 0, len(credentials))
+	for _, credential := range credentials {
+		result = append(result, &sonm.RegistryCredential{
+			Name:      credential.GetName(),
+			Username:  credential.GetUsername(),
+			ExpiresAt: credential.GetExpiresAt(),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetName() < result[j].GetName()
+	})
+
+	return result, nil
+}
+
+func (m *registryCredentials) Set(credential *sonm.RegistryCredential) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	credentials, err := m.load()
+	if err != nil {
+		return err
+	}
+
+	credentials[credential.GetName()] = credential
+
+	return m.save(credentials)
+}
+
+func (m *registryCredentials) Remove(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	credentials, err := m.load()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := credentials[name]; !ok {
+		return status.Errorf(codes.NotFound, "registry credential %s not found", name)
+	}
+
+	delete(credentials, name)
+
+	return m.save(credentials)
+}
+
+// Resolve replaces the credential reference of the given registry settings
+// with the stored secrets. Registry settings without references are returned
+// as is.
+func (m *registryCredentials) Resolve(registry *sonm.Registry, now time.Time) (*sonm.Registry, error) {
+	name := registry.GetCredential()
+	if len(name) == 0 {
+		return registry, nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	credentials, err := m.load()
+	if err != nil {
+		return nil, err
+	}
+
+	credential, ok := credentials[name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "registry credential %s not found", name)
+	}
+
+	if expiresAt := credential.GetExpiresAt(); expiresAt != nil && !now.Before(expiresAt.Unix()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "registry token of %s credential has expired", name)
+	}
+
+	return credential.Registry(), nil
+}
+
+// ResolveSpec resolves credential references of both pull and push registry
+// settings of the given task spec in place.
+func (m *registryCredentials) ResolveSpec(spec *sonm.TaskSpec, now time.Time) error {
+	if spec == nil {
+		return nil
+	}
+
+	registry, err := m.Resolve(spec.GetRegistry(), now)
+	if err != nil {
+		return err
+	}
+
+	pushRegistry, err := m.Resolve(spec.GetPushRegistry(), now)
+	if err != nil {
+		return err
+	}
+
+	spec.Registry = registry
+	spec.PushRegistry = pushRegistry
+
+	return nil
+}
+
+// ResolveRequest resolves credential references of task specs and registry
+// settings contained in the given worker request, if any.
+func (m *registryCredentials) ResolveRequest(request interface{}, now time.Time) error {
+	switch request := request.(type) {
+	case *sonm.StartTaskRequest:
+		return m.ResolveSpec(request.GetSpec(), now)
+	case *sonm.RestoreTaskRequest:
+		return m.ResolveSpec(request.GetSpec(), now)
+	case *sonm.StartTaskGroupRequest:
+		for _, spec := range request.GetSpec().GetTasks() {
+			if err := m.ResolveSpec(spec, now); err != nil {
+				return err
+			}
+		}
+	case *sonm.PrefetchImagesRequest:
+		registry, err := m.Resolve(request.GetRegistry(), now)
+		if err != nil {
+			return err
+		}
+		request.Registry = registry
+	}
+
+	return nil
+}
+
+func (m *registryCredentials) load() (map[string]*sonm.RegistryCredential, error) {
+	credentials := map[string]*sonm.RegistryCredential{}
+
+	data, err := ioutil.ReadFile(m.path)
+	if os.IsNotExist(err) {
+		return credentials, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read registry credentials: %v", err)
+	}
+
+	nonceSize := m.aead.NonceSize()
+	if len(data) < nonceSize {
+		return nil, errors.New("failed to decrypt registry credentials: file is too short")
+	}
+
+	plaintext, err := m.aead.Open(nil, data[:nonceSize], data[nonceSize:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt registry credentials: %v", err)
+	}
+
+	if err := json.Unmarshal(plaintext, &credentials); err != nil {
+		return nil, fmt.Errorf("failed to decode registry credentials: %v", err)
+	}
+
+	return credentials, nil
+}
+
+func (m *registryCredentials) save(credentials map[string]*sonm.RegistryCredential) error {
+	plaintext, err := json.Marshal(credentials)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, m.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+
+	data := m.aead.Seal(nonce, nonce, plaintext, nil)
+
+	if err := os.MkdirAll(filepath.Dir(m.path), 0700); err != nil {
+		return fmt.Errorf("failed to save registry credentials: %v", err)
+	}
+
+	tmpPath := m.path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return fmt.Errorf("failed to save registry credentials: %v", err)
+	}
+
+	if err := os.Rename(tmpPath, m.path); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to save registry credentials: %v", err)
+	}
+
+	return nil
+}
+
+type registryCredentialsAPI struct {
+	remotes *remoteOptions
+}
+
+func newRegistryCredentialsAPI(opts *remoteOptions) sonm.RegistryCredentialsServer {
+	return &registryCredentialsAPI{
+		remotes: opts,
+	}
+}
+
+func (m *registryCredentialsAPI) List(ctx context.Context, request *sonm.Empty) (*sonm.RegistryCredentialsReply, error) {
+	credentials, err := m.remotes.credentials.List()
+	if err != nil {
+		return nil, err
+	}
+
+	return &sonm.RegistryCredentialsReply{Credentials: credentials}, nil
+}
+
+func (m *registryCredentialsAPI) Set(ctx context.Context, request *sonm.RegistryCredential) (*sonm.Empty, error) {
+	if err := m.remotes.credentials.Set(request); err != nil {
+		return nil, err
+	}
+
+	return &sonm.Empty{}, nil
+}
+
+func (m *registryCredentialsAPI) Remove(ctx context.Context, request *sonm.ID) (*sonm.Empty, error) {
+	if err := m.remotes.credentials.Remove(request.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &sonm.Empty{}, nil
+}
@@ -0,0 +1,66 @@
+package node
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sonm-registry")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg := registryConfig{Path: filepath.Join(dir, "credentials")}
+	key := newTestKey(t)
+
+	credentials, err := newRegistryCredentials(cfg, key)
+	require.NoError(t, err)
+
+	now := time.Now()
+	require.NoError(t, credentials.Set(&sonm.RegistryCredential{Name: "private", Username: "user", Password: "secret"}))
+	require.NoError(t, credentials.Set(&sonm.RegistryCredential{Name: "gcr", RegistryToken: "token", ExpiresAt: sonm.NewTimestamp(now.Add(time.Hour))}))
+
+	data, err := ioutil.ReadFile(cfg.Path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secret")
+
+	list, err := credentials.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "gcr", list[0].GetName())
+	assert.Equal(t, "private", list[1].GetName())
+	assert.Equal(t, "user", list[1].GetUsername())
+	assert.Empty(t, list[1].GetPassword())
+
+	spec := &sonm.TaskSpec{
+		Registry:     &sonm.Registry{Credential: "private"},
+		PushRegistry: &sonm.Registry{Credential: "gcr"},
+	}
+	require.NoError(t, credentials.ResolveRequest(&sonm.StartTaskRequest{Spec: spec}, now))
+	assert.Equal(t, &sonm.Registry{Username: "user", Password: "secret"}, spec.GetRegistry())
+	assert.Equal(t, &sonm.Registry{RegistryToken: "token"}, spec.GetPushRegistry())
+
+	prefetch := &sonm.PrefetchImagesRequest{Images: []string{"alpine"}, Registry: &sonm.Registry{Credential: "private"}}
+	require.NoError(t, credentials.ResolveRequest(prefetch, now))
+	assert.Equal(t, &sonm.Registry{Username: "user", Password: "secret"}, prefetch.GetRegistry())
+
+	_, err = credentials.Resolve(&sonm.Registry{Credential: "gcr"}, now.Add(2*time.Hour))
+	assert.Error(t, err)
+	_, err = credentials.Resolve(&sonm.Registry{Credential: "unknown"}, now)
+	assert.Error(t, err)
+
+	require.NoError(t, credentials.Remove("gcr"))
+	assert.Error(t, credentials.Remove("gcr"))
+
+	other, err := newRegistryCredentials(cfg, newTestKey(t))
+	require.NoError(t, err)
+	_, err = other.List()
+	assert.Error(t, err)
+}
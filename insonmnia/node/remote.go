@@ -38,6 +38,7 @@ type remoteOptions struct {
 
 	benchList    benchmarks.BenchList
 	orderMatcher matcher.Matcher
+	credentials  *registryCredentials
 
 	log *zap.SugaredLogger
 }
@@ -154,6 +155,11 @@ func newRemoteOptions(ctx context.Context, cfg *Config, key *ecdsa.PrivateKey, c
 		orderMatcher = matcher.NewDisabledMatcher()
 	}
 
+	registry, err := newRegistryCredentials(cfg.Registry, key)
+	if err != nil {
+		return nil, err
+	}
+
 	return &remoteOptions{
 		cfg:           cfg,
 		key:           key,
@@ -163,6 +169,7 @@ func newRemoteOptions(ctx context.Context, cfg *Config, key *ecdsa.PrivateKey, c
 		workerCreator: workerFactory,
 		benchList:     benchList,
 		orderMatcher:  orderMatcher,
+		credentials:   registry,
 		log:           log,
 	}, nil
 }
@@ -163,7 +163,7 @@ func newServer(cfg nodeConfig, services Services, options ...ServerOption) (*Ser
 	if opts.allowGRPC {
 		options := append(opts.optionsGRPC, []xgrpc.ServerOption{
 			xgrpc.DefaultTraceInterceptor(),
-			xgrpc.RequestLogInterceptor([]string{"PushTask", "PullTask"}),
+			xgrpc.RequestLogInterceptor([]string{"PushTask", "PullTask", "ExecTask", "CopyToTask", "CopyFromTask"}),
 			xgrpc.VerifyInterceptor(),
 			xgrpc.UnaryServerInterceptor(services.Interceptor()),
 			xgrpc.StreamServerInterceptor(services.StreamInterceptor()),
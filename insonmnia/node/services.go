@@ -20,6 +20,7 @@ type services struct {
 	blacklist      sonm.BlacklistServer
 	profile        sonm.ProfilesServer
 	monitoring     sonm.MonitoringServer
+	registry       sonm.RegistryCredentialsServer
 	orderPredictor *optimus.PredictorService
 }
 
@@ -34,6 +35,7 @@ func newServices(options *remoteOptions) *services {
 		blacklist:      newBlacklistAPI(options),
 		profile:        newProfileAPI(options),
 		monitoring:     newMonitoringAPI(options),
+		registry:       newRegistryCredentialsAPI(options),
 		orderPredictor: optimus.NewPredictorService(options.cfg.Predictor, options.eth, options.benchList, options.dwh, options.log),
 	}
 }
@@ -55,6 +57,7 @@ func (m *services) RegisterGRPC(server *grpc.Server) error {
 	sonm.RegisterBlacklistServer(server, m.blacklist)
 	sonm.RegisterProfilesServer(server, m.profile)
 	sonm.RegisterMonitoringServer(server, m.monitoring)
+	sonm.RegisterRegistryCredentialsServer(server, m.registry)
 	if m.orderPredictor != nil {
 		sonm.RegisterOrderPredictorServer(server, m.orderPredictor)
 	}
@@ -97,6 +100,9 @@ func (m *services) RegisterREST(server *rest.Server) error {
 	if err := server.RegisterService((*sonm.MonitoringServer)(nil), m.monitoring); err != nil {
 		return err
 	}
+	if err := server.RegisterService((*sonm.RegistryCredentialsServer)(nil), m.registry); err != nil {
+		return err
+	}
 	if err := server.RegisterService((*sonm.DWHServer)(nil), m.intercepted); err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 
 	"github.com/sonm-io/core/proto"
 	"go.uber.org/zap"
@@ -55,6 +56,10 @@ func (t *tasksAPI) List(ctx context.Context, req *sonm.TaskListRequest) (*sonm.T
 }
 
 func (t *tasksAPI) Start(ctx context.Context, req *sonm.StartTaskRequest) (*sonm.StartTaskReply, error) {
+	if err := t.remotes.credentials.ResolveSpec(req.GetSpec(), time.Now()); err != nil {
+		return nil, err
+	}
+
 	dealID := req.GetDealID().Unwrap().String()
 	worker, cc, err := t.remotes.getWorkerClientForDeal(ctx, dealID)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"io"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/sonm-io/core/insonmnia/auth"
@@ -81,9 +82,23 @@ func (m *interceptedAPI) intercept(ctx context.Context, req interface{}, info *g
 	serverName := strings.Split(info.FullMethod, "/")[1]
 	switch serverName {
 	case "sonm.Worker":
+		// Stored registry credentials are resolved here, so they never
+		// appear in task specs sent by clients.
+		if err := m.remotes.credentials.ResolveRequest(req, time.Now()); err != nil {
+			return nil, err
+		}
 		ctx = util.ForwardMetadata(ctx)
-		cli, closer, err = m.getWorkerClient(ctx)
+		if info.FullMethod == "/sonm.Worker/BenchmarkReport" {
+			// Benchmark reports are requested before deals, so the worker is
+			// addressed the same way as for management.
+			cli, closer, err = m.getWorkerManagementClient(ctx)
+		} else {
+			cli, closer, err = m.getWorkerClient(ctx)
+		}
 	case "sonm.WorkerManagement":
+		if err := m.remotes.credentials.ResolveRequest(req, time.Now()); err != nil {
+			return nil, err
+		}
 		ctx = util.ForwardMetadata(ctx)
 		cli, closer, err = m.getWorkerManagementClient(ctx)
 	case "sonm.DWH":
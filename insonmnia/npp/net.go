@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strconv"
 	"syscall"
 
 	"github.com/libp2p/go-reuseport"
@@ -37,6 +38,9 @@ func DialContext(ctx context.Context, network, laddr, raddr string) (net.Conn, e
 
 // PrivateAddrs collects and returns private addresses of a network interfaces
 // the socket bind on.
+//
+// Sockets bound on the unspecified IPv6 address are assumed to be IPv6-only,
+// so only IPv6 addresses are collected for them.
 func privateAddrs(addr net.Addr) ([]net.Addr, error) {
 	ip, port, err := netutil.SplitHostPort(addr.String())
 	if err != nil {
@@ -56,8 +60,12 @@ func privateAddrs(addr net.Addr) ([]net.Addr, error) {
 	}
 
 	var addrs []net.Addr
-	for _, ip := range ips {
-		addr, err := net.ResolveTCPAddr(protocol, fmt.Sprintf("%s:%d", ip, uint16(port)))
+	for _, availableIP := range ips {
+		if !netutil.IsIPv4(ip) && netutil.IsIPv4(availableIP) {
+			continue
+		}
+
+		addr, err := net.ResolveTCPAddr(protocol, net.JoinHostPort(availableIP.String(), strconv.Itoa(int(port))))
 		if err != nil {
 			return nil, err
 		}
@@ -67,3 +75,30 @@ func privateAddrs(addr net.Addr) ([]net.Addr, error) {
 
 	return addrs, nil
 }
+
+// ListenIPv6 listens on the unspecified IPv6 address with the port of the
+// given IPv4 address, allowing to reuse it.
+//
+// Returns an error if the given address is not an IPv4 one, since IPv6
+// sockets are used for punching directly in that case.
+func listenIPv6(addr net.Addr) (net.Listener, error) {
+	ip, port, err := netutil.SplitHostPort(addr.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if !netutil.IsIPv4(ip) {
+		return nil, fmt.Errorf("address %s is not an IPv4 one", addr.String())
+	}
+
+	return reuseport.Listen("tcp6", net.JoinHostPort(net.IPv6unspecified.String(), strconv.Itoa(int(port))))
+}
+
+func isIPv6Addr(addr net.Addr) bool {
+	ip, err := netutil.ExtractHost(addr.String())
+	if err != nil {
+		return false
+	}
+
+	return !netutil.IsIPv4(ip)
+}
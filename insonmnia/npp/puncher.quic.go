@@ -52,7 +52,7 @@ func newNATPuncherQUICBase(rendezvousClient *rendezvousClientQUIC, tlsConfig *tl
 		rendezvousClient:      rendezvousClient,
 		tlsConfig:             tlsConfig,
 		protocol:              protocol,
-		listener:              newChanListener(&xnet.BackPressureListener{Listener: &xnet.QUICListener{Listener: listener}, Log: log.Desugar()}, connectionTxRx),
+		listener:              newChanListener(connectionTxRx, &xnet.BackPressureListener{Listener: &xnet.QUICListener{Listener: listener}, Log: log.Desugar()}),
 		passiveConnectionTxRx: connectionTxRx,
 		log:                   log.With(zap.String("protocol", protocol)),
 	}
@@ -46,20 +46,24 @@ func (m *clientConnectionWatcher) OnMoreConnections(conn net.Conn) {
 // ChanListener wraps the "net.Listener" providing an ability to accept
 // connections asynchronously and push them into the specified channel.
 //
-// It takes the ownership over the given Listener, so it shouldn't be closed.
-// However, this listener must be closed explicitly using "Close" method.
+// Several listeners can be wrapped at once, for example IPv4 and IPv6 ones,
+// pushing connections into the same channel.
+//
+// It takes the ownership over the given Listeners, so they shouldn't be
+// closed. However, this listener must be closed explicitly using "Close"
+// method.
 // The given channel will be closed when there isn't possible to accept
 // connections anymore, i.e. either after critical error or calling "Close".
 type chanListener struct {
-	listener       net.Listener
+	listeners      []net.Listener
 	connectionTxRx chan<- connResult
 }
 
 // NewChanListener constructs a new channel listener and runs it accepting
 // loop.
-func newChanListener(listener net.Listener, connectionTxRx chan<- connResult) *chanListener {
+func newChanListener(connectionTxRx chan<- connResult, listeners ...net.Listener) *chanListener {
 	m := &chanListener{
-		listener:       listener,
+		listeners:      listeners,
 		connectionTxRx: connectionTxRx,
 	}
 
@@ -72,35 +76,64 @@ func newChanListener(listener net.Listener, connectionTxRx chan<- connResult) *c
 func (m *chanListener) processEvents() {
 	defer close(m.connectionTxRx)
 
-	for {
-		conn, err := m.listener.Accept()
-		m.connectionTxRx <- newConnResult(conn, err)
+	wg := sync.WaitGroup{}
+	wg.Add(len(m.listeners))
 
-		if err != nil {
-			return
-		}
+	for _, listener := range m.listeners {
+		listener := listener
+
+		go func() {
+			defer wg.Done()
+
+			for {
+				conn, err := listener.Accept()
+				m.connectionTxRx <- newConnResult(conn, err)
+
+				if err != nil {
+					return
+				}
+			}
+		}()
 	}
+
+	wg.Wait()
 }
 
 // PrivateAddrs collects and returns private addresses of a network interfaces
-// the listening socket bind on.
+// the listening sockets bind on.
 // They will be used for connection establishment when both peers are located
 // in the same private network.
+//
+// For IPv6 sockets global addresses are collected as well, which allows to
+// establish direct connections to hosts that are reachable over IPv6 but
+// have their IPv4 connectivity NAT'ed.
 func (m *chanListener) PrivateAddrs() ([]*sonm.Addr, error) {
-	addrs, err := privateAddrs(m.listener.Addr())
-	if err != nil {
-		return nil, err
+	var addrs []net.Addr
+	for _, listener := range m.listeners {
+		listenerAddrs, err := privateAddrs(listener.Addr())
+		if err != nil {
+			return nil, err
+		}
+
+		addrs = append(addrs, listenerAddrs...)
 	}
 
 	return sonm.TransformNetAddrs(addrs)
 }
 
-// Close closes this channel listener by closing the underlying listener.
+// Close closes this channel listener by closing the underlying listeners.
 //
 // As a result - the processing loop will be broken and the channel will be
 // closed.
 func (m *chanListener) Close() error {
-	return m.listener.Close()
+	errs := multierror.NewMultiError()
+	for _, listener := range m.listeners {
+		if err := listener.Close(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return errs.ErrorOrNil()
 }
 
 type natPuncherTCPBase struct {
@@ -114,6 +147,9 @@ type natPuncherTCPBase struct {
 	// whether the connection arrives to the dialing socket or to the listening
 	// one.
 	listener *chanListener
+	// LocalAddr6 is the local address of the IPv6 listener, which is used
+	// when punching IPv6 candidates. Empty when no such listener exists.
+	localAddr6 string
 	// RendezvousClient is a client to the Rendezvous server.
 	// It is managed by this puncher and is closed during executing "Close"
 	// method.
@@ -144,11 +180,26 @@ func newNatPuncherTCPBase(rendezvousClient *rendezvousClient, protocol string, l
 		return nil, err
 	}
 
+	listeners := []net.Listener{&xnet.BackPressureListener{Listener: listener, Log: log.Desugar()}}
+
+	// When the Rendezvous is reached over IPv4 we also listen on the same port
+	// over IPv6 to announce global IPv6 addresses, which in most cases do not
+	// require NAT traversal at all. This is optional, since the host may have
+	// no IPv6 connectivity.
+	localAddr6 := ""
+	if listener6, err := listenIPv6(rendezvousClient.LocalAddr()); err == nil {
+		listeners = append(listeners, &xnet.BackPressureListener{Listener: listener6, Log: log.Desugar()})
+		localAddr6 = listener6.Addr().String()
+	} else {
+		log.Debugf("failed to listen on IPv6, skipping: %v", err)
+	}
+
 	connectionTxRx := make(chan connResult, 64)
 
 	m := &natPuncherTCPBase{
 		protocol:              protocol,
-		listener:              newChanListener(&xnet.BackPressureListener{Listener: listener, Log: log.Desugar()}, connectionTxRx),
+		listener:              newChanListener(connectionTxRx, listeners...),
+		localAddr6:            localAddr6,
 		rendezvousClient:      rendezvousClient,
 		passiveConnectionTxRx: connectionTxRx,
 		maxPunchAttempts:      3,
@@ -174,6 +225,13 @@ func (m *natPuncherTCPBase) punchAddr(ctx context.Context, addr *sonm.Addr) (net
 		return nil, err
 	}
 
+	// Candidates of the other IP family can't be dialed from the socket
+	// connected to the Rendezvous.
+	localAddr := m.rendezvousClient.LocalAddr().String()
+	if addr.IsIPv6() != isIPv6Addr(m.rendezvousClient.LocalAddr()) {
+		localAddr = m.localAddr6
+	}
+
 	// Tune attempts number to prevent "nil, nil" situations.
 	maxPunchAttempts := 1
 	if m.maxPunchAttempts > 0 {
@@ -182,7 +240,7 @@ func (m *natPuncherTCPBase) punchAddr(ctx context.Context, addr *sonm.Addr) (net
 
 	errs := multierror.NewMultiError()
 	for i := 0; i < maxPunchAttempts; i++ {
-		conn, err := DialContext(ctx, protocol, m.rendezvousClient.LocalAddr().String(), remoteAddr.String())
+		conn, err := DialContext(ctx, protocol, localAddr, remoteAddr.String())
 		if err != nil {
 			errs = multierror.AppendUnique(errs, err)
 			continue
@@ -3,6 +3,7 @@ package rendezvous
 import (
 	"github.com/pborman/uuid"
 	"github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util/netutil"
 	"google.golang.org/grpc/peer"
 )
 
@@ -16,6 +17,24 @@ func NewPeer(peerInfo peer.Peer, privateAddrs []*sonm.Addr) Peer {
 	return Peer{peerInfo, NewPeerID(), privateAddrs}
 }
 
+// HasIPv6 checks whether the peer is connected over IPv6 or has announced at
+// least one IPv6 address.
+func (m Peer) HasIPv6() bool {
+	if m.Addr != nil {
+		if ip, err := netutil.ExtractHost(m.Addr.String()); err == nil && ip != nil && !netutil.IsIPv4(ip) {
+			return true
+		}
+	}
+
+	for _, addr := range m.privateAddrs {
+		if addr.IsIPv6() {
+			return true
+		}
+	}
+
+	return false
+}
+
 // PeerID represents an unique peer id generated at the time of either
 // publishing or resolving another peer.
 //
@@ -27,7 +27,10 @@
 // Clients should specify the desired protocol and ID for resolution.
 //
 // Currently only TCP endpoints exchanging is supported.
-// TODO: When resolving it's necessary to track also IP version. For example to be able not to return IPv6 when connecting socket is IPv4.
+//
+// Peers may announce global IPv6 addresses along with private ones. These
+// are provided only to peers that have announced IPv6 addresses too, since
+// IPv4-only peers won't be able to reach them anyway.
 
 package rendezvous
 
@@ -255,7 +258,7 @@ func (m *Server) Resolve(ctx context.Context, request *sonm.ConnectRequest) (*so
 			zap.Stringer("public_addr", p.Addr),
 			zap.Any("private_addrs", p.privateAddrs),
 		)
-		return m.newReply(p)
+		return m.newReplyFor(p, peerHandle)
 	}
 }
 
@@ -309,7 +312,7 @@ func (m *Server) Publish(ctx context.Context, request *sonm.PublishRequest) (*so
 			zap.Stringer("public_addr", p.Addr),
 			zap.Any("private_addrs", p.privateAddrs),
 		)
-		return m.newReply(p)
+		return m.newReplyFor(p, peerHandle)
 	}
 }
 
@@ -415,6 +418,32 @@ func (m *Server) newReply(peer Peer) (*sonm.RendezvousReply, error) {
 	}, nil
 }
 
+// newReplyFor constructs a reply describing the peer to the requesting one,
+// omitting addresses the requester is unlikely able to reach.
+func (m *Server) newReplyFor(peer Peer, requester Peer) (*sonm.RendezvousReply, error) {
+	reply, err := m.newReply(peer)
+	if err != nil {
+		return nil, err
+	}
+
+	if !requester.HasIPv6() {
+		reply.PrivateAddrs = filterIPv4Addrs(reply.PrivateAddrs)
+	}
+
+	return reply, nil
+}
+
+func filterIPv4Addrs(addrs []*sonm.Addr) []*sonm.Addr {
+	var filtered []*sonm.Addr
+	for _, addr := range addrs {
+		if !addr.IsIPv6() {
+			filtered = append(filtered, addr)
+		}
+	}
+
+	return filtered
+}
+
 func (m *Server) Info(ctx context.Context, request *sonm.Empty) (*sonm.RendezvousState, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -0,0 +1,275 @@
+// Package portforward implements tunnelling of TCP connections to ports
+// exposed by tasks, which allows to reach them through NPP even when the
+// worker has no public IP addresses.
+//
+// Tunnelled connections are secured with TLS, both sides are authenticated
+// by ETH addresses from their certificates. Each connection then starts
+// with a request frame specifying the task and its port, which the server
+// replies to with a response frame. After a successful response the
+// connection is spliced with the task's port.
+package portforward
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sonm-io/core/insonmnia/auth"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// HandshakeTimeout limits the time both sides may spend exchanging
+	// request and response frames.
+	HandshakeTimeout = 30 * time.Second
+	dialTimeout      = 10 * time.Second
+	maxFrameSize     = 4096
+)
+
+type request struct {
+	TaskID string `json:"task_id"`
+	Port   string `json:"port"`
+}
+
+type response struct {
+	Error string `json:"error,omitempty"`
+}
+
+func sendFrame(wr io.Writer, message interface{}) error {
+	frame, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	if len(frame) > maxFrameSize {
+		return fmt.Errorf("message too large")
+	}
+
+	buf := make([]byte, 2+len(frame))
+	binary.BigEndian.PutUint16(buf, uint16(len(frame)))
+	copy(buf[2:], frame)
+
+	_, err = wr.Write(buf)
+	return err
+}
+
+func recvFrame(rd io.Reader, message interface{}) error {
+	var size uint16
+	if err := binary.Read(rd, binary.BigEndian, &size); err != nil {
+		return err
+	}
+
+	if size > maxFrameSize {
+		return fmt.Errorf("message too large")
+	}
+
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(rd, buf); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(buf, message)
+}
+
+// NormalizePort appends the default "tcp" protocol to ports specified
+// without one, matching the format of exposed ports in task port maps.
+func NormalizePort(port string) string {
+	if strings.Contains(port, "/") {
+		return port
+	}
+
+	return port + "/tcp"
+}
+
+// Handshake authenticates the server with the given ETH address on the
+// other side of the connection and requests it to forward the connection to
+// the given port of the task.
+//
+// The returned connection can be used for communicating with the task's
+// port once this function succeeds.
+func Handshake(ctx context.Context, conn net.Conn, credentials credentials.TransportCredentials, server common.Address, taskID, port string) (net.Conn, error) {
+	if err := conn.SetDeadline(time.Now().Add(HandshakeTimeout)); err != nil {
+		return nil, err
+	}
+
+	conn, _, err := auth.NewWalletAuthenticator(credentials, server).ClientHandshake(ctx, server.Hex(), conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate port forwarding server: %v", err)
+	}
+
+	if err := sendFrame(conn, &request{TaskID: taskID, Port: NormalizePort(port)}); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to send port forwarding request: %v", err)
+	}
+
+	resp := &response{}
+	if err := recvFrame(conn, resp); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to receive port forwarding response: %v", err)
+	}
+
+	if len(resp.Error) != 0 {
+		conn.Close()
+		return nil, errors.New(resp.Error)
+	}
+
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// Resolver returns the address the given port of the task is reachable at
+// from the server, failing if the peer with the given ETH address is not
+// allowed to connect to the task.
+type Resolver func(peer common.Address, taskID, port string) (string, error)
+
+// Server accepts tunnelled connections and forwards them to tasks.
+type Server struct {
+	credentials credentials.TransportCredentials
+	resolve     Resolver
+	log         *zap.SugaredLogger
+}
+
+// NewServer constructs a new port forwarding server, which authenticates
+// peers using the given credentials and uses the given resolver to find
+// tasks' ports.
+func NewServer(credentials credentials.TransportCredentials, resolve Resolver, log *zap.SugaredLogger) *Server {
+	return &Server{
+		credentials: credentials,
+		resolve:     resolve,
+		log:         log,
+	}
+}
+
+// Serve accepts connections on the listener until it is closed.
+func (m *Server) Serve(listener net.Listener) error {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+
+		go m.serveConn(conn)
+	}
+}
+
+func (m *Server) serveConn(conn net.Conn) {
+	defer conn.Close()
+
+	log := m.log.With(zap.Stringer("remote_addr", conn.RemoteAddr()))
+
+	if err := conn.SetDeadline(time.Now().Add(HandshakeTimeout)); err != nil {
+		log.Warnw("failed to set handshake deadline", zap.Error(err))
+		return
+	}
+
+	conn, authInfo, err := m.credentials.ServerHandshake(conn)
+	if err != nil {
+		log.Warnw("failed to authenticate peer", zap.Error(err))
+		return
+	}
+	defer conn.Close()
+
+	peer, err := peerWallet(authInfo)
+	if err != nil {
+		log.Warnw("failed to authenticate peer", zap.Error(err))
+		return
+	}
+
+	log = log.With(zap.Stringer("peer", peer))
+
+	req := &request{}
+	if err := recvFrame(conn, req); err != nil {
+		log.Warnw("failed to receive port forwarding request", zap.Error(err))
+		return
+	}
+
+	log = log.With(zap.String("task_id", req.TaskID), zap.String("port", req.Port))
+
+	target, err := m.connect(peer, req)
+	if err != nil {
+		log.Warnw("failed to forward connection", zap.Error(err))
+		sendFrame(conn, &response{Error: err.Error()})
+		return
+	}
+	defer target.Close()
+
+	if err := sendFrame(conn, &response{}); err != nil {
+		log.Warnw("failed to send port forwarding response", zap.Error(err))
+		return
+	}
+
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		log.Warnw("failed to reset handshake deadline", zap.Error(err))
+		return
+	}
+
+	log.Debugf("forwarding connection to %s", target.RemoteAddr())
+	Splice(conn, target)
+	log.Debugf("finished forwarding connection to %s", target.RemoteAddr())
+}
+
+func (m *Server) connect(peer common.Address, req *request) (net.Conn, error) {
+	addr, err := m.resolve(peer, req.TaskID, req.Port)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := net.DialTimeout("tcp", addr, dialTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to port %s of task %s: %v", req.Port, req.TaskID, err)
+	}
+
+	return conn, nil
+}
+
+func peerWallet(authInfo credentials.AuthInfo) (common.Address, error) {
+	switch authInfo := authInfo.(type) {
+	case auth.EthAuthInfo:
+		return authInfo.Wallet, nil
+	default:
+		return common.Address{}, fmt.Errorf("unsupported auth info %T", authInfo)
+	}
+}
+
+type closeWriter interface {
+	CloseWrite() error
+}
+
+// Splice copies data between both connections in both directions until
+// they are closed.
+func Splice(a, b net.Conn) {
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+
+	forward := func(dst, src net.Conn) {
+		defer wg.Done()
+
+		io.Copy(dst, src)
+
+		// Propagate EOF, closing the connection entirely when half-closing
+		// is not supported.
+		if conn, ok := dst.(closeWriter); ok {
+			conn.CloseWrite()
+		} else {
+			dst.Close()
+		}
+	}
+
+	go forward(a, b)
+	go forward(b, a)
+
+	wg.Wait()
+}
@@ -0,0 +1,131 @@
+package portforward
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sonm-io/core/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
+)
+
+func TestFrame(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.NoError(t, sendFrame(buf, &request{TaskID: "task", Port: "80/tcp"}))
+	buf.WriteString("payload")
+
+	req := &request{}
+	require.NoError(t, recvFrame(buf, req))
+	assert.Equal(t, &request{TaskID: "task", Port: "80/tcp"}, req)
+	// Data following the frame must be left untouched.
+	assert.Equal(t, "payload", buf.String())
+}
+
+func TestNormalizePort(t *testing.T) {
+	assert.Equal(t, "80/tcp", NormalizePort("80"))
+	assert.Equal(t, "80/tcp", NormalizePort("80/tcp"))
+	assert.Equal(t, "53/udp", NormalizePort("53/udp"))
+}
+
+func newEchoServer(t *testing.T) net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+				data, _ := ioutil.ReadAll(conn)
+				conn.Write(data)
+			}()
+		}
+	}()
+
+	return listener
+}
+
+func newCredentials(t *testing.T) (common.Address, credentials.TransportCredentials) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	_, TLSConfig, err := util.NewHitlessCertRotator(context.Background(), key)
+	require.NoError(t, err)
+
+	return crypto.PubkeyToAddress(key.PublicKey), util.NewTLS(TLSConfig)
+}
+
+func TestServer(t *testing.T) {
+	echo := newEchoServer(t)
+	defer echo.Close()
+
+	serverAddr, serverCredentials := newCredentials(t)
+	clientAddr, clientCredentials := newCredentials(t)
+	_, strangerCredentials := newCredentials(t)
+
+	server := NewServer(serverCredentials, func(peer common.Address, taskID, port string) (string, error) {
+		if peer != clientAddr {
+			return "", fmt.Errorf("%s is not allowed to connect to task %s", peer.Hex(), taskID)
+		}
+		if taskID != "task" || port != "80/tcp" {
+			return "", fmt.Errorf("port %s of task %s is not exposed", port, taskID)
+		}
+
+		return echo.Addr().String(), nil
+	}, zap.NewNop().Sugar())
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go server.Serve(listener)
+
+	dial := func(credentials credentials.TransportCredentials, server common.Address, port string) (net.Conn, error) {
+		conn, err := net.Dial("tcp", listener.Addr().String())
+		require.NoError(t, err)
+
+		tunnel, err := Handshake(context.Background(), conn, credentials, server, "task", port)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+
+		return tunnel, nil
+	}
+
+	conn, err := dial(clientCredentials, serverAddr, "80")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("ping"))
+	require.NoError(t, err)
+	require.NoError(t, conn.(closeWriter).CloseWrite())
+
+	data, err := ioutil.ReadAll(conn)
+	require.NoError(t, err)
+	assert.Equal(t, "ping", string(data))
+
+	_, err = dial(clientCredentials, serverAddr, "8080")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "port 8080/tcp of task task is not exposed")
+
+	_, err = dial(strangerCredentials, serverAddr, "80")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not allowed to connect to task task")
+
+	_, err = dial(clientCredentials, clientAddr, "80")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to authenticate port forwarding server")
+}
@@ -2,6 +2,8 @@ package structs
 
 import (
 	"errors"
+	"fmt"
+	"net"
 	"strings"
 
 	"github.com/pborman/uuid"
@@ -17,6 +19,26 @@ func validateNetworkSpec(id string, spec *sonm.NetworkSpec) error {
 	if len(spec.GetType()) == 0 {
 		return errors.New("network type is required in network spec")
 	}
+
+	var subnet *net.IPNet
+	if len(spec.GetIpv6Subnet()) > 0 {
+		ip, ipNet, err := net.ParseCIDR(spec.GetIpv6Subnet())
+		if err != nil || ip.To4() != nil {
+			return fmt.Errorf("invalid IPv6 subnet in network spec: %s", spec.GetIpv6Subnet())
+		}
+		subnet = ipNet
+	}
+
+	if len(spec.GetIpv6Addr()) > 0 {
+		ip := net.ParseIP(spec.GetIpv6Addr())
+		if ip == nil || ip.To4() != nil {
+			return fmt.Errorf("invalid IPv6 address in network spec: %s", spec.GetIpv6Addr())
+		}
+		if subnet != nil && !subnet.Contains(ip) {
+			return fmt.Errorf("IPv6 address %s does not match %s subnet", ip, subnet)
+		}
+	}
+
 	return nil
 }
 
@@ -3,6 +3,7 @@ package worker
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	log "github.com/noxiouz/zapctx/ctxlog"
@@ -76,6 +77,34 @@ func (d *dealAuthorization) Authorize(ctx context.Context, request interface{})
 	return nil
 }
 
+// archivedLogsAuthorization allows the consumer of a closed deal to read
+// archived logs of its tasks.
+type archivedLogsAuthorization struct {
+	logs *logArchive
+}
+
+func newArchivedLogsAuthorization(logs *logArchive) auth.Authorization {
+	return &archivedLogsAuthorization{logs: logs}
+}
+
+func (m *archivedLogsAuthorization) Authorize(ctx context.Context, request interface{}) error {
+	meta, err := m.logs.Meta(request.(*sonm.TaskLogsRequest).GetId(), time.Now())
+	if err != nil {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	wallet, err := auth.ExtractWalletFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if *wallet != meta.Consumer {
+		return status.Errorf(codes.Unauthenticated, "wallet mismatch: %s", wallet.Hex())
+	}
+
+	return nil
+}
+
 type kycFetcher interface {
 	GetProfileLevel(ctx context.Context, owner common.Address) (sonm.IdentityLevel, error)
 }
@@ -168,6 +197,14 @@ func newFromNamedTaskDealExtractor(worker *Worker, name string) DealExtractor {
 	}
 }
 
+// newFromTaskGroupDealExtractor constructs a deal id extractor that requires
+// the specified request to be an ID of the task group.
+func newFromTaskGroupDealExtractor(worker *Worker) DealExtractor {
+	return newRequestDealExtractor(func(request interface{}) (*sonm.BigInt, error) {
+		return worker.taskGroupDealID(request.(*sonm.ID).GetId())
+	})
+}
+
 func newRequestDealExtractor(fn func(request interface{}) (*sonm.BigInt, error)) DealExtractor {
 	return newCustomDealExtractor(func(ctx context.Context, request interface{}) (*sonm.BigInt, error) {
 		return fn(request)
@@ -0,0 +1,156 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sonm-io/core/insonmnia/benchmarks"
+	"github.com/sonm-io/core/insonmnia/worker/gpu"
+	"github.com/sonm-io/core/proto"
+)
+
+// benchmarkRecord is the benchmark value cached in the storage.
+type benchmarkRecord struct {
+	Result    uint64    `json:"result"`
+	Runner    string    `json:"runner"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// UnmarshalJSON also accepts plain values cached by previous versions of the
+// worker, which were always measured in containers.
+func (m *benchmarkRecord) UnmarshalJSON(data []byte) error {
+	var result uint64
+	if err := json.Unmarshal(data, &result); err == nil {
+		*m = benchmarkRecord{Result: result, Runner: benchmarks.DockerRunnerName}
+		return nil
+	}
+
+	type record benchmarkRecord
+	return json.Unmarshal(data, (*record)(m))
+}
+
+func (m *benchmarkRecord) IntoProto(bench *sonm.Benchmark, device interface{}) *sonm.BenchmarkResult {
+	result := &sonm.BenchmarkResult{
+		ID:          bench.GetID(),
+		Code:        bench.GetCode(),
+		Type:        bench.GetType(),
+		Device:      deviceName(device),
+		Fingerprint: deviceHash(device),
+		Result:      m.Result,
+		Runner:      m.Runner,
+	}
+
+	if !m.Timestamp.IsZero() {
+		result.Timestamp = sonm.NewTimestamp(m.Timestamp)
+	}
+
+	return result
+}
+
+// dockerBenchmarkRunner measures benchmarks in containers of their images.
+type dockerBenchmarkRunner struct {
+	worker *Worker
+}
+
+func (m *dockerBenchmarkRunner) Name() string {
+	return benchmarks.DockerRunnerName
+}
+
+// Supports reports whether the benchmark has an image. Values of the host
+// are never measured in containers.
+func (m *dockerBenchmarkRunner) Supports(bench *sonm.Benchmark) bool {
+	return len(bench.GetImage()) != 0 && !benchmarks.IsHostValue(bench)
+}
+
+func (m *dockerBenchmarkRunner) Run(ctx context.Context, bench *sonm.Benchmark, device interface{}) (uint64, error) {
+	d, err := getDescriptionForBenchmark(bench)
+	if err != nil {
+		return 0, fmt.Errorf("could not create description for benchmark: %s", err)
+	}
+	d.Env[benchmarks.CPUCountBenchParam] = fmt.Sprintf("%d", m.worker.hardware.CPU.Device.Cores)
+
+	gpuDevice, isGpu := device.(*sonm.GPUDevice)
+	if isGpu {
+		d.Env[benchmarks.GPUVendorParam] = gpuDevice.VendorType().String()
+		d.GPUDevices = []gpu.GPUID{gpu.GPUID(gpuDevice.GetID())}
+	}
+
+	res, err := m.worker.execBenchmarkContainer(bench, d)
+	if err != nil {
+		return 0, err
+	}
+
+	// Time slices share the throughput of the device.
+	if slices := gpuDevice.GetPartition().GetSlices(); isGpu && slices > 1 {
+		res.Result /= slices
+	}
+
+	return res.Result, nil
+}
+
+func (m *Worker) newBenchmarkRunners(cfg benchmarks.Config) ([]benchmarks.Runner, error) {
+	names := cfg.Runners
+	if len(names) == 0 {
+		names = benchmarks.DefaultRunners
+	}
+
+	runners := make([]benchmarks.Runner, 0, len(names))
+	for _, name := range names {
+		switch name {
+		case benchmarks.DockerRunnerName:
+			runners = append(runners, &dockerBenchmarkRunner{worker: m})
+		case benchmarks.NativeRunnerName:
+			runners = append(runners, benchmarks.NewNativeRunner(cfg.Native))
+		default:
+			return nil, fmt.Errorf("unknown benchmark runner: %s", name)
+		}
+	}
+
+	for _, bench := range m.benchmarks.ByID() {
+		if bench != nil && benchmarks.IsHostValue(bench) {
+			if _, err := benchmarks.SelectRunner(runners, bench); err != nil {
+				return nil, fmt.Errorf("%s runner is required to take values of the host: %v", benchmarks.NativeRunnerName, err)
+			}
+		}
+	}
+
+	return runners, nil
+}
+
+func (m *Worker) setBenchmarkResult(bench *sonm.Benchmark, device interface{}, record *benchmarkRecord) {
+	m.benchmarkResultsMu.Lock()
+	defer m.benchmarkResultsMu.Unlock()
+
+	m.benchmarkResults[benchKey(bench, device)] = record.IntoProto(bench, device)
+}
+
+func (m *Worker) benchmarkReport() (*sonm.BenchmarkReportReply, error) {
+	m.benchmarkResultsMu.Lock()
+	results := make([]*sonm.BenchmarkResult, 0, len(m.benchmarkResults))
+	for _, result := range m.benchmarkResults {
+		results = append(results, result)
+	}
+	m.benchmarkResultsMu.Unlock()
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].GetID() != results[j].GetID() {
+			return results[i].GetID() < results[j].GetID()
+		}
+		return results[i].GetDevice() < results[j].GetDevice()
+	})
+
+	report := &sonm.BenchmarkReportReply{
+		Fingerprint: m.hardware.Hash(),
+		Timestamp:   sonm.CurrentTimestamp(),
+		Results:     results,
+	}
+
+	if err := report.Sign(m.key); err != nil {
+		return nil, fmt.Errorf("failed to sign benchmark report: %v", err)
+	}
+
+	return report, nil
+}
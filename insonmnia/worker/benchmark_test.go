@@ -0,0 +1,45 @@
+package worker
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sonm-io/core/insonmnia/benchmarks"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeLegacyBenchmarkRecord(t *testing.T) {
+	// Plain values cached by previous versions of the worker.
+	record := &benchmarkRecord{}
+	require.NoError(t, json.Unmarshal([]byte(`42`), record))
+
+	assert.Equal(t, uint64(42), record.Result)
+	assert.Equal(t, benchmarks.DockerRunnerName, record.Runner)
+	assert.True(t, record.Timestamp.IsZero())
+}
+
+func TestDecodeBenchmarkRecord(t *testing.T) {
+	timestamp := time.Date(2019, time.March, 5, 10, 0, 0, 0, time.UTC)
+	data, err := json.Marshal(&benchmarkRecord{Result: 42, Runner: benchmarks.NativeRunnerName, Timestamp: timestamp})
+	require.NoError(t, err)
+
+	record := &benchmarkRecord{}
+	require.NoError(t, json.Unmarshal(data, record))
+
+	assert.Equal(t, uint64(42), record.Result)
+	assert.Equal(t, benchmarks.NativeRunnerName, record.Runner)
+	assert.True(t, timestamp.Equal(record.Timestamp))
+}
+
+func TestRunnerBenchKey(t *testing.T) {
+	bench := &sonm.Benchmark{ID: benchmarks.CPUSysbenchMulti, Code: "cpu-sysbench-multi", Type: sonm.DeviceType_DEV_CPU}
+	device := &sonm.CPUDevice{Cores: 8}
+
+	// Values measured in containers are found by keys of previous versions.
+	assert.Equal(t, benchKey(bench, device), runnerBenchKey(bench, device, benchmarks.DockerRunnerName))
+	assert.NotEqual(t, runnerBenchKey(bench, device, benchmarks.DockerRunnerName),
+		runnerBenchKey(bench, device, benchmarks.NativeRunnerName))
+}
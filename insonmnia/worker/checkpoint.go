@@ -0,0 +1,313 @@
+package worker
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	sonm "github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util/xdocker"
+)
+
+// checkpointDir is a directory inside checkpoint images where contents of
+// checkpointed volumes are stored, one subdirectory per volume.
+const checkpointDir = "/.sonm-checkpoint"
+
+// Checkpoint commits the container with contents of its checkpointed volumes
+// into a new image, tags it and optionally pushes into the checkpoint
+// repository.
+//
+// Each checkpoint is tagged twice: with a timestamped tag and with the
+// "<deal>_<task>_checkpoint" tag, which always points to the latest one.
+func (c *containerDescriptor) Checkpoint(ctx context.Context) (_ *sonm.TaskCheckpoint, err error) {
+	c.checkpointMu.Lock()
+	defer c.checkpointMu.Unlock()
+
+	c.log.Info("checkpointing the container")
+
+	resp, err := c.client.ContainerCommit(ctx, c.ID, types.ContainerCommitOptions{Pause: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit container: %v", err)
+	}
+
+	// Images of failed checkpoints are removed, otherwise they pile up with
+	// each checkpoint interval.
+	imageIDs := []string{resp.ID}
+	var tags []xdocker.Reference
+	defer func() {
+		if err != nil {
+			c.removeCheckpointImages(ctx, tags, imageIDs)
+		}
+	}()
+
+	imageID, err := c.snapshotVolumes(ctx, resp.ID)
+	if err != nil {
+		return nil, err
+	}
+	if imageID != resp.ID {
+		imageIDs = append([]string{imageID}, imageIDs...)
+	}
+
+	ref, err := c.checkpointReference()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	latest, err := ref.WithTag(fmt.Sprintf("%s_%s_checkpoint", c.description.DealId, c.description.TaskId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to tag checkpoint: %v", err)
+	}
+	image, err := ref.WithTag(fmt.Sprintf("%s_%s_checkpoint_%d", c.description.DealId, c.description.TaskId, now.Unix()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to tag checkpoint: %v", err)
+	}
+
+	for _, tag := range []xdocker.Reference{image, latest} {
+		if err := c.client.ImageTag(ctx, imageID, tag.String()); err != nil {
+			return nil, fmt.Errorf("failed to tag checkpoint image %s: %v", tag.String(), err)
+		}
+		tags = append(tags, tag)
+
+		if len(c.description.GetCheckpoint().GetRepository()) != 0 {
+			if err := c.push(ctx, tag); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Only the latest checkpoint is kept locally.
+	if c.lastCheckpoint != nil {
+		if err := imageRemove(ctx, c.client, c.lastCheckpoint.GetImage()); err != nil {
+			c.log.Warnf("failed to remove previous checkpoint: %v", err)
+		}
+	}
+
+	c.lastCheckpoint = &sonm.TaskCheckpoint{
+		Image:     image.String(),
+		Timestamp: sonm.NewTimestamp(now),
+	}
+
+	c.log.Infof("checkpointed the container into %s", image.String())
+
+	return c.lastCheckpoint, nil
+}
+
+// removeCheckpointImages removes images of a failed checkpoint with their
+// tags, pointing the latest checkpoint tag back to the previous checkpoint.
+// Image IDs go from the tagged image to its parents. Should be called with
+// the checkpoint lock held.
+func (c *containerDescriptor) removeCheckpointImages(ctx context.Context, tags []xdocker.Reference, imageIDs []string) {
+	for _, tag := range tags {
+		if err := imageRemove(ctx, c.client, tag.String()); err != nil {
+			c.log.Warnf("failed to untag failed checkpoint: %v", err)
+		}
+	}
+
+	// Removing the last tag removes the tagged image as well.
+	if len(tags) > 0 {
+		imageIDs = imageIDs[1:]
+	}
+
+	for _, id := range imageIDs {
+		if err := imageRemove(ctx, c.client, id); err != nil {
+			c.log.Warnf("failed to remove failed checkpoint: %v", err)
+		}
+	}
+
+	if c.lastCheckpoint == nil || len(tags) < 2 {
+		return
+	}
+
+	latest := tags[1]
+	if err := c.client.ImageTag(ctx, c.lastCheckpoint.GetImage(), latest.String()); err != nil {
+		c.log.Warnf("failed to restore checkpoint tag %s: %v", latest.String(), err)
+	}
+}
+
+// LastCheckpoint returns the most recent successful checkpoint, if any.
+func (c *containerDescriptor) LastCheckpoint() *sonm.TaskCheckpoint {
+	c.checkpointMu.Lock()
+	defer c.checkpointMu.Unlock()
+
+	return c.lastCheckpoint
+}
+
+// StopCheckpoints stops periodic checkpoints of the container.
+func (c *containerDescriptor) StopCheckpoints() {
+	if c.stopCheckpoints != nil {
+		c.stopCheckpoints()
+	}
+}
+
+func (c *containerDescriptor) checkpointReference() (xdocker.Reference, error) {
+	repository := c.description.GetCheckpoint().GetRepository()
+	if len(repository) == 0 {
+		return c.description.Reference, nil
+	}
+
+	ref, err := xdocker.NewReference(repository)
+	if err != nil {
+		return xdocker.Reference{}, fmt.Errorf("failed to parse checkpoint repository: %v", err)
+	}
+
+	return ref, nil
+}
+
+func (c *containerDescriptor) push(ctx context.Context, ref xdocker.Reference) error {
+	c.log.Infof("pushing checkpoint image %s", ref.String())
+
+	reader, err := c.client.ImagePush(ctx, ref.String(), types.ImagePushOptions{RegistryAuth: c.description.PushAuth})
+	if err != nil {
+		return fmt.Errorf("failed to push checkpoint image: %v", err)
+	}
+	defer reader.Close()
+
+	if err := xdocker.DecodeImagePull(reader); err != nil {
+		return fmt.Errorf("failed to push checkpoint image: %v", err)
+	}
+
+	return nil
+}
+
+// checkpointMounts returns mounts of volumes that should be saved with
+// checkpoints.
+func (c *containerDescriptor) checkpointMounts() []volumeMount {
+	var mounts []volumeMount
+	for _, name := range c.description.GetCheckpoint().GetVolumes() {
+		for _, mount := range c.description.mounts {
+			if mount.Source == name {
+				mounts = append(mounts, volumeMount{Name: name, Target: mount.Target})
+				break
+			}
+		}
+	}
+
+	return mounts
+}
+
+type volumeMount struct {
+	Name   string
+	Target string
+}
+
+// snapshotVolumes copies contents of checkpointed volumes on top of the given
+// image, because Docker does not include volumes into committed images.
+func (c *containerDescriptor) snapshotVolumes(ctx context.Context, imageID string) (string, error) {
+	mounts := c.checkpointMounts()
+	if len(mounts) == 0 {
+		return imageID, nil
+	}
+
+	created, err := c.client.ContainerCreate(ctx, &container.Config{Image: imageID}, nil, nil, "")
+	if err != nil {
+		return "", fmt.Errorf("failed to create checkpoint container: %v", err)
+	}
+	defer func() {
+		if err := containerRemove(ctx, c.client, created.ID); err != nil {
+			c.log.Warnf("failed to remove checkpoint container: %v", err)
+		}
+	}()
+
+	for _, mount := range mounts {
+		// The checkpoint directory does not exist yet, so the volume is
+		// copied into the root with the archive rebased.
+		target := strings.TrimPrefix(path.Join(checkpointDir, mount.Name), "/")
+		if err := copyBetweenContainers(ctx, c.client, c.ID, mount.Target, created.ID, "/", target); err != nil {
+			return "", fmt.Errorf("failed to snapshot volume %s: %v", mount.Name, err)
+		}
+	}
+
+	resp, err := c.client.ContainerCommit(ctx, created.ID, types.ContainerCommitOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to commit checkpoint container: %v", err)
+	}
+
+	return resp.ID, nil
+}
+
+// restoreVolumes populates volumes of a freshly created container from the
+// checkpoint image it was created from. Volumes missing in the checkpoint are
+// left untouched.
+func (c *containerDescriptor) restoreVolumes(ctx context.Context) error {
+	for _, mount := range c.description.mounts {
+		source := path.Join(checkpointDir, mount.Source)
+		if _, err := c.client.ContainerStatPath(ctx, c.ID, source); err != nil {
+			c.log.Infof("checkpoint has no data for volume %s: %v", mount.Source, err)
+			continue
+		}
+		if err := copyBetweenContainers(ctx, c.client, c.ID, source, c.ID, path.Dir(mount.Target), path.Base(mount.Target)); err != nil {
+			return fmt.Errorf("failed to restore volume %s: %v", mount.Source, err)
+		}
+	}
+
+	return nil
+}
+
+// copyBetweenContainers copies the "src" directory of the "from" container
+// into the "dstDir" directory of the "to" container under the "dstName" name.
+func copyBetweenContainers(ctx context.Context, cli client.APIClient, from, src, to, dstDir, dstName string) error {
+	rd, _, err := cli.CopyFromContainer(ctx, from, src)
+	if err != nil {
+		return err
+	}
+	defer rd.Close()
+
+	archive := rebaseArchive(rd, path.Base(src), dstName)
+	defer archive.Close()
+
+	return cli.CopyToContainer(ctx, to, dstDir, archive, types.CopyToContainerOptions{})
+}
+
+// rebaseArchive streams the given tar archive with its entries moved from
+// the "from" root directory to the "to" one.
+func rebaseArchive(rd io.Reader, from, to string) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(copyRebased(pw, rd, from, to))
+	}()
+
+	return pr
+}
+
+func copyRebased(wr io.Writer, rd io.Reader, from, to string) error {
+	src := tar.NewReader(rd)
+	dst := tar.NewWriter(wr)
+
+	for {
+		hdr, err := src.Next()
+		if err == io.EOF {
+			return dst.Close()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read header: %v", err)
+		}
+
+		hdr.Name = rebasePath(hdr.Name, from, to)
+		if hdr.Typeflag == tar.TypeLink {
+			hdr.Linkname = rebasePath(hdr.Linkname, from, to)
+		}
+
+		if err := dst.WriteHeader(hdr); err != nil {
+			return fmt.Errorf("failed to write header: %v", err)
+		}
+		if _, err := io.Copy(dst, src); err != nil {
+			return fmt.Errorf("failed to copy %s: %v", hdr.Name, err)
+		}
+	}
+}
+
+func rebasePath(name, from, to string) string {
+	if name == from || strings.HasPrefix(name, from+"/") {
+		return to + strings.TrimPrefix(name, from)
+	}
+
+	return name
+}
@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebaseArchive(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	wr := tar.NewWriter(buf)
+	for _, name := range []string{"data/", "data/file", "database"} {
+		hdr := &tar.Header{Name: name, Typeflag: tar.TypeDir, Mode: 0755}
+		if name == "data/file" {
+			hdr = &tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0644, Size: 4}
+		}
+		require.NoError(t, wr.WriteHeader(hdr))
+		if hdr.Size > 0 {
+			_, err := wr.Write([]byte("sonm"))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, wr.WriteHeader(&tar.Header{Name: "data/link", Typeflag: tar.TypeLink, Linkname: "data/file"}))
+	require.NoError(t, wr.Close())
+
+	rd := tar.NewReader(rebaseArchive(buf, "data", ".sonm-checkpoint/volume"))
+
+	var names []string
+	for {
+		hdr, err := rd.Next()
+		if err != nil {
+			break
+		}
+		names = append(names, hdr.Name)
+
+		switch hdr.Name {
+		case ".sonm-checkpoint/volume/file":
+			body, err := ioutil.ReadAll(rd)
+			require.NoError(t, err)
+			assert.Equal(t, "sonm", string(body))
+		case ".sonm-checkpoint/volume/link":
+			assert.Equal(t, ".sonm-checkpoint/volume/file", hdr.Linkname)
+		}
+	}
+
+	assert.Equal(t, []string{
+		".sonm-checkpoint/volume/",
+		".sonm-checkpoint/volume/file",
+		"database",
+		".sonm-checkpoint/volume/link",
+	}, names)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/sonm-io/core/insonmnia/matcher"
 	"github.com/sonm-io/core/insonmnia/npp"
 	"github.com/sonm-io/core/insonmnia/state"
+	"github.com/sonm-io/core/insonmnia/worker/gpu"
 	"github.com/sonm-io/core/insonmnia/worker/network"
 	"github.com/sonm-io/core/insonmnia/worker/plugin"
 	"github.com/sonm-io/core/insonmnia/worker/salesman"
@@ -45,9 +46,13 @@ type Config struct {
 	Blockchain        *blockchain.Config    `yaml:"blockchain"`
 	NPP               npp.Config            `yaml:"npp"`
 	SSH               *SSHConfig            `yaml:"ssh" required:"false" `
+	PortForwarding    *PortForwardingConfig `yaml:"port_forwarding" required:"false"`
 	PublicIPs         []string              `yaml:"public_ip_addrs" required:"false" `
 	Plugins           plugin.Config         `yaml:"plugins"`
+	GPUHealth         gpu.HealthConfig      `yaml:"gpu_health"`
 	Storage           state.StorageConfig   `yaml:"store"`
+	Logs              LogsConfig            `yaml:"logs"`
+	Images            ImagesConfig          `yaml:"images"`
 	Benchmarks        benchmarks.Config     `yaml:"benchmarks"`
 	Whitelist         WhitelistConfig       `yaml:"whitelist"`
 	MetricsListenAddr string                `yaml:"metrics_listen_addr" default:"127.0.0.1:14000"`
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
@@ -15,6 +16,7 @@ import (
 	log "github.com/noxiouz/zapctx/ctxlog"
 	"github.com/rcrowley/go-metrics"
 	"github.com/sonm-io/core/insonmnia/worker/plugin"
+	sonm "github.com/sonm-io/core/proto"
 	"github.com/sonm-io/core/util/multierror"
 	"go.uber.org/zap"
 )
@@ -99,8 +101,18 @@ type containerDescriptor struct {
 	CommitedImageID string
 	description     Description
 	stats           *containerStats
+	metrics         *taskMetricsSeries
+	restarts        *restartSupervisor
 
 	cleanup plugin.Cleanup
+	egress  containerEgress
+
+	// checkpointMu serializes checkpoints and protects the last one.
+	checkpointMu   sync.Mutex
+	lastCheckpoint *sonm.TaskCheckpoint
+	// stopCheckpoints cancels periodic checkpoints, if they are enabled. It
+	// is set once before the descriptor is published.
+	stopCheckpoints context.CancelFunc
 }
 
 func attachContainer(ctx context.Context, dockerClient *client.Client, ID string, d Description, tuners *plugin.Repository) (*containerDescriptor, error) {
@@ -111,6 +123,8 @@ func attachContainer(ctx context.Context, dockerClient *client.Client, ID string
 		client:      dockerClient,
 		description: d,
 		stats:       newContainerStats(),
+		metrics:     newTaskMetricsSeries(taskMetricsCapacity),
+		restarts:    newRestartSupervisor(d.RestartPolicy),
 	}
 
 	cleanup, err := tuners.GetCleanup(ctx, &d)
@@ -131,6 +145,8 @@ func newContainer(ctx context.Context, dockerClient *client.Client, d Descriptio
 		client:      dockerClient,
 		description: d,
 		stats:       newContainerStats(),
+		metrics:     newTaskMetricsSeries(taskMetricsCapacity),
+		restarts:    newRestartSupervisor(d.RestartPolicy),
 	}
 
 	exposedPorts, portBindings, err := d.Expose()
@@ -151,9 +167,12 @@ func newContainer(ctx context.Context, dockerClient *client.Client, d Descriptio
 
 		Image: d.Reference.String(),
 		// TODO: set actual name
-		Labels:  map[string]string{overseerTag: "", dealIDTag: d.DealId},
+		Labels:  map[string]string{overseerTag: "", dealIDTag: d.DealId, taskIDTag: d.TaskId},
 		Env:     d.FormatEnv(),
 		Volumes: make(map[string]struct{}),
+		// Image-defined health checks are left untouched when the task
+		// has no health check specified.
+		Healthcheck: d.Healthcheck.Unwrap(),
 	}
 
 	// NOTE: all ports are EXPOSE as PublishAll
@@ -165,22 +184,29 @@ func newContainer(ctx context.Context, dockerClient *client.Client, d Descriptio
 		LogConfig:       container.LogConfig{Type: "json-file", Config: logOpts},
 		PublishAllPorts: true,
 		PortBindings:    portBindings,
-		RestartPolicy:   d.RestartPolicy.Unwrap(),
 		AutoRemove:      d.Autoremove,
 		Resources:       d.Resources.ToHostConfigResources(d.CGroupParent),
 	}
+	// Restart policy is not passed to Docker, because restarts are
+	// supervised by the overseer to track their history and detect crash
+	// loops.
 
 	networkingConfig := network.NetworkingConfig{
 		EndpointsConfig: map[string]*network.EndpointSettings{},
 	}
-	if d.NetworkOptions != nil {
+	if d.NetworkContainer != "" {
+		// Containers sharing the network namespace can neither publish ports
+		// nor connect to networks.
+		hostConfig.NetworkMode = container.NetworkMode("container:" + d.NetworkContainer)
+		hostConfig.PublishAllPorts = false
+	} else if d.NetworkOptions != nil {
 		networkingConfig.EndpointsConfig[d.NetworkOptions.Name] = &network.EndpointSettings{
 			NetworkID: d.NetworkOptions.ID,
 		}
 	}
 
 	additionalNetworking := &network.NetworkingConfig{}
-	cleanup, err := tuners.Tune(ctx, &d, &hostConfig, additionalNetworking)
+	cleanup, err := tuners.Tune(ctx, &d, &config, &hostConfig, additionalNetworking)
 	if err != nil {
 		log.G(ctx).Error("failed to tune container", zap.Error(err))
 		return nil, err
@@ -211,6 +237,20 @@ func newContainer(ctx context.Context, dockerClient *client.Client, d Descriptio
 	cont.ID = resp.ID
 	cont.log = log.S(ctx).With(zap.String("container_id", cont.ID))
 	cont.cleanup = cleanup
+
+	if d.Restore {
+		if err := cont.restoreVolumes(ctx); err != nil {
+			// The container has never been started, so it is removed along
+			// with its volumes and networks right away.
+			if err := containerRemove(ctx, cont.client, cont.ID); err != nil {
+				cont.log.Warnf("failed to remove container after failed volume restoration: %v", err)
+			}
+			if err := cont.cleanup.Close(); err != nil {
+				cont.log.Warnf("failed to clean up container after failed volume restoration: %v", err)
+			}
+			return nil, err
+		}
+	}
 	if len(resp.Warnings) > 0 {
 		log.G(ctx).Warn("ContainerCreate finished with warnings", zap.Strings("warnings", resp.Warnings))
 	}
@@ -227,7 +267,41 @@ func (c *containerDescriptor) startContainer(ctx context.Context) error {
 	return nil
 }
 
-func (c *containerDescriptor) execCommand(ctx context.Context, cmd []string, env []string, isTty bool, wCh <-chan ssh.Window) (conn types.HijackedResponse, err error) {
+func (c *containerDescriptor) execCommand(ctx context.Context, cmd []string, env []string, isTty bool, wCh <-chan ssh.Window) (types.HijackedResponse, error) {
+	session, err := c.execSession(ctx, cmd, env, isTty, wCh)
+	if err != nil {
+		return types.HijackedResponse{}, err
+	}
+
+	return session.HijackedResponse, nil
+}
+
+// ExecSession is a command being executed inside a running container.
+type ExecSession struct {
+	types.HijackedResponse
+
+	id     string
+	client *client.Client
+}
+
+// ExitCode returns the exit code of the command. Should be called after the
+// command output has been fully read.
+func (m *ExecSession) ExitCode(ctx context.Context) (int, error) {
+	inspect, err := m.client.ContainerExecInspect(ctx, m.id)
+	if err != nil {
+		return 0, err
+	}
+
+	if inspect.Running {
+		return 0, fmt.Errorf("command is still running")
+	}
+
+	return inspect.ExitCode, nil
+}
+
+func (c *containerDescriptor) execSession(ctx context.Context, cmd []string, env []string, isTty bool, wCh <-chan ssh.Window) (session *ExecSession, err error) {
+	var conn types.HijackedResponse
+
 	cfg := types.ExecConfig{
 		User:         "root",
 		Tty:          isTty,
@@ -265,7 +339,7 @@ func (c *containerDescriptor) execCommand(ctx context.Context, cmd []string, env
 					return
 				}
 				c.log.Infof("resizing tty to %dx%d", w.Height, w.Width)
-				err = c.client.ContainerExecResize(ctx, execId.ID, types.ResizeOptions{Height: uint(w.Height), Width: uint(w.Width)})
+				err := c.client.ContainerExecResize(ctx, execId.ID, types.ResizeOptions{Height: uint(w.Height), Width: uint(w.Width)})
 				if err != nil {
 					log.G(ctx).Warn("ContainerExecResize finished with error", zap.Error(err))
 				}
@@ -276,6 +350,11 @@ func (c *containerDescriptor) execCommand(ctx context.Context, cmd []string, env
 	}()
 
 	c.log.Info("attached command to container")
+	session = &ExecSession{
+		HijackedResponse: conn,
+		id:               execId.ID,
+		client:           c.client,
+	}
 	return
 }
 
@@ -288,6 +367,15 @@ func (c *containerDescriptor) Kill(ctx context.Context) error {
 	return nil
 }
 
+func (c *containerDescriptor) Terminate(ctx context.Context) error {
+	c.log.Info("terminate the container")
+	if err := c.client.ContainerKill(ctx, c.ID, "SIGTERM"); err != nil {
+		c.log.Warnf("failed to send SIGTERM to the container: %s", err)
+		return err
+	}
+	return nil
+}
+
 func (c *containerDescriptor) Remove(ctx context.Context) error {
 	c.log.Info("remove the container")
 	result := multierror.NewMultiError()
@@ -304,7 +392,14 @@ func (c *containerDescriptor) Remove(ctx context.Context) error {
 
 //TODO: pass context
 func (c *containerDescriptor) Cleanup() error {
-	return c.cleanup.Close()
+	result := multierror.NewMultiError()
+	if err := c.removeEgress(); err != nil {
+		result = multierror.Append(result, err)
+	}
+	if err := c.cleanup.Close(); err != nil {
+		result = multierror.Append(result, err)
+	}
+	return result.ErrorOrNil()
 }
 
 func containerRemove(ctx context.Context, client client.APIClient, id string) error {
@@ -352,7 +447,7 @@ func (c *containerDescriptor) upload(ctx context.Context) error {
 
 	if c.description.PushOnStop {
 		options := types.ImagePushOptions{
-			RegistryAuth: c.description.Auth,
+			RegistryAuth: c.description.PushAuth,
 		}
 
 		c.log.Infof("pushing image %s", newImg)
@@ -0,0 +1,173 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"github.com/sonm-io/core/insonmnia/worker/network"
+	"go.uber.org/zap"
+)
+
+// egressRefreshInterval is how often egress rules are rebuilt.
+const egressRefreshInterval = 5 * time.Minute
+
+// containerEgress manages egress rules of a container.
+//
+// Rules match the container's address within the ask plan's network, which
+// may change after restarts, so they are reinstalled every time the
+// container starts. Allowed hosts may change their addresses as well, so
+// rules are rebuilt every egressRefreshInterval.
+type containerEgress struct {
+	mu     sync.Mutex
+	action *network.TaskEgressFilterAction
+	queue  *network.ActionQueue
+	// violations is the number of dropped packets counted by rules that
+	// have been already removed.
+	violations uint64
+	// current is the most recent number of dropped packets counted by the
+	// installed rules.
+	current uint64
+}
+
+// isEgressRequired checks whether the container's outbound connections
+// must be filtered.
+//
+// All containers attached to the ask plan's network are filtered, because
+// the network drops their new outbound connections until they are admitted,
+// which happens once their egress rules are in place. Containers sharing the
+// network namespace of another container are filtered by that container's
+// rules.
+func (d *Description) isEgressRequired() bool {
+	return d.NetworkOptions != nil && d.NetworkContainer == ""
+}
+
+// setupEgress installs egress rules of the started container, replacing
+// previously installed ones, and admits its outbound connections.
+func (c *containerDescriptor) setupEgress(ctx context.Context) error {
+	if !c.description.isEgressRequired() {
+		return nil
+	}
+
+	info, err := c.client.ContainerInspect(ctx, c.ID)
+	if err != nil {
+		return err
+	}
+
+	endpoint, ok := info.NetworkSettings.Networks[c.description.NetworkOptions.Name]
+	if !ok || endpoint == nil {
+		return fmt.Errorf("container is not attached to %s network", c.description.NetworkOptions.Name)
+	}
+
+	addr := net.ParseIP(endpoint.IPAddress)
+	if addr == nil {
+		return fmt.Errorf("container has invalid address within %s network: %q", c.description.NetworkOptions.Name, endpoint.IPAddress)
+	}
+
+	// Global IPv6 address is assigned only within dual-stack networks.
+	var ipv6Addr net.IP
+	if len(endpoint.GlobalIPv6Address) > 0 {
+		if ipv6Addr = net.ParseIP(endpoint.GlobalIPv6Address); ipv6Addr == nil {
+			return fmt.Errorf("container has invalid IPv6 address within %s network: %q", c.description.NetworkOptions.Name, endpoint.GlobalIPv6Address)
+		}
+	}
+
+	c.egress.mu.Lock()
+	defer c.egress.mu.Unlock()
+
+	if err := c.removeEgressLocked(); err != nil {
+		return err
+	}
+
+	action := &network.TaskEgressFilterAction{
+		Network:  c.description.NetworkOptions,
+		TaskID:   c.description.TaskId,
+		Addr:     addr,
+		IPv6Addr: ipv6Addr,
+		Egress:   c.description.GetEgress(),
+	}
+
+	queue := network.NewActionQueue()
+	if err, rollbackErr := queue.Execute(ctx, action); err != nil {
+		if rollbackErr != nil {
+			log.G(ctx).Warn("failed to rollback egress rules", zap.String("id", c.ID), zap.Error(rollbackErr))
+		}
+		return err
+	}
+
+	c.egress.action = action
+	c.egress.queue = queue
+
+	return nil
+}
+
+// removeEgress removes egress rules of the container, if any.
+func (c *containerDescriptor) removeEgress() error {
+	c.egress.mu.Lock()
+	defer c.egress.mu.Unlock()
+
+	return c.removeEgressLocked()
+}
+
+func (c *containerDescriptor) removeEgressLocked() error {
+	if c.egress.queue == nil {
+		return nil
+	}
+
+	if violations, err := c.egress.action.Violations(); err == nil {
+		c.egress.violations += violations
+	} else {
+		c.egress.violations += c.egress.current
+	}
+	c.egress.current = 0
+
+	err := c.egress.queue.Rollback()
+	c.egress.action = nil
+	c.egress.queue = nil
+
+	return err
+}
+
+// refreshEgress rebuilds egress rules of the container, resolving allowed
+// hosts again.
+func (c *containerDescriptor) refreshEgress(ctx context.Context) error {
+	c.egress.mu.Lock()
+	defer c.egress.mu.Unlock()
+
+	if c.egress.action == nil {
+		return nil
+	}
+
+	return c.egress.action.Refresh(ctx)
+}
+
+// updateEgressViolations refreshes the number of dropped packets counted by
+// the installed rules.
+func (c *containerDescriptor) updateEgressViolations() error {
+	c.egress.mu.Lock()
+	defer c.egress.mu.Unlock()
+
+	if c.egress.action == nil {
+		return nil
+	}
+
+	violations, err := c.egress.action.Violations()
+	if err != nil {
+		return err
+	}
+
+	c.egress.current = violations
+	return nil
+}
+
+// EgressViolations returns the number of outbound packets of the container
+// dropped because of egress policies, as of the last update.
+func (c *containerDescriptor) EgressViolations() uint64 {
+	c.egress.mu.Lock()
+	defer c.egress.mu.Unlock()
+
+	return c.egress.violations + c.egress.current
+}
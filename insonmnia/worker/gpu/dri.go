@@ -237,6 +237,22 @@ func (card *DRICard) readPowerConsumption() (float64, error) {
 	return 0, fmt.Errorf("cannot find average power consumption param in the amdgpu_pm_info")
 }
 
+// readUtilization reads the GPU busy percentage, which is exposed by the
+// amdgpu driver since Linux 4.19. Zero is returned for older kernels.
+func (card *DRICard) readUtilization() (float64, error) {
+	p := fmt.Sprintf("/sys/dev/char/%d:%d/device/gpu_busy_percent", card.Major, card.Minor)
+
+	raw, err := ioutil.ReadFile(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return strconv.ParseFloat(strings.TrimSpace(string(raw)), 64)
+}
+
 func (card *DRICard) Metrics() (*DRICardMetrics, error) {
 	if len(card.HwmonPath) == 0 {
 		return nil, fmt.Errorf("metrics interface is not available for %s", card.Name)
@@ -297,6 +313,22 @@ func parsePCISlotName(s string) (string, bool) {
 	return "", false
 }
 
+// normalizePCIBusID converts the PCI bus ID with the 32-bit domain, as NVML
+// reports it, into the PCI slot name format with the 16-bit domain.
+func normalizePCIBusID(id string) string {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 {
+		return id
+	}
+
+	domain, err := strconv.ParseUint(parts[0], 16, 32)
+	if err != nil {
+		return id
+	}
+
+	return strings.ToLower(fmt.Sprintf("%04x:%s", domain, parts[1]))
+}
+
 // CollectDRICardDevices traverses overs /dev/dri and collect card Devices
 // which can be bound into the container
 func CollectDRICardDevices() ([]DRICard, error) {
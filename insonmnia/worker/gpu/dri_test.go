@@ -86,3 +86,19 @@ func TestParsePCISlotName(t *testing.T) {
 		assert.Equal(t, out, tt.out)
 	}
 }
+
+func TestNormalizePCIBusID(t *testing.T) {
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{in: "00000000:01:00.0", out: "0000:01:00.0"},
+		{in: "0000:0A:00.0", out: "0000:0a:00.0"},
+		{in: "0000:01:00.0", out: "0000:01:00.0"},
+		{in: "PCI:0001", out: "PCI:0001"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.out, normalizePCIBusID(tt.in))
+	}
+}
@@ -3,6 +3,9 @@ package gpu
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/docker/docker/api/types/container"
 	"github.com/noxiouz/zapctx/ctxlog"
@@ -10,17 +13,42 @@ import (
 	"go.uber.org/zap"
 )
 
+// fakeTemperature is the temperature healthy fake devices report.
+const fakeTemperature = 50.0
+
+// fakeGPUTuner emulates GPUs without any hardware. It also acts as a
+// metrics handler, whose device statuses can be changed to inject faults.
 type fakeGPUTuner struct {
 	log     *zap.Logger
 	devices []*sonm.GPUDevice
+	devMap  map[GPUID]*sonm.GPUDevice
+
+	mu sync.Mutex
+	// statuses maps IDs of physical devices to their statuses, lost devices
+	// are missing.
+	statuses map[GPUID]*DeviceStatus
 }
 
 func newFakeTuner(ctx context.Context, opts ...Option) (Tuner, error) {
+	tuner, err := newFakeGPUTuner(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return tuner, nil
+}
+
+func newFakeGPUTuner(ctx context.Context, opts ...Option) (*fakeGPUTuner, error) {
 	options := fakeDefaultOptions()
 	for _, f := range opts {
 		f(options)
 	}
 
+	var profiles []string
+	if len(options.MIGProfiles) > 0 {
+		profiles = strings.Split(options.MIGProfiles, ",")
+	}
+
 	var devices []*sonm.GPUDevice
 	for i := 0; i < options.DeviceCount; i++ {
 		dev := &sonm.GPUDevice{
@@ -35,18 +63,39 @@ func newFakeTuner(ctx context.Context, opts ...Option) (Tuner, error) {
 		}
 
 		dev.FillHashID()
-		devices = append(devices, dev)
+
+		var migDevices []*sonm.GPUDevice
+		for id, profile := range profiles {
+			profile = strings.TrimSpace(profile)
+			memory, err := migProfileMemory(profile)
+			if err != nil {
+				return nil, err
+			}
+
+			migDevices = append(migDevices, newMIGDevice(dev, migInstance{Profile: profile, ID: uint64(id + 1)}, memory, nil))
+		}
+
+		devices = append(devices, partitionDevice(dev, migDevices, options.TimeSlices)...)
+	}
+
+	devMap := map[GPUID]*sonm.GPUDevice{}
+	statuses := map[GPUID]*DeviceStatus{}
+	for _, dev := range devices {
+		devMap[GPUID(dev.GetID())] = dev
+		statuses[GPUID(dev.PhysicalID())] = &DeviceStatus{Temperature: fakeTemperature}
 	}
 
 	return &fakeGPUTuner{
-		log:     ctxlog.GetLogger(ctx),
-		devices: devices,
+		log:      ctxlog.GetLogger(ctx),
+		devices:  devices,
+		devMap:   devMap,
+		statuses: statuses,
 	}, nil
 }
 
-func (f *fakeGPUTuner) Tune(hostconfig *container.HostConfig, ids []GPUID) error {
+func (f *fakeGPUTuner) Tune(config *container.Config, hostconfig *container.HostConfig, ids []GPUID) error {
 	f.log.Debug("tuning container with fake GPU driver", zap.Any("device_ids", ids))
-	return nil
+	return tuneContainer(config, hostconfig, f.devMap, ids)
 }
 
 func (f *fakeGPUTuner) Devices() []*sonm.GPUDevice {
@@ -54,6 +103,67 @@ func (f *fakeGPUTuner) Devices() []*sonm.GPUDevice {
 	return f.devices
 }
 
+func (f *fakeGPUTuner) GetMetrics() (map[string]float64, error) {
+	statuses, err := f.GetDeviceStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for id := range statuses {
+		ids = append(ids, string(id))
+	}
+	sort.Strings(ids)
+
+	metrics := make(map[string]float64)
+	for i, id := range ids {
+		metrics[tempKey(i)] = statuses[GPUID(id)].Temperature
+	}
+
+	return metrics, nil
+}
+
+func (f *fakeGPUTuner) GetUtilization() (map[GPUID]float64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	utilization := make(map[GPUID]float64)
+	for id := range f.statuses {
+		utilization[id] = 0
+	}
+
+	return utilization, nil
+}
+
+func (f *fakeGPUTuner) GetDeviceStatus() (map[GPUID]*DeviceStatus, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	statuses := make(map[GPUID]*DeviceStatus, len(f.statuses))
+	for id, status := range f.statuses {
+		statusCopy := *status
+		statuses[id] = &statusCopy
+	}
+
+	return statuses, nil
+}
+
+// setStatus injects the status of the physical device.
+func (f *fakeGPUTuner) setStatus(id GPUID, status DeviceStatus) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.statuses[id] = &status
+}
+
+// removeDevice emulates the physical device falling off the bus.
+func (f *fakeGPUTuner) removeDevice(id GPUID) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.statuses, id)
+}
+
 func (f *fakeGPUTuner) Close() error {
 	f.log.Debug("closing fake GPU driver")
 	return nil
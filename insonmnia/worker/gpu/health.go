@@ -0,0 +1,151 @@
+package gpu
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sonm-io/core/util"
+	"go.uber.org/zap"
+)
+
+// temperatureHysteresis is how much an overheated device must cool down
+// below the limit to be considered healthy again.
+const temperatureHysteresis = 5.0
+
+// HealthConfig describes when GPUs are considered faulty.
+type HealthConfig struct {
+	// Interval specifies how often devices are checked.
+	Interval time.Duration `yaml:"interval" default:"30s"`
+	// MaxTemperature is the temperature in degrees Celsius above which a
+	// device is considered overheated.
+	MaxTemperature float64 `yaml:"max_temperature" default:"90"`
+	// MaxECCErrors is the number of uncorrected ECC errors a device is
+	// allowed to have.
+	MaxECCErrors uint64 `yaml:"max_ecc_errors" default:"0"`
+}
+
+type deviceFault struct {
+	reason string
+	// Permanent faults persist until the worker is restarted, because the
+	// device requires the driver to be reloaded or the host to be rebooted.
+	permanent bool
+}
+
+// HealthMonitor tracks the health of physical GPUs using metrics handlers.
+//
+// A device is considered faulty when it overheats, has uncorrected ECC
+// errors or disappears, i.e. is no longer able to report its status.
+type HealthMonitor struct {
+	cfg      HealthConfig
+	handlers []MetricsHandler
+	log      *zap.Logger
+
+	mu sync.Mutex
+	// known contains devices ever seen by each handler.
+	known  []map[GPUID]struct{}
+	faults map[GPUID]*deviceFault
+}
+
+func NewHealthMonitor(cfg HealthConfig, handlers []MetricsHandler, log *zap.Logger) *HealthMonitor {
+	known := make([]map[GPUID]struct{}, len(handlers))
+	for idx := range known {
+		known[idx] = map[GPUID]struct{}{}
+	}
+
+	return &HealthMonitor{
+		cfg:      cfg,
+		handlers: handlers,
+		log:      log.Named("gpu_health"),
+		known:    known,
+		faults:   map[GPUID]*deviceFault{},
+	}
+}
+
+// Run checks devices periodically until the context is canceled.
+func (m *HealthMonitor) Run(ctx context.Context) error {
+	m.log.Info("starting GPU health monitoring")
+	defer m.log.Info("stopped GPU health monitoring")
+
+	ticker := util.NewImmediateTicker(m.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			m.Check()
+		}
+	}
+}
+
+// Check updates health of devices once.
+func (m *HealthMonitor) Check() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for idx, handler := range m.handlers {
+		statuses, err := handler.GetDeviceStatus()
+		if err != nil {
+			// Do not treat devices as lost when the whole handler fails, it
+			// is most likely a transient problem.
+			m.log.Warn("failed to get GPU device status", zap.Error(err))
+			continue
+		}
+
+		for id := range statuses {
+			m.known[idx][id] = struct{}{}
+		}
+
+		for id := range m.known[idx] {
+			m.update(id, m.checkDevice(id, statuses[id]))
+		}
+	}
+}
+
+func (m *HealthMonitor) checkDevice(id GPUID, status *DeviceStatus) *deviceFault {
+	switch {
+	case status == nil:
+		return &deviceFault{reason: "device is lost", permanent: true}
+	case status.ECCErrors > m.cfg.MaxECCErrors:
+		return &deviceFault{reason: fmt.Sprintf("%d uncorrected ECC errors", status.ECCErrors), permanent: true}
+	case status.Temperature > m.cfg.MaxTemperature:
+		return &deviceFault{reason: fmt.Sprintf("overheated: %.0f°C", status.Temperature)}
+	case status.Temperature > m.cfg.MaxTemperature-temperatureHysteresis:
+		// Keep overheated devices faulty until they cool down enough.
+		return m.faults[id]
+	default:
+		return nil
+	}
+}
+
+func (m *HealthMonitor) update(id GPUID, fault *deviceFault) {
+	current, isFaulty := m.faults[id]
+	if isFaulty && current.permanent {
+		return
+	}
+
+	switch {
+	case fault != nil && (!isFaulty || current.reason != fault.reason):
+		m.log.Warn("GPU device is faulty", zap.String("id", string(id)), zap.String("reason", fault.reason))
+		m.faults[id] = fault
+	case fault == nil && isFaulty:
+		m.log.Info("GPU device is healthy again", zap.String("id", string(id)))
+		delete(m.faults, id)
+	}
+}
+
+// Faults returns reasons of faulty physical devices by their IDs.
+func (m *HealthMonitor) Faults() map[string]string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	faults := make(map[string]string, len(m.faults))
+	for id, fault := range m.faults {
+		faults[string(id)] = fault.reason
+	}
+
+	return faults
+}
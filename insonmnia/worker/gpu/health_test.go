@@ -0,0 +1,86 @@
+package gpu
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type failingMetricsHandler struct {
+	nilMetricsHandler
+}
+
+func (failingMetricsHandler) GetDeviceStatus() (map[GPUID]*DeviceStatus, error) {
+	return nil, errors.New("driver is not responding")
+}
+
+func newTestHealthMonitor(t *testing.T, opts map[string]string) (*HealthMonitor, *fakeGPUTuner) {
+	tuner, err := newFakeGPUTuner(context.Background(), WithOptions(opts))
+	require.NoError(t, err)
+
+	cfg := HealthConfig{MaxTemperature: 90}
+	monitor := NewHealthMonitor(cfg, []MetricsHandler{tuner, &failingMetricsHandler{}}, zap.NewNop())
+
+	return monitor, tuner
+}
+
+func TestHealthMonitorHealthy(t *testing.T) {
+	monitor, _ := newTestHealthMonitor(t, map[string]string{"device_count": "2"})
+
+	monitor.Check()
+	assert.Empty(t, monitor.Faults())
+}
+
+func TestHealthMonitorOverheat(t *testing.T) {
+	monitor, tuner := newTestHealthMonitor(t, map[string]string{"device_count": "2"})
+	monitor.Check()
+
+	tuner.setStatus("PCI:0001", DeviceStatus{Temperature: 95})
+	monitor.Check()
+	assert.Equal(t, map[string]string{"PCI:0001": "overheated: 95°C"}, monitor.Faults())
+
+	// Devices remain faulty until they cool down enough.
+	tuner.setStatus("PCI:0001", DeviceStatus{Temperature: 88})
+	monitor.Check()
+	assert.Equal(t, map[string]string{"PCI:0001": "overheated: 95°C"}, monitor.Faults())
+
+	tuner.setStatus("PCI:0001", DeviceStatus{Temperature: 80})
+	monitor.Check()
+	assert.Empty(t, monitor.Faults())
+
+	// Devices that have never overheated are healthy within the hysteresis.
+	tuner.setStatus("PCI:0000", DeviceStatus{Temperature: 88})
+	monitor.Check()
+	assert.Empty(t, monitor.Faults())
+}
+
+func TestHealthMonitorECCErrors(t *testing.T) {
+	monitor, tuner := newTestHealthMonitor(t, map[string]string{"device_count": "2"})
+	monitor.Check()
+
+	tuner.setStatus("PCI:0000", DeviceStatus{Temperature: fakeTemperature, ECCErrors: 3})
+	monitor.Check()
+	assert.Equal(t, map[string]string{"PCI:0000": "3 uncorrected ECC errors"}, monitor.Faults())
+
+	// ECC errors are permanent, while the device reports healthy status.
+	tuner.setStatus("PCI:0000", DeviceStatus{Temperature: fakeTemperature})
+	monitor.Check()
+	assert.Equal(t, map[string]string{"PCI:0000": "3 uncorrected ECC errors"}, monitor.Faults())
+}
+
+func TestHealthMonitorLostDevice(t *testing.T) {
+	monitor, tuner := newTestHealthMonitor(t, map[string]string{"device_count": "2", "time_slices": "2"})
+	monitor.Check()
+
+	tuner.removeDevice("PCI:0001")
+	monitor.Check()
+	assert.Equal(t, map[string]string{"PCI:0001": "device is lost"}, monitor.Faults())
+
+	tuner.setStatus("PCI:0001", DeviceStatus{Temperature: fakeTemperature})
+	monitor.Check()
+	assert.Equal(t, map[string]string{"PCI:0001": "device is lost"}, monitor.Faults())
+}
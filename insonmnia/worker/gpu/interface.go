@@ -2,6 +2,7 @@ package gpu
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/docker/docker/api/types/container"
 	log "github.com/noxiouz/zapctx/ctxlog"
@@ -14,7 +15,7 @@ type GPUID string
 // Tuner is responsible for preparing GPU-friendly environment and baking proper options in container.HostConfig
 type Tuner interface {
 	// Tune is attaching GPUs with given IDs into a container
-	Tune(hostconfig *container.HostConfig, ids []GPUID) error
+	Tune(config *container.Config, hostconfig *container.HostConfig, ids []GPUID) error
 	// Devices returns device list that this tuner can control
 	Devices() []*sonm.GPUDevice
 	// Close closes the tuner and removes related files and sockets from the host system
@@ -44,29 +45,66 @@ func New(ctx context.Context, gpuType sonm.GPUVendorType, opts ...Option) (Tuner
 // of GPU metrics interface.
 type MetricsHandler interface {
 	GetMetrics() (map[string]float64, error)
+	// GetUtilization returns utilization of each device in percents.
+	GetUtilization() (map[GPUID]float64, error)
+	// GetDeviceStatus returns the status of each physical device. Devices
+	// that are unable to report their status are omitted.
+	GetDeviceStatus() (map[GPUID]*DeviceStatus, error)
 	Close() error
 }
 
-func NewMetricsHandler(gpuType sonm.GPUVendorType) (MetricsHandler, error) {
+// DeviceStatus describes the state of a physical device relevant for its
+// health.
+type DeviceStatus struct {
+	// Temperature is the device temperature in degrees Celsius.
+	Temperature float64
+	// ECCErrors is the number of uncorrected ECC memory errors occurred
+	// since the driver was loaded.
+	ECCErrors uint64
+}
+
+func NewMetricsHandler(gpuType sonm.GPUVendorType, opts ...Option) (MetricsHandler, error) {
 	switch gpuType {
 	case sonm.GPUVendorType_RADEON:
 		return newRadeonMetricsHandler()
 	case sonm.GPUVendorType_NVIDIA:
 		return newNvidiaMetricsHandler()
+	case sonm.GPUVendorType_FAKE:
+		handler, err := newFakeGPUTuner(context.Background(), opts...)
+		if err != nil {
+			return nil, err
+		}
+		return handler, nil
 	default:
 		return nilMetricsHandler{}, nil
 	}
 }
 
+func tempKey(i int) string {
+	return fmt.Sprintf("%s%d_%s", sonm.MetricsKeyGPUPrefix, i, sonm.MetricsKeyGPUTemperature)
+}
+
+func fanKey(i int) string {
+	return fmt.Sprintf("%s%d_%s", sonm.MetricsKeyGPUPrefix, i, sonm.MetricsKeyGPUFan)
+}
+
+func powerKey(i int) string {
+	return fmt.Sprintf("%s%d_%s", sonm.MetricsKeyGPUPrefix, i, sonm.MetricsKeyGPUPower)
+}
+
 type nilMetricsHandler struct{}
 
-func (nilMetricsHandler) GetMetrics() (map[string]float64, error) { return map[string]float64{}, nil }
-func (nilMetricsHandler) Close() error                            { return nil }
+func (nilMetricsHandler) GetMetrics() (map[string]float64, error)           { return map[string]float64{}, nil }
+func (nilMetricsHandler) GetUtilization() (map[GPUID]float64, error)        { return map[GPUID]float64{}, nil }
+func (nilMetricsHandler) GetDeviceStatus() (map[GPUID]*DeviceStatus, error) { return nil, nil }
+func (nilMetricsHandler) Close() error                                      { return nil }
 
 // NilTuner is just a null pattern
 type NilTuner struct{}
 
-func (NilTuner) Tune(hostconfig *container.HostConfig, ids []GPUID) error { return nil }
+func (NilTuner) Tune(config *container.Config, hostconfig *container.HostConfig, ids []GPUID) error {
+	return nil
+}
 
 func (NilTuner) Devices() []*sonm.GPUDevice { return nil }
 
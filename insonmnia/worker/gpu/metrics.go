@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"sync"
 
-	"github.com/sonm-io/core/proto"
 	"github.com/sshaman1101/nvidia-docker/nvidia"
 )
 
@@ -63,6 +62,55 @@ func (m *nvidiaMetrics) GetMetrics() (map[string]float64, error) {
 	return metrics, nil
 }
 
+func (m *nvidiaMetrics) GetUtilization() (map[GPUID]float64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	utilization := make(map[GPUID]float64)
+	for _, dev := range m.devices {
+		status, err := dev.Status()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get device status for GPU `%s`: %v", *dev.Model, err)
+		}
+
+		var value float64 = 0
+		if status.Utilization.GPU != nil {
+			value = float64(*status.Utilization.GPU)
+		}
+
+		utilization[GPUID(normalizePCIBusID(dev.PCI.BusID))] = value
+	}
+
+	return utilization, nil
+}
+
+func (m *nvidiaMetrics) GetDeviceStatus() (map[GPUID]*DeviceStatus, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	statuses := make(map[GPUID]*DeviceStatus)
+	for _, dev := range m.devices {
+		status, err := dev.Status()
+		if err != nil {
+			// Devices fallen off the bus are unable to report their status.
+			continue
+		}
+
+		deviceStatus := &DeviceStatus{}
+		if status.Temperature != nil {
+			deviceStatus.Temperature = float64(*status.Temperature)
+		}
+
+		if status.Memory.ECCErrors.Global != nil {
+			deviceStatus.ECCErrors = *status.Memory.ECCErrors.Global
+		}
+
+		statuses[GPUID(normalizePCIBusID(dev.PCI.BusID))] = deviceStatus
+	}
+
+	return statuses, nil
+}
+
 func (m *nvidiaMetrics) Close() error {
 	return nvidia.Shutdown()
 }
@@ -100,18 +148,40 @@ func (m *radeonMetrics) GetMetrics() (map[string]float64, error) {
 	return metrics, nil
 }
 
-func (m *radeonMetrics) Close() error {
-	return nil
-}
+func (m *radeonMetrics) GetUtilization() (map[GPUID]float64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	utilization := make(map[GPUID]float64)
+	for _, dev := range m.devices {
+		value, err := dev.readUtilization()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get utilization for GPU `%s`: %v", dev.Name, err)
+		}
 
-func tempKey(i int) string {
-	return fmt.Sprintf("%s%d_%s", sonm.MetricsKeyGPUPrefix, i, sonm.MetricsKeyGPUTemperature)
+		utilization[GPUID(dev.PCIBusID)] = value
+	}
+
+	return utilization, nil
 }
 
-func fanKey(i int) string {
-	return fmt.Sprintf("%s%d_%s", sonm.MetricsKeyGPUPrefix, i, sonm.MetricsKeyGPUFan)
+func (m *radeonMetrics) GetDeviceStatus() (map[GPUID]*DeviceStatus, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	statuses := make(map[GPUID]*DeviceStatus)
+	for _, dev := range m.devices {
+		status, err := dev.Metrics()
+		if err != nil {
+			continue
+		}
+
+		statuses[GPUID(dev.PCIBusID)] = &DeviceStatus{Temperature: status.Temperature}
+	}
+
+	return statuses, nil
 }
 
-func powerKey(i int) string {
-	return fmt.Sprintf("%s%d_%s", sonm.MetricsKeyGPUPrefix, i, sonm.MetricsKeyGPUPower)
+func (m *radeonMetrics) Close() error {
+	return nil
 }
@@ -5,8 +5,12 @@ package gpu
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"sync"
 	"syscall"
 
@@ -29,11 +33,22 @@ type nvidiaTuner struct {
 	devMap map[GPUID]*sonm.GPUDevice
 }
 
-func (g *nvidiaTuner) Tune(hostconfig *container.HostConfig, ids []GPUID) error {
+func (g *nvidiaTuner) Tune(config *container.Config, hostconfig *container.HostConfig, ids []GPUID) error {
 	g.m.Lock()
 	defer g.m.Unlock()
 
-	return tuneContainer(hostconfig, g.devMap, ids)
+	if err := tuneContainer(config, hostconfig, g.devMap, ids); err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if g.devMap[id].GetPartition().GetType() == sonm.GPUPartition_TIME_SLICE {
+			tuneMPS(config, hostconfig, g.options.MPSPipeDir)
+			break
+		}
+	}
+
+	return nil
 }
 
 func (g *nvidiaTuner) Devices() []*sonm.GPUDevice {
@@ -55,12 +70,83 @@ func (g *nvidiaTuner) Close() error {
 	return os.Remove(g.options.SocketPath)
 }
 
+// lookupMIGDevices returns devices representing MIG instances created on the
+// device with the given minor number, if any.
+//
+// Instances are discovered through capabilities exposed by the driver in
+// procfs, while their profiles are queried using "nvidia-smi".
+func lookupMIGDevices(dev *sonm.GPUDevice, minor uint64) ([]*sonm.GPUDevice, error) {
+	migDir := fmt.Sprintf("/proc/driver/nvidia/capabilities/gpu%d/mig", minor)
+	instanceDirs, err := filepath.Glob(filepath.Join(migDir, "gi*"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(instanceDirs) == 0 {
+		return nil, nil
+	}
+
+	output, err := exec.Command("nvidia-smi", "-i", dev.GetID(), "mig", "-lgi").CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list MIG instances: %v: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	instances, err := parseMIGInstances(output)
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []*sonm.GPUDevice
+	for _, instance := range instances {
+		memory, err := migProfileMemory(instance.Profile)
+		if err != nil {
+			return nil, err
+		}
+
+		// Access to the GPU instance and all of its compute instances is
+		// required for using it.
+		instanceDir := filepath.Join(migDir, fmt.Sprintf("gi%d", instance.ID))
+		accessFiles, err := filepath.Glob(filepath.Join(instanceDir, "ci*", "access"))
+		if err != nil {
+			return nil, err
+		}
+
+		var capFiles []string
+		for _, path := range append([]string{filepath.Join(instanceDir, "access")}, accessFiles...) {
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				return nil, err
+			}
+
+			capMinor, err := parseCapabilityMinor(data)
+			if err != nil {
+				return nil, fmt.Errorf("malformed capability %s: %v", path, err)
+			}
+
+			capFiles = append(capFiles, capabilityDevicePath(capMinor))
+		}
+
+		devices = append(devices, newMIGDevice(dev, instance, memory, capFiles))
+	}
+
+	return devices, nil
+}
+
 func newNvidiaTuner(ctx context.Context, opts ...Option) (Tuner, error) {
 	options := nvidiaDefaultOptions()
 	for _, f := range opts {
 		f(options)
 	}
 
+	// Memory limits of time slices are useless without MPS, so refuse to
+	// sell slices that can not be enforced.
+	if options.TimeSlices > 1 {
+		if err := checkMPSDaemon(options.MPSPipeDir); err != nil {
+			log.G(ctx).Error("failed to enable GPU time slicing", zap.Error(err))
+			return nil, err
+		}
+	}
+
 	// get devices list provided by openCL
 	clDevices, err := gpu.GetGPUDevices()
 	if err != nil {
@@ -136,7 +222,18 @@ func newNvidiaTuner(ctx context.Context, opts ...Option) (Tuner, error) {
 		}
 
 		dev.FillHashID()
-		ovs.devMap[GPUID(card.PCIBusID)] = dev
+
+		migDevices, err := lookupMIGDevices(dev, card.Minor)
+		if err != nil {
+			log.G(ctx).Error("failed to lookup MIG instances", zap.String("device", card.PCIBusID), zap.Error(err))
+			return nil, err
+		}
+
+		for _, partition := range partitionDevice(dev, migDevices, options.TimeSlices) {
+			log.G(ctx).Info("discovered GPU device", zap.String("id", partition.GetID()),
+				zap.String("name", partition.GetDeviceName()), zap.Uint64("mem", partition.GetMemory()))
+			ovs.devMap[GPUID(partition.GetID())] = partition
+		}
 	}
 
 	volInfo := []nvidia.VolumeInfo{
@@ -11,6 +11,7 @@ const (
 	nvidiaVolumeDriver   = "nvidia-docker"
 	nvidiaDriverVersion  = "300.0"
 	nvidiaLibsMountPoint = "/usr/local/nvidia"
+	nvidiaMPSPipeDir     = "/tmp/nvidia-mps"
 	radeonVolumeDriver   = "radeon-docker"
 	radeonDriverVersion  = "2482.3"
 	radeonLibsMountPoint = "/usr/local/amdgpu"
@@ -25,6 +26,15 @@ type tunerOptions struct {
 	RemoteSocket     string `mapstructure:"remote_sock"`
 	SocketPath       string `mapstructure:"-"`
 	libsMountPoint   string `mapstructure:"-"`
+	// TimeSlices is the number of time slices each device without MIG
+	// instances is split into, no slicing is done when less than 2.
+	TimeSlices uint64 `mapstructure:"time_slices"`
+	// MPSPipeDir is the pipe directory of the CUDA MPS control daemon,
+	// which enforces memory limits of time slices.
+	MPSPipeDir string `mapstructure:"mps_pipe_dir"`
+	// MIGProfiles is a comma-separated list of MIG profiles of instances
+	// each fake device is split into.
+	MIGProfiles string `mapstructure:"mig_profiles"`
 }
 
 type Option func(*tunerOptions)
@@ -49,6 +59,7 @@ func nvidiaDefaultOptions() *tunerOptions {
 		libsMountPoint:   nvidiaLibsMountPoint,
 		VolumePath:       fmt.Sprintf("/var/lib/%s/volumes", nvidiaVolumeDriver),
 		SocketPath:       fmt.Sprintf("/run/docker/plugins/%s.sock", nvidiaVolumeDriver),
+		MPSPipeDir:       nvidiaMPSPipeDir,
 	}
 }
 
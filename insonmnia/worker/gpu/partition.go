@@ -0,0 +1,263 @@
+package gpu
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/sonm-io/core/proto"
+)
+
+const (
+	// partitionSeparator separates the ID of the physical device from the
+	// partition suffix in IDs of partitions, e.g. "0000:01:00.0/mig3".
+	partitionSeparator = "/"
+	// nvidiaCapsDir is where NVIDIA capability device files live.
+	nvidiaCapsDir = "/dev/nvidia-caps"
+	// nvidiaMemLimitEnv limits device memory available to CUDA clients.
+	// Honoured when the CUDA MPS control daemon is running on the host.
+	nvidiaMemLimitEnv = "CUDA_MPS_PINNED_DEVICE_MEM_LIMIT"
+	// nvidiaDeviceOrderEnv makes CUDA enumerate devices in PCI bus order,
+	// which is the order memory limits are specified in.
+	nvidiaDeviceOrderEnv = "CUDA_DEVICE_ORDER=PCI_BUS_ID"
+	// nvidiaMPSPipeDirEnv points CUDA clients to pipes of the MPS control
+	// daemon.
+	nvidiaMPSPipeDirEnv = "CUDA_MPS_PIPE_DIRECTORY"
+)
+
+// Physical returns the ID of the physical device the GPU belongs to.
+func (m GPUID) Physical() GPUID {
+	return GPUID(strings.SplitN(string(m), partitionSeparator, 2)[0])
+}
+
+// migInstance describes an NVIDIA MIG GPU instance.
+type migInstance struct {
+	Profile string
+	ID      uint64
+}
+
+// Matches rows of the "nvidia-smi mig -lgi" table, like
+// "|   0  MIG 1g.5gb       19        7          0:1     |".
+var migInstanceRe = regexp.MustCompile(`^\|\s+\d+\s+MIG\s+(\S+)\s+\d+\s+(\d+)\s+\d+:\d+\s+\|$`)
+
+// parseMIGInstances parses the output of "nvidia-smi mig -lgi".
+func parseMIGInstances(output []byte) ([]migInstance, error) {
+	var instances []migInstance
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		m := migInstanceRe.FindStringSubmatch(strings.TrimSpace(scanner.Text()))
+		if m == nil {
+			continue
+		}
+
+		id, err := strconv.ParseUint(m[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed MIG instance ID `%s`: %v", m[2], err)
+		}
+
+		instances = append(instances, migInstance{Profile: m[1], ID: id})
+	}
+
+	return instances, scanner.Err()
+}
+
+// Matches MIG profile names, like "1g.5gb", "3g.20gb" or "1g.10gb+me".
+var migProfileRe = regexp.MustCompile(`^\d+g\.(\d+)gb(\+\w+)?$`)
+
+// migProfileMemory returns the amount of memory of MIG instances with the
+// given profile, as advertised by the profile name.
+func migProfileMemory(profile string) (uint64, error) {
+	m := migProfileRe.FindStringSubmatch(profile)
+	if m == nil {
+		return 0, fmt.Errorf("unknown MIG profile `%s`", profile)
+	}
+
+	size, err := strconv.ParseUint(m[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unknown MIG profile `%s`: %v", profile, err)
+	}
+
+	return size << 30, nil
+}
+
+// parseCapabilityMinor parses NVIDIA capability access files from
+// "/proc/driver/nvidia/capabilities", returning the minor number of the
+// corresponding "/dev/nvidia-caps/nvidia-cap*" device file.
+func parseCapabilityMinor(data []byte) (uint64, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), ":", 2)
+		if len(parts) == 2 && strings.TrimSpace(parts[0]) == "DeviceFileMinor" {
+			return strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 64)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	return 0, fmt.Errorf("no device file minor found")
+}
+
+func capabilityDevicePath(minor uint64) string {
+	return fmt.Sprintf("%s/nvidia-cap%d", nvidiaCapsDir, minor)
+}
+
+func newPartition(parent *sonm.GPUDevice, suffix string, partition *sonm.GPUPartition) *sonm.GPUDevice {
+	partition.ParentID = parent.GetID()
+
+	dev := &sonm.GPUDevice{
+		ID:            parent.GetID() + partitionSeparator + suffix,
+		VendorID:      parent.GetVendorID(),
+		VendorName:    parent.GetVendorName(),
+		DeviceID:      parent.GetDeviceID(),
+		DeviceName:    parent.GetDeviceName(),
+		MajorNumber:   parent.GetMajorNumber(),
+		MinorNumber:   parent.GetMinorNumber(),
+		Memory:        parent.GetMemory(),
+		DeviceFiles:   append([]string{}, parent.GetDeviceFiles()...),
+		DriverVolumes: parent.GetDriverVolumes(),
+		Partition:     partition,
+	}
+
+	return dev
+}
+
+// newMIGDevice constructs the device representing the MIG instance of the
+// parent device. Capability device files grant access to the instance.
+func newMIGDevice(parent *sonm.GPUDevice, instance migInstance, memory uint64, capFiles []string) *sonm.GPUDevice {
+	dev := newPartition(parent, fmt.Sprintf("mig%d", instance.ID), &sonm.GPUPartition{
+		Type:    sonm.GPUPartition_MIG,
+		Profile: instance.Profile,
+	})
+	dev.DeviceName = fmt.Sprintf("%s MIG %s", parent.GetDeviceName(), instance.Profile)
+	dev.Memory = memory
+	dev.DeviceFiles = append(dev.DeviceFiles, capFiles...)
+	dev.FillHashID()
+
+	return dev
+}
+
+// newTimeSlicedDevices splits the device into the given number of time
+// slices, each having an equal share of device memory.
+//
+// Memory shares are enforced by the CUDA MPS control daemon, see
+// timeSliceEnv.
+func newTimeSlicedDevices(parent *sonm.GPUDevice, slices uint64) []*sonm.GPUDevice {
+	devices := make([]*sonm.GPUDevice, 0, slices)
+	for slice := uint64(0); slice < slices; slice++ {
+		dev := newPartition(parent, fmt.Sprintf("slice%d", slice), &sonm.GPUPartition{
+			Type:   sonm.GPUPartition_TIME_SLICE,
+			Slice:  slice,
+			Slices: slices,
+		})
+		dev.DeviceName = fmt.Sprintf("%s (%d/%d)", parent.GetDeviceName(), slice+1, slices)
+		dev.Memory = parent.GetMemory() / slices
+		dev.FillHashID()
+
+		devices = append(devices, dev)
+	}
+
+	return devices
+}
+
+// partitionDevice returns devices the physical device is exposed as.
+//
+// MIG instances, when present, replace the device, because CUDA can not use
+// MIG-enabled devices as a whole. Otherwise the device is split into time
+// slices when more than one is configured.
+func partitionDevice(dev *sonm.GPUDevice, migDevices []*sonm.GPUDevice, timeSlices uint64) []*sonm.GPUDevice {
+	switch {
+	case len(migDevices) > 0:
+		return migDevices
+	case timeSlices > 1:
+		return newTimeSlicedDevices(dev, timeSlices)
+	default:
+		return []*sonm.GPUDevice{dev}
+	}
+}
+
+// timeSliceEnv returns environment variables limiting memory of time-sliced
+// devices attached to a container.
+//
+// Limits are specified by device index, hence all physical devices are
+// enumerated in PCI bus order. Several slices of the same device add up.
+func timeSliceEnv(devices []*sonm.GPUDevice) []string {
+	memory := map[string]uint64{}
+	var physicalIDs []string
+	for _, dev := range devices {
+		id := dev.PhysicalID()
+		if _, ok := memory[id]; !ok {
+			physicalIDs = append(physicalIDs, id)
+			memory[id] = 0
+		}
+
+		if dev.GetPartition().GetType() == sonm.GPUPartition_TIME_SLICE {
+			memory[id] += dev.GetMemory()
+		}
+	}
+
+	sort.Strings(physicalIDs)
+
+	var limits []string
+	for idx, id := range physicalIDs {
+		if memory[id] > 0 {
+			limits = append(limits, fmt.Sprintf("%d=%dM", idx, memory[id]>>20))
+		}
+	}
+
+	if len(limits) == 0 {
+		return nil
+	}
+
+	return []string{nvidiaDeviceOrderEnv, nvidiaMemLimitEnv + "=" + strings.Join(limits, ",")}
+}
+
+// checkMPSDaemon returns an error unless the CUDA MPS control daemon serving
+// the given pipe directory is running.
+//
+// The daemon keeps its control pipe open for reading, hence opening it for
+// writing without blocking fails when there is no daemon, even if a stale
+// pipe is left behind.
+func checkMPSDaemon(pipeDir string) error {
+	path := filepath.Join(pipeDir, "control")
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("CUDA MPS control daemon is not running: %v", err)
+	}
+
+	if info.Mode()&os.ModeNamedPipe == 0 {
+		return fmt.Errorf("CUDA MPS control daemon is not running: %s is not a pipe", path)
+	}
+
+	pipe, err := os.OpenFile(path, os.O_WRONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return fmt.Errorf("CUDA MPS control daemon is not running: %v", err)
+	}
+
+	return pipe.Close()
+}
+
+// tuneMPS makes CUDA clients of the container connect to the MPS control
+// daemon, which enforces memory limits of time slices.
+//
+// MPS clients must share the IPC namespace with the daemon.
+func tuneMPS(config *container.Config, hostconfig *container.HostConfig, pipeDir string) {
+	config.Env = append(config.Env, nvidiaMPSPipeDirEnv+"="+pipeDir)
+	hostconfig.Mounts = append(hostconfig.Mounts, mount.Mount{
+		Type:   mount.TypeBind,
+		Source: pipeDir,
+		Target: pipeDir,
+	})
+	hostconfig.IpcMode = "host"
+}
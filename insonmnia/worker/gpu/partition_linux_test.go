@@ -0,0 +1,32 @@
+package gpu
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckMPSDaemon(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nvidia-mps")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.Error(t, checkMPSDaemon(dir))
+
+	path := filepath.Join(dir, "control")
+	require.NoError(t, syscall.Mkfifo(path, 0600))
+
+	// Stale pipe left by a stopped daemon.
+	assert.Error(t, checkMPSDaemon(dir))
+
+	daemon, err := os.OpenFile(path, os.O_RDONLY|syscall.O_NONBLOCK, 0)
+	require.NoError(t, err)
+	defer daemon.Close()
+
+	assert.NoError(t, checkMPSDaemon(dir))
+}
@@ -0,0 +1,181 @@
+package gpu
+
+import (
+	"context"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGPUIDPhysical(t *testing.T) {
+	assert.Equal(t, GPUID("0000:01:00.0"), GPUID("0000:01:00.0").Physical())
+	assert.Equal(t, GPUID("0000:01:00.0"), GPUID("0000:01:00.0/mig3").Physical())
+	assert.Equal(t, GPUID("0000:01:00.0"), GPUID("0000:01:00.0/slice1").Physical())
+}
+
+func TestParseMIGInstances(t *testing.T) {
+	output := `+----------------------------------------------------+
+| GPU instances:                                     |
+| GPU   Name          Profile  Instance   Placement  |
+|                       ID       ID       Start:Size |
+|====================================================|
+|   0  MIG 1g.5gb       19        7          0:1     |
++----------------------------------------------------+
+|   0  MIG 3g.20gb       9        2          4:4     |
++----------------------------------------------------+
+`
+
+	instances, err := parseMIGInstances([]byte(output))
+	require.NoError(t, err)
+	assert.Equal(t, []migInstance{{Profile: "1g.5gb", ID: 7}, {Profile: "3g.20gb", ID: 2}}, instances)
+
+	instances, err = parseMIGInstances([]byte("No GPU instances found: Not Found\n"))
+	require.NoError(t, err)
+	assert.Empty(t, instances)
+}
+
+func TestMIGProfileMemory(t *testing.T) {
+	memory, err := migProfileMemory("1g.5gb")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5<<30), memory)
+
+	memory, err = migProfileMemory("1g.10gb+me")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10<<30), memory)
+
+	_, err = migProfileMemory("5gb")
+	assert.Error(t, err)
+}
+
+func TestParseCapabilityMinor(t *testing.T) {
+	minor, err := parseCapabilityMinor([]byte("DeviceFileMinor: 66\nDeviceFileMode: 292\nDeviceFileModify: 1\n"))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(66), minor)
+	assert.Equal(t, "/dev/nvidia-caps/nvidia-cap66", capabilityDevicePath(minor))
+
+	_, err = parseCapabilityMinor([]byte("DeviceFileMode: 292\n"))
+	assert.Error(t, err)
+}
+
+func newTestDevice() *sonm.GPUDevice {
+	dev := &sonm.GPUDevice{
+		ID:          "0000:01:00.0",
+		DeviceName:  "A100",
+		Memory:      40 << 30,
+		DeviceFiles: []string{"/dev/nvidiactl", "/dev/nvidia0"},
+	}
+	dev.FillHashID()
+
+	return dev
+}
+
+func TestPartitionDevice(t *testing.T) {
+	dev := newTestDevice()
+
+	assert.Equal(t, []*sonm.GPUDevice{dev}, partitionDevice(dev, nil, 0))
+	assert.Equal(t, []*sonm.GPUDevice{dev}, partitionDevice(dev, nil, 1))
+
+	slices := partitionDevice(dev, nil, 4)
+	require.Len(t, slices, 4)
+	for idx, slice := range slices {
+		assert.Equal(t, uint64(10<<30), slice.GetMemory())
+		assert.Equal(t, dev.GetDeviceFiles(), slice.GetDeviceFiles())
+		assert.Equal(t, dev.GetID(), slice.PhysicalID())
+		assert.Equal(t, uint64(idx), slice.GetPartition().GetSlice())
+		assert.NotEqual(t, dev.GetHash(), slice.GetHash())
+	}
+	assert.Equal(t, "0000:01:00.0/slice1", slices[1].GetID())
+
+	migDevice := newMIGDevice(dev, migInstance{Profile: "3g.20gb", ID: 2}, 20<<30, []string{"/dev/nvidia-caps/nvidia-cap21"})
+	assert.Equal(t, "0000:01:00.0/mig2", migDevice.GetID())
+	assert.Equal(t, "A100 MIG 3g.20gb", migDevice.GetDeviceName())
+	assert.Equal(t, []string{"/dev/nvidiactl", "/dev/nvidia0", "/dev/nvidia-caps/nvidia-cap21"}, migDevice.GetDeviceFiles())
+	// Parent devices must not be affected.
+	assert.Equal(t, []string{"/dev/nvidiactl", "/dev/nvidia0"}, dev.GetDeviceFiles())
+
+	// MIG instances take precedence over time slicing.
+	assert.Equal(t, []*sonm.GPUDevice{migDevice}, partitionDevice(dev, []*sonm.GPUDevice{migDevice}, 4))
+}
+
+func TestTimeSliceEnv(t *testing.T) {
+	dev := newTestDevice()
+	slices := newTimeSlicedDevices(dev, 4)
+
+	other := &sonm.GPUDevice{ID: "0000:02:00.0", Memory: 8 << 30}
+	migDevice := newMIGDevice(other, migInstance{Profile: "1g.5gb", ID: 7}, 5<<30, nil)
+
+	assert.Empty(t, timeSliceEnv(nil))
+	assert.Empty(t, timeSliceEnv([]*sonm.GPUDevice{dev, migDevice}))
+	assert.Equal(t, []string{"CUDA_DEVICE_ORDER=PCI_BUS_ID", "CUDA_MPS_PINNED_DEVICE_MEM_LIMIT=0=20480M"},
+		timeSliceEnv([]*sonm.GPUDevice{migDevice, slices[0], slices[2]}))
+
+	otherSlices := newTimeSlicedDevices(other, 2)
+	assert.Equal(t, []string{"CUDA_DEVICE_ORDER=PCI_BUS_ID", "CUDA_MPS_PINNED_DEVICE_MEM_LIMIT=0=10240M,1=4096M"},
+		timeSliceEnv([]*sonm.GPUDevice{otherSlices[1], slices[3]}))
+}
+
+func TestFakeTunerPartitions(t *testing.T) {
+	tuner, err := New(context.Background(), sonm.GPUVendorType_FAKE, WithOptions(map[string]string{
+		"device_count": "2",
+		"mig_profiles": "3g.20gb, 1g.5gb",
+	}))
+	require.NoError(t, err)
+
+	devices := tuner.Devices()
+	require.Len(t, devices, 4)
+
+	hashes := map[string]struct{}{}
+	for _, dev := range devices {
+		assert.Equal(t, sonm.GPUPartition_MIG, dev.GetPartition().GetType())
+		hashes[dev.GetHash()] = struct{}{}
+	}
+	assert.Len(t, hashes, 4)
+	assert.Equal(t, "PCI:0000/mig1", devices[0].GetID())
+	assert.Equal(t, uint64(20<<30), devices[0].GetMemory())
+	assert.Equal(t, uint64(5<<30), devices[1].GetMemory())
+
+	// Partitions are sold independently.
+	resources := &sonm.AskPlanGPU{}
+	for _, dev := range devices {
+		resources.Hashes = append(resources.Hashes, dev.GetHash())
+	}
+	plan := &sonm.AskPlanGPU{Hashes: []string{devices[1].GetHash()}}
+	require.NoError(t, resources.Sub(plan))
+	assert.Len(t, resources.GetHashes(), 3)
+	assert.False(t, resources.Contains(plan))
+
+	_, err = New(context.Background(), sonm.GPUVendorType_FAKE, WithOptions(map[string]string{"mig_profiles": "huge"}))
+	assert.Error(t, err)
+}
+
+func TestFakeTunerTimeSlices(t *testing.T) {
+	tuner, err := New(context.Background(), sonm.GPUVendorType_FAKE, WithOptions(map[string]string{
+		"time_slices": "2",
+	}))
+	require.NoError(t, err)
+
+	devices := tuner.Devices()
+	require.Len(t, devices, 2)
+
+	config := &container.Config{}
+	hostConfig := &container.HostConfig{}
+	require.NoError(t, tuner.Tune(config, hostConfig, []GPUID{GPUID(devices[1].GetID())}))
+	assert.Equal(t, []string{"CUDA_DEVICE_ORDER=PCI_BUS_ID", "CUDA_MPS_PINNED_DEVICE_MEM_LIMIT=0=2048M"}, config.Env)
+
+	assert.Error(t, tuner.Tune(config, hostConfig, []GPUID{"PCI:0000"}))
+}
+
+func TestTuneMPS(t *testing.T) {
+	config := &container.Config{Env: []string{"FOO=bar"}}
+	hostConfig := &container.HostConfig{}
+	tuneMPS(config, hostConfig, "/tmp/nvidia-mps")
+
+	assert.Equal(t, []string{"FOO=bar", "CUDA_MPS_PIPE_DIRECTORY=/tmp/nvidia-mps"}, config.Env)
+	require.Len(t, hostConfig.Mounts, 1)
+	assert.Equal(t, "/tmp/nvidia-mps", hostConfig.Mounts[0].Source)
+	assert.Equal(t, "/tmp/nvidia-mps", hostConfig.Mounts[0].Target)
+	assert.Equal(t, container.IpcMode("host"), hostConfig.IpcMode)
+}
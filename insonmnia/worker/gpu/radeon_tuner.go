@@ -105,11 +105,11 @@ func newRadeonTuner(ctx context.Context, opts ...Option) (Tuner, error) {
 	return tun, nil
 }
 
-func (tun *radeonTuner) Tune(hostconfig *container.HostConfig, ids []GPUID) error {
+func (tun *radeonTuner) Tune(config *container.Config, hostconfig *container.HostConfig, ids []GPUID) error {
 	tun.m.Lock()
 	defer tun.m.Unlock()
 
-	return tuneContainer(hostconfig, tun.devMap, ids)
+	return tuneContainer(config, hostconfig, tun.devMap, ids)
 }
 
 func (tun *radeonTuner) Devices() []*sonm.GPUDevice {
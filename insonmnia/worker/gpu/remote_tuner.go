@@ -70,9 +70,9 @@ func (m *remoteTuner) deviceMap() map[GPUID]*sonm.GPUDevice {
 	return devMap
 }
 
-func (m *remoteTuner) Tune(hostconfig *container.HostConfig, ids []GPUID) error {
+func (m *remoteTuner) Tune(config *container.Config, hostconfig *container.HostConfig, ids []GPUID) error {
 	devMap := m.deviceMap()
-	return tuneContainer(hostconfig, devMap, ids)
+	return tuneContainer(config, hostconfig, devMap, ids)
 }
 
 func (m *remoteTuner) Close() error { return nil }
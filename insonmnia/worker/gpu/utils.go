@@ -29,9 +29,11 @@ func newVolumeMount(src, dst, name string) mount.Mount {
 	}
 }
 
-func tuneContainer(hostconfig *container.HostConfig, devices map[GPUID]*sonm.GPUDevice, ids []GPUID) error {
+func tuneContainer(config *container.Config, hostconfig *container.HostConfig, devices map[GPUID]*sonm.GPUDevice, ids []GPUID) error {
 	var cardsToBind = make(map[GPUID]*sonm.GPUDevice)
 	var volumeMapping = make(map[string]mount.Mount)
+	// Partitions of the same physical device share its device files.
+	var deviceFiles = make(map[string]struct{})
 	for _, id := range ids {
 		card, ok := devices[id]
 		if !ok {
@@ -43,6 +45,11 @@ func tuneContainer(hostconfig *container.HostConfig, devices map[GPUID]*sonm.GPU
 
 	for _, card := range cardsToBind {
 		for _, device := range card.GetDeviceFiles() {
+			if _, ok := deviceFiles[device]; ok {
+				continue
+			}
+			deviceFiles[device] = struct{}{}
+
 			hostconfig.Devices = append(hostconfig.Devices, container.DeviceMapping{
 				PathOnHost:        device,
 				PathInContainer:   device,
@@ -64,5 +71,11 @@ func tuneContainer(hostconfig *container.HostConfig, devices map[GPUID]*sonm.GPU
 		hostconfig.Mounts = append(hostconfig.Mounts, mnt)
 	}
 
+	var cards []*sonm.GPUDevice
+	for _, card := range cardsToBind {
+		cards = append(cards, card)
+	}
+	config.Env = append(config.Env, timeSliceEnv(cards)...)
+
 	return nil
 }
@@ -0,0 +1,470 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/docker/distribution/reference"
+	"github.com/docker/docker/api/types"
+	"github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util/xdocker"
+	"go.uber.org/zap"
+)
+
+const imageCacheKey = "image_cache"
+
+// ImagesConfig describes how images pulled by the worker are cached.
+type ImagesConfig struct {
+	// DiskBudget limits the disk space taken by Docker images. When
+	// exceeded, least recently used images pulled by the worker are removed.
+	// Zero means no limit.
+	DiskBudget sonm.DataSize `yaml:"disk_budget"`
+	// GCInterval is how often the disk budget is checked.
+	GCInterval time.Duration `yaml:"gc_interval" default:"10m"`
+	// Pinned images are pulled on start and never removed.
+	Pinned []string `yaml:"pinned"`
+}
+
+// imageRecord describes an image tracked by the cache.
+type imageRecord struct {
+	LastUsed time.Time `json:"last_used"`
+	// Pinned is set for images explicitly pinned using the API.
+	Pinned bool `json:"pinned"`
+}
+
+type imageCacheStorage interface {
+	Save(key string, value interface{}) error
+	Load(key string, value interface{}) (bool, error)
+}
+
+type imageAPI interface {
+	DiskUsage(ctx context.Context) (types.DiskUsage, error)
+	ImageRemove(ctx context.Context, imageID string, options types.ImageRemoveOptions) ([]types.ImageDeleteResponseItem, error)
+	Close() error
+}
+
+type imageSpoolFunc func(ctx context.Context, d Description, progress func(xdocker.PullProgress)) error
+
+// imageCache keeps track of images pulled by the worker, removing least
+// recently used ones when the disk space taken by images exceeds the
+// budget.
+//
+// Images are never removed while they are used by containers, being pulled,
+// spooled for tasks being started or pinned. Images are pinned by the worker config, by ask plans that list
+// them for prefetching or explicitly using the API.
+type imageCache struct {
+	cfg     ImagesConfig
+	client  imageAPI
+	spool   imageSpoolFunc
+	storage imageCacheStorage
+	// planImages returns images listed by ask plans.
+	planImages func() []string
+	log        *zap.SugaredLogger
+
+	mu      sync.Mutex
+	records map[string]*imageRecord
+	// pulls contains progress of images being prefetched.
+	pulls map[string]*sonm.ImagePullProgress
+	// errors contains the reasons of failed prefetches.
+	errors map[string]string
+	// uses counts tasks being started using the image.
+	uses map[string]int
+}
+
+func newImageCache(cfg ImagesConfig, client imageAPI, spool imageSpoolFunc, storage imageCacheStorage, planImages func() []string, log *zap.SugaredLogger) (*imageCache, error) {
+	records := map[string]*imageRecord{}
+	if _, err := storage.Load(imageCacheKey, &records); err != nil {
+		return nil, fmt.Errorf("failed to load image cache state: %v", err)
+	}
+
+	for _, image := range cfg.Pinned {
+		if _, err := imageKey(image); err != nil {
+			return nil, fmt.Errorf("invalid pinned image %s: %v", image, err)
+		}
+	}
+
+	return &imageCache{
+		cfg:        cfg,
+		client:     client,
+		spool:      spool,
+		storage:    storage,
+		planImages: planImages,
+		log:        log,
+		records:    records,
+		pulls:      map[string]*sonm.ImagePullProgress{},
+		errors:     map[string]string{},
+		uses:       map[string]int{},
+	}, nil
+}
+
+// imageKey normalizes the given image reference, so different spellings of
+// the same image are tracked once, e.g. "alpine" becomes
+// "docker.io/library/alpine:latest".
+func imageKey(image string) (string, error) {
+	ref, err := xdocker.NewReference(image)
+	if err != nil {
+		return "", err
+	}
+
+	return referenceKey(ref), nil
+}
+
+func referenceKey(ref xdocker.Reference) string {
+	if named := ref.Named(); named != nil {
+		return reference.TagNameOnly(named).String()
+	}
+
+	return ref.String()
+}
+
+// Touch marks the image as recently used, starting to track it if it's not
+// tracked yet.
+func (m *imageCache) Touch(ref xdocker.Reference, now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.touch(referenceKey(ref), now)
+}
+
+// Use marks the image as recently used and prevents its removal until the
+// returned function is called. Tasks being started use their images from
+// spooling until their containers are created, because until then nothing
+// else keeps images from being removed.
+func (m *imageCache) Use(ref xdocker.Reference, now time.Time) func() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := referenceKey(ref)
+	m.uses[key]++
+	m.touch(key, now)
+
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		m.uses[key]--
+		if m.uses[key] == 0 {
+			delete(m.uses, key)
+		}
+	}
+}
+
+// touch should be called with the lock held.
+func (m *imageCache) touch(key string, now time.Time) {
+	record, ok := m.records[key]
+	if !ok {
+		record = &imageRecord{}
+		m.records[key] = record
+	}
+	record.LastUsed = now
+
+	m.save()
+}
+
+// Prefetch starts pulling the given images in the background, optionally
+// pinning them. Returns errors for images that cannot be prefetched.
+//
+// The context must outlive the pulls, i.e. it shouldn't be a request one.
+func (m *imageCache) Prefetch(ctx context.Context, images []string, auth string, pin bool) *sonm.ErrorByStringID {
+	result := sonm.NewTSErrorByStringID()
+
+	for _, image := range images {
+		ref, err := xdocker.NewReference(image)
+		if err != nil {
+			result.Append(image, err)
+			continue
+		}
+
+		key := referenceKey(ref)
+
+		m.mu.Lock()
+		if pin {
+			record, ok := m.records[key]
+			if !ok {
+				record = &imageRecord{}
+				m.records[key] = record
+			}
+			record.Pinned = true
+			m.save()
+		}
+
+		if _, ok := m.pulls[key]; ok {
+			m.mu.Unlock()
+			result.Append(image, nil)
+			continue
+		}
+
+		m.pulls[key] = &sonm.ImagePullProgress{}
+		delete(m.errors, key)
+		m.mu.Unlock()
+
+		go m.pull(ctx, key, ref, auth)
+
+		result.Append(image, nil)
+	}
+
+	return result.Unwrap()
+}
+
+func (m *imageCache) pull(ctx context.Context, key string, ref xdocker.Reference, auth string) {
+	m.log.Infof("prefetching image %s", key)
+
+	err := m.spool(ctx, Description{Reference: ref, Auth: auth}, func(progress xdocker.PullProgress) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		m.pulls[key] = newImagePullProgress(progress)
+	})
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.pulls, key)
+	if err != nil {
+		m.log.Warnf("failed to prefetch image %s: %v", key, err)
+		m.errors[key] = err.Error()
+		return
+	}
+
+	m.log.Infof("prefetched image %s", key)
+
+	record, ok := m.records[key]
+	if !ok {
+		record = &imageRecord{}
+		m.records[key] = record
+	}
+	record.LastUsed = time.Now()
+
+	m.save()
+}
+
+// PrefetchPinned starts pulling images pinned by the config and ask plans.
+func (m *imageCache) PrefetchPinned(ctx context.Context) {
+	images := append(append([]string{}, m.cfg.Pinned...), m.planImages()...)
+	if len(images) == 0 {
+		return
+	}
+
+	for _, item := range m.Prefetch(ctx, images, "", false).GetResponse() {
+		if len(item.GetError()) != 0 {
+			m.log.Warnf("failed to prefetch pinned image %s: %s", item.GetID(), item.GetError())
+		}
+	}
+}
+
+// Images returns the state of the cache.
+func (m *imageCache) Images(ctx context.Context) (*sonm.ImagesReply, error) {
+	usage, err := m.client.DiskUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pinned := m.pinned()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	keys := map[string]struct{}{}
+	for key := range m.records {
+		keys[key] = struct{}{}
+	}
+	for key := range m.pulls {
+		keys[key] = struct{}{}
+	}
+	for key := range m.errors {
+		keys[key] = struct{}{}
+	}
+	for key := range pinned {
+		keys[key] = struct{}{}
+	}
+
+	reply := &sonm.ImagesReply{
+		DiskBudget: m.cfg.DiskBudget.Bytes,
+		DiskUsage:  uint64(usage.LayersSize),
+	}
+
+	for key := range keys {
+		image := &sonm.CachedImage{
+			Image:    key,
+			Progress: m.pulls[key],
+			Error:    m.errors[key],
+		}
+
+		if record, ok := m.records[key]; ok {
+			if !record.LastUsed.IsZero() {
+				image.LastUsed = sonm.NewTimestamp(record.LastUsed)
+			}
+			image.Pinned = record.Pinned
+		}
+		if _, ok := pinned[key]; ok {
+			image.Pinned = true
+		}
+		if summary := findImageSummary(usage.Images, key); summary != nil {
+			image.Size = uint64(summary.Size - summary.SharedSize)
+		}
+
+		reply.Images = append(reply.Images, image)
+	}
+
+	sort.Slice(reply.Images, func(i, j int) bool {
+		return reply.Images[i].GetImage() < reply.Images[j].GetImage()
+	})
+
+	return reply, nil
+}
+
+// Run periodically removes least recently used images while the disk budget
+// is exceeded.
+func (m *imageCache) Run(ctx context.Context) error {
+	if m.cfg.DiskBudget.Bytes == 0 || m.cfg.GCInterval == 0 {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	ticker := time.NewTicker(m.cfg.GCInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := m.Collect(ctx); err != nil {
+				m.log.Warnf("failed to collect images: %v", err)
+			}
+		}
+	}
+}
+
+// Collect removes least recently used images one by one until the disk
+// space taken by images fits into the budget or there are no more images
+// that can be removed. Disk usage is queried after each removal, because
+// layers shared between images are freed only with the last of them.
+func (m *imageCache) Collect(ctx context.Context) error {
+	pinned := m.pinned()
+	skipped := map[string]struct{}{}
+
+	for {
+		usage, err := m.client.DiskUsage(ctx)
+		if err != nil {
+			return err
+		}
+
+		if uint64(usage.LayersSize) <= m.cfg.DiskBudget.Bytes {
+			return nil
+		}
+
+		key, ok := m.evictionCandidate(usage.Images, pinned, skipped)
+		if !ok {
+			m.log.Warnf("images take %d bytes exceeding the disk budget of %d bytes, but no more images can be removed",
+				usage.LayersSize, m.cfg.DiskBudget.Bytes)
+			return nil
+		}
+
+		m.log.Infof("removing least recently used image %s", key)
+		if _, err := m.client.ImageRemove(ctx, key, types.ImageRemoveOptions{PruneChildren: true}); err != nil {
+			m.log.Warnf("failed to remove image %s: %v", key, err)
+			skipped[key] = struct{}{}
+			continue
+		}
+
+		m.forget(key)
+	}
+}
+
+func (m *imageCache) evictionCandidate(images []*types.ImageSummary, pinned map[string]struct{}, skipped map[string]struct{}) (string, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var candidate string
+	var lastUsed time.Time
+	for key, record := range m.records {
+		if record.Pinned {
+			continue
+		}
+		if _, ok := pinned[key]; ok {
+			continue
+		}
+		if _, ok := skipped[key]; ok {
+			continue
+		}
+		if _, ok := m.pulls[key]; ok {
+			continue
+		}
+		if _, ok := m.uses[key]; ok {
+			continue
+		}
+
+		summary := findImageSummary(images, key)
+		if summary == nil || summary.Containers > 0 {
+			continue
+		}
+
+		if len(candidate) == 0 || record.LastUsed.Before(lastUsed) {
+			candidate, lastUsed = key, record.LastUsed
+		}
+	}
+
+	return candidate, len(candidate) != 0
+}
+
+func (m *imageCache) forget(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.records, key)
+	m.save()
+}
+
+// pinned returns keys of images pinned by the config and ask plans.
+func (m *imageCache) pinned() map[string]struct{} {
+	result := map[string]struct{}{}
+	for _, image := range append(append([]string{}, m.cfg.Pinned...), m.planImages()...) {
+		if key, err := imageKey(image); err == nil {
+			result[key] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// save persists tracked images. Should be called with the lock held.
+func (m *imageCache) save() {
+	if err := m.storage.Save(imageCacheKey, m.records); err != nil {
+		m.log.Warnf("failed to save image cache state: %v", err)
+	}
+}
+
+func findImageSummary(images []*types.ImageSummary, key string) *types.ImageSummary {
+	for _, summary := range images {
+		if summary.ID == key {
+			return summary
+		}
+
+		for _, tags := range [][]string{summary.RepoTags, summary.RepoDigests} {
+			for _, tag := range tags {
+				if normalized, err := imageKey(tag); err == nil && normalized == key {
+					return summary
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *imageCache) Close() error {
+	return m.client.Close()
+}
+
+func newImagePullProgress(progress xdocker.PullProgress) *sonm.ImagePullProgress {
+	return &sonm.ImagePullProgress{
+		Current:    progress.Current,
+		Total:      progress.Total,
+		Layers:     uint32(progress.Layers),
+		LayersDone: uint32(progress.Done),
+	}
+}
@@ -0,0 +1,244 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util/xdocker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testImageStorage struct {
+	records map[string]*imageRecord
+}
+
+func (m *testImageStorage) Save(key string, value interface{}) error {
+	m.records = map[string]*imageRecord{}
+	for k, v := range value.(map[string]*imageRecord) {
+		record := *v
+		m.records[k] = &record
+	}
+	return nil
+}
+
+func (m *testImageStorage) Load(key string, value interface{}) (bool, error) {
+	if m.records == nil {
+		return false, nil
+	}
+
+	records := value.(*map[string]*imageRecord)
+	for k, v := range m.records {
+		record := *v
+		(*records)[k] = &record
+	}
+	return true, nil
+}
+
+// testImageAPI emulates Docker images, each taking 100 bytes of its own
+// layers.
+type testImageAPI struct {
+	mu      sync.Mutex
+	images  []*types.ImageSummary
+	removed []string
+}
+
+func (m *testImageAPI) DiskUsage(ctx context.Context) (types.DiskUsage, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return types.DiskUsage{
+		LayersSize: int64(100 * len(m.images)),
+		Images:     append([]*types.ImageSummary{}, m.images...),
+	}, nil
+}
+
+func (m *testImageAPI) ImageRemove(ctx context.Context, imageID string, options types.ImageRemoveOptions) ([]types.ImageDeleteResponseItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, summary := range m.images {
+		if key, _ := imageKey(summary.RepoTags[0]); key == imageID {
+			m.images = append(m.images[:id], m.images[id+1:]...)
+			m.removed = append(m.removed, imageID)
+			return nil, nil
+		}
+	}
+
+	return nil, errors.New("no such image")
+}
+
+func (m *testImageAPI) Close() error {
+	return nil
+}
+
+func (m *testImageAPI) add(tag string, containers int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.images = append(m.images, &types.ImageSummary{
+		ID:         "sha256:" + tag,
+		RepoTags:   []string{tag},
+		Size:       100,
+		Containers: containers,
+	})
+}
+
+func newTestImageCache(t *testing.T, cfg ImagesConfig, client imageAPI, planImages ...string) *imageCache {
+	spool := func(ctx context.Context, d Description, progress func(xdocker.PullProgress)) error {
+		return nil
+	}
+
+	cache, err := newImageCache(cfg, client, spool, &testImageStorage{}, func() []string { return planImages }, zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	return cache
+}
+
+func testReference(t *testing.T, image string) xdocker.Reference {
+	ref, err := xdocker.NewReference(image)
+	require.NoError(t, err)
+	return ref
+}
+
+// waitTestImages polls the cache state until it satisfies the given
+// condition.
+func waitTestImages(t *testing.T, cache *imageCache, fn func(reply *sonm.ImagesReply) bool) *sonm.ImagesReply {
+	deadline := time.Now().Add(time.Second)
+	for {
+		reply, err := cache.Images(context.Background())
+		require.NoError(t, err)
+		if fn(reply) {
+			return reply
+		}
+
+		require.True(t, time.Now().Before(deadline), "timed out waiting for image cache state")
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestImageKey(t *testing.T) {
+	for _, image := range []string{"alpine", "alpine:latest", "docker.io/library/alpine", "docker.io/library/alpine:latest"} {
+		key, err := imageKey(image)
+		require.NoError(t, err)
+		assert.Equal(t, "docker.io/library/alpine:latest", key)
+	}
+
+	_, err := imageKey("Invalid:Reference:")
+	assert.Error(t, err)
+}
+
+func TestImageCacheCollect(t *testing.T) {
+	client := &testImageAPI{}
+	for _, tag := range []string{"used:1", "pinned:1", "plan:1", "old:1", "new:1", "foreign:1"} {
+		client.add(tag, 0)
+	}
+	client.images[0].Containers = 1
+
+	cfg := ImagesConfig{
+		DiskBudget: sonm.DataSize{Bytes: 300},
+		Pinned:     []string{"pinned:1"},
+	}
+	cache := newTestImageCache(t, cfg, client, "plan:1")
+
+	now := time.Now()
+	for id, image := range []string{"used:1", "pinned:1", "plan:1", "old:1", "new:1"} {
+		cache.Touch(testReference(t, image), now.Add(time.Duration(id)*time.Minute))
+	}
+
+	require.NoError(t, cache.Collect(context.Background()))
+	// Only images pulled by the worker, that aren't used or pinned are
+	// removed, the least recently used first.
+	assert.Equal(t, []string{"docker.io/library/old:1", "docker.io/library/new:1"}, client.removed)
+
+	// The budget is still exceeded, but there is nothing to remove.
+	require.NoError(t, cache.Collect(context.Background()))
+	assert.Len(t, client.removed, 2)
+
+	reply, err := cache.Images(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(300), reply.GetDiskBudget())
+	assert.Equal(t, uint64(400), reply.GetDiskUsage())
+	require.Len(t, reply.GetImages(), 3)
+	for _, image := range reply.GetImages() {
+		assert.Equal(t, uint64(100), image.GetSize())
+	}
+	assert.Equal(t, "docker.io/library/pinned:1", reply.GetImages()[0].GetImage())
+	assert.True(t, reply.GetImages()[0].GetPinned())
+	assert.True(t, reply.GetImages()[1].GetPinned())
+	assert.False(t, reply.GetImages()[2].GetPinned())
+}
+
+func TestImageCacheUse(t *testing.T) {
+	client := &testImageAPI{}
+	client.add("spooled:1", 0)
+
+	cache := newTestImageCache(t, ImagesConfig{DiskBudget: sonm.DataSize{Bytes: 0}}, client)
+
+	// Images of tasks being started are kept until containers are created.
+	release := cache.Use(testReference(t, "spooled:1"), time.Now())
+	require.NoError(t, cache.Collect(context.Background()))
+	assert.Empty(t, client.removed)
+
+	release()
+	require.NoError(t, cache.Collect(context.Background()))
+	assert.Equal(t, []string{"docker.io/library/spooled:1"}, client.removed)
+}
+
+func TestImageCachePrefetch(t *testing.T) {
+	client := &testImageAPI{}
+	storage := &testImageStorage{}
+
+	release := make(chan struct{})
+	spool := func(ctx context.Context, d Description, progress func(xdocker.PullProgress)) error {
+		progress(xdocker.PullProgress{Current: 10, Total: 100, Layers: 2})
+		<-release
+
+		if d.Reference.String() == "docker.io/library/broken" {
+			return errors.New("pull failed")
+		}
+
+		assert.Equal(t, "auth", d.Auth)
+		client.add(d.Reference.String(), 0)
+		return nil
+	}
+
+	cache, err := newImageCache(ImagesConfig{}, client, spool, storage, func() []string { return nil }, zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	result := cache.Prefetch(context.Background(), []string{"alpine", "broken", "Invalid:Reference:"}, "auth", true)
+	require.Len(t, result.GetResponse(), 3)
+	assert.Empty(t, result.GetResponse()[0].GetError())
+	assert.Empty(t, result.GetResponse()[1].GetError())
+	assert.NotEmpty(t, result.GetResponse()[2].GetError())
+
+	reply := waitTestImages(t, cache, func(reply *sonm.ImagesReply) bool {
+		return len(reply.GetImages()) == 2 && reply.GetImages()[0].GetProgress().GetCurrent() == 10
+	})
+	assert.Equal(t, uint32(2), reply.GetImages()[0].GetProgress().GetLayers())
+
+	close(release)
+
+	reply = waitTestImages(t, cache, func(reply *sonm.ImagesReply) bool {
+		return reply.GetImages()[0].GetProgress() == nil && reply.GetImages()[1].GetProgress() == nil
+	})
+
+	alpine, broken := reply.GetImages()[0], reply.GetImages()[1]
+	assert.Equal(t, "docker.io/library/alpine:latest", alpine.GetImage())
+	assert.True(t, alpine.GetPinned())
+	assert.Nil(t, alpine.GetProgress())
+	assert.Empty(t, alpine.GetError())
+	assert.Equal(t, "docker.io/library/broken:latest", broken.GetImage())
+	assert.Equal(t, "pull failed", broken.GetError())
+
+	// Pins survive restarts.
+	cache, err = newImageCache(ImagesConfig{}, client, spool, storage, func() []string { return nil }, zap.NewNop().Sugar())
+	require.NoError(t, err)
+	assert.True(t, cache.records["docker.io/library/alpine:latest"].Pinned)
+}
@@ -0,0 +1,506 @@
+package worker
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	timetypes "github.com/docker/docker/api/types/time"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sonm-io/core/proto"
+	"go.uber.org/zap"
+)
+
+const (
+	logsCleanupInterval = time.Hour
+	logFrameHeaderSize  = 8
+)
+
+// LogsConfig describes how logs of tasks are kept after their containers
+// are removed.
+type LogsConfig struct {
+	// Dir is a directory where compressed task logs are stored.
+	Dir string `yaml:"dir" default:"/var/lib/sonm/logs"`
+	// MaxSize limits the uncompressed size of logs kept for a single task.
+	// When exceeded, only the most recent entries are kept.
+	MaxSize sonm.DataSize `yaml:"max_size" default:"16 MB"`
+	// MaxAge limits the age of kept log entries. Zero means no limit.
+	MaxAge time.Duration `yaml:"max_age" default:"168h"`
+	// GracePeriod is how long logs are served after the deal is closed.
+	GracePeriod time.Duration `yaml:"grace_period" default:"72h"`
+}
+
+// archivedLogsMeta describes the archived logs of a single task.
+type archivedLogsMeta struct {
+	DealID     *sonm.BigInt   `json:"deal_id"`
+	Consumer   common.Address `json:"consumer"`
+	FinishedAt time.Time      `json:"finished_at"`
+}
+
+// logArchive keeps compressed logs of finished tasks on disk, allowing to
+// serve them after the containers are removed.
+//
+// Each task has two files: "<task>.log.gz" with the Docker multiplexed log
+// stream, where each entry is prefixed with its timestamp, and "<task>.json"
+// with the archive metadata.
+type logArchive struct {
+	cfg LogsConfig
+	log *zap.SugaredLogger
+}
+
+func newLogArchive(cfg LogsConfig, log *zap.SugaredLogger) (*logArchive, error) {
+	if err := os.MkdirAll(cfg.Dir, 0750); err != nil {
+		return nil, fmt.Errorf("failed to create logs directory: %v", err)
+	}
+
+	return &logArchive{
+		cfg: cfg,
+		log: log,
+	}, nil
+}
+
+// Save archives logs of the given task, replacing the previous archive if
+// any. Logs are read twice: first to find out how many leading entries must
+// be dropped to fit into the size limit, then to actually store them.
+func (m *logArchive) Save(ctx context.Context, taskID string, meta archivedLogsMeta, logs func(ctx context.Context, opts types.ContainerLogsOptions) (io.ReadCloser, error)) error {
+	logsPath, metaPath, err := m.paths(taskID)
+	if err != nil {
+		return err
+	}
+
+	opts := types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Timestamps: true,
+	}
+	if m.cfg.MaxAge > 0 {
+		opts.Since = strconv.FormatInt(meta.FinishedAt.Add(-m.cfg.MaxAge).Unix(), 10)
+	}
+
+	rd, err := logs(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("failed to read logs: %v", err)
+	}
+	skip, err := logFramesToSkip(rd, m.cfg.MaxSize.GetBytes())
+	rd.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read logs: %v", err)
+	}
+
+	rd, err = logs(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("failed to read logs: %v", err)
+	}
+	defer rd.Close()
+
+	if err := writeFileAtomically(logsPath, func(wr io.Writer) error {
+		gz := gzip.NewWriter(wr)
+		if err := copyLogFrames(gz, rd, skip); err != nil {
+			return err
+		}
+		return gz.Close()
+	}); err != nil {
+		return fmt.Errorf("failed to archive logs: %v", err)
+	}
+
+	if err := writeFileAtomically(metaPath, func(wr io.Writer) error {
+		return json.NewEncoder(wr).Encode(meta)
+	}); err != nil {
+		os.Remove(logsPath)
+		return fmt.Errorf("failed to archive logs metadata: %v", err)
+	}
+
+	m.log.Infof("archived logs of task %s, %d entries dropped due to size limit", taskID, skip)
+
+	return nil
+}
+
+// Meta returns metadata of the archived logs of the given task. Archives
+// whose grace period is over are treated as missing.
+func (m *logArchive) Meta(taskID string, now time.Time) (*archivedLogsMeta, error) {
+	_, metaPath, err := m.paths(taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(metaPath)
+	if err != nil {
+		return nil, fmt.Errorf("no archived logs for task %s", taskID)
+	}
+
+	meta := &archivedLogsMeta{}
+	if err := json.Unmarshal(data, meta); err != nil {
+		return nil, fmt.Errorf("failed to decode archived logs metadata: %v", err)
+	}
+
+	if m.isExpired(meta, now) {
+		return nil, fmt.Errorf("archived logs for task %s have expired", taskID)
+	}
+
+	return meta, nil
+}
+
+// Open returns archived logs of the given task filtered according to the
+// request in the same format Docker serves them.
+func (m *logArchive) Open(taskID string, request *sonm.TaskLogsRequest, now time.Time) (io.ReadCloser, error) {
+	if _, err := m.Meta(taskID, now); err != nil {
+		return nil, err
+	}
+
+	filter, err := newLogsFilter(request, now)
+	if err != nil {
+		return nil, err
+	}
+
+	logsPath, _, err := m.paths(taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(logsPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archived logs: %v", err)
+	}
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to open archived logs: %v", err)
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		defer file.Close()
+		pw.CloseWithError(filter.Copy(pw, gz))
+	}()
+
+	return pr, nil
+}
+
+// Cleanup removes archives whose grace period is over.
+func (m *logArchive) Cleanup(now time.Time) error {
+	names, err := filepath.Glob(filepath.Join(m.cfg.Dir, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		taskID := strings.TrimSuffix(filepath.Base(name), ".json")
+		if _, err := m.Meta(taskID, now); err == nil {
+			continue
+		}
+
+		m.log.Infof("removing archived logs of task %s", taskID)
+
+		logsPath, metaPath, err := m.paths(taskID)
+		if err != nil {
+			continue
+		}
+		if err := os.Remove(logsPath); err != nil && !os.IsNotExist(err) {
+			m.log.Warnf("failed to remove archived logs of task %s: %v", taskID, err)
+		}
+		if err := os.Remove(metaPath); err != nil && !os.IsNotExist(err) {
+			m.log.Warnf("failed to remove archived logs metadata of task %s: %v", taskID, err)
+		}
+	}
+
+	return nil
+}
+
+// Run periodically removes expired archives until the context is canceled.
+func (m *logArchive) Run(ctx context.Context) error {
+	ticker := time.NewTicker(logsCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case now := <-ticker.C:
+			if err := m.Cleanup(now); err != nil {
+				m.log.Warnf("failed to cleanup archived logs: %v", err)
+			}
+		}
+	}
+}
+
+func (m *logArchive) isExpired(meta *archivedLogsMeta, now time.Time) bool {
+	return now.After(meta.FinishedAt.Add(m.cfg.GracePeriod))
+}
+
+func (m *logArchive) paths(taskID string) (string, string, error) {
+	if len(taskID) == 0 || taskID == "." || taskID == ".." || strings.ContainsAny(taskID, `/\`) {
+		return "", "", fmt.Errorf("invalid task id %q", taskID)
+	}
+
+	base := filepath.Join(m.cfg.Dir, taskID)
+	return base + ".log.gz", base + ".json", nil
+}
+
+func writeFileAtomically(name string, fn func(wr io.Writer) error) error {
+	file, err := ioutil.TempFile(filepath.Dir(name), filepath.Base(name)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if err := fn(file); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), name)
+}
+
+// logFrame is a single entry of the Docker multiplexed log stream.
+type logFrame struct {
+	Stream  byte
+	Payload []byte
+}
+
+func (m *logFrame) Size() uint64 {
+	return uint64(logFrameHeaderSize + len(m.Payload))
+}
+
+func (m *logFrame) WriteTo(wr io.Writer) (int64, error) {
+	header := [logFrameHeaderSize]byte{m.Stream}
+	binary.BigEndian.PutUint32(header[4:], uint32(len(m.Payload)))
+
+	n, err := wr.Write(header[:])
+	if err != nil {
+		return int64(n), err
+	}
+	k, err := wr.Write(m.Payload)
+	return int64(n + k), err
+}
+
+// Timestamp splits the payload into the timestamp Docker prefixes entries
+// with and the remaining message.
+func (m *logFrame) Timestamp() (time.Time, []byte, error) {
+	idx := bytes.IndexByte(m.Payload, ' ')
+	if idx < 0 {
+		return time.Time{}, nil, fmt.Errorf("log entry has no timestamp")
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, string(m.Payload[:idx]))
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	return timestamp, m.Payload[idx+1:], nil
+}
+
+func readLogFrame(rd io.Reader) (*logFrame, error) {
+	header := [logFrameHeaderSize]byte{}
+	if _, err := io.ReadFull(rd, header[:]); err != nil {
+		return nil, err
+	}
+
+	frame := &logFrame{
+		Stream:  header[0],
+		Payload: make([]byte, binary.BigEndian.Uint32(header[4:])),
+	}
+	if _, err := io.ReadFull(rd, frame.Payload); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	return frame, nil
+}
+
+// logFramesToSkip returns the number of leading frames that must be dropped
+// for the rest of the stream to fit into the given size.
+func logFramesToSkip(rd io.Reader, maxSize uint64) (int, error) {
+	rd = bufio.NewReader(rd)
+
+	var sizes []uint64
+	var total uint64
+	for {
+		frame, err := readLogFrame(rd)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		sizes = append(sizes, frame.Size())
+		total += frame.Size()
+	}
+
+	skip := 0
+	for ; maxSize > 0 && total > maxSize && skip < len(sizes); skip++ {
+		total -= sizes[skip]
+	}
+
+	return skip, nil
+}
+
+func copyLogFrames(wr io.Writer, rd io.Reader, skip int) error {
+	rd = bufio.NewReader(rd)
+
+	for id := 0; ; id++ {
+		frame, err := readLogFrame(rd)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if id < skip {
+			continue
+		}
+		if _, err := frame.WriteTo(wr); err != nil {
+			return err
+		}
+	}
+}
+
+// logsFilter applies TaskLogsRequest filters to the archived log stream.
+// Following and details are not supported for archived logs and are
+// ignored.
+type logsFilter struct {
+	stdout     bool
+	stderr     bool
+	since      time.Time
+	tail       int
+	timestamps bool
+}
+
+func newLogsFilter(request *sonm.TaskLogsRequest, now time.Time) (*logsFilter, error) {
+	since, err := parseLogsSince(request.GetSince(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	tail, err := parseLogsTail(request.GetTail())
+	if err != nil {
+		return nil, err
+	}
+
+	return &logsFilter{
+		stdout:     request.GetType() == sonm.TaskLogsRequest_STDOUT || request.GetType() == sonm.TaskLogsRequest_BOTH,
+		stderr:     request.GetType() == sonm.TaskLogsRequest_STDERR || request.GetType() == sonm.TaskLogsRequest_BOTH,
+		since:      since,
+		tail:       tail,
+		timestamps: request.GetAddTimestamps(),
+	}, nil
+}
+
+// Copy writes matching frames of the given stream. Negative tail means all
+// matching frames.
+func (m *logsFilter) Copy(wr io.Writer, rd io.Reader) error {
+	rd = bufio.NewReader(rd)
+
+	var frames []*logFrame
+	for {
+		frame, err := readLogFrame(rd)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if !m.matches(frame) {
+			continue
+		}
+
+		if m.tail < 0 {
+			if _, err := frame.WriteTo(wr); err != nil {
+				return err
+			}
+			continue
+		}
+
+		frames = append(frames, frame)
+		if len(frames) > m.tail {
+			frames = frames[1:]
+		}
+	}
+
+	for _, frame := range frames {
+		if _, err := frame.WriteTo(wr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *logsFilter) matches(frame *logFrame) bool {
+	switch frame.Stream {
+	case 1:
+		if !m.stdout {
+			return false
+		}
+	case 2:
+		if !m.stderr {
+			return false
+		}
+	}
+
+	timestamp, message, err := frame.Timestamp()
+	if err != nil {
+		return true
+	}
+	if timestamp.Before(m.since) {
+		return false
+	}
+	if !m.timestamps {
+		frame.Payload = message
+	}
+
+	return true
+}
+
+// parseLogsSince parses the "since" filter the same way Docker does: as a
+// relative duration, a timestamp or Unix time in seconds.
+func parseLogsSince(value string, now time.Time) (time.Time, error) {
+	if len(value) == 0 {
+		return time.Time{}, nil
+	}
+
+	timestamp, err := timetypes.GetTimestamp(value, now)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid logs since value %q: %v", value, err)
+	}
+
+	seconds, nanoseconds, err := timetypes.ParseTimestamps(timestamp, 0)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid logs since value %q: %v", value, err)
+	}
+
+	return time.Unix(seconds, nanoseconds), nil
+}
+
+func parseLogsTail(value string) (int, error) {
+	if len(value) == 0 || value == "all" {
+		return -1, nil
+	}
+
+	tail, err := strconv.Atoi(value)
+	if err != nil || tail < 0 {
+		return 0, fmt.Errorf("invalid logs tail value %q", value)
+	}
+
+	return tail, nil
+}
@@ -0,0 +1,135 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var testLogsEpoch = time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func testLogStream(t *testing.T) []byte {
+	buf := bytes.NewBuffer(nil)
+	for id, message := range []string{"out 0\n", "err 1\n", "out 2\n", "err 3\n", "out 4\n"} {
+		timestamp := testLogsEpoch.Add(time.Duration(id) * time.Minute).Format(time.RFC3339Nano)
+		frame := &logFrame{Stream: 1, Payload: []byte(timestamp + " " + message)}
+		if id%2 == 1 {
+			frame.Stream = 2
+		}
+		_, err := frame.WriteTo(buf)
+		require.NoError(t, err)
+	}
+
+	return buf.Bytes()
+}
+
+func readTestLogMessages(t *testing.T, rd io.Reader) []string {
+	var messages []string
+	for {
+		frame, err := readLogFrame(rd)
+		if err == io.EOF {
+			return messages
+		}
+		require.NoError(t, err)
+		messages = append(messages, string(frame.Payload))
+	}
+}
+
+func filterTestLogs(t *testing.T, request *sonm.TaskLogsRequest) []string {
+	filter, err := newLogsFilter(request, testLogsEpoch.Add(time.Hour))
+	require.NoError(t, err)
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, filter.Copy(buf, bytes.NewReader(testLogStream(t))))
+
+	return readTestLogMessages(t, buf)
+}
+
+func TestLogsFilter(t *testing.T) {
+	assert.Equal(t, []string{"out 0\n", "err 1\n", "out 2\n", "err 3\n", "out 4\n"},
+		filterTestLogs(t, &sonm.TaskLogsRequest{}))
+	assert.Equal(t, []string{"err 1\n", "err 3\n"},
+		filterTestLogs(t, &sonm.TaskLogsRequest{Type: sonm.TaskLogsRequest_STDERR}))
+	assert.Equal(t, []string{"err 3\n", "out 4\n"},
+		filterTestLogs(t, &sonm.TaskLogsRequest{Tail: "2"}))
+	assert.Equal(t, []string{"out 4\n"},
+		filterTestLogs(t, &sonm.TaskLogsRequest{Type: sonm.TaskLogsRequest_STDOUT, Tail: "1"}))
+	assert.Equal(t, []string{"out 2\n", "err 3\n", "out 4\n"},
+		filterTestLogs(t, &sonm.TaskLogsRequest{Since: "58m"}))
+	assert.Equal(t, []string{"err 3\n", "out 4\n"},
+		filterTestLogs(t, &sonm.TaskLogsRequest{Since: testLogsEpoch.Add(3 * time.Minute).Format(time.RFC3339)}))
+	assert.Equal(t, []string{"2018-06-01T12:04:00Z out 4\n"},
+		filterTestLogs(t, &sonm.TaskLogsRequest{Tail: "1", AddTimestamps: true}))
+}
+
+func TestLogsFilterInvalid(t *testing.T) {
+	_, err := newLogsFilter(&sonm.TaskLogsRequest{Tail: "-1"}, testLogsEpoch)
+	assert.Error(t, err)
+
+	_, err = newLogsFilter(&sonm.TaskLogsRequest{Since: "yesterday"}, testLogsEpoch)
+	assert.Error(t, err)
+}
+
+func TestLogArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sonm-logs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	stream := testLogStream(t)
+	// Keep room for the last two entries only.
+	maxSize := uint64(len(stream) * 2 / 5)
+
+	archive, err := newLogArchive(LogsConfig{
+		Dir:         dir,
+		MaxSize:     sonm.DataSize{Bytes: maxSize},
+		GracePeriod: time.Hour,
+	}, zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	meta := archivedLogsMeta{
+		DealID:     sonm.NewBigIntFromInt(42),
+		FinishedAt: testLogsEpoch.Add(5 * time.Minute),
+	}
+	err = archive.Save(context.Background(), "task", meta, func(ctx context.Context, opts types.ContainerLogsOptions) (io.ReadCloser, error) {
+		assert.True(t, opts.Timestamps)
+		return ioutil.NopCloser(bytes.NewReader(stream)), nil
+	})
+	require.NoError(t, err)
+
+	saved, err := archive.Meta("task", testLogsEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, "42", saved.DealID.String())
+
+	rd, err := archive.Open("task", &sonm.TaskLogsRequest{}, testLogsEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"err 3\n", "out 4\n"}, readTestLogMessages(t, rd))
+	rd.Close()
+
+	expired := testLogsEpoch.Add(2 * time.Hour)
+	_, err = archive.Open("task", &sonm.TaskLogsRequest{}, expired)
+	assert.Error(t, err)
+
+	require.NoError(t, archive.Cleanup(expired))
+	names, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, names)
+}
+
+func TestLogArchiveInvalidTaskID(t *testing.T) {
+	archive := &logArchive{cfg: LogsConfig{Dir: "/tmp"}}
+
+	for _, id := range []string{"", "..", "../task", "a/b"} {
+		_, err := archive.Meta(id, testLogsEpoch)
+		assert.Error(t, err, id)
+	}
+}
@@ -22,15 +22,17 @@ type Handler struct {
 	GPUs map[sonm.GPUVendorType]gpu.MetricsHandler
 	// in future, special handlers for other hardware units can be controlled by this plugin
 
-	mu        sync.Mutex
-	lastState *sonm.WorkerMetricsResponse
+	mu              sync.Mutex
+	lastState       *sonm.WorkerMetricsResponse
+	lastUtilization map[gpu.GPUID]float64
 }
 
 func NewHandler(log *zap.Logger, GPUConfig map[string]map[string]string) (*Handler, error) {
 	handler := &Handler{
-		lastState: &sonm.WorkerMetricsResponse{},
-		GPUs:      make(map[sonm.GPUVendorType]gpu.MetricsHandler),
-		logger:    log.Named("metrix"),
+		lastState:       &sonm.WorkerMetricsResponse{},
+		lastUtilization: map[gpu.GPUID]float64{},
+		GPUs:            make(map[sonm.GPUVendorType]gpu.MetricsHandler),
+		logger:          log.Named("metrix"),
 	}
 
 	handler.logger.Debug("initializing metrics handler")
@@ -41,7 +43,7 @@ func NewHandler(log *zap.Logger, GPUConfig map[string]map[string]string) (*Handl
 			return nil, err
 		}
 
-		h, err := gpu.NewMetricsHandler(typeID)
+		h, err := gpu.NewMetricsHandler(typeID, gpu.WithOptions(GPUConfig[vendor]))
 		if err != nil {
 			handler.logger.Error("failed to initialize GPU metrics plugin", zap.String("vendor", vendor), zap.Error(err))
 			return nil, err
@@ -84,6 +86,11 @@ func (m *Handler) update(ctx context.Context) error {
 		merr = multierror.Append(merr, fmt.Errorf("failed to update GPU metrics: %v", err))
 	}
 
+	gpuUtilization, err := m.updateGPUUtilization()
+	if err != nil {
+		merr = multierror.Append(merr, fmt.Errorf("failed to update GPU utilization: %v", err))
+	}
+
 	cpuMetrics, err := m.updateCPUMetrics()
 	if err != nil {
 		merr = multierror.Append(merr, fmt.Errorf("failed to update CPU metrics: %v", err))
@@ -109,6 +116,7 @@ func (m *Handler) update(ctx context.Context) error {
 	newState := &sonm.WorkerMetricsResponse{}
 	newState.Append(gpuMetrics, cpuMetrics, diskMetrics, ramMetrics)
 	m.lastState = newState
+	m.lastUtilization = gpuUtilization
 
 	return nil
 }
@@ -129,6 +137,22 @@ func (m *Handler) updateGPUMetrics() (map[string]float64, error) {
 	return result, nil
 }
 
+func (m *Handler) updateGPUUtilization() (map[gpu.GPUID]float64, error) {
+	result := make(map[gpu.GPUID]float64)
+	for _, h := range m.GPUs {
+		utilization, err := h.GetUtilization()
+		if err != nil {
+			return nil, err
+		}
+
+		for id, v := range utilization {
+			result[id] = v
+		}
+	}
+
+	return result, nil
+}
+
 func (m *Handler) updateCPUMetrics() (map[string]float64, error) {
 	loads, err := cpu.Percent(time.Second, false)
 	if err != nil {
@@ -182,6 +206,15 @@ func (m *Handler) Get() *sonm.WorkerMetricsResponse {
 	return m.lastState
 }
 
+// GPUUtilization returns the last collected utilization of GPU devices in
+// percents.
+func (m *Handler) GPUUtilization() map[gpu.GPUID]float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.lastUtilization
+}
+
 func (m *Handler) Close() error {
 	merr := multierror.NewMultiError()
 
@@ -5,4 +5,9 @@ type NetworkConfig struct {
 	// configurations.
 	// You'd likely not want to touch this.
 	RemoteQOS string `yaml:"remote_qos"`
+	// IPv6Subnet is an optional IPv6 prefix routed to this host, for example
+	// "2001:db8:1::/48". When specified, networks created for ask plans
+	// become dual-stack, each one getting its own /64 subnet from this
+	// prefix.
+	IPv6Subnet string `yaml:"ipv6_subnet"`
 }
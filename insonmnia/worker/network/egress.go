@@ -0,0 +1,287 @@
+package network
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/sonm-io/core/proto"
+)
+
+const (
+	// egressHookChain is the chain Docker provides for user rules, which are
+	// evaluated before Docker's own forwarding rules.
+	egressHookChain        = "DOCKER-USER"
+	egressPlanChainPrefix  = "SONM-EGP-"
+	egressTaskChainPrefix  = "SONM-EGT-"
+	egressGuardChainPrefix = "SONM-EGG-"
+	// maxEgressStages is the maximum number of policies checked for a
+	// single packet, which are the task's and the ask plan's ones.
+	maxEgressStages = 2
+)
+
+// EgressFilterAction represents an action that restricts outbound
+// connections from the bridge network according to the ask plan's egress
+// policy.
+//
+// It also installs the guard chain of the network, which drops new outbound
+// connections of containers until they are admitted by their
+// TaskEgressFilterAction, so containers have no network access until their
+// own rules are in place.
+//
+// Must be appended after creating Docker network.
+type EgressFilterAction struct {
+	Network *Network
+}
+
+// TaskEgressFilterAction represents an action that restricts outbound
+// connections of a single container attached to the bridge network and
+// admits the container through the guard chain of the network.
+//
+// Both the task's and the ask plan's egress policies are checked, so drops
+// can be attributed to the task. Hosts are resolved only when rules are
+// built, so they should be refreshed periodically.
+type TaskEgressFilterAction struct {
+	Network *Network
+	// TaskID identifies rules of the task.
+	TaskID string
+	// Addr is the container's address within the network.
+	Addr net.IP
+	// IPv6Addr is the container's IPv6 address within the network, nil for
+	// IPv4-only networks.
+	IPv6Addr net.IP
+	// Egress is the task's egress policy.
+	Egress *sonm.EgressPolicy
+}
+
+// lookupIPAddr resolves the host into its addresses.
+type lookupIPAddr func(ctx context.Context, host string) ([]net.IPAddr, error)
+
+// egressChain describes an iptables chain with its rules.
+type egressChain struct {
+	Name  string
+	Rules [][]string
+}
+
+// egressChainName returns the name of the chain that belongs to the given
+// ID. IDs are hashed, because chain names are limited to 28 characters.
+func egressChainName(prefix, ID string, stage int) string {
+	h := fnv.New32a()
+	io.WriteString(h, ID)
+	return fmt.Sprintf("%s%08x-%d", prefix, h.Sum32(), stage)
+}
+
+// egressGuardChainName returns the name of the guard chain of the network.
+func egressGuardChainName(network string) string {
+	return egressChainName(egressGuardChainPrefix, network, 0)
+}
+
+func egressChainNames(prefix, ID string) []string {
+	names := make([]string, 0, maxEgressStages)
+	for stage := 0; stage < maxEgressStages; stage++ {
+		names = append(names, egressChainName(prefix, ID, stage))
+	}
+
+	return names
+}
+
+// newEgressChains builds chains checking the given policies in order, empty
+// policies are skipped. Chains are built either for IPv4 or for IPv6
+// destinations, since these are managed by separate tools.
+//
+// Allowed packets are passed to the next chain using "goto", so the last
+// chain returns them to the hook chain. Packets violating any policy are
+// dropped by the chain of that policy, which allows to count violations.
+func newEgressChains(ctx context.Context, prefix, ID string, policies []*sonm.EgressPolicy, lookup lookupIPAddr, ipv6 bool) ([]egressChain, error) {
+	var nonEmpty []*sonm.EgressPolicy
+	for _, policy := range policies {
+		if !policy.IsEmpty() {
+			nonEmpty = append(nonEmpty, policy)
+		}
+	}
+	if len(nonEmpty) > maxEgressStages {
+		return nil, fmt.Errorf("too many egress policies: %d", len(nonEmpty))
+	}
+
+	chains := make([]egressChain, 0, len(nonEmpty))
+	for stage, policy := range nonEmpty {
+		target := []string{"-j", "RETURN"}
+		if stage+1 < len(nonEmpty) {
+			target = []string{"-g", egressChainName(prefix, ID, stage+1)}
+		}
+
+		rules, err := newEgressRules(ctx, policy, lookup, target, ipv6)
+		if err != nil {
+			return nil, err
+		}
+
+		chains = append(chains, egressChain{
+			Name:  egressChainName(prefix, ID, stage),
+			Rules: append(rules, []string{"-j", "DROP"}),
+		})
+	}
+
+	return chains, nil
+}
+
+// newEgressRules returns specifications of rules matching connections
+// allowed by the policy, with the target applied to them.
+//
+// Hosts are resolved during the call, unresolvable ones allow nothing.
+// Only destinations of the requested IP family are considered.
+func newEgressRules(ctx context.Context, policy *sonm.EgressPolicy, lookup lookupIPAddr, target []string, ipv6 bool) ([][]string, error) {
+	cidrs, err := policy.ParseCIDRs()
+	if err != nil {
+		return nil, err
+	}
+	ports, err := policy.ParsePorts()
+	if err != nil {
+		return nil, err
+	}
+
+	var destinations []string
+	for _, cidr := range cidrs {
+		if isIPv6(cidr.IP) == ipv6 {
+			destinations = append(destinations, cidr.String())
+		}
+	}
+	for _, host := range policy.GetHosts() {
+		addrs, err := lookup(ctx, host)
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			if isIPv6(addr.IP) != ipv6 {
+				continue
+			}
+
+			if ipv6 {
+				destinations = append(destinations, addr.IP.String()+"/128")
+			} else {
+				destinations = append(destinations, addr.IP.String()+"/32")
+			}
+		}
+	}
+
+	if policy.HasDestinations() {
+		if len(destinations) == 0 {
+			return nil, nil
+		}
+	} else {
+		destinations = []string{""}
+	}
+
+	var rules [][]string
+	for _, destination := range destinations {
+		var rule []string
+		if len(destination) > 0 {
+			rule = append(rule, "-d", destination)
+		}
+
+		if len(ports) == 0 {
+			rules = append(rules, append(rule, target...))
+			continue
+		}
+
+		for _, port := range ports {
+			portRule := append(append([]string{}, rule...), "-p", port.Protocol, "--dport", formatPortRange(port))
+			rules = append(rules, append(portRule, target...))
+		}
+	}
+
+	return rules, nil
+}
+
+func isIPv6(ip net.IP) bool {
+	return ip.To4() == nil
+}
+
+func formatPortRange(port sonm.EgressPort) string {
+	if port.From == port.To {
+		return strconv.FormatUint(uint64(port.From), 10)
+	}
+
+	return fmt.Sprintf("%d:%d", port.From, port.To)
+}
+
+// newEgressHook returns the specification of the rule that passes new
+// outbound connections from the bridge to the chain. Replies to incoming
+// connections are not restricted.
+func newEgressHook(bridge string, source net.IP, chain string) []string {
+	var rule []string
+	if source != nil {
+		rule = append(rule, "-s", source.String())
+	}
+
+	return append(rule, "-i", bridge, "!", "-o", bridge, "-m", "conntrack", "--ctstate", "NEW", "-j", chain)
+}
+
+// newEgressAdmission returns the specification of the guard chain rule that
+// lets new outbound connections of the container through.
+func newEgressAdmission(source net.IP) []string {
+	return []string{"-s", source.String(), "-j", "RETURN"}
+}
+
+// newEgressRestoreInput returns the "iptables-restore --noflush" input that
+// atomically replaces rules of the existing chains.
+func newEgressRestoreInput(chains []egressChain) []byte {
+	buf := bytes.NewBufferString("*filter\n")
+	for _, chain := range chains {
+		fmt.Fprintf(buf, "-F %s\n", chain.Name)
+		for _, rule := range chain.Rules {
+			fmt.Fprintf(buf, "-A %s %s\n", chain.Name, strings.Join(rule, " "))
+		}
+	}
+	buf.WriteString("COMMIT\n")
+
+	return buf.Bytes()
+}
+
+// lookupHookRules finds rules jumping to the chain in the "iptables -S"
+// output, returning their specifications without the chain name.
+func lookupHookRules(output []byte, chain string) [][]string {
+	var rules [][]string
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 || fields[0] != "-A" {
+			continue
+		}
+
+		if fields[len(fields)-2] == "-j" && fields[len(fields)-1] == chain {
+			rules = append(rules, fields[2:])
+		}
+	}
+
+	return rules
+}
+
+// lookupDroppedPackets sums packet counters of dropping rules in the
+// "iptables -L -n -v -x" output.
+func lookupDroppedPackets(output []byte) (uint64, error) {
+	dropped := uint64(0)
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 || fields[2] != "DROP" {
+			continue
+		}
+
+		packets, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse packet counter: %v", err)
+		}
+
+		dropped += packets
+	}
+
+	return dropped, scanner.Err()
+}
@@ -0,0 +1,385 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"os/exec"
+
+	"github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util/multierror"
+)
+
+// iptablesCmd is the name of the tool managing either IPv4 or IPv6 rules.
+type iptablesCmd string
+
+const (
+	iptables  iptablesCmd = "iptables"
+	ip6tables iptablesCmd = "ip6tables"
+)
+
+func (m iptablesCmd) run(args ...string) ([]byte, error) {
+	output, err := exec.Command(string(m), append([]string{"-w", "-t", "filter"}, args...)...).CombinedOutput()
+	if err != nil {
+		return output, fmt.Errorf("%s %v failed: %v: %s", m, args, err, output)
+	}
+
+	return output, nil
+}
+
+// restore atomically replaces rules of the given existing chains.
+func (m iptablesCmd) restore(chains []egressChain) error {
+	cmd := exec.Command(string(m)+"-restore", "--noflush")
+	cmd.Stdin = bytes.NewReader(newEgressRestoreInput(chains))
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s-restore failed: %v: %s", m, err, output)
+	}
+
+	return nil
+}
+
+func (m iptablesCmd) ruleExists(chain string, rule []string) bool {
+	_, err := m.run(append([]string{"-C", chain}, rule...)...)
+	return err == nil
+}
+
+func (m iptablesCmd) chainExists(chain string) bool {
+	_, err := m.run("-n", "-L", chain)
+	return err == nil
+}
+
+// ensureHookChain creates the hook chain unless it exists. Docker manages it
+// for IPv4, but not necessarily for IPv6.
+func (m iptablesCmd) ensureHookChain() error {
+	if m.chainExists(egressHookChain) {
+		return nil
+	}
+
+	if _, err := m.run("-N", egressHookChain); err != nil {
+		return err
+	}
+
+	_, err := m.run("-I", "FORWARD", "1", "-j", egressHookChain)
+	return err
+}
+
+// installEgressChains replaces previously installed chains with the given
+// ones and hooks the first one with the given rule.
+func installEgressChains(cmd iptablesCmd, names []string, chains []egressChain, hook []string) error {
+	if err := removeEgressChains(cmd, names); err != nil {
+		return err
+	}
+
+	if len(chains) == 0 {
+		return nil
+	}
+
+	if err := cmd.ensureHookChain(); err != nil {
+		return err
+	}
+
+	for _, chain := range chains {
+		if _, err := cmd.run("-N", chain.Name); err != nil {
+			return err
+		}
+	}
+
+	for _, chain := range chains {
+		for _, rule := range chain.Rules {
+			if _, err := cmd.run(append([]string{"-A", chain.Name}, rule...)...); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := cmd.run(append([]string{"-I", egressHookChain, "1"}, hook...)...)
+	return err
+}
+
+// removeEgressChains removes the chains together with all rules jumping to
+// them from the hook chain. Missing chains are ignored.
+func removeEgressChains(cmd iptablesCmd, names []string) error {
+	errs := multierror.NewMultiError()
+
+	var hooks []byte
+	if cmd.chainExists(egressHookChain) {
+		output, err := cmd.run("-S", egressHookChain)
+		if err != nil {
+			return err
+		}
+
+		hooks = output
+	}
+
+	var existing []string
+	for _, name := range names {
+		for _, rule := range lookupHookRules(hooks, name) {
+			if _, err := cmd.run(append([]string{"-D", egressHookChain}, rule...)...); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
+
+		if cmd.chainExists(name) {
+			existing = append(existing, name)
+		}
+	}
+
+	// Chains may refer each other, so all of them must be flushed before
+	// deleting.
+	for _, name := range existing {
+		if _, err := cmd.run("-F", name); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	for _, name := range existing {
+		if _, err := cmd.run("-X", name); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// ensureGuardChain installs the guard chain of the network unless it exists,
+// keeping containers admitted before, e.g. when the network is restored after
+// the worker restart.
+func ensureGuardChain(cmd iptablesCmd, network string) error {
+	guard := egressGuardChainName(network)
+	if cmd.chainExists(guard) {
+		return nil
+	}
+
+	if err := cmd.ensureHookChain(); err != nil {
+		return err
+	}
+	if _, err := cmd.run("-N", guard); err != nil {
+		return err
+	}
+	if _, err := cmd.run("-A", guard, "-j", "DROP"); err != nil {
+		return err
+	}
+
+	_, err := cmd.run(append([]string{"-I", egressHookChain, "1"}, newEgressHook(network, nil, guard)...)...)
+	return err
+}
+
+// admitEgress lets new outbound connections from the address through the
+// guard chain of the network. Networks created by previous versions of the
+// worker have no guard chain, so there is nothing to do.
+func admitEgress(cmd iptablesCmd, network string, addr net.IP) error {
+	guard := egressGuardChainName(network)
+	rule := newEgressAdmission(addr)
+	if !cmd.chainExists(guard) || cmd.ruleExists(guard, rule) {
+		return nil
+	}
+
+	_, err := cmd.run(append([]string{"-I", guard, "1"}, rule...)...)
+	return err
+}
+
+// revokeEgress removes the admission of the address from the guard chain of
+// the network, if any.
+func revokeEgress(cmd iptablesCmd, network string, addr net.IP) error {
+	guard := egressGuardChainName(network)
+	rule := newEgressAdmission(addr)
+	if !cmd.chainExists(guard) || !cmd.ruleExists(guard, rule) {
+		return nil
+	}
+
+	_, err := cmd.run(append([]string{"-D", guard}, rule...)...)
+	return err
+}
+
+// refreshEgressChains rebuilds rules of the installed chains, resolving hosts
+// again. Chains that are not installed are skipped.
+func refreshEgressChains(ctx context.Context, cmd iptablesCmd, prefix, ID string, policies []*sonm.EgressPolicy) error {
+	chains, err := newEgressChains(ctx, prefix, ID, policies, net.DefaultResolver.LookupIPAddr, cmd == ip6tables)
+	if err != nil {
+		return err
+	}
+	if len(chains) == 0 || !cmd.chainExists(chains[0].Name) {
+		return nil
+	}
+
+	return cmd.restore(chains)
+}
+
+func egressDroppedPackets(cmd iptablesCmd, names []string) (uint64, error) {
+	dropped := uint64(0)
+	for _, name := range names {
+		if !cmd.chainExists(name) {
+			continue
+		}
+
+		output, err := cmd.run("-n", "-v", "-x", "-L", name)
+		if err != nil {
+			return 0, err
+		}
+
+		packets, err := lookupDroppedPackets(output)
+		if err != nil {
+			return 0, err
+		}
+
+		dropped += packets
+	}
+
+	return dropped, nil
+}
+
+// egressCmds returns tools managing rules for IP families of the network.
+func egressCmds(network *Network) []iptablesCmd {
+	if len(network.IPv6Subnet) > 0 {
+		return []iptablesCmd{iptables, ip6tables}
+	}
+
+	return []iptablesCmd{iptables}
+}
+
+func (m *EgressFilterAction) Execute(ctx context.Context) error {
+	for _, cmd := range egressCmds(m.Network) {
+		if err := ensureGuardChain(cmd, m.Network.Name); err != nil {
+			return fmt.Errorf("failed to install egress guard: %v", err)
+		}
+
+		if m.Network.Egress.IsEmpty() {
+			continue
+		}
+
+		chains, err := newEgressChains(ctx, egressPlanChainPrefix, m.Network.Name, []*sonm.EgressPolicy{m.Network.Egress}, net.DefaultResolver.LookupIPAddr, cmd == ip6tables)
+		if err != nil {
+			return err
+		}
+
+		hook := newEgressHook(m.Network.Name, nil, chains[0].Name)
+		if err := installEgressChains(cmd, egressChainNames(egressPlanChainPrefix, m.Network.Name), chains, hook); err != nil {
+			return fmt.Errorf("failed to install egress rules: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func (m *EgressFilterAction) Rollback() error {
+	errs := multierror.NewMultiError()
+	for _, cmd := range egressCmds(m.Network) {
+		names := []string{egressGuardChainName(m.Network.Name)}
+		if !m.Network.Egress.IsEmpty() {
+			names = append(names, egressChainNames(egressPlanChainPrefix, m.Network.Name)...)
+		}
+
+		if err := removeEgressChains(cmd, names); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func (m *TaskEgressFilterAction) Execute(ctx context.Context) error {
+	for _, cmd := range egressCmds(m.Network) {
+		addr := m.addr(cmd)
+		if addr == nil {
+			continue
+		}
+
+		chains, err := newEgressChains(ctx, egressTaskChainPrefix, m.TaskID, m.policies(), net.DefaultResolver.LookupIPAddr, cmd == ip6tables)
+		if err != nil {
+			return err
+		}
+
+		if len(chains) > 0 {
+			hook := newEgressHook(m.Network.Name, addr, chains[0].Name)
+			if err := installEgressChains(cmd, egressChainNames(egressTaskChainPrefix, m.TaskID), chains, hook); err != nil {
+				return fmt.Errorf("failed to install task egress rules: %v", err)
+			}
+		}
+
+		// The container is admitted only when its rules are in place.
+		if err := admitEgress(cmd, m.Network.Name, addr); err != nil {
+			return fmt.Errorf("failed to admit task egress: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func (m *TaskEgressFilterAction) Rollback() error {
+	errs := multierror.NewMultiError()
+	for _, cmd := range egressCmds(m.Network) {
+		if addr := m.addr(cmd); addr != nil {
+			if err := revokeEgress(cmd, m.Network.Name, addr); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
+
+		if m.isEmpty() {
+			continue
+		}
+
+		if err := removeEgressChains(cmd, egressChainNames(egressTaskChainPrefix, m.TaskID)); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// Refresh rebuilds installed rules of the task and the ask plan's rules of
+// the network, so they follow changes of addresses of allowed hosts.
+func (m *TaskEgressFilterAction) Refresh(ctx context.Context) error {
+	if m.isEmpty() {
+		return nil
+	}
+
+	errs := multierror.NewMultiError()
+	for _, cmd := range egressCmds(m.Network) {
+		if err := refreshEgressChains(ctx, cmd, egressTaskChainPrefix, m.TaskID, m.policies()); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+
+		planPolicies := []*sonm.EgressPolicy{m.Network.Egress}
+		if err := refreshEgressChains(ctx, cmd, egressPlanChainPrefix, m.Network.Name, planPolicies); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// Violations returns the number of outbound packets of the task dropped
+// since the rules were installed.
+func (m *TaskEgressFilterAction) Violations() (uint64, error) {
+	if m.isEmpty() {
+		return 0, nil
+	}
+
+	violations := uint64(0)
+	for _, cmd := range egressCmds(m.Network) {
+		dropped, err := egressDroppedPackets(cmd, egressChainNames(egressTaskChainPrefix, m.TaskID))
+		if err != nil {
+			return 0, err
+		}
+
+		violations += dropped
+	}
+
+	return violations, nil
+}
+
+func (m *TaskEgressFilterAction) addr(cmd iptablesCmd) net.IP {
+	if cmd == ip6tables {
+		return m.IPv6Addr
+	}
+
+	return m.Addr
+}
+
+func (m *TaskEgressFilterAction) policies() []*sonm.EgressPolicy {
+	return []*sonm.EgressPolicy{m.Egress, m.Network.Egress}
+}
+
+func (m *TaskEgressFilterAction) isEmpty() bool {
+	return m.Egress.IsEmpty() && m.Network.Egress.IsEmpty()
+}
@@ -0,0 +1,151 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testLookup(ctx context.Context, host string) ([]net.IPAddr, error) {
+	switch host {
+	case "pool.example.com":
+		return []net.IPAddr{{IP: net.ParseIP("1.2.3.4")}, {IP: net.ParseIP("2001:db8::1")}}, nil
+	default:
+		return nil, errors.New("no such host")
+	}
+}
+
+func TestEgressChainsTaskAndPlan(t *testing.T) {
+	task := &sonm.EgressPolicy{Hosts: []string{"pool.example.com"}, Ports: []string{"3333/tcp"}}
+	plan := &sonm.EgressPolicy{CIDRs: []string{"1.0.0.0/8", "2001:db8::/32"}}
+
+	chains, err := newEgressChains(context.Background(), egressTaskChainPrefix, "task", []*sonm.EgressPolicy{task, plan}, testLookup, false)
+	require.NoError(t, err)
+	require.Len(t, chains, 2)
+
+	first := egressChainName(egressTaskChainPrefix, "task", 0)
+	second := egressChainName(egressTaskChainPrefix, "task", 1)
+	assert.True(t, len(first) <= 28)
+
+	assert.Equal(t, first, chains[0].Name)
+	assert.Equal(t, [][]string{
+		{"-d", "1.2.3.4/32", "-p", "tcp", "--dport", "3333", "-g", second},
+		{"-j", "DROP"},
+	}, chains[0].Rules)
+
+	assert.Equal(t, second, chains[1].Name)
+	assert.Equal(t, [][]string{
+		{"-d", "1.0.0.0/8", "-j", "RETURN"},
+		{"-j", "DROP"},
+	}, chains[1].Rules)
+}
+
+func TestEgressChainsIPv6(t *testing.T) {
+	task := &sonm.EgressPolicy{Hosts: []string{"pool.example.com"}, Ports: []string{"3333/tcp"}}
+	plan := &sonm.EgressPolicy{CIDRs: []string{"1.0.0.0/8", "2001:db8::/32"}}
+
+	chains, err := newEgressChains(context.Background(), egressTaskChainPrefix, "task", []*sonm.EgressPolicy{task, plan}, testLookup, true)
+	require.NoError(t, err)
+	require.Len(t, chains, 2)
+
+	assert.Equal(t, [][]string{
+		{"-d", "2001:db8::1/128", "-p", "tcp", "--dport", "3333", "-g", egressChainName(egressTaskChainPrefix, "task", 1)},
+		{"-j", "DROP"},
+	}, chains[0].Rules)
+	assert.Equal(t, [][]string{
+		{"-d", "2001:db8::/32", "-j", "RETURN"},
+		{"-j", "DROP"},
+	}, chains[1].Rules)
+}
+
+func TestEgressChainsSkipEmptyPolicies(t *testing.T) {
+	plan := &sonm.EgressPolicy{Ports: []string{"443", "8000-8100/udp"}}
+
+	chains, err := newEgressChains(context.Background(), egressTaskChainPrefix, "task", []*sonm.EgressPolicy{nil, plan}, testLookup, false)
+	require.NoError(t, err)
+	require.Len(t, chains, 1)
+
+	assert.Equal(t, egressChainName(egressTaskChainPrefix, "task", 0), chains[0].Name)
+	assert.Equal(t, [][]string{
+		{"-p", "tcp", "--dport", "443", "-j", "RETURN"},
+		{"-p", "udp", "--dport", "443", "-j", "RETURN"},
+		{"-p", "udp", "--dport", "8000:8100", "-j", "RETURN"},
+		{"-j", "DROP"},
+	}, chains[0].Rules)
+
+	chains, err = newEgressChains(context.Background(), egressTaskChainPrefix, "task", []*sonm.EgressPolicy{nil, {}}, testLookup, false)
+	require.NoError(t, err)
+	assert.Len(t, chains, 0)
+}
+
+func TestEgressChainsUnresolvedHostsAllowNothing(t *testing.T) {
+	policy := &sonm.EgressPolicy{Hosts: []string{"unknown.example.com"}, Ports: []string{"443"}}
+
+	chains, err := newEgressChains(context.Background(), egressPlanChainPrefix, "sonm42", []*sonm.EgressPolicy{policy}, testLookup, false)
+	require.NoError(t, err)
+	require.Len(t, chains, 1)
+	assert.Equal(t, [][]string{{"-j", "DROP"}}, chains[0].Rules)
+}
+
+func TestEgressHook(t *testing.T) {
+	assert.Equal(t,
+		[]string{"-s", "172.18.0.2", "-i", "sonm42", "!", "-o", "sonm42", "-m", "conntrack", "--ctstate", "NEW", "-j", "SONM-EGT-1-0"},
+		newEgressHook("sonm42", net.ParseIP("172.18.0.2"), "SONM-EGT-1-0"))
+}
+
+func TestEgressAdmission(t *testing.T) {
+	assert.Equal(t, []string{"-s", "172.18.0.2", "-j", "RETURN"}, newEgressAdmission(net.ParseIP("172.18.0.2")))
+	assert.True(t, len(egressGuardChainName("sonm42")) <= 28)
+}
+
+func TestEgressRestoreInput(t *testing.T) {
+	chains := []egressChain{
+		{Name: "SONM-EGT-1-0", Rules: [][]string{{"-d", "1.2.3.4/32", "-g", "SONM-EGT-1-1"}, {"-j", "DROP"}}},
+		{Name: "SONM-EGT-1-1", Rules: [][]string{{"-j", "DROP"}}},
+	}
+
+	assert.Equal(t, `*filter
+-F SONM-EGT-1-0
+-A SONM-EGT-1-0 -d 1.2.3.4/32 -g SONM-EGT-1-1
+-A SONM-EGT-1-0 -j DROP
+-F SONM-EGT-1-1
+-A SONM-EGT-1-1 -j DROP
+COMMIT
+`, string(newEgressRestoreInput(chains)))
+}
+
+func TestLookupHookRules(t *testing.T) {
+	const output = `-N DOCKER-USER
+-A DOCKER-USER -s 172.18.0.2/32 -i sonm42 ! -o sonm42 -m conntrack --ctstate NEW -j SONM-EGT-1-0
+-A DOCKER-USER -i sonm42 ! -o sonm42 -m conntrack --ctstate NEW -j SONM-EGP-2-0
+-A DOCKER-USER -s 172.18.0.3/32 -i sonm42 ! -o sonm42 -m conntrack --ctstate NEW -j SONM-EGT-1-0
+-A DOCKER-USER -j RETURN
+`
+
+	assert.Equal(t, [][]string{
+		{"-s", "172.18.0.2/32", "-i", "sonm42", "!", "-o", "sonm42", "-m", "conntrack", "--ctstate", "NEW", "-j", "SONM-EGT-1-0"},
+		{"-s", "172.18.0.3/32", "-i", "sonm42", "!", "-o", "sonm42", "-m", "conntrack", "--ctstate", "NEW", "-j", "SONM-EGT-1-0"},
+	}, lookupHookRules([]byte(output), "SONM-EGT-1-0"))
+	assert.Len(t, lookupHookRules([]byte(output), "SONM-EGT-1-1"), 0)
+}
+
+func TestLookupDroppedPackets(t *testing.T) {
+	const output = `Chain SONM-EGT-1-0 (1 references)
+    pkts      bytes target     prot opt in     out     source               destination
+      10      600 RETURN     tcp  --  *      *       0.0.0.0/0            1.2.3.4              tcp dpt:3333
+       3      180 DROP       all  --  *      *       0.0.0.0/0            0.0.0.0/0
+`
+
+	dropped, err := lookupDroppedPackets([]byte(output))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), dropped)
+
+	dropped, err = lookupDroppedPackets([]byte("Chain SONM-EGT-1-0 (0 references)\n"))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), dropped)
+}
@@ -0,0 +1,112 @@
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+)
+
+const (
+	// ipv6SubnetSize is the prefix length of IPv6 subnets allocated for
+	// networks. Shorter subnets break SLAAC, longer ones are wasteful.
+	ipv6SubnetSize = 64
+	// maxIPv6Subnets limits the number of subnets scanned when allocating,
+	// which is more than enough for the number of ask plans a worker has.
+	maxIPv6Subnets = 1 << 16
+)
+
+// parseIPv6Prefix parses the prefix subnets for networks are allocated from.
+func parseIPv6Prefix(prefix string) (*net.IPNet, error) {
+	ip, ipNet, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("invalid IPv6 subnet: %v", err)
+	}
+
+	if ip.To4() != nil {
+		return nil, fmt.Errorf("invalid IPv6 subnet: %s is an IPv4 one", prefix)
+	}
+
+	if ones, _ := ipNet.Mask.Size(); ones > ipv6SubnetSize {
+		return nil, fmt.Errorf("invalid IPv6 subnet: %s must be /%d or shorter", prefix, ipv6SubnetSize)
+	}
+
+	return ipNet, nil
+}
+
+// nextIPv6Subnet returns the first subnet within the prefix that is not
+// used yet.
+func nextIPv6Subnet(prefix *net.IPNet, used []*net.IPNet) (*net.IPNet, error) {
+	ones, _ := prefix.Mask.Size()
+
+	count := uint64(maxIPv6Subnets)
+	if bits := uint(ipv6SubnetSize - ones); bits < 16 {
+		count = 1 << bits
+	}
+
+	base := binary.BigEndian.Uint64(prefix.IP.To16()[:8])
+
+	for id := uint64(0); id < count; id++ {
+		ip := make(net.IP, net.IPv6len)
+		binary.BigEndian.PutUint64(ip[:8], base+id)
+
+		subnet := &net.IPNet{IP: ip, Mask: net.CIDRMask(ipv6SubnetSize, 8*net.IPv6len)}
+		if !isIPv6SubnetUsed(subnet, used) {
+			return subnet, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no free IPv6 subnets left in %s", prefix.String())
+}
+
+func isIPv6SubnetUsed(subnet *net.IPNet, used []*net.IPNet) bool {
+	for _, ipNet := range used {
+		if ipNet.Contains(subnet.IP) || subnet.Contains(ipNet.IP) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// allocateIPv6Subnet allocates an IPv6 subnet for the network with the given
+// name.
+//
+// Subnets are tracked by Docker networks themselves, so the network that
+// already exists, for example after the worker restart, keeps its subnet.
+func (m *NetworkManager) allocateIPv6Subnet(ctx context.Context, name string) (string, error) {
+	filter := filters.NewArgs()
+	filter.Add("label", tagSonmNetwork)
+	networks, err := m.dockerClient.NetworkList(ctx, types.NetworkListOptions{
+		Filters: filter,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var used []*net.IPNet
+	for _, network := range networks {
+		for _, config := range network.IPAM.Config {
+			ip, ipNet, err := net.ParseCIDR(config.Subnet)
+			if err != nil || ip.To4() != nil {
+				continue
+			}
+
+			if network.Name == name {
+				return ipNet.String(), nil
+			}
+
+			used = append(used, ipNet)
+		}
+	}
+
+	subnet, err := nextIPv6Subnet(m.ipv6Prefix, used)
+	if err != nil {
+		return "", err
+	}
+
+	return subnet.String(), nil
+}
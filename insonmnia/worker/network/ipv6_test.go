@@ -0,0 +1,79 @@
+package network
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustParseCIDR(t *testing.T, cidr string) *net.IPNet {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	require.NoError(t, err)
+	return ipNet
+}
+
+func TestParseIPv6Prefix(t *testing.T) {
+	prefix, err := parseIPv6Prefix("2001:db8:1::/48")
+	require.NoError(t, err)
+	assert.Equal(t, "2001:db8:1::/48", prefix.String())
+
+	_, err = parseIPv6Prefix("10.0.0.0/8")
+	assert.Error(t, err)
+
+	_, err = parseIPv6Prefix("2001:db8::/96")
+	assert.Error(t, err)
+
+	_, err = parseIPv6Prefix("2001:db8::")
+	assert.Error(t, err)
+}
+
+func TestNextIPv6Subnet(t *testing.T) {
+	prefix := mustParseCIDR(t, "2001:db8:1::/48")
+
+	subnet, err := nextIPv6Subnet(prefix, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "2001:db8:1::/64", subnet.String())
+
+	subnet, err = nextIPv6Subnet(prefix, []*net.IPNet{
+		mustParseCIDR(t, "2001:db8:1::/64"),
+		mustParseCIDR(t, "2001:db8:1:1::/64"),
+		mustParseCIDR(t, "2001:db8:2::/64"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "2001:db8:1:2::/64", subnet.String())
+}
+
+func TestNextIPv6SubnetExhausted(t *testing.T) {
+	prefix := mustParseCIDR(t, "2001:db8:1:2::/63")
+
+	subnet, err := nextIPv6Subnet(prefix, []*net.IPNet{mustParseCIDR(t, "2001:db8:1:2::/64")})
+	require.NoError(t, err)
+	assert.Equal(t, "2001:db8:1:3::/64", subnet.String())
+
+	_, err = nextIPv6Subnet(prefix, []*net.IPNet{mustParseCIDR(t, "2001:db8:1:2::/63")})
+	assert.Error(t, err)
+}
+
+func TestGetRandomIP6(t *testing.T) {
+	pool := mustParseCIDR(t, "2001:db8:1:2::/126")
+
+	occupied := map[IP6]struct{}{}
+	for i := 0; i < 2; i++ {
+		ip, err := getRandomIP6(occupied, pool)
+		require.NoError(t, err)
+
+		addr := ip.ToCommon()
+		assert.True(t, pool.Contains(addr))
+		assert.Contains(t, []string{"2001:db8:1:2::2", "2001:db8:1:2::3"}, addr.String())
+
+		occupied[ip] = struct{}{}
+	}
+
+	_, err := getRandomIP6(occupied, pool)
+	assert.Error(t, err)
+
+	_, err = getRandomIP6(nil, mustParseCIDR(t, "10.0.0.0/24"))
+	assert.Error(t, err)
+}
@@ -24,6 +24,7 @@ type l2tpNetworkConfig struct {
 	ID                  string `required:"true" yaml:"id"`
 	LNSAddr             string `required:"true" yaml:"lns_addr"`
 	Subnet              string `required:"true" yaml:"subnet"`
+	Subnet6             string `required:"false" yaml:"subnet6"`
 	PPPUsername         string `required:"false" yaml:"ppp_username"`
 	PPPPassword         string `required:"false" yaml:"ppp_password"`
 	PPPMTU              string `required:"false" yaml:"ppp_mtu" default:"1410"`
@@ -57,6 +58,16 @@ func (o *l2tpNetworkConfig) validate() error {
 		return fmt.Errorf("failed to parse Subnet `%s` to CIDR: %v", o.Subnet, err)
 	}
 
+	if len(o.Subnet6) > 0 {
+		ip, _, err := net.ParseCIDR(o.Subnet6)
+		if err != nil {
+			return fmt.Errorf("failed to parse Subnet6 `%s` to CIDR: %v", o.Subnet6, err)
+		}
+		if ip.To4() != nil {
+			return fmt.Errorf("Subnet6 `%s` is not an IPv6 subnet", o.Subnet6)
+		}
+	}
+
 	return nil
 }
 
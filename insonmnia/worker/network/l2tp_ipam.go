@@ -8,6 +8,7 @@ import (
 	"net"
 	"os"
 	"os/exec"
+	"strings"
 	"time"
 
 	"github.com/docker/go-plugins-helpers/ipam"
@@ -15,6 +16,10 @@ import (
 	"go.uber.org/zap"
 )
 
+// l2tpIPv6PoolSuffix distinguishes IPv6 pools from IPv4 ones, which are
+// identified by the network's pool ID.
+const l2tpIPv6PoolSuffix = "/v6"
+
 type IPAMDriver struct {
 	*l2tpState
 	counter int
@@ -40,6 +45,23 @@ func (d *IPAMDriver) RequestPool(request *ipam.RequestPoolRequest) (*ipam.Reques
 		return nil, fmt.Errorf("failed to parse options: %v", err)
 	}
 
+	// Docker requests the IPv6 pool after the IPv4 one, so the network must
+	// be already set up.
+	if request.V6 {
+		if len(opts.Subnet6) == 0 {
+			d.logger.Errorw("no IPv6 subnet configured", zap.String("pool_id", opts.PoolID()))
+			return nil, errors.New("no IPv6 subnet configured")
+		}
+
+		n, err := d.GetNetwork(opts.PoolID())
+		if err != nil {
+			d.logger.Errorw("failed to get network", zap.String("pool_id", opts.PoolID()), zap.Error(err))
+			return nil, fmt.Errorf("failed to get network: %v", err)
+		}
+
+		return &ipam.RequestPoolResponse{PoolID: n.PoolID + l2tpIPv6PoolSuffix, Pool: opts.Subnet6}, nil
+	}
+
 	n := newL2tpNetwork(opts)
 	if err := n.Setup(); err != nil {
 		d.logger.Errorw("failed to setup network", zap.Error(err))
@@ -65,6 +87,10 @@ func (d *IPAMDriver) RequestAddress(request *ipam.RequestAddressRequest) (*ipam.
 		return nil, errors.New("requests for specific addresses are not supported")
 	}
 
+	if strings.HasSuffix(request.PoolID, l2tpIPv6PoolSuffix) {
+		return d.requestAddress6(strings.TrimSuffix(request.PoolID, l2tpIPv6PoolSuffix))
+	}
+
 	n, err := d.GetNetwork(request.PoolID)
 	if err != nil {
 		d.logger.Errorw("failed to get network", zap.String("pool_id", request.PoolID), zap.Error(err))
@@ -121,7 +147,7 @@ func (d *IPAMDriver) RequestAddress(request *ipam.RequestAddressRequest) (*ipam.
 			n.ID, xl2tpdCfg, err)
 	}
 
-	assignedCIDR, err := d.getAssignedCIDR(ept.PPPDevName)
+	assignedCIDR, err := d.getAssignedCIDR(ept.PPPDevName, false)
 	if err != nil {
 		d.logger.Errorw("failed to get assigned IP", zap.String("network_id", n.ID),
 			zap.Any("config", xl2tpdCfg), zap.Error(err))
@@ -138,8 +164,47 @@ func (d *IPAMDriver) RequestAddress(request *ipam.RequestAddressRequest) (*ipam.
 	return &ipam.RequestAddressResponse{Address: ept.AssignedCIDR}, nil
 }
 
+// requestAddress6 provides IPv6 addresses for the network, which are
+// obtained via SLAAC over the PPP link established while allocating the IPv4
+// address.
+func (d *IPAMDriver) requestAddress6(poolID string) (*ipam.RequestAddressResponse, error) {
+	n, err := d.GetNetwork(poolID)
+	if err != nil {
+		d.logger.Errorw("failed to get network", zap.String("pool_id", poolID), zap.Error(err))
+		return nil, fmt.Errorf("failed to get network: %v", err)
+	}
+
+	if n.NeedsGateway6 {
+		n.NeedsGateway6 = false
+		d.logger.Infow("allocated fake IPv6 gateway", zap.String("pool_id", poolID))
+		return &ipam.RequestAddressResponse{Address: n.NetworkOpts.Subnet6}, nil
+	}
+
+	if n.Endpoint == nil {
+		d.logger.Errorw("network's endpoint is nil", zap.String("network_id", n.ID))
+		return nil, fmt.Errorf("network %s endpoint is nil", n.ID)
+	}
+
+	assignedCIDR, err := d.getAssignedCIDR(n.Endpoint.PPPDevName, true)
+	if err != nil {
+		d.logger.Errorw("failed to get assigned IPv6", zap.String("network_id", n.ID), zap.Error(err))
+		return nil, fmt.Errorf("failed to get assigned IPv6: %v", err)
+	}
+
+	d.logger.Infow("received IPv6", zap.String("network_id", n.ID), zap.String("ip", assignedCIDR))
+
+	n.Endpoint.AssignedCIDR6 = assignedCIDR
+
+	return &ipam.RequestAddressResponse{Address: assignedCIDR}, nil
+}
+
 func (d *IPAMDriver) ReleasePool(request *ipam.ReleasePoolRequest) error {
 	d.logger.Infow("received ReleasePool request", zap.Any("request", request))
+	// The network is torn down when releasing its IPv4 pool.
+	if strings.HasSuffix(request.PoolID, l2tpIPv6PoolSuffix) {
+		return nil
+	}
+
 	n, err := d.GetNetwork(request.PoolID)
 	if err != nil {
 		d.logger.Errorw("failed to get network", zap.String("pool_id", request.PoolID), zap.Error(err))
@@ -180,7 +245,10 @@ func (d *IPAMDriver) GetDefaultAddressSpaces() (*ipam.AddressSpacesResponse, err
 	return &ipam.AddressSpacesResponse{}, nil
 }
 
-func (d *IPAMDriver) getAssignedCIDR(devName string) (string, error) {
+// getAssignedCIDR returns the first address of the requested IP family
+// assigned to the device. Only global IPv6 addresses are considered, since
+// link-local ones are always present.
+func (d *IPAMDriver) getAssignedCIDR(devName string, ipv6 bool) (string, error) {
 	time.Sleep(time.Second * 7)
 	ifaces, err := net.Interfaces()
 	if err != nil {
@@ -194,11 +262,24 @@ func (d *IPAMDriver) getAssignedCIDR(devName string) (string, error) {
 				return "", err
 			}
 
-			if len(addrs) < 1 {
-				return "", errors.New("no addresses assigned")
+			for _, addr := range addrs {
+				ipNet, ok := addr.(*net.IPNet)
+				if !ok {
+					continue
+				}
+
+				if (ipNet.IP.To4() == nil) != ipv6 {
+					continue
+				}
+
+				if ipv6 && !ipNet.IP.IsGlobalUnicast() {
+					continue
+				}
+
+				return addr.String(), nil
 			}
 
-			return addrs[0].String(), nil
+			return "", errors.New("no addresses assigned")
 		}
 	}
 
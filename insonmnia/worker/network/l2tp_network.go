@@ -102,11 +102,16 @@ func (d *L2TPNetworkDriver) Join(request *network.JoinRequest) (*network.JoinRes
 		zap.String("network_id", n.ID), zap.String("endpoint_id", request.EndpointID),
 		zap.String("ip", n.Endpoint.AssignedIP))
 
+	routes := []*network.StaticRoute{
+		{Destination: n.NetworkOpts.Subnet, RouteType: 1},
+	}
+	if len(n.NetworkOpts.Subnet6) > 0 {
+		routes = append(routes, &network.StaticRoute{Destination: n.NetworkOpts.Subnet6, RouteType: 1})
+	}
+
 	return &network.JoinResponse{
 		InterfaceName: network.InterfaceName{SrcName: n.Endpoint.PPPDevName, DstPrefix: "ppp"},
-		StaticRoutes: []*network.StaticRoute{
-			{Destination: n.NetworkOpts.Subnet, RouteType: 1},
-		},
+		StaticRoutes:  routes,
 	}, nil
 }
 
@@ -120,18 +120,20 @@ func (s *l2tpState) GetNetwork(netID string) (*l2tpNetwork, error) {
 }
 
 type l2tpNetwork struct {
-	ID           string
-	PoolID       string
-	Count        int
-	NetworkOpts  *l2tpNetworkConfig
-	Endpoint     *l2tpEndpoint
-	NeedsGateway bool
+	ID            string
+	PoolID        string
+	Count         int
+	NetworkOpts   *l2tpNetworkConfig
+	Endpoint      *l2tpEndpoint
+	NeedsGateway  bool
+	NeedsGateway6 bool
 }
 
 func newL2tpNetwork(opts *l2tpNetworkConfig) *l2tpNetwork {
 	return &l2tpNetwork{
-		NetworkOpts:  opts,
-		NeedsGateway: true,
+		NetworkOpts:   opts,
+		NeedsGateway:  true,
+		NeedsGateway6: len(opts.Subnet6) > 0,
 	}
 }
 
@@ -153,7 +155,10 @@ type l2tpEndpoint struct {
 	PPPDevName   string
 	AssignedCIDR string
 	AssignedIP   string
-	NetworkOpts  *l2tpNetworkConfig
+	// AssignedCIDR6 is the global IPv6 address obtained via SLAAC over the
+	// PPP link, empty for IPv4-only networks.
+	AssignedCIDR6 string
+	NetworkOpts   *l2tpNetworkConfig
 }
 
 func NewL2TPEndpoint(netInfo *l2tpNetwork) *l2tpEndpoint {
@@ -192,6 +197,9 @@ func (e *l2tpEndpoint) GetPppConfig() string {
 	if e.NetworkOpts.PPPNoauth {
 		fmt.Fprint(cfg, "\nnoauth")
 	}
+	if len(e.NetworkOpts.Subnet6) > 0 {
+		fmt.Fprint(cfg, "\n+ipv6")
+	}
 
 	fmt.Fprintf(cfg, "\nifname %s", e.PPPDevName)
 	fmt.Fprintf(cfg, "\nname %s", e.NetworkOpts.PPPUsername)
@@ -109,7 +109,13 @@ func (t *L2TPTuner) Run(ctx context.Context) error {
 
 func (t *L2TPTuner) Tune(ctx context.Context, net *structs.NetworkSpec, hostCfg *container.HostConfig, netCfg *network.NetworkingConfig) (Cleanup, error) {
 	log.G(ctx).Info("tuning l2tp")
-	configPath, err := t.writeConfig(net.NetID, net.Options)
+	opts := net.Options
+	if len(net.GetIpv6Subnet()) > 0 {
+		opts = cloneOptions(net.Options)
+		opts["subnet6"] = net.GetIpv6Subnet()
+	}
+
+	configPath, err := t.writeConfig(net.NetID, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +127,10 @@ func (t *L2TPTuner) Tune(ctx context.Context, net *structs.NetworkSpec, hostCfg
 		IPAM:    &network.IPAM{Driver: "l2tp_ipam", Options: driverOpts},
 	}
 
+	if _, ok := opts["subnet6"]; ok {
+		createOpts.EnableIPv6 = true
+	}
+
 	response, err := t.cli.NetworkCreate(ctx, net.NetID, createOpts)
 	if err != nil {
 		return nil, err
@@ -2,13 +2,16 @@ package network
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
 	"net/url"
 	"strings"
 	"sync"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/filters"
+	dockerNetwork "github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/client"
 	"github.com/sonm-io/core/insonmnia/worker/network/tc"
 	"github.com/sonm-io/core/proto"
@@ -26,6 +29,10 @@ const (
 	maxLinkNameLen      = 12
 )
 
+// ErrTrafficUnsupported is returned when the network manager is not capable
+// of traffic accounting.
+var ErrTrafficUnsupported = errors.New("traffic accounting is not supported")
+
 // Action is an abstraction of some action that can be rolled back.
 type Action interface {
 	// Execute executes this action, returning error if any.
@@ -101,6 +108,11 @@ type Network struct {
 	Alias            string
 	RateLimitEgress  uint64
 	RateLimitIngress uint64
+	// Egress restricts outbound connections from the network.
+	Egress *sonm.EgressPolicy
+	// IPv6Subnet is the IPv6 subnet of the network, empty for IPv4-only
+	// networks.
+	IPv6Subnet string
 }
 
 type CreateNetworkRequest struct {
@@ -108,6 +120,7 @@ type CreateNetworkRequest struct {
 	ID               string
 	RateLimitEgress  uint64
 	RateLimitIngress uint64
+	Egress           *sonm.EgressPolicy
 }
 
 type PruneRequest struct {
@@ -126,21 +139,36 @@ type NetworkManagerConfig struct {
 	Log               *zap.SugaredLogger
 }
 
+// Traffic describes the number of bytes transferred through the network's
+// shaping classes since they were created.
+type Traffic struct {
+	// BytesIn is the number of bytes received by containers.
+	BytesIn uint64
+	// BytesOut is the number of bytes sent by containers.
+	BytesOut uint64
+}
+
 type networkManager interface {
 	Init() error
 	Close() error
 	NewActions(network *Network) []Action
+	// Traffic returns counters of the network's shaping classes.
+	Traffic(network *Network) (*Traffic, error)
+	// SetRateLimits changes the network's rate limits, keeping counters.
+	SetRateLimits(network *Network, ingress, egress uint64) error
 }
 
 type NetworkManager struct {
 	networkManager networkManager
 	dockerClient   *client.Client
+	ipv6Prefix     *net.IPNet
 	log            *zap.SugaredLogger
 }
 
 type options struct {
 	NetworkManager networkManager
 	DockerClient   *client.Client
+	IPv6Prefix     *net.IPNet
 	Log            *zap.SugaredLogger
 }
 
@@ -201,6 +229,24 @@ func WithRemote(uri string) Option {
 	}
 }
 
+// WithIPv6Subnet makes created networks dual-stack, allocating their IPv6
+// subnets from the given prefix. Empty prefix means IPv4-only networks.
+func WithIPv6Subnet(prefix string) Option {
+	return func(o *options) error {
+		if len(prefix) == 0 {
+			return nil
+		}
+
+		ipNet, err := parseIPv6Prefix(prefix)
+		if err != nil {
+			return err
+		}
+
+		o.IPv6Prefix = ipNet
+		return nil
+	}
+}
+
 func WithLog(log *zap.SugaredLogger) Option {
 	return func(o *options) error {
 		o.Log = log
@@ -231,6 +277,7 @@ func NewNetworkManager(options ...Option) (*NetworkManager, error) {
 	m := &NetworkManager{
 		networkManager: opts.NetworkManager,
 		dockerClient:   opts.DockerClient,
+		ipv6Prefix:     opts.IPv6Prefix,
 		log:            opts.Log,
 	}
 
@@ -249,6 +296,16 @@ func (m *NetworkManager) CreateNetwork(ctx context.Context, request *CreateNetwo
 		Alias:            name,
 		RateLimitEgress:  request.RateLimitEgress,
 		RateLimitIngress: request.RateLimitIngress,
+		Egress:           request.Egress,
+	}
+
+	if m.ipv6Prefix != nil {
+		subnet, err := m.allocateIPv6Subnet(ctx, nameLink)
+		if err != nil {
+			return nil, fmt.Errorf("failed to allocate IPv6 subnet: %v", err)
+		}
+
+		network.IPv6Subnet = subnet
 	}
 
 	actionQueue := NewActionQueue()
@@ -308,6 +365,20 @@ func (m *NetworkManager) Prune(ctx context.Context, request *PruneRequest) (*Pru
 	return &PruneReply{Result: result}, nil
 }
 
+// Traffic returns the number of bytes transferred through the network.
+//
+// Counters start from zero each time the network is created, including
+// worker restarts.
+func (m *NetworkManager) Traffic(network *Network) (*Traffic, error) {
+	return m.networkManager.Traffic(network)
+}
+
+// SetRateLimits temporarily changes the network's rate limits in bits per
+// second. Original limits are restored when the network is recreated.
+func (m *NetworkManager) SetRateLimits(network *Network, ingress, egress uint64) error {
+	return m.networkManager.SetRateLimits(network, ingress, egress)
+}
+
 func (m *NetworkManager) Close() error {
 	return m.networkManager.Close()
 }
@@ -318,7 +389,7 @@ type DockerNetworkCreateAction struct {
 }
 
 func (m *DockerNetworkCreateAction) Execute(ctx context.Context) error {
-	network, err := m.DockerClient.NetworkCreate(ctx, m.Network.Name, types.NetworkCreate{
+	options := types.NetworkCreate{
 		CheckDuplicate: true,
 		Driver:         driverBridge,
 		Options: map[string]string{
@@ -328,7 +399,17 @@ func (m *DockerNetworkCreateAction) Execute(ctx context.Context) error {
 			tagSonmNetwork:      "",
 			tagSonmNetworkAlias: m.Network.Alias,
 		},
-	})
+	}
+
+	// IPv4 subnet is still allocated by Docker from its default pools.
+	if len(m.Network.IPv6Subnet) > 0 {
+		options.EnableIPv6 = true
+		options.IPAM = &dockerNetwork.IPAM{
+			Config: []dockerNetwork.IPAMConfig{{Subnet: m.Network.IPv6Subnet}},
+		}
+	}
+
+	network, err := m.DockerClient.NetworkCreate(ctx, m.Network.Name, options)
 	m.Network.ID = network.ID
 
 	if m.isErrNetworkAlreadyExists(err) {
@@ -335,7 +335,80 @@ func (m *localNetworkManager) NewActions(network *Network) []Action {
 			Network: network,
 			tc:      m.tc,
 		},
+		&EgressFilterAction{
+			Network: network,
+		},
+	}
+}
+
+// htbClasses returns shaping classes of the network, limiting the traffic
+// received and sent by containers respectively.
+func htbClasses(network *Network) (*tc.HTBClass, *tc.HTBClass, error) {
+	link, err := netlink.LinkByName(network.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ifbLink, err := netlink.LinkByName(NewIFBLink(network.Name).Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rootHandle := tc.NewHandle(0x8001, 0)
+	ingress := &tc.HTBClass{
+		ClassAttrs: tc.ClassAttrs{
+			Link:   link,
+			Handle: rootHandle.WithMinor(1),
+			Parent: rootHandle,
+		},
+	}
+	egress := &tc.HTBClass{
+		ClassAttrs: tc.ClassAttrs{
+			Link:   ifbLink,
+			Handle: rootHandle.WithMinor(1),
+			Parent: rootHandle,
+		},
+	}
+
+	return ingress, egress, nil
+}
+
+func (m *localNetworkManager) Traffic(network *Network) (*Traffic, error) {
+	ingress, egress, err := htbClasses(network)
+	if err != nil {
+		return nil, err
+	}
+
+	bytesIn, err := tc.ClassBytes(ingress)
+	if err != nil {
+		return nil, err
+	}
+
+	bytesOut, err := tc.ClassBytes(egress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Traffic{BytesIn: bytesIn, BytesOut: bytesOut}, nil
+}
+
+func (m *localNetworkManager) SetRateLimits(network *Network, ingressRate, egressRate uint64) error {
+	ingress, egress, err := htbClasses(network)
+	if err != nil {
+		return err
 	}
+
+	ingress.Rate, ingress.Ceil = ingressRate, ingressRate
+	if err := m.tc.ClassReplace(ingress); err != nil {
+		return fmt.Errorf("failed to change HTB class: %s", err)
+	}
+
+	egress.Rate, egress.Ceil = egressRate, egressRate
+	if err := m.tc.ClassReplace(egress); err != nil {
+		return fmt.Errorf("failed to change ifb HTB class: %s", err)
+	}
+
+	return nil
 }
 
 type RemoteQOS struct {
@@ -28,6 +28,10 @@ func (TC) ClassAdd(class tc.Class) error {
 	return ErrUnsupportedPlatform
 }
 
+func (TC) ClassReplace(class tc.Class) error {
+	return ErrUnsupportedPlatform
+}
+
 func (TC) ClassDel(class tc.Class) error {
 	return ErrUnsupportedPlatform
 }
@@ -58,6 +62,14 @@ func (m *localNetworkManager) NewActions(network *Network) []Action {
 	}
 }
 
+func (m *localNetworkManager) Traffic(network *Network) (*Traffic, error) {
+	return nil, ErrTrafficUnsupported
+}
+
+func (m *localNetworkManager) SetRateLimits(network *Network, ingress, egress uint64) error {
+	return ErrTrafficUnsupported
+}
+
 type nilQOS struct{}
 
 func (nilQOS) SetAlias(context.Context, *sonm.QOSSetAliasRequest) (*sonm.QOSSetAliasResponse, error) {
@@ -76,3 +88,35 @@ func (nilQOS) Flush(context.Context, *sonm.QOSFlushRequest) (*sonm.QOSFlushRespo
 func NewRemoteQOS() (sonm.QOSServer, error) {
 	return &nilQOS{}, nil
 }
+
+func (m *EgressFilterAction) Execute(ctx context.Context) error {
+	if m.Network.Egress.IsEmpty() {
+		return nil
+	}
+
+	return ErrUnsupportedPlatform
+}
+
+func (m *EgressFilterAction) Rollback() error {
+	return nil
+}
+
+func (m *TaskEgressFilterAction) Execute(ctx context.Context) error {
+	if m.Egress.IsEmpty() && m.Network.Egress.IsEmpty() {
+		return nil
+	}
+
+	return ErrUnsupportedPlatform
+}
+
+func (m *TaskEgressFilterAction) Rollback() error {
+	return nil
+}
+
+func (m *TaskEgressFilterAction) Refresh(ctx context.Context) error {
+	return nil
+}
+
+func (m *TaskEgressFilterAction) Violations() (uint64, error) {
+	return 0, nil
+}
@@ -2,6 +2,7 @@ package network
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/docker/docker/client"
@@ -63,6 +64,24 @@ func (m *RemoteHTBShapingAction) Rollback() error {
 	return err
 }
 
+// RemoteEgressFilterAction rejects egress policies, because the remote QOS
+// server is not capable of managing firewall rules.
+type RemoteEgressFilterAction struct {
+	Network *Network
+}
+
+func (m *RemoteEgressFilterAction) Execute(ctx context.Context) error {
+	if m.Network.Egress.IsEmpty() {
+		return nil
+	}
+
+	return errors.New("egress policies are not supported with remote QOS")
+}
+
+func (m *RemoteEgressFilterAction) Rollback() error {
+	return nil
+}
+
 type remoteNetworkManager struct {
 	client       sonm.QOSClient
 	dockerClient *client.Client
@@ -80,6 +99,16 @@ func (m *remoteNetworkManager) Close() error {
 	return nil
 }
 
+// Traffic is not supported, because the remote QOS server doesn't expose
+// counters.
+func (m *remoteNetworkManager) Traffic(network *Network) (*Traffic, error) {
+	return nil, ErrTrafficUnsupported
+}
+
+func (m *remoteNetworkManager) SetRateLimits(network *Network, ingress, egress uint64) error {
+	return ErrTrafficUnsupported
+}
+
 func (m *remoteNetworkManager) NewActions(network *Network) []Action {
 	return []Action{
 		&DockerNetworkCreateAction{
@@ -94,5 +123,8 @@ func (m *remoteNetworkManager) NewActions(network *Network) []Action {
 			Client:  m.client,
 			Network: network,
 		},
+		&RemoteEgressFilterAction{
+			Network: network,
+		},
 	}
 }
@@ -0,0 +1,62 @@
+package tc
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// ClassBytes returns the number of bytes sent through the class since it
+// was created.
+//
+// Statistics are obtained from "tc", because it's available regardless of
+// the traffic control implementation.
+func ClassBytes(class Class) (uint64, error) {
+	attrs := class.Attrs()
+	output, err := exec.Command("tc", "-s", "class", "show", "dev", attrs.Link.Attrs().Name, "classid", attrs.Handle.String()).CombinedOutput()
+	if err != nil {
+		return 0, fmt.Errorf("failed to show class statistics: %v: %s", err, output)
+	}
+
+	return parseClassBytes(output, class.Kind(), attrs.Handle)
+}
+
+// parseClassBytes finds the "Sent" counter of the class in the
+// "tc -s class show" output, which looks like:
+//
+//	class htb 8001:1 root leaf 8002: prio 0 rate 8Mbit ceil 8Mbit burst 1600b cburst 1600b
+//	 Sent 1024 bytes 16 pkt (dropped 0, overlimits 0 requeues 0)
+func parseClassBytes(output []byte, kind string, handle Handle) (uint64, error) {
+	found := false
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "class":
+			found = len(fields) >= 3 && fields[1] == kind && fields[2] == handle.String()
+		case "Sent":
+			if !found {
+				continue
+			}
+			if len(fields) < 3 || fields[2] != "bytes" {
+				return 0, fmt.Errorf("malformed class statistics: %s", scanner.Text())
+			}
+
+			return strconv.ParseUint(fields[1], 10, 64)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	return 0, fmt.Errorf("no statistics found for %s class %s", kind, handle)
+}
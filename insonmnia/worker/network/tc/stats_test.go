@@ -0,0 +1,33 @@
+package tc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClassBytes(t *testing.T) {
+	const output = `class htb 8001:2 root prio 0 rate 8bit ceil 8bit burst 1600b cburst 1600b
+ Sent 42 bytes 1 pkt (dropped 0, overlimits 0 requeues 0)
+ backlog 0b 0p requeues 0
+class htb 8001:1 root leaf 8002: prio 0 rate 8Mbit ceil 8Mbit burst 1600b cburst 1600b
+ Sent 123456789012 bytes 1024 pkt (dropped 3, overlimits 0 requeues 0)
+ backlog 0b 0p requeues 0
+ lended: 1024 borrowed: 0 giants: 0
+`
+
+	sent, err := parseClassBytes([]byte(output), "htb", NewHandle(0x8001, 1))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(123456789012), sent)
+
+	sent, err = parseClassBytes([]byte(output), "htb", NewHandle(0x8001, 2))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), sent)
+
+	_, err = parseClassBytes([]byte(output), "htb", NewHandle(0x8001, 3))
+	assert.Error(t, err)
+
+	_, err = parseClassBytes([]byte("class htb 8001:1 root\n Sent many bytes\n"), "htb", NewHandle(0x8001, 1))
+	assert.Error(t, err)
+}
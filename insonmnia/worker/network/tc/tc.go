@@ -6,8 +6,9 @@ import (
 )
 
 const (
-	TCActionAdd = "add"
-	TCActionDel = "del"
+	TCActionAdd     = "add"
+	TCActionDel     = "del"
+	TCActionReplace = "replace"
 )
 
 // CMD represents "tc" commands and sub-commands that can be described using "tc"
@@ -20,6 +21,9 @@ type TC interface {
 	QDiscAdd(qdisc QDisc) error
 	QDiscDel(qdisc QDisc) error
 	ClassAdd(class Class) error
+	// ClassReplace changes parameters of the existing class, keeping its
+	// statistics.
+	ClassReplace(class Class) error
 	ClassDel(class Class) error
 	FilterAdd(filter Filter) error
 	FilterDel(filter Filter) error
@@ -58,6 +62,11 @@ func (m *CmdTC) ClassAdd(class Class) error {
 	return cmd.Run()
 }
 
+func (m *CmdTC) ClassReplace(class Class) error {
+	cmd := exec.Command(m.tcBin, append([]string{"class", TCActionReplace, "dev", class.Attrs().Link.Attrs().Name}, class.Cmd()...)...)
+	return cmd.Run()
+}
+
 func (m *CmdTC) ClassDel(class Class) error {
 	cmd := exec.Command(m.tcBin, append([]string{"class", TCActionDel, "dev", class.Attrs().Link.Attrs().Name}, class.Cmd()...)...)
 	return cmd.Run()
@@ -116,6 +116,14 @@ func (m *NetlinkTC) execQDisc(qdiscDesc QDisc, fn func(qdisc *C.struct_rtnl_qdis
 }
 
 func (m *NetlinkTC) ClassAdd(classDesc Class) error {
+	return m.classModify(classDesc, C.NLM_F_CREATE)
+}
+
+func (m *NetlinkTC) ClassReplace(classDesc Class) error {
+	return m.classModify(classDesc, C.NLM_F_REPLACE)
+}
+
+func (m *NetlinkTC) classModify(classDesc Class, flags C.int) error {
 	class := C.rtnl_class_alloc()
 	if class == nil {
 		return fmt.Errorf("failed to allocate traffic control class")
@@ -173,8 +181,8 @@ func (m *NetlinkTC) ClassAdd(classDesc Class) error {
 		return fmt.Errorf("unknown class type: %T", v)
 	}
 
-	if ec := C.rtnl_class_add(m.sock, class, C.NLM_F_CREATE); ec != 0 {
-		return fmt.Errorf("failed to add traffic control class: %s", C.GoString(C.nl_geterror(ec)))
+	if ec := C.rtnl_class_add(m.sock, class, flags); ec != 0 {
+		return fmt.Errorf("failed to modify traffic control class: %s", C.GoString(C.nl_geterror(ec)))
 	}
 
 	return nil
@@ -37,6 +37,7 @@ type TincNetwork struct {
 	NodeID       string
 	DockerID     string
 	Pool         *net.IPNet
+	Pool6        *net.IPNet
 	Invitation   string
 	EnableBridge bool
 	CgroupParent string
@@ -128,7 +129,8 @@ func (t *TincNetworkDriver) CreateEndpoint(request *network.CreateEndpointReques
 		return nil, err
 	}
 	selfAddr := strings.Split(request.Interface.Address, "/")[0]
-	err = n.Start(t.ctx, selfAddr)
+	selfAddr6 := strings.Split(request.Interface.AddressIPv6, "/")[0]
+	err = n.Start(t.ctx, selfAddr, selfAddr6)
 	if err != nil {
 		return nil, err
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/rand"
 	"net"
+	"strings"
 
 	"github.com/docker/go-plugins-helpers/ipam"
 
@@ -13,6 +14,10 @@ import (
 	"go.uber.org/zap"
 )
 
+// tincIPv6PoolSuffix distinguishes IPv6 pools from IPv4 ones, which are
+// identified by the network's node ID.
+const tincIPv6PoolSuffix = "/v6"
+
 type TincIPAMDriver struct {
 	*TincNetworkState
 	logger *zap.SugaredLogger
@@ -42,6 +47,19 @@ func (t *TincIPAMDriver) RequestPool(request *ipam.RequestPoolRequest) (*ipam.Re
 	if err != nil {
 		return nil, err
 	}
+
+	if request.V6 {
+		if n.Pool6 == nil {
+			return nil, errors.New("no IPv6 subnet specified for the network")
+		}
+
+		return &ipam.RequestPoolResponse{
+			PoolID: n.NodeID + tincIPv6PoolSuffix,
+			Pool:   n.Pool6.String(),
+			Data:   request.Options,
+		}, nil
+	}
+
 	return &ipam.RequestPoolResponse{
 		PoolID: n.NodeID,
 		Pool:   n.Pool.String(),
@@ -57,23 +75,35 @@ func (t *TincIPAMDriver) ReleasePool(request *ipam.ReleasePoolRequest) error {
 func (t *TincIPAMDriver) RequestAddress(request *ipam.RequestAddressRequest) (*ipam.RequestAddressResponse, error) {
 	t.logger.Infow("received RequestAddress request", zap.Any("request", request))
 
-	n, err := t.netByID(request.PoolID)
+	nodeID := strings.TrimSuffix(request.PoolID, tincIPv6PoolSuffix)
+	isIPv6 := nodeID != request.PoolID
+
+	n, err := t.netByID(nodeID)
 	if err != nil {
 		return nil, err
 	}
 	t.logger.Debugw("fetched network", zap.Any("network", n))
 
-	mask, _ := n.Pool.Mask.Size()
+	pool := n.Pool
+	if isIPv6 {
+		pool = n.Pool6
+	}
+	if pool == nil {
+		t.logger.Errorf("no subnet specified for pool %s", request.PoolID)
+		return nil, errors.New("invalid subnet")
+	}
+
+	mask, _ := pool.Mask.Size()
 
 	if mask == 0 {
-		t.logger.Errorf("invalid subnet specified for pool %s", n.Pool.String())
+		t.logger.Errorf("invalid subnet specified for pool %s", pool.String())
 		return nil, errors.New("invalid subnet")
 	}
 
 	ty, ok := request.Options["RequestAddressType"]
 	if ok && ty == "com.docker.network.gateway" {
-		ip := make(net.IP, len(n.Pool.IP))
-		copy(ip, n.Pool.IP)
+		ip := make(net.IP, len(pool.IP))
+		copy(ip, pool.IP)
 		if len(ip) == 4 {
 			ip[3]++
 		} else {
@@ -86,13 +116,30 @@ func (t *TincIPAMDriver) RequestAddress(request *ipam.RequestAddressRequest) (*i
 		}, nil
 	}
 
+	if isIPv6 {
+		addrs, err := n.OccupiedIPs6(t.ctx)
+		t.logger.Debugw("fetched occupied ips", zap.Any("ips", addrs))
+		if err != nil {
+			return nil, err
+		}
+
+		ip, err := getRandomIP6(addrs, pool)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ipam.RequestAddressResponse{
+			Address: ip.ToCommon().String() + "/" + fmt.Sprint(mask),
+		}, nil
+	}
+
 	addrs, err := n.OccupiedIPs(t.ctx)
 	t.logger.Debugw("fetched occupied ips", zap.Any("ips", addrs))
 	if err != nil {
 		return nil, err
 	}
 
-	ip, err := getRandomIP(addrs, n.Pool)
+	ip, err := getRandomIP(addrs, pool)
 	if err != nil {
 		return nil, err
 	}
@@ -140,3 +187,40 @@ func randomIP(ipNet *net.IPNet, addrBits int) IP4 {
 	ip.d += byte(r & 0xff)
 	return ip
 }
+
+func getRandomIP6(occupied map[IP6]struct{}, ipNet *net.IPNet) (IP6, error) {
+	ones, bits := ipNet.Mask.Size()
+	if bits != 8*net.IPv6len || ones > bits-2 {
+		return IP6{}, errors.New("invalid mask")
+	}
+	var ip IP6
+	for i := 0; i < 1000; i++ {
+		ip = randomIP6(ipNet, bits-ones)
+		if _, ok := occupied[ip]; !ok {
+			return ip, nil
+		}
+	}
+	return ip, errors.New("give up")
+}
+
+// randomIP6 returns a random address within the subnet, excluding the
+// subnet address and the gateway one, which is the first in the subnet.
+func randomIP6(ipNet *net.IPNet, addrBits int) IP6 {
+	if addrBits > 63 {
+		addrBits = 63
+	}
+
+	var r uint64
+	for {
+		r = uint64(rand.Int63n(1 << uint(addrBits)))
+		if r > 1 {
+			break
+		}
+	}
+	ip := newIP6(ipNet.IP)
+	for id := net.IPv6len - 1; id >= 0 && r != 0; id-- {
+		ip[id] |= byte(r & 0xff)
+		r >>= 8
+	}
+	return ip
+}
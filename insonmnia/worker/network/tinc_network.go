@@ -26,6 +26,21 @@ func (i *IP4) ToCommon() net.IP {
 	return net.IP{i.a, i.b, i.c, i.d}
 }
 
+// A comparable type for v6 address
+type IP6 [net.IPv6len]byte
+
+func newIP6(ip net.IP) IP6 {
+	var v6 IP6
+	copy(v6[:], ip.To16())
+	return v6
+}
+
+func (i *IP6) ToCommon() net.IP {
+	ip := make(net.IP, net.IPv6len)
+	copy(ip, i[:])
+	return ip
+}
+
 func (t *TincNetwork) Init(ctx context.Context) error {
 	err := t.runCommand(ctx, "tinc", "--batch", "-n", t.NodeID, "-c", t.ConfigPath, "init", "initial_node_"+t.NodeID)
 	if err != nil {
@@ -49,11 +64,16 @@ func (t *TincNetwork) Join(ctx context.Context) error {
 	return err
 }
 
-func (t *TincNetwork) Start(ctx context.Context, addr string) error {
+func (t *TincNetwork) Start(ctx context.Context, addr, addr6 string) error {
 	iface := t.NodeID[:15]
 
-	err := t.runCommand(ctx, "tinc", "-n", t.NodeID, "-c", t.ConfigPath, "start",
-		"-o", "Interface="+iface, "-o", "Subnet="+t.Pool.String(), "-o", "Subnet="+addr+"/32", "-o", "LogLevel=0")
+	args := []string{"-n", t.NodeID, "-c", t.ConfigPath, "start",
+		"-o", "Interface=" + iface, "-o", "Subnet=" + t.Pool.String(), "-o", "Subnet=" + addr + "/32", "-o", "LogLevel=0"}
+	if t.Pool6 != nil && len(addr6) > 0 {
+		args = append(args, "-o", "Subnet="+t.Pool6.String(), "-o", "Subnet="+addr6+"/128")
+	}
+
+	err := t.runCommand(ctx, "tinc", args...)
 	if err != nil {
 		t.logger.Errorf("failed to start tinc: %s", err)
 	} else {
@@ -85,6 +105,42 @@ func (t *TincNetwork) Invite(ctx context.Context, inviteeID string) (string, err
 }
 
 func (t *TincNetwork) OccupiedIPs(ctx context.Context) (map[IP4]struct{}, error) {
+	addrs, err := t.dumpSubnetAddrs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ips := map[IP4]struct{}{}
+	for _, ip := range addrs {
+		if ip.To4() == nil {
+			continue
+		}
+		ips[newIP4(ip)] = struct{}{}
+	}
+
+	return ips, nil
+}
+
+func (t *TincNetwork) OccupiedIPs6(ctx context.Context) (map[IP6]struct{}, error) {
+	addrs, err := t.dumpSubnetAddrs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ips := map[IP6]struct{}{}
+	for _, ip := range addrs {
+		if ip.To4() != nil {
+			continue
+		}
+		ips[newIP6(ip)] = struct{}{}
+	}
+
+	return ips, nil
+}
+
+// dumpSubnetAddrs returns global unicast addresses of all nodes in the
+// network, skipping subnets.
+func (t *TincNetwork) dumpSubnetAddrs(ctx context.Context) ([]net.IP, error) {
 	err := t.runCommand(ctx, "tinc", "-n", t.NodeID, "-c", t.ConfigPath, "start")
 	if err != nil {
 		return nil, err
@@ -97,9 +153,13 @@ func (t *TincNetwork) OccupiedIPs(ctx context.Context) (map[IP4]struct{}, error)
 	if err != nil {
 		return nil, err
 	}
-	lines := strings.Split(stdout, "\n")
-	ips := map[IP4]struct{}{}
-	for _, line := range lines {
+
+	return parseSubnetAddrs(stdout), nil
+}
+
+func parseSubnetAddrs(dump string) []net.IP {
+	var ips []net.IP
+	for _, line := range strings.Split(dump, "\n") {
 		addr := strings.Split(line, " ")[0]
 		if strings.Contains(addr, "/") {
 			continue
@@ -108,16 +168,13 @@ func (t *TincNetwork) OccupiedIPs(ctx context.Context) (map[IP4]struct{}, error)
 		if ip == nil {
 			continue
 		}
-		if ip.To4() == nil {
-			continue
-		}
 		if !ip.IsGlobalUnicast() {
 			continue
 		}
-		ips[newIP4(ip)] = struct{}{}
+		ips = append(ips, ip)
 	}
 
-	return ips, nil
+	return ips
 }
 
 func (t *TincNetwork) runCommand(ctx context.Context, name string, arg ...string) error {
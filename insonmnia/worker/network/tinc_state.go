@@ -37,7 +37,7 @@ func defaultNet() *net.IPNet {
 }
 
 func newTincNetworkState(ctx context.Context, client *client.Client, config *TincNetworkConfig) (*TincNetworkState, error) {
-	storage, err := makeStore(ctx, config.StatePath)
+	storage, err := makeStore(ctx, config.StatePath, "sonm_tinc_driver_state")
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +81,14 @@ func (t *TincNetworkState) InsertTincNetwork(n *structs.NetworkSpec, cgroupParen
 		return nil, errors.New("ip does not match network pool")
 	}
 
+	var pool6 *net.IPNet
+	if len(n.GetIpv6Subnet()) > 0 {
+		_, pool6, err = net.ParseCIDR(n.GetIpv6Subnet())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	containerConfig := &container.Config{
 		Image: "sonm/tinc",
 	}
@@ -113,6 +121,7 @@ func (t *TincNetworkState) InsertTincNetwork(n *structs.NetworkSpec, cgroupParen
 		NodeID:          n.NetID,
 		DockerID:        "",
 		Pool:            pool,
+		Pool6:           pool6,
 		Invitation:      invitation,
 		EnableBridge:    enableBridge,
 		CgroupParent:    cgroupParent,
@@ -172,12 +181,12 @@ func (t *TincNetworkState) netByDockerID(id string) (*TincNetwork, error) {
 	return nil, fmt.Errorf("network not found by docker id %s", id)
 }
 
-func makeStore(ctx context.Context, path string) (store.Store, error) {
+func makeStore(ctx context.Context, path, bucket string) (store.Store, error) {
 	boltdb.Register()
 	s := store.Backend(store.BOLTDB)
 	endpoints := []string{path}
 	config := store.Config{
-		Bucket: "sonm_tinc_driver_state",
+		Bucket: bucket,
 	}
 	return libkv.NewStore(s, endpoints, &config)
 }
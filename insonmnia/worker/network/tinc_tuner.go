@@ -119,6 +119,12 @@ func (t *TincTuner) Tune(ctx context.Context, net *structs.NetworkSpec, hostConf
 		},
 		Options: opts,
 	}
+	if tincNet.Pool6 != nil {
+		createOpts.EnableIPv6 = true
+		createOpts.IPAM.Config = append(createOpts.IPAM.Config, network.IPAMConfig{
+			Subnet: tincNet.Pool6.String(),
+		})
+	}
 
 	response, err := t.client.NetworkCreate(ctx, net.NetID, createOpts)
 	if err != nil {
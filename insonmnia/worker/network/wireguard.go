@@ -0,0 +1,184 @@
+package network
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"golang.org/x/crypto/curve25519"
+)
+
+const (
+	// wireGuardKeepalive is the interval in seconds invitees use to keep
+	// NAT mappings towards the inviter alive.
+	wireGuardKeepalive = 25
+)
+
+// wgKey is either a private or a public WireGuard key.
+type wgKey [32]byte
+
+// newWGPrivateKey generates a new private key, clamped as Curve25519
+// requires.
+func newWGPrivateKey() (wgKey, error) {
+	var key wgKey
+	if _, err := rand.Read(key[:]); err != nil {
+		return wgKey{}, fmt.Errorf("failed to generate WireGuard key: %v", err)
+	}
+
+	key[0] &= 248
+	key[31] &= 127
+	key[31] |= 64
+	return key, nil
+}
+
+func parseWGKey(s string) (wgKey, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return wgKey{}, fmt.Errorf("invalid WireGuard key: %v", err)
+	}
+	if len(data) != len(wgKey{}) {
+		return wgKey{}, fmt.Errorf("invalid WireGuard key length: %d", len(data))
+	}
+
+	var key wgKey
+	copy(key[:], data)
+	return key, nil
+}
+
+// PublicKey returns the public key of the private one.
+func (m wgKey) PublicKey() wgKey {
+	var public [32]byte
+	private := [32]byte(m)
+	curve25519.ScalarBaseMult(&public, &private)
+	return wgKey(public)
+}
+
+func (m wgKey) IsZero() bool {
+	return m == wgKey{}
+}
+
+func (m wgKey) String() string {
+	return base64.StdEncoding.EncodeToString(m[:])
+}
+
+func (m wgKey) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *wgKey) UnmarshalText(data []byte) error {
+	key, err := parseWGKey(string(data))
+	if err != nil {
+		return err
+	}
+
+	*m = key
+	return nil
+}
+
+// WireGuardPeer describes a remote side of WireGuard tunnel.
+type WireGuardPeer struct {
+	PublicKey wgKey
+	// Endpoint is the "host:port" address of the peer, empty if the peer
+	// connects by itself.
+	Endpoint            string   `json:",omitempty"`
+	AllowedIPs          []string `json:",omitempty"`
+	PersistentKeepalive int      `json:",omitempty"`
+}
+
+// args returns "wg set" arguments configuring the peer.
+func (m *WireGuardPeer) args() []string {
+	args := []string{"peer", m.PublicKey.String(), "allowed-ips", strings.Join(m.AllowedIPs, ",")}
+	if len(m.Endpoint) > 0 {
+		args = append(args, "endpoint", m.Endpoint)
+	}
+	if m.PersistentKeepalive > 0 {
+		args = append(args, "persistent-keepalive", fmt.Sprint(m.PersistentKeepalive))
+	}
+
+	return args
+}
+
+// wireGuardInvitation is everything required to join a WireGuard network:
+// the invitee's interface key and address, generated by the inviter, and
+// the inviter itself as the only peer.
+//
+// Invitees reach each other through the inviter, which forwards packets
+// within the network.
+type wireGuardInvitation struct {
+	PrivateKey wgKey
+	Subnet     string
+	Addr       string
+	Peer       *WireGuardPeer
+}
+
+func (m *wireGuardInvitation) Validate() error {
+	if m.PrivateKey.IsZero() {
+		return errors.New("private key is required")
+	}
+	if m.Peer == nil || m.Peer.PublicKey.IsZero() || len(m.Peer.Endpoint) == 0 {
+		return errors.New("peer with public key and endpoint is required")
+	}
+
+	_, subnet, err := net.ParseCIDR(m.Subnet)
+	if err != nil {
+		return fmt.Errorf("invalid subnet: %v", err)
+	}
+	ip := net.ParseIP(m.Addr)
+	if ip == nil || !subnet.Contains(ip) {
+		return fmt.Errorf("address %q does not match %s subnet", m.Addr, subnet)
+	}
+
+	return nil
+}
+
+func (m *wireGuardInvitation) Encode() (string, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+func decodeWireGuardInvitation(s string) (*wireGuardInvitation, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("malformed WireGuard invitation: %v", err)
+	}
+
+	invitation := &wireGuardInvitation{}
+	if err := json.Unmarshal(data, invitation); err != nil {
+		return nil, fmt.Errorf("malformed WireGuard invitation: %v", err)
+	}
+	if err := invitation.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid WireGuard invitation: %v", err)
+	}
+
+	return invitation, nil
+}
+
+// Config renders the invitation in "wg-quick" format, which allows to join
+// the network from any host, not only from a worker.
+func (m *wireGuardInvitation) Config() string {
+	_, subnet, _ := net.ParseCIDR(m.Subnet)
+	ones, _ := subnet.Mask.Size()
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "[Interface]\n")
+	fmt.Fprintf(buf, "PrivateKey = %s\n", m.PrivateKey)
+	fmt.Fprintf(buf, "Address = %s/%d\n", m.Addr, ones)
+	fmt.Fprintf(buf, "\n[Peer]\n")
+	fmt.Fprintf(buf, "PublicKey = %s\n", m.Peer.PublicKey)
+	fmt.Fprintf(buf, "Endpoint = %s\n", m.Peer.Endpoint)
+	fmt.Fprintf(buf, "AllowedIPs = %s\n", strings.Join(m.Peer.AllowedIPs, ", "))
+	if m.Peer.PersistentKeepalive > 0 {
+		fmt.Fprintf(buf, "PersistentKeepalive = %d\n", m.Peer.PersistentKeepalive)
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,11 @@
+package network
+
+type WireGuardConfig struct {
+	Enabled                  bool   `yaml:"enabled"`
+	DockerNetPluginSockPath  string `yaml:"docker_net_plugin_dir" default:"/run/docker/plugins/wireguard/wireguard.sock"`
+	DockerIPAMPluginSockPath string `yaml:"docker_ipam_plugin_dir" default:"/run/docker/plugins/wireguardipam/wireguardipam.sock"`
+	StatePath                string `yaml:"state_path" default:"/var/lib/sonm/wireguard_network_state"`
+	// Endpoint is the public host other workers use to reach WireGuard
+	// interfaces of this worker. Invitations can't be generated without it.
+	Endpoint string `yaml:"endpoint"`
+}
@@ -0,0 +1,89 @@
+package network
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"os/exec"
+	"strings"
+)
+
+// wgDevice is a WireGuard interface managed with "ip" and "wg" tools.
+//
+// Interfaces are created within the host network namespace and then moved
+// by Docker into containers. WireGuard keeps its UDP socket within the
+// namespace the interface was created in, so tunnels are reachable through
+// the host's public address.
+type wgDevice struct {
+	Name string
+	// Netns is the path of the network namespace the interface currently
+	// lives in, empty for the host one.
+	Netns string
+}
+
+func (m *wgDevice) run(input []byte, name string, args ...string) ([]byte, error) {
+	if len(m.Netns) > 0 {
+		args = append([]string{"--net=" + m.Netns, name}, args...)
+		name = "nsenter"
+	}
+
+	cmd := exec.Command(name, args...)
+	if input != nil {
+		cmd.Stdin = bytes.NewReader(input)
+	}
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return output, fmt.Errorf("%s %v failed: %v: %s", name, args, err, output)
+	}
+
+	return output, nil
+}
+
+func (m *wgDevice) Exists() bool {
+	_, err := m.run(nil, "ip", "link", "show", "dev", m.Name)
+	return err == nil
+}
+
+func (m *wgDevice) Create() error {
+	_, err := m.run(nil, "ip", "link", "add", "dev", m.Name, "type", "wireguard")
+	return err
+}
+
+func (m *wgDevice) Remove() error {
+	_, err := m.run(nil, "ip", "link", "del", "dev", m.Name)
+	return err
+}
+
+// Configure sets the interface key and port. The key is passed through the
+// standard input to keep it away from the process list.
+func (m *wgDevice) Configure(privateKey wgKey, listenPort uint16) error {
+	_, err := m.run([]byte(privateKey.String()), "wg", "set", m.Name, "listen-port", fmt.Sprint(listenPort), "private-key", "/dev/stdin")
+	return err
+}
+
+func (m *wgDevice) SetPeer(peer *WireGuardPeer) error {
+	_, err := m.run(nil, "wg", append([]string{"set", m.Name}, peer.args()...)...)
+	return err
+}
+
+// PublicKey returns the public key the interface is configured with.
+func (m *wgDevice) PublicKey() (wgKey, error) {
+	output, err := m.run(nil, "wg", "show", m.Name, "public-key")
+	if err != nil {
+		return wgKey{}, err
+	}
+
+	return parseWGKey(strings.TrimSpace(string(output)))
+}
+
+// freeUDPPort asks the kernel for an unused UDP port.
+func freeUDPPort() (uint16, error) {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{})
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	return uint16(conn.LocalAddr().(*net.UDPAddr).Port), nil
+}
@@ -0,0 +1,310 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/docker/go-plugins-helpers/network"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"github.com/sonm-io/core/insonmnia/structs"
+	"github.com/sonm-io/core/proto"
+	"go.uber.org/zap"
+)
+
+type WireGuardNetworkDriver struct {
+	*WireGuardNetworkState
+	logger *zap.SugaredLogger
+}
+
+func NewWireGuard(ctx context.Context, config *WireGuardConfig) (*WireGuardNetworkDriver, *WireGuardIPAMDriver, error) {
+	state, err := newWireGuardNetworkState(ctx, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	netDr := &WireGuardNetworkDriver{
+		WireGuardNetworkState: state,
+		logger:                log.S(ctx).With("source", "wireguard/network"),
+	}
+	ipamDr := &WireGuardIPAMDriver{
+		WireGuardNetworkState: state,
+		logger:                log.S(ctx).With("source", "wireguard/ipam"),
+	}
+
+	return netDr, ipamDr, nil
+}
+
+func (m *WireGuardNetworkDriver) GetCapabilities() (*network.CapabilitiesResponse, error) {
+	m.logger.Info("received GetCapabilities request")
+	return &network.CapabilitiesResponse{
+		Scope:             "local",
+		ConnectivityScope: "local",
+	}, nil
+}
+
+func (m *WireGuardNetworkDriver) CreateNetwork(request *network.CreateNetworkRequest) error {
+	m.logger.Infow("received CreateNetwork request", zap.Any("request", request))
+	n, err := m.netByOptions(request.Options)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n.DockerID = request.NetworkID
+	return m.sync()
+}
+
+func (m *WireGuardNetworkDriver) AllocateNetwork(request *network.AllocateNetworkRequest) (*network.AllocateNetworkResponse, error) {
+	m.logger.Infow("received AllocateNetwork request", zap.Any("request", request))
+	return nil, nil
+}
+
+func (m *WireGuardNetworkDriver) DeleteNetwork(request *network.DeleteNetworkRequest) error {
+	m.logger.Infow("received DeleteNetwork request", zap.Any("request", request))
+	n, err := m.netByDockerID(request.NetworkID)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.Networks, n.NodeID)
+	return m.sync()
+}
+
+func (m *WireGuardNetworkDriver) FreeNetwork(request *network.FreeNetworkRequest) error {
+	m.logger.Infow("received FreeNetwork request", zap.Any("request", request))
+	return nil
+}
+
+// CreateEndpoint creates and configures the WireGuard interface within the
+// host network namespace, Docker moves it into the container on join.
+func (m *WireGuardNetworkDriver) CreateEndpoint(request *network.CreateEndpointRequest) (*network.CreateEndpointResponse, error) {
+	m.logger.Infow("received CreateEndpoint request", zap.Any("request", request))
+
+	n, err := m.netByOptions(request.Options)
+	if err != nil {
+		m.logger.Warnw("no such network", zap.Error(err))
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	n.Addr = strings.Split(request.Interface.Address, "/")[0]
+	n.SandboxKey = ""
+
+	// The port is kept across container restarts, so peers don't lose the
+	// inviter.
+	if n.ListenPort == 0 {
+		if n.ListenPort, err = freeUDPPort(); err != nil {
+			return nil, err
+		}
+	}
+
+	dev := n.device()
+	if dev.Exists() {
+		if err := dev.Remove(); err != nil {
+			return nil, err
+		}
+	}
+	if err := dev.Create(); err != nil {
+		return nil, err
+	}
+
+	if err := m.configureDevice(dev, n); err != nil {
+		if err := dev.Remove(); err != nil {
+			m.logger.Warnw("failed to remove WireGuard interface", zap.String("interface", dev.Name), zap.Error(err))
+		}
+		return nil, err
+	}
+
+	if err := m.sync(); err != nil {
+		return nil, err
+	}
+
+	return &network.CreateEndpointResponse{}, nil
+}
+
+func (m *WireGuardNetworkDriver) configureDevice(dev *wgDevice, n *WireGuardNetwork) error {
+	if err := dev.Configure(n.PrivateKey, n.ListenPort); err != nil {
+		return err
+	}
+
+	for _, peer := range n.Peers {
+		if err := dev.SetPeer(peer); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteEndpoint removes the interface, which Docker moves back into the
+// host network namespace once the container stops.
+func (m *WireGuardNetworkDriver) DeleteEndpoint(request *network.DeleteEndpointRequest) error {
+	m.logger.Infow("received DeleteEndpoint request", zap.Any("request", request))
+
+	n, err := m.netByDockerID(request.NetworkID)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	n.SandboxKey = ""
+	dev := n.device()
+	if !dev.Exists() {
+		return nil
+	}
+
+	return dev.Remove()
+}
+
+func (m *WireGuardNetworkDriver) EndpointInfo(request *network.InfoRequest) (*network.InfoResponse, error) {
+	m.logger.Infow("received EndpointInfo request", zap.Any("request", request))
+	val := make(map[string]string)
+	return &network.InfoResponse{Value: val}, nil
+}
+
+func (m *WireGuardNetworkDriver) Join(request *network.JoinRequest) (*network.JoinResponse, error) {
+	m.logger.Infow("received Join request", zap.Any("request", request))
+	n, err := m.netByDockerID(request.NetworkID)
+	if err != nil {
+		m.logger.Warnw("no such network", zap.Error(err))
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	n.SandboxKey = request.SandboxKey
+	if err := m.sync(); err != nil {
+		return nil, err
+	}
+
+	return &network.JoinResponse{
+		DisableGatewayService: true,
+		InterfaceName: network.InterfaceName{
+			SrcName:   n.device().Name,
+			DstPrefix: wireGuardInterfacePrefix,
+		},
+	}, nil
+}
+
+func (m *WireGuardNetworkDriver) Leave(request *network.LeaveRequest) error {
+	m.logger.Infow("received Leave request", zap.Any("request", request))
+	return nil
+}
+
+func (m *WireGuardNetworkDriver) DiscoverNew(request *network.DiscoveryNotification) error {
+	m.logger.Infow("received DiscoverNew request", zap.Any("request", request))
+	return nil
+}
+
+func (m *WireGuardNetworkDriver) DiscoverDelete(request *network.DiscoveryNotification) error {
+	m.logger.Infow("received DiscoverDelete request", zap.Any("request", request))
+	return nil
+}
+
+func (m *WireGuardNetworkDriver) ProgramExternalConnectivity(request *network.ProgramExternalConnectivityRequest) error {
+	m.logger.Infow("received ProgramExternalConnectivity request", zap.Any("request", request))
+	return nil
+}
+
+func (m *WireGuardNetworkDriver) RevokeExternalConnectivity(request *network.RevokeExternalConnectivityRequest) error {
+	m.logger.Infow("received RevokeExternalConnectivity request", zap.Any("request", request))
+	return nil
+}
+
+func (m *WireGuardNetworkDriver) HasNetwork(NodeID string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.Networks[NodeID]
+	return ok
+}
+
+// GenerateInvitation registers a new peer within the network, returning
+// the spec that allows to join the network as that peer.
+//
+// The peer's key pair and address are generated here, so the peer is
+// reachable right after joining, without further negotiation.
+func (m *WireGuardNetworkDriver) GenerateInvitation(NodeID string) (*structs.NetworkSpec, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n, ok := m.Networks[NodeID]
+	if !ok {
+		return nil, fmt.Errorf("no such network %s", NodeID)
+	}
+
+	if n.Invited {
+		return nil, errors.New("invitations can be generated only by the worker that has created the network")
+	}
+	if len(m.config.Endpoint) == 0 {
+		return nil, errors.New("WireGuard endpoint is not configured")
+	}
+	if n.ListenPort == 0 {
+		return nil, errors.New("network is not started yet")
+	}
+
+	privateKey, err := newWGPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	ip, err := getRandomIP(n.occupiedIPs(), n.Pool)
+	if err != nil {
+		return nil, err
+	}
+	addr := ip.ToCommon().String()
+
+	peer := &WireGuardPeer{
+		PublicKey:  privateKey.PublicKey(),
+		AllowedIPs: []string{addr + "/32"},
+	}
+
+	// The interface is absent while the container is stopped, the peer is
+	// configured with others once it's started again.
+	if len(n.SandboxKey) > 0 {
+		if err := n.device().SetPeer(peer); err != nil {
+			return nil, err
+		}
+	}
+
+	n.Peers = append(n.Peers, peer)
+	if err := m.sync(); err != nil {
+		return nil, err
+	}
+
+	invitation := &wireGuardInvitation{
+		PrivateKey: privateKey,
+		Subnet:     n.Pool.String(),
+		Addr:       addr,
+		Peer: &WireGuardPeer{
+			PublicKey:           n.PrivateKey.PublicKey(),
+			Endpoint:            net.JoinHostPort(m.config.Endpoint, fmt.Sprint(n.ListenPort)),
+			AllowedIPs:          []string{n.Pool.String()},
+			PersistentKeepalive: wireGuardKeepalive,
+		},
+	}
+
+	encoded, err := invitation.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	spec := structs.NetworkSpec{
+		NetworkSpec: &sonm.NetworkSpec{
+			Type:    wireGuardNetwork,
+			Subnet:  invitation.Subnet,
+			Addr:    invitation.Addr,
+			Options: map[string]string{"invitation": encoded, "config": invitation.Config()},
+		},
+	}
+	return &spec, nil
+}
@@ -0,0 +1,103 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/docker/go-plugins-helpers/ipam"
+	"go.uber.org/zap"
+)
+
+// WireGuardIPAMDriver allocates addresses within WireGuard networks.
+//
+// Addresses are chosen randomly the same way as for tinc networks, invitees
+// get the one assigned by the inviter.
+type WireGuardIPAMDriver struct {
+	*WireGuardNetworkState
+	logger *zap.SugaredLogger
+}
+
+func (m *WireGuardIPAMDriver) GetCapabilities() (*ipam.CapabilitiesResponse, error) {
+	m.logger.Info("received GetCapabilities request")
+	return &ipam.CapabilitiesResponse{RequiresMACAddress: false}, nil
+}
+
+func (m *WireGuardIPAMDriver) GetDefaultAddressSpaces() (*ipam.AddressSpacesResponse, error) {
+	m.logger.Info("received GetDefaultAddressSpaces request")
+	return nil, nil
+}
+
+func (m *WireGuardIPAMDriver) RequestPool(request *ipam.RequestPoolRequest) (*ipam.RequestPoolResponse, error) {
+	m.logger.Infow("received RequestPool request", zap.Any("request", request))
+
+	if request.V6 {
+		return nil, errors.New("IPv6 is not supported by wireguard networks")
+	}
+
+	n, err := m.netByIPAMOptions(request.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ipam.RequestPoolResponse{
+		PoolID: n.NodeID,
+		Pool:   n.Pool.String(),
+		Data:   request.Options,
+	}, nil
+}
+
+func (m *WireGuardIPAMDriver) ReleasePool(request *ipam.ReleasePoolRequest) error {
+	m.logger.Infow("received ReleasePool request", zap.Any("request", request))
+	return nil
+}
+
+func (m *WireGuardIPAMDriver) RequestAddress(request *ipam.RequestAddressRequest) (*ipam.RequestAddressResponse, error) {
+	m.logger.Infow("received RequestAddress request", zap.Any("request", request))
+
+	n, err := m.netByID(request.PoolID)
+	if err != nil {
+		return nil, err
+	}
+
+	mask, _ := n.Pool.Mask.Size()
+
+	ty, ok := request.Options["RequestAddressType"]
+	if ok && ty == "com.docker.network.gateway" {
+		addr := n.gateway().String() + "/" + fmt.Sprint(mask)
+		m.logger.Infof("providing gateway address %s", addr)
+		return &ipam.RequestAddressResponse{
+			Address: addr,
+		}, nil
+	}
+
+	if len(request.Address) > 0 {
+		ip := net.ParseIP(strings.Split(request.Address, "/")[0])
+		if ip == nil || !n.Pool.Contains(ip) {
+			return nil, fmt.Errorf("address %s does not match %s subnet", request.Address, n.Pool)
+		}
+
+		return &ipam.RequestAddressResponse{
+			Address: ip.String() + "/" + fmt.Sprint(mask),
+		}, nil
+	}
+
+	m.mu.RLock()
+	occupied := n.occupiedIPs()
+	m.mu.RUnlock()
+
+	ip, err := getRandomIP(occupied, n.Pool)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ipam.RequestAddressResponse{
+		Address: ip.ToCommon().String() + "/" + fmt.Sprint(mask),
+	}, nil
+}
+
+func (m *WireGuardIPAMDriver) ReleaseAddress(request *ipam.ReleaseAddressRequest) error {
+	m.logger.Infow("received ReleaseAddress request", zap.Any("request", request))
+	return nil
+}
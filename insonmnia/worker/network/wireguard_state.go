@@ -0,0 +1,265 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/docker/libkv/store"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"github.com/sonm-io/core/insonmnia/structs"
+	"go.uber.org/zap"
+)
+
+const (
+	wireGuardNetwork = "wireguard"
+	// wireGuardInterfacePrefix is prepended to interface names, which are
+	// limited to 15 characters.
+	wireGuardInterfacePrefix = "wg"
+)
+
+func defaultWireGuardNet() *net.IPNet {
+	_, r, _ := net.ParseCIDR("10.20.40.0/24")
+	return r
+}
+
+// WireGuardNetwork is a WireGuard network a single container is attached
+// to.
+type WireGuardNetwork struct {
+	NodeID   string
+	DockerID string
+	Pool     *net.IPNet
+	// PrivateKey is the key of the container's interface.
+	PrivateKey wgKey
+	ListenPort uint16
+	// Addr is the container's address within the network. Invitees get it
+	// from the invitation, others - once the IPAM allocates it.
+	Addr string
+	// Invited is true when the network was joined using an invitation.
+	Invited bool
+	// SandboxKey is the path of the container's network namespace, which
+	// the interface lives in while the container is running.
+	SandboxKey string
+	Peers      []*WireGuardPeer
+}
+
+func (m *WireGuardNetwork) device() *wgDevice {
+	name := wireGuardInterfacePrefix + m.NodeID
+	if len(name) > 15 {
+		name = name[:15]
+	}
+
+	return &wgDevice{Name: name, Netns: m.SandboxKey}
+}
+
+func (m *WireGuardNetwork) gateway() net.IP {
+	ip := newIP4(m.Pool.IP)
+	ip.d++
+	return ip.ToCommon()
+}
+
+func (m *WireGuardNetwork) broadcast() net.IP {
+	ip := make(net.IP, net.IPv4len)
+	for id, b := range m.Pool.IP.To4() {
+		ip[id] = b | ^m.Pool.Mask[len(m.Pool.Mask)-net.IPv4len+id]
+	}
+	return ip
+}
+
+// occupiedIPs returns addresses that must not be allocated, which are the
+// gateway and broadcast ones, the container's and its peers' ones.
+func (m *WireGuardNetwork) occupiedIPs() map[IP4]struct{} {
+	occupied := map[IP4]struct{}{
+		newIP4(m.gateway()):   {},
+		newIP4(m.broadcast()): {},
+	}
+
+	if ip := net.ParseIP(m.Addr); ip != nil {
+		occupied[newIP4(ip)] = struct{}{}
+	}
+
+	for _, peer := range m.Peers {
+		for _, allowed := range peer.AllowedIPs {
+			ip, _, err := net.ParseCIDR(allowed)
+			if err == nil && ip.To4() != nil {
+				occupied[newIP4(ip)] = struct{}{}
+			}
+		}
+	}
+
+	return occupied
+}
+
+type WireGuardNetworkState struct {
+	ctx      context.Context
+	config   *WireGuardConfig
+	mu       sync.RWMutex
+	Networks map[string]*WireGuardNetwork
+	logger   *zap.SugaredLogger
+	storage  store.Store
+}
+
+func newWireGuardNetworkState(ctx context.Context, config *WireGuardConfig) (*WireGuardNetworkState, error) {
+	storage, err := makeStore(ctx, config.StatePath, "sonm_wireguard_driver_state")
+	if err != nil {
+		return nil, err
+	}
+
+	state := &WireGuardNetworkState{
+		ctx:      ctx,
+		config:   config,
+		Networks: map[string]*WireGuardNetwork{},
+		storage:  storage,
+		logger:   log.S(ctx).With("source", "wireguard/state"),
+	}
+
+	if err := state.load(); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// InsertWireGuardNetwork registers a network described by the spec. Either
+// a new network is started, or an existing one is joined when the spec
+// contains an invitation.
+func (m *WireGuardNetworkState) InsertWireGuardNetwork(n *structs.NetworkSpec) (*WireGuardNetwork, error) {
+	if len(n.GetIpv6Subnet()) > 0 {
+		return nil, errors.New("IPv6 is not supported by wireguard networks")
+	}
+
+	result := &WireGuardNetwork{
+		NodeID: n.NetID,
+	}
+
+	if encoded, ok := n.Options["invitation"]; ok {
+		invitation, err := decodeWireGuardInvitation(encoded)
+		if err != nil {
+			return nil, err
+		}
+
+		_, pool, _ := net.ParseCIDR(invitation.Subnet)
+		result.Pool = pool
+		result.PrivateKey = invitation.PrivateKey
+		result.Addr = invitation.Addr
+		result.Invited = true
+		result.Peers = []*WireGuardPeer{invitation.Peer}
+	} else {
+		pool := defaultWireGuardNet()
+		if len(n.Subnet) > 0 {
+			_, ipNet, err := net.ParseCIDR(n.Subnet)
+			if err != nil {
+				return nil, err
+			}
+			if ipNet.IP.To4() == nil {
+				return nil, fmt.Errorf("invalid IPv4 subnet: %s", n.Subnet)
+			}
+			pool = ipNet
+		}
+
+		privateKey, err := newWGPrivateKey()
+		if err != nil {
+			return nil, err
+		}
+
+		result.Pool = pool
+		result.PrivateKey = privateKey
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Networks[result.NodeID] = result
+	return result, nil
+}
+
+func (m *WireGuardNetworkState) netByID(id string) (*WireGuardNetwork, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n, ok := m.Networks[id]
+	if !ok {
+		return nil, fmt.Errorf("could not find network by id %s", id)
+	}
+	return n, nil
+}
+
+func (m *WireGuardNetworkState) netByOptions(data map[string]interface{}) (*WireGuardNetwork, error) {
+	id, ok := data["id"]
+	if !ok {
+		g, ok := data["com.docker.network.generic"]
+		if ok {
+			id, _ = g.(map[string]interface{})["id"]
+		}
+	}
+
+	idStr, ok := id.(string)
+	if !ok {
+		return nil, errors.New("missing id in option is required")
+	}
+	return m.netByID(idStr)
+}
+
+func (m *WireGuardNetworkState) netByIPAMOptions(data map[string]string) (*WireGuardNetwork, error) {
+	id, ok := data["id"]
+	if !ok {
+		m.logger.Warnw("missing id field in options", zap.Any("options", data))
+		return nil, errors.New("missing id field in options")
+	}
+	return m.netByID(id)
+}
+
+func (m *WireGuardNetworkState) netByDockerID(id string) (*WireGuardNetwork, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, n := range m.Networks {
+		if n.DockerID == id {
+			return n, nil
+		}
+	}
+	return nil, fmt.Errorf("network not found by docker id %s", id)
+}
+
+func (m *WireGuardNetworkState) load() (err error) {
+	defer func() {
+		if err == store.ErrKeyNotFound {
+			err = nil
+		}
+		if err != nil {
+			m.logger.Errorf("could not load wireguard network state - %s; erasing key", err)
+			if delErr := m.storage.Delete("state"); delErr != nil {
+				m.logger.Errorf("could not cleanup storage for wireguard network: %s", delErr)
+			}
+		}
+	}()
+
+	exists, err := m.storage.Exists("state")
+	if err != nil || !exists {
+		return
+	}
+
+	data, err := m.storage.Get("state")
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(data.Value, m)
+	return
+}
+
+// sync persists the state. Must be called with the lock held.
+func (m *WireGuardNetworkState) sync() error {
+	marshalled, err := json.Marshal(m)
+	if err != nil {
+		m.logger.Errorf("could not sync network state: %s", err)
+		return err
+	}
+
+	if err := m.storage.Put("state", marshalled, &store.WriteOptions{}); err != nil {
+		m.logger.Errorf("could not sync network state: %s", err)
+		return err
+	}
+
+	return nil
+}
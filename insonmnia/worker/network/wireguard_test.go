@@ -0,0 +1,214 @@
+package network
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWGKeyPublic(t *testing.T) {
+	// Test vector from RFC 7748, section 6.1.
+	private, err := hex.DecodeString("77076d0a7318a57d3c16c17251b26645df4c2f87ebc0992ab177fba51db92c2a")
+	require.NoError(t, err)
+
+	var key wgKey
+	copy(key[:], private)
+	public := key.PublicKey()
+	assert.Equal(t, "8520f0098930a754748b7ddcb43ef75a0dbf3a0d26381af4eba4a98eaa9b4e6a", hex.EncodeToString(public[:]))
+}
+
+func TestWGKeyText(t *testing.T) {
+	key, err := newWGPrivateKey()
+	require.NoError(t, err)
+	assert.False(t, key.IsZero())
+	assert.Equal(t, byte(0), key[0]&7)
+	assert.Equal(t, byte(64), key[31]&192)
+
+	text, err := key.MarshalText()
+	require.NoError(t, err)
+
+	var parsed wgKey
+	require.NoError(t, parsed.UnmarshalText(text))
+	assert.Equal(t, key, parsed)
+
+	assert.Error(t, parsed.UnmarshalText([]byte("not a key")))
+	assert.Error(t, parsed.UnmarshalText([]byte("AAAA")))
+}
+
+func TestWireGuardPeerArgs(t *testing.T) {
+	peer := &WireGuardPeer{
+		AllowedIPs: []string{"10.20.40.5/32"},
+	}
+	assert.Equal(t, []string{"peer", wgKey{}.String(), "allowed-ips", "10.20.40.5/32"}, peer.args())
+
+	peer.Endpoint = "203.0.113.1:51820"
+	peer.PersistentKeepalive = 25
+	assert.Equal(t, []string{"peer", wgKey{}.String(), "allowed-ips", "10.20.40.5/32", "endpoint", "203.0.113.1:51820", "persistent-keepalive", "25"}, peer.args())
+}
+
+func newTestInvitation(t *testing.T) *wireGuardInvitation {
+	privateKey, err := newWGPrivateKey()
+	require.NoError(t, err)
+	peerKey, err := newWGPrivateKey()
+	require.NoError(t, err)
+
+	return &wireGuardInvitation{
+		PrivateKey: privateKey,
+		Subnet:     "10.20.40.0/24",
+		Addr:       "10.20.40.5",
+		Peer: &WireGuardPeer{
+			PublicKey:           peerKey.PublicKey(),
+			Endpoint:            "203.0.113.1:51820",
+			AllowedIPs:          []string{"10.20.40.0/24"},
+			PersistentKeepalive: wireGuardKeepalive,
+		},
+	}
+}
+
+func TestWireGuardInvitationEncode(t *testing.T) {
+	invitation := newTestInvitation(t)
+
+	encoded, err := invitation.Encode()
+	require.NoError(t, err)
+
+	decoded, err := decodeWireGuardInvitation(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, invitation, decoded)
+}
+
+func TestWireGuardInvitationInvalid(t *testing.T) {
+	_, err := decodeWireGuardInvitation("not an invitation")
+	assert.Error(t, err)
+
+	invitation := newTestInvitation(t)
+	invitation.Addr = "10.20.41.5"
+	encoded, err := invitation.Encode()
+	require.NoError(t, err)
+	_, err = decodeWireGuardInvitation(encoded)
+	assert.Error(t, err)
+
+	invitation = newTestInvitation(t)
+	invitation.Peer.Endpoint = ""
+	encoded, err = invitation.Encode()
+	require.NoError(t, err)
+	_, err = decodeWireGuardInvitation(encoded)
+	assert.Error(t, err)
+}
+
+func TestWireGuardInvitationConfig(t *testing.T) {
+	invitation := newTestInvitation(t)
+
+	expected := "[Interface]\n" +
+		"PrivateKey = " + invitation.PrivateKey.String() + "\n" +
+		"Address = 10.20.40.5/24\n" +
+		"\n[Peer]\n" +
+		"PublicKey = " + invitation.Peer.PublicKey.String() + "\n" +
+		"Endpoint = 203.0.113.1:51820\n" +
+		"AllowedIPs = 10.20.40.0/24\n" +
+		"PersistentKeepalive = 25\n"
+	assert.Equal(t, expected, invitation.Config())
+}
+
+func TestWireGuardNetworkOccupiedIPs(t *testing.T) {
+	_, pool, _ := net.ParseCIDR("10.20.40.0/29")
+	n := &WireGuardNetwork{
+		NodeID: "0123456789abcdef",
+		Pool:   pool,
+		Addr:   "10.20.40.2",
+		Peers: []*WireGuardPeer{
+			{AllowedIPs: []string{"10.20.40.3/32"}},
+			{AllowedIPs: []string{"10.20.40.4/32"}},
+		},
+	}
+
+	assert.Equal(t, "wg0123456789abc", n.device().Name)
+	assert.Equal(t, "10.20.40.1", n.gateway().String())
+	assert.Equal(t, "10.20.40.7", n.broadcast().String())
+
+	occupied := n.occupiedIPs()
+	assert.Len(t, occupied, 5)
+	for _, addr := range []string{"10.20.40.1", "10.20.40.2", "10.20.40.3", "10.20.40.4", "10.20.40.7"} {
+		assert.Contains(t, occupied, newIP4(net.ParseIP(addr)))
+	}
+
+	for i := 0; i < 100; i++ {
+		ip, err := getRandomIP(occupied, pool)
+		require.NoError(t, err)
+		assert.Contains(t, []string{"10.20.40.5", "10.20.40.6"}, ip.ToCommon().String())
+	}
+}
+
+// TestWireGuardDevices connects two network namespaces on this host with
+// WireGuard interfaces created the same way as for containers: within the
+// host namespace first, then moved.
+func TestWireGuardDevices(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("root privileges are required")
+	}
+	for _, tool := range []string{"ip", "wg", "nsenter", "ping"} {
+		if _, err := exec.LookPath(tool); err != nil {
+			t.Skipf("%s is required", tool)
+		}
+	}
+
+	namespaces := []string{"sonm-wg-test0", "sonm-wg-test1"}
+	addrs := []string{"10.20.40.2", "10.20.40.3"}
+	devices := make([]*wgDevice, len(namespaces))
+	keys := make([]wgKey, len(namespaces))
+	ports := make([]uint16, len(namespaces))
+
+	for id, ns := range namespaces {
+		require.NoError(t, exec.Command("ip", "netns", "add", ns).Run())
+		defer exec.Command("ip", "netns", "del", ns).Run()
+
+		key, err := newWGPrivateKey()
+		require.NoError(t, err)
+		port, err := freeUDPPort()
+		require.NoError(t, err)
+
+		dev := &wgDevice{Name: fmt.Sprintf("wgsonmtest%d", id)}
+		require.NoError(t, dev.Create())
+		defer dev.Remove()
+		require.NoError(t, dev.Configure(key, port))
+
+		devices[id], keys[id], ports[id] = dev, key, port
+	}
+
+	// The second peer is configured before moving, the first one - after,
+	// as it happens on invitations.
+	require.NoError(t, devices[1].SetPeer(&WireGuardPeer{
+		PublicKey:           keys[0].PublicKey(),
+		Endpoint:            fmt.Sprintf("127.0.0.1:%d", ports[0]),
+		AllowedIPs:          []string{"10.20.40.0/24"},
+		PersistentKeepalive: wireGuardKeepalive,
+	}))
+
+	for id, dev := range devices {
+		_, err := dev.run(nil, "ip", "link", "set", "dev", dev.Name, "netns", namespaces[id])
+		require.NoError(t, err)
+
+		dev.Netns = "/var/run/netns/" + namespaces[id]
+		_, err = dev.run(nil, "ip", "addr", "add", addrs[id]+"/24", "dev", dev.Name)
+		require.NoError(t, err)
+		_, err = dev.run(nil, "ip", "link", "set", "dev", dev.Name, "up")
+		require.NoError(t, err)
+
+		publicKey, err := dev.PublicKey()
+		require.NoError(t, err)
+		assert.Equal(t, keys[id].PublicKey(), publicKey)
+	}
+
+	require.NoError(t, devices[0].SetPeer(&WireGuardPeer{
+		PublicKey:  keys[1].PublicKey(),
+		AllowedIPs: []string{addrs[1] + "/32"},
+	}))
+
+	_, err := devices[1].run(nil, "ping", "-c", "3", "-W", "5", addrs[0])
+	assert.NoError(t, err)
+}
@@ -0,0 +1,169 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+	"github.com/docker/go-connections/sockets"
+	"github.com/docker/go-plugins-helpers/ipam"
+	netdriver "github.com/docker/go-plugins-helpers/network"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"github.com/sonm-io/core/insonmnia/structs"
+	"go.uber.org/zap"
+)
+
+// WireGuardTuner attaches containers to WireGuard overlay networks.
+//
+// Each network gets its own interface with a distinct key pair and port.
+// The worker that creates the network acts as a hub: every invitation adds
+// a peer to it, while invitees are connected to the hub only.
+type WireGuardTuner struct {
+	client     *client.Client
+	config     *WireGuardConfig
+	netDriver  *WireGuardNetworkDriver
+	ipamDriver *WireGuardIPAMDriver
+}
+
+func NewWireGuardTuner(ctx context.Context, config *WireGuardConfig) (*WireGuardTuner, error) {
+	cli, err := client.NewEnvClient()
+	if err != nil {
+		return nil, err
+	}
+	netDriver, ipamDriver, err := NewWireGuard(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	tuner := &WireGuardTuner{
+		client:     cli,
+		config:     config,
+		netDriver:  netDriver,
+		ipamDriver: ipamDriver,
+	}
+	if err := tuner.runDriver(ctx); err != nil {
+		return nil, err
+	}
+
+	return tuner, nil
+}
+
+func (t *WireGuardTuner) runDriver(ctx context.Context) error {
+	if err := os.MkdirAll(filepath.Dir(t.config.DockerNetPluginSockPath), 0770); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(t.config.DockerIPAMPluginSockPath), 0770); err != nil {
+		return err
+	}
+
+	netListener, err := sockets.NewUnixSocket(t.config.DockerNetPluginSockPath, syscall.Getgid())
+	if err != nil {
+		return err
+	}
+
+	ipamListener, err := sockets.NewUnixSocket(t.config.DockerIPAMPluginSockPath, syscall.Getgid())
+	if err != nil {
+		netListener.Close()
+		return err
+	}
+
+	netHandle := netdriver.NewHandler(t.netDriver)
+	ipamHandle := ipam.NewHandler(t.ipamDriver)
+
+	go func() {
+		<-ctx.Done()
+		log.G(ctx).Info("stopping wireguard socket listener")
+		netListener.Close()
+		ipamListener.Close()
+	}()
+	go func() {
+		log.G(ctx).Info("wireguard ipam plugin has been initialized")
+		ipamHandle.Serve(ipamListener)
+	}()
+	go func() {
+		log.G(ctx).Info("wireguard network plugin has been initialized")
+		netHandle.Serve(netListener)
+	}()
+	return nil
+}
+
+func (t *WireGuardTuner) Tune(ctx context.Context, net *structs.NetworkSpec, hostConfig *container.HostConfig, config *network.NetworkingConfig) (Cleanup, error) {
+	wgNet, err := t.netDriver.InsertWireGuardNetwork(net)
+	if err != nil {
+		return nil, err
+	}
+	opts := map[string]string{"id": wgNet.NodeID}
+
+	createOpts := types.NetworkCreate{
+		Driver:  wireGuardNetwork,
+		Options: opts,
+		IPAM: &network.IPAM{
+			Driver: wireGuardNetwork + "ipam",
+			Config: []network.IPAMConfig{
+				{
+					Subnet: wgNet.Pool.String(),
+				},
+			},
+			Options: opts,
+		},
+	}
+
+	response, err := t.client.NetworkCreate(ctx, net.NetID, createOpts)
+	if err != nil {
+		log.G(ctx).Warn("failed to create wireguard network", zap.Error(err))
+		return nil, err
+	}
+
+	endpoint := &network.EndpointSettings{
+		NetworkID:  response.ID,
+		DriverOpts: opts,
+	}
+	if wgNet.Invited {
+		endpoint.IPAMConfig = &network.EndpointIPAMConfig{
+			IPv4Address: wgNet.Addr,
+		}
+	} else {
+		// Invitees reach each other through the container that has created
+		// the network.
+		if hostConfig.Sysctls == nil {
+			hostConfig.Sysctls = map[string]string{}
+		}
+		hostConfig.Sysctls["net.ipv4.ip_forward"] = "1"
+	}
+
+	if config.EndpointsConfig == nil {
+		config.EndpointsConfig = make(map[string]*network.EndpointSettings)
+	}
+	config.EndpointsConfig[response.ID] = endpoint
+
+	return &TincCleaner{
+		ctx:       ctx,
+		client:    t.client,
+		networkID: response.ID,
+	}, nil
+}
+
+func (t *WireGuardTuner) GetCleaner(ctx context.Context, ID string) (Cleanup, error) {
+	if !t.netDriver.HasNetwork(ID) {
+		return nil, errors.New("failed to find network with id " + ID)
+	}
+	return &TincCleaner{
+		ctx:       ctx,
+		client:    t.client,
+		networkID: ID,
+	}, nil
+}
+
+func (t *WireGuardTuner) Tuned(ID string) bool {
+	return t.netDriver.HasNetwork(ID)
+}
+
+func (t *WireGuardTuner) GenerateInvitation(ID string) (*structs.NetworkSpec, error) {
+	return t.netDriver.GenerateInvitation(ID)
+}
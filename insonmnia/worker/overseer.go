@@ -7,7 +7,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -16,6 +19,7 @@ import (
 	"github.com/docker/docker/client"
 	"github.com/docker/go-connections/nat"
 	"github.com/docker/go-units"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gliderlabs/ssh"
 	log "github.com/noxiouz/zapctx/ctxlog"
 	"github.com/pkg/errors"
@@ -28,11 +32,16 @@ import (
 	"github.com/sonm-io/core/util/multierror"
 	"github.com/sonm-io/core/util/xdocker"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const overseerTag = "sonm.overseer"
 const dealIDTag = "sonm.dealid"
+const taskIDTag = "sonm.taskid"
 const dieEvent = "die"
+const healthStatusEvent = "health_status"
+const oomEvent = "oom"
 
 // Description for a target application.
 type Description struct {
@@ -52,10 +61,47 @@ type Description struct {
 
 	NetworkOptions *network.Network
 	NetworkSpecs   []*structs.NetworkSpec
+
+	// GroupId is the ID of the task group this task belongs to, if any.
+	GroupId    string
+	GroupIndex int
+	// SharedVolumes are names of group volumes that are shared between all
+	// tasks of the group. They are owned by the first task in the group.
+	SharedVolumes []string
+	// NetworkContainer is the ID of a container whose network namespace is
+	// joined instead of configuring own networking.
+	NetworkContainer string
+	// Restore marks that the container is started from a checkpoint image,
+	// so its volumes should be populated from the checkpoint.
+	Restore bool
+	// PushAuth are registry credentials used for pushing images of the
+	// container, which may differ from the pull ones.
+	PushAuth string
+	// LoopbackPorts binds exposed ports to loopback addresses only, which
+	// leaves them reachable through the port forwarding service only.
+	LoopbackPorts bool
+}
+
+func (d *Description) VolumeID(name string) string {
+	if d.isSharedVolume(name) {
+		return fmt.Sprintf("%s/%s", d.GroupId, name)
+	}
+
+	return fmt.Sprintf("%s/%s", d.TaskId, name)
 }
 
-func (d *Description) ID() string {
-	return d.TaskId
+func (d *Description) IsVolumeOwner(name string) bool {
+	return !d.isSharedVolume(name) || d.GroupIndex == 0
+}
+
+func (d *Description) isSharedVolume(name string) bool {
+	for _, shared := range d.SharedVolumes {
+		if shared == name {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (d *Description) Volumes() map[string]*sonm.Volume {
@@ -107,8 +153,41 @@ func (d *Description) FormatEnv() []string {
 	return vars
 }
 
+// Expose parses port exposing specifications of the container.
+//
+// IPv6 host addresses must be enclosed in square brackets, like
+// "[2001:db8::1]:8080:80", otherwise they are ambiguous.
 func (d *Description) Expose() (nat.PortSet, nat.PortMap, error) {
-	return nat.ParsePortSpecs(d.Container.Expose)
+	for _, spec := range d.Container.Expose {
+		if strings.Count(spec, ":") > 2 && !strings.HasPrefix(spec, "[") {
+			return nil, nil, fmt.Errorf("invalid expose specification %q: IPv6 address must be enclosed in square brackets", spec)
+		}
+	}
+
+	portSet, portBindings, err := nat.ParsePortSpecs(d.Container.Expose)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if d.LoopbackPorts {
+		for _, bindings := range portBindings {
+			for i := range bindings {
+				bindings[i].HostIP = loopbackIP(bindings[i].HostIP)
+			}
+		}
+	}
+
+	return portSet, portBindings, nil
+}
+
+// loopbackIP returns the loopback address of the same family as the given
+// host address, IPv4 one if the family is not specified.
+func loopbackIP(host string) string {
+	if ip := net.ParseIP(host); ip != nil && ip.To4() == nil {
+		return net.IPv6loopback.String()
+	}
+
+	return "127.0.0.1"
 }
 
 // ContainerInfo is a brief information about containers
@@ -126,6 +205,20 @@ type ContainerInfo struct {
 	TaskId       string
 	Tag          *sonm.TaskTag
 	AskID        string
+	GroupID      string
+	GroupIndex   int
+	// ForwardAddr is the ETH address of the port forwarding service the
+	// task's ports are published through, if any.
+	ForwardAddr string
+	// ForwardedPorts maps published ports to the addresses they are bound
+	// to on the worker host.
+	ForwardedPorts map[nat.Port]string
+
+	// spoolProgress is the image pull progress while the task is spooling.
+	spoolProgress *sonm.ImagePullProgress
+	// detached is set for tasks restored from their records, whose
+	// containers no longer exist.
+	detached bool
 }
 
 func (c *ContainerInfo) IntoProto(ctx context.Context) *sonm.TaskStatusReply {
@@ -153,6 +246,28 @@ func (c *ContainerInfo) IntoProto(ctx context.Context) *sonm.TaskStatusReply {
 		Usage:              nil,
 		AllocatedResources: nil,
 		Tag:                c.Tag,
+		GroupID:            c.GroupID,
+		SpoolProgress:      c.spoolProgress,
+		PortForwarding:     c.portForwarding(),
+	}
+}
+
+// portForwarding describes ports of the task published through NPP, nil if
+// there are none.
+func (c *ContainerInfo) portForwarding() *sonm.PortForwarding {
+	if len(c.ForwardAddr) == 0 || len(c.ForwardedPorts) == 0 {
+		return nil
+	}
+
+	ports := make([]string, 0, len(c.ForwardedPorts))
+	for port := range c.ForwardedPorts {
+		ports = append(ports, string(port))
+	}
+	sort.Strings(ports)
+
+	return &sonm.PortForwarding{
+		Addr:  sonm.NewEthAddress(common.HexToAddress(c.ForwardAddr)),
+		Ports: ports,
 	}
 }
 
@@ -161,6 +276,9 @@ type ContainerMetrics struct {
 	cpu types.CPUStats
 	mem types.MemoryStats
 	net map[string]*NetworkStatsExt
+	// egressViolations is the number of outbound packets dropped because
+	// of egress policies.
+	egressViolations uint64
 }
 
 func (m *ContainerMetrics) Marshal() *sonm.ResourceUsage {
@@ -190,7 +308,8 @@ func (m *ContainerMetrics) Marshal() *sonm.ResourceUsage {
 		Memory: &sonm.MemoryUsage{
 			MaxUsage: m.mem.MaxUsage,
 		},
-		Network: networkUsage,
+		Network:          networkUsage,
+		EgressViolations: m.egressViolations,
 	}
 }
 
@@ -207,7 +326,9 @@ type Overseer interface {
 	// Spool prepares an application for its further start.
 	//
 	// For Docker containers this is an equivalent of pulling from the registry.
-	Spool(ctx context.Context, d Description) error
+	// The optional progress function is called each time the pull progress
+	// changes.
+	Spool(ctx context.Context, d Description, progress func(xdocker.PullProgress)) error
 
 	// Start attempts to start an application using the specified description.
 	//
@@ -215,15 +336,28 @@ type Overseer interface {
 	// to complete them.
 	Start(ctx context.Context, description Description) (chan sonm.TaskStatusReply_Status, ContainerInfo, error)
 
-	// Attach attemps to attach to a running application with a specified description
-	Attach(ctx context.Context, ID string, description Description) (chan sonm.TaskStatusReply_Status, error)
+	// Attach attemps to attach to a running application with a specified description.
+	//
+	// When restart is set, the container that has exited while the worker
+	// was down is restarted according to its restart policy, as it would be
+	// if its exit was observed.
+	Attach(ctx context.Context, ID string, description Description, restart bool) (chan sonm.TaskStatusReply_Status, error)
 
 	// Exec a given command in running container
 	Exec(ctx context.Context, Id string, cmd []string, env []string, isTty bool, wCh <-chan ssh.Window) (types.HijackedResponse, error)
 
+	// ExecSession is the same as Exec, but additionally allows to obtain the
+	// exit code of the command after it finishes.
+	ExecSession(ctx context.Context, id string, cmd []string, env []string, isTty bool, wCh <-chan ssh.Window) (*ExecSession, error)
+
 	// Stop terminates the container.
 	Stop(ctx context.Context, containerID string) error
 
+	// Terminate asks the application to finish gracefully by sending SIGTERM
+	// to it. Unlike Stop the container is not killed, but it is not restarted
+	// after it exits either.
+	Terminate(ctx context.Context, containerID string) error
+
 	// OnDealFinish makes all cleanup related to closed deal
 	OnDealFinish(ctx context.Context, containerID string) error
 
@@ -235,6 +369,33 @@ type Overseer interface {
 	// Logs fetch logs of the container
 	Logs(ctx context.Context, id string, opts types.ContainerLogsOptions) (io.ReadCloser, error)
 
+	// CopyTo extracts the given tar archive into the directory of the
+	// container.
+	CopyTo(ctx context.Context, id string, path string, archive io.Reader) error
+
+	// CopyFrom returns a tar archive of the given path of the container.
+	CopyFrom(ctx context.Context, id string, path string) (io.ReadCloser, error)
+
+	// StorageUsage returns the size of the writable layer of the container
+	// in bytes.
+	StorageUsage(ctx context.Context, id string) (uint64, error)
+
+	// HealthLog returns the most recent health check probe results of the
+	// container. Docker keeps only the last few probes.
+	HealthLog(ctx context.Context, containerID string) ([]*sonm.TaskHealthProbe, error)
+
+	// LastCheckpoint returns the most recent checkpoint of the container or
+	// nil if there were no checkpoints yet.
+	LastCheckpoint(containerID string) *sonm.TaskCheckpoint
+
+	// Restarts returns the number of times the container has been restarted
+	// by the worker and its most recent exit, if any.
+	Restarts(containerID string) (uint32, *sonm.TaskExit)
+
+	// TaskMetrics returns the resource usage time series of the container
+	// within the given time range.
+	TaskMetrics(containerID string, from, to time.Time, step time.Duration) ([]*sonm.TaskMetricsSample, error)
+
 	// Close terminates all associated asynchronous operations and prepares the Overseer for shutting down.
 	Close() error
 }
@@ -243,7 +404,8 @@ type overseer struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
-	plugins *plugin.Repository
+	plugins        *plugin.Repository
+	gpuUtilization GPUUtilization
 
 	client *client.Client
 
@@ -252,15 +414,60 @@ type overseer struct {
 	// protects containers map
 	mu         sync.Mutex
 	containers map[string]*containerDescriptor
-	statuses   map[string]chan sonm.TaskStatusReply_Status
+	statuses   map[string]*statusChannel
+}
+
+// statusChannel delivers status updates of a container to its listener.
+//
+// Updates may be sent concurrently with closing, hence the channel has its
+// own lock instead of the overseer's one, so slow listeners never block the
+// overseer.
+type statusChannel struct {
+	mu     sync.Mutex
+	ch     chan sonm.TaskStatusReply_Status
+	closed bool
+}
+
+func newStatusChannel() *statusChannel {
+	return &statusChannel{ch: make(chan sonm.TaskStatusReply_Status, 1)}
+}
+
+// Send sends the intermediate status, which is dropped if the channel is
+// already closed.
+func (m *statusChannel) Send(ctx context.Context, status sonm.TaskStatusReply_Status) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return
+	}
+
+	select {
+	case m.ch <- status:
+	case <-ctx.Done():
+	}
+}
+
+// Close sends the final status and closes the channel.
+func (m *statusChannel) Close(status sonm.TaskStatusReply_Status) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return
+	}
+
+	m.closed = true
+	m.ch <- status
+	close(m.ch)
 }
 
 func (o *overseer) supportGPU() bool {
 	return o.plugins.HasGPU()
 }
 
-// NewOverseer creates new overseer
-func NewOverseer(ctx context.Context, plugins *plugin.Repository) (Overseer, error) {
+// NewOverseer creates new overseer. The GPU utilization source is optional.
+func NewOverseer(ctx context.Context, plugins *plugin.Repository, gpuUtilization GPUUtilization) (Overseer, error) {
 	dockerClient, err := client.NewEnvClient()
 	if err != nil {
 		return nil, err
@@ -268,15 +475,17 @@ func NewOverseer(ctx context.Context, plugins *plugin.Repository) (Overseer, err
 
 	ctx, cancel := context.WithCancel(ctx)
 	ovr := &overseer{
-		ctx:        ctx,
-		cancel:     cancel,
-		plugins:    plugins,
-		client:     dockerClient,
-		containers: make(map[string]*containerDescriptor),
-		statuses:   make(map[string]chan sonm.TaskStatusReply_Status),
+		ctx:            ctx,
+		cancel:         cancel,
+		plugins:        plugins,
+		gpuUtilization: gpuUtilization,
+		client:         dockerClient,
+		containers:     make(map[string]*containerDescriptor),
+		statuses:       make(map[string]*statusChannel),
 	}
 
 	go ovr.collectStats()
+	go ovr.refreshEgress()
 	go ovr.watchEvents()
 
 	return ovr, nil
@@ -288,9 +497,10 @@ func (o *overseer) Info(ctx context.Context) (map[string]ContainerMetrics, error
 	o.mu.Lock()
 	for _, container := range o.containers {
 		metrics := ContainerMetrics{
-			cpu: container.stats.CPUStats,
-			mem: container.stats.MemoryStats,
-			net: container.stats.NetworksExt,
+			cpu:              container.stats.CPUStats,
+			mem:              container.stats.MemoryStats,
+			net:              container.stats.NetworksExt,
+			egressViolations: container.EgressViolations(),
 		}
 
 		info[container.ID] = metrics
@@ -327,52 +537,180 @@ func (o *overseer) handleStreamingEvents(ctx context.Context, sinceUnix int64, f
 
 			switch message.Status {
 			case dieEvent:
-				id := message.Actor.ID
-				log.G(ctx).Info("container has died", zap.String("id", id))
-
-				var c *containerDescriptor
-				o.mu.Lock()
-				c, containerFound := o.containers[id]
-				s, statusFound := o.statuses[id]
-				// We intentionally do not delete container from the map to save history for deal.
-				// It will be removed from that map after deal finishes and corresponding container would be deleted
-				delete(o.statuses, id)
-				o.mu.Unlock()
-
-				if !containerFound {
+				o.onDie(ctx, message.Actor.ID)
+			case oomEvent:
+				o.onOOM(ctx, message.Actor.ID)
+			default:
+				if strings.HasPrefix(message.Status, healthStatusEvent) {
+					health := strings.TrimSpace(strings.TrimPrefix(message.Status, healthStatusEvent+":"))
+					o.onHealthStatus(ctx, message.Actor.ID, health)
 					continue
 				}
-				if statusFound {
-					info, err := o.client.ContainerInspect(ctx, id)
-					if err != nil {
-						log.S(ctx).Warnf("failed to inspect exited container %s: %s", id, err)
-						s <- sonm.TaskStatusReply_BROKEN
-					} else if info.State.ExitCode == 0 {
-						s <- sonm.TaskStatusReply_FINISHED
-					} else if info.State.OOMKilled {
-						s <- sonm.TaskStatusReply_KILLED_OOM
-					} else {
-						s <- sonm.TaskStatusReply_BROKEN
-					}
-					close(s)
-				}
-				if c.description.CommitOnStop {
-					log.G(ctx).Info("trying to upload container")
-					err := c.upload(ctx)
-					if err != nil {
-						log.G(ctx).Error("failed to commit container", zap.String("id", id), zap.Error(err))
-					}
-				}
-				if err := c.Cleanup(); err != nil {
-					log.G(ctx).Error("failed to clean up container", zap.String("id", id), zap.Error(err))
-				}
-			default:
+
 				log.G(ctx).Warn("received unknown event", zap.String("status", message.Status))
 			}
 		}
 	}
 }
 
+// onDie either schedules the restart of the exited container according to
+// its restart policy or reports the final status to the status listener.
+func (o *overseer) onDie(ctx context.Context, id string) {
+	log.G(ctx).Info("container has died", zap.String("id", id))
+
+	o.mu.Lock()
+	c, containerFound := o.containers[id]
+	_, statusFound := o.statuses[id]
+	o.mu.Unlock()
+
+	if !containerFound {
+		return
+	}
+
+	// Containers stopped by the worker have no status listener and are
+	// never restarted.
+	if !statusFound {
+		o.finish(ctx, c, sonm.TaskStatusReply_FINISHED)
+		return
+	}
+
+	info, err := o.client.ContainerInspect(ctx, id)
+	if err != nil {
+		log.S(ctx).Warnf("failed to inspect exited container %s: %s", id, err)
+		o.finish(ctx, c, sonm.TaskStatusReply_BROKEN)
+		return
+	}
+
+	o.onExit(ctx, c, info.State)
+}
+
+// onExit either schedules the restart of the exited container according to
+// its restart policy or reports its final status.
+func (o *overseer) onExit(ctx context.Context, c *containerDescriptor, state *types.ContainerState) {
+	status, delay := c.restarts.OnExit(newTaskExit(state))
+	if status == sonm.TaskStatusReply_RESTARTING {
+		log.G(ctx).Info("scheduling container restart", zap.String("id", c.ID), zap.Duration("delay", delay))
+		o.notifyStatus(ctx, c.ID, status)
+		go o.restart(c, delay)
+		return
+	}
+
+	o.finish(ctx, c, status)
+}
+
+// onOOM remembers that the container has been killed by the OOM killer,
+// because Docker may not reflect it in the container state, for example,
+// when a child process is killed.
+func (o *overseer) onOOM(ctx context.Context, id string) {
+	log.G(ctx).Info("container has run out of memory", zap.String("id", id))
+
+	o.mu.Lock()
+	c, ok := o.containers[id]
+	o.mu.Unlock()
+
+	if ok {
+		c.restarts.OnOOM()
+	}
+}
+
+// restart starts the exited container again after the given delay.
+func (o *overseer) restart(c *containerDescriptor, delay time.Duration) {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-o.ctx.Done():
+		return
+	}
+
+	// The task has been stopped during the delay, which also cleans it up.
+	if !c.restarts.Resume() {
+		return
+	}
+
+	if err := c.startContainer(o.ctx); err != nil {
+		c.log.Warnf("failed to restart container: %v", err)
+		o.finish(o.ctx, c, sonm.TaskStatusReply_BROKEN)
+		return
+	}
+
+	// The container's address may change after restart.
+	if err := c.setupEgress(o.ctx); err != nil {
+		c.log.Warnf("failed to setup egress rules of restarted container: %v", err)
+		c.restarts.Stop()
+		c.Kill(o.ctx)
+		return
+	}
+
+	c.log.Info("container has been restarted")
+	o.notifyStatus(o.ctx, c.ID, sonm.TaskStatusReply_RUNNING)
+}
+
+// finish reports the final status of the exited container and releases
+// its resources.
+func (o *overseer) finish(ctx context.Context, c *containerDescriptor, status sonm.TaskStatusReply_Status) {
+	o.mu.Lock()
+	s, statusFound := o.statuses[c.ID]
+	// We intentionally do not delete container from the map to save history for deal.
+	// It will be removed from that map after deal finishes and corresponding container would be deleted
+	delete(o.statuses, c.ID)
+	o.mu.Unlock()
+
+	c.StopCheckpoints()
+	if statusFound {
+		s.Close(status)
+	}
+	if c.description.CommitOnStop {
+		log.G(ctx).Info("trying to upload container")
+		err := c.upload(ctx)
+		if err != nil {
+			log.G(ctx).Error("failed to commit container", zap.String("id", c.ID), zap.Error(err))
+		}
+	}
+	if err := c.Cleanup(); err != nil {
+		log.G(ctx).Error("failed to clean up container", zap.String("id", c.ID), zap.Error(err))
+	}
+}
+
+// onHealthStatus notifies the status listener about health check state
+// changes of containers that have a health check specified.
+func (o *overseer) onHealthStatus(ctx context.Context, id string, health string) {
+	var status sonm.TaskStatusReply_Status
+	switch health {
+	case types.Healthy:
+		status = sonm.TaskStatusReply_HEALTHY
+	case types.Unhealthy:
+		status = sonm.TaskStatusReply_UNHEALTHY
+	default:
+		return
+	}
+
+	log.G(ctx).Info("container health status has been changed", zap.String("id", id), zap.String("health", health))
+
+	o.mu.Lock()
+	c, ok := o.containers[id]
+	o.mu.Unlock()
+
+	if !ok || c.description.Healthcheck == nil {
+		return
+	}
+
+	o.notifyStatus(ctx, id, status)
+}
+
+// notifyStatus sends the intermediate status of the container to its status
+// listener, if any.
+func (o *overseer) notifyStatus(ctx context.Context, id string, status sonm.TaskStatusReply_Status) {
+	o.mu.Lock()
+	s, ok := o.statuses[id]
+	o.mu.Unlock()
+
+	if ok {
+		s.Send(ctx, status)
+	}
+}
+
 func (o *overseer) watchEvents() {
 	backoff := NewBackoffTimer(time.Second, time.Second*32)
 	defer backoff.Stop()
@@ -381,6 +719,8 @@ func (o *overseer) watchEvents() {
 
 	filterArgs := filters.NewArgs()
 	filterArgs.Add("event", dieEvent)
+	filterArgs.Add("event", healthStatusEvent)
+	filterArgs.Add("event", oomEvent)
 	filterArgs.Add("label", overseerTag)
 
 	var err error
@@ -406,6 +746,33 @@ func (o *overseer) watchEvents() {
 	}
 }
 
+// refreshEgress periodically rebuilds egress rules of containers, so that
+// rules follow changes of addresses of allowed hosts.
+func (o *overseer) refreshEgress() {
+	t := time.NewTicker(egressRefreshInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			o.mu.Lock()
+			containers := make([]*containerDescriptor, 0, len(o.containers))
+			for _, container := range o.containers {
+				containers = append(containers, container)
+			}
+			o.mu.Unlock()
+
+			for _, container := range containers {
+				if err := container.refreshEgress(o.ctx); err != nil {
+					log.G(o.ctx).Warn("failed to refresh egress rules", zap.String("id", container.ID), zap.Error(err))
+				}
+			}
+		case <-o.ctx.Done():
+			return
+		}
+	}
+}
+
 func (o *overseer) collectStats() {
 	t := time.NewTicker(30 * time.Second)
 	defer t.Stop()
@@ -418,11 +785,24 @@ func (o *overseer) collectStats() {
 				ids = append(ids, id)
 			}
 			o.mu.Unlock()
+			utilization := map[gpu.GPUID]float64{}
+			if o.gpuUtilization != nil {
+				utilization = o.gpuUtilization()
+			}
 			for _, id := range ids {
 				if len(id) == 0 {
 					continue
 				}
 
+				o.mu.Lock()
+				container, ok := o.containers[id]
+				o.mu.Unlock()
+				if ok {
+					if err := container.updateEgressViolations(); err != nil {
+						log.G(o.ctx).Warn("failed to get egress violations", zap.String("id", id), zap.Error(err))
+					}
+				}
+
 				resp, err := o.client.ContainerStats(o.ctx, id, false)
 				if err != nil {
 					log.G(o.ctx).Warn("failed to get Stats", zap.String("id", id), zap.Error(err))
@@ -444,6 +824,9 @@ func (o *overseer) collectStats() {
 				o.mu.Lock()
 				if container, ok := o.containers[id]; ok {
 					container.stats.Update(stats)
+					if !stats.Read.IsZero() {
+						container.metrics.Append(stats, averageGPUUtilization(utilization, container.description.GPUDevices))
+					}
 				}
 				o.mu.Unlock()
 			}
@@ -480,7 +863,7 @@ func (o *overseer) Save(ctx context.Context, imageID string) (types.ImageInspect
 	return imageInspect, rd, nil
 }
 
-func (o *overseer) Spool(ctx context.Context, d Description) error {
+func (o *overseer) Spool(ctx context.Context, d Description, progress func(xdocker.PullProgress)) error {
 	log.G(ctx).Info("pull the application image")
 	// TODO: maybe add sonm labels to make filtration easier
 	summaries, err := o.client.ImageList(ctx, types.ImageListOptions{All: true})
@@ -489,7 +872,7 @@ func (o *overseer) Spool(ctx context.Context, d Description) error {
 	}
 	refStr := d.Reference.String()
 	for _, summary := range summaries {
-		if summary.ID == refStr {
+		if summary.ID == refStr || hasRepoTag(summary, refStr) {
 			log.S(ctx).Infof("application image %s is already present", d.Reference.String())
 			return nil
 		}
@@ -505,7 +888,9 @@ func (o *overseer) Spool(ctx context.Context, d Description) error {
 		return err
 	}
 
-	if err = xdocker.DecodeImagePull(body); err != nil {
+	defer body.Close()
+
+	if err = xdocker.DecodeImagePullProgress(body, progress); err != nil {
 		log.G(ctx).Error("failed to pull an image", zap.Error(err))
 		return err
 	}
@@ -513,7 +898,7 @@ func (o *overseer) Spool(ctx context.Context, d Description) error {
 	return nil
 }
 
-func (o *overseer) Attach(ctx context.Context, ID string, d Description) (chan sonm.TaskStatusReply_Status, error) {
+func (o *overseer) Attach(ctx context.Context, ID string, d Description, restart bool) (chan sonm.TaskStatusReply_Status, error) {
 	cont, err := attachContainer(ctx, o.client, ID, d, o.plugins)
 	if err != nil {
 		log.S(ctx).Debugf("failed to attach to container %s", err)
@@ -522,13 +907,32 @@ func (o *overseer) Attach(ctx context.Context, ID string, d Description) (chan s
 	cont.ID = ID
 	log.S(ctx).Debugf("attached to running container %s", ID)
 
+	// Rules are kept by the system between worker restarts, but the
+	// descriptor must own them to remove later.
+	if err := cont.setupEgress(ctx); err != nil {
+		log.S(ctx).Warnf("failed to setup egress rules of container %s: %v", ID, err)
+	}
+
+	o.startCheckpoints(cont)
+
 	o.mu.Lock()
 	o.containers[ID] = cont
-	status := make(chan sonm.TaskStatusReply_Status, 1)
+	status := newStatusChannel()
 	o.statuses[ID] = status
 	o.mu.Unlock()
 
-	return status, nil
+	if restart {
+		info, err := o.client.ContainerInspect(ctx, ID)
+		if err != nil {
+			log.S(ctx).Warnf("failed to inspect attached container %s: %v", ID, err)
+		} else if info.State.Status == "exited" {
+			// Docker events of the exit are gone, so it is handled as if
+			// it has just happened.
+			go o.onExit(ctx, cont, info.State)
+		}
+	}
+
+	return status.ch, nil
 }
 
 func (o *overseer) Start(ctx context.Context, description Description) (status chan sonm.TaskStatusReply_Status, cinfo ContainerInfo, err error) {
@@ -547,18 +951,29 @@ func (o *overseer) Start(ctx context.Context, description Description) (status c
 	}
 	log.S(ctx).Debugf("created container %s", pr.ID)
 
+	o.startCheckpoints(pr)
+
 	o.mu.Lock()
 	o.containers[pr.ID] = pr
-	status = make(chan sonm.TaskStatusReply_Status, 1)
-	o.statuses[pr.ID] = status
+	channel := newStatusChannel()
+	o.statuses[pr.ID] = channel
+	status = channel.ch
 	o.mu.Unlock()
 
 	if err = pr.startContainer(ctx); err != nil {
 		log.S(ctx).Warnf("failed to start container %s", pr.ID)
+		pr.StopCheckpoints()
 		return
 	}
 	log.S(ctx).Debugf("started container %s", pr.ID)
 
+	if err = pr.setupEgress(ctx); err != nil {
+		log.S(ctx).Warnf("failed to setup egress rules of container %s: %v", pr.ID, err)
+		pr.restarts.Stop()
+		pr.Kill(ctx)
+		return
+	}
+
 	cjson, err := o.client.ContainerInspect(ctx, pr.ID)
 	if err != nil {
 		log.S(ctx).Warnf("failed to inspect container %s", pr.ID)
@@ -597,6 +1012,17 @@ func (o *overseer) Exec(ctx context.Context, id string, cmd []string, env []stri
 	return
 }
 
+func (o *overseer) ExecSession(ctx context.Context, id string, cmd []string, env []string, isTty bool, wCh <-chan ssh.Window) (*ExecSession, error) {
+	o.mu.Lock()
+	descriptor, ok := o.containers[id]
+	o.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("no such container %s", id)
+	}
+
+	return descriptor.execSession(ctx, cmd, env, isTty, wCh)
+}
+
 func (o *overseer) Stop(ctx context.Context, containerid string) error {
 	o.mu.Lock()
 
@@ -606,17 +1032,47 @@ func (o *overseer) Stop(ctx context.Context, containerid string) error {
 	o.mu.Unlock()
 
 	if sok {
-		status <- sonm.TaskStatusReply_FINISHED
-		close(status)
+		status.Close(sonm.TaskStatusReply_FINISHED)
 	}
 
 	if !dok {
 		return fmt.Errorf("no such container %s", containerid)
 	}
 
+	// There is nothing to kill while the container is waiting for restart.
+	if descriptor.restarts.Stop() {
+		o.finish(ctx, descriptor, sonm.TaskStatusReply_FINISHED)
+		return nil
+	}
+
+	descriptor.StopCheckpoints()
+
 	return descriptor.Kill(ctx)
 }
 
+func (o *overseer) Terminate(ctx context.Context, containerID string) error {
+	o.mu.Lock()
+	descriptor, ok := o.containers[containerID]
+	_, sok := o.statuses[containerID]
+	o.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("no such container %s", containerID)
+	}
+	// Finished containers are kept until the deal is finished.
+	if !sok {
+		return nil
+	}
+
+	// There is nothing to terminate while the container is waiting for restart.
+	if descriptor.restarts.Stop() {
+		o.finish(ctx, descriptor, sonm.TaskStatusReply_FINISHED)
+		return nil
+	}
+
+	return descriptor.Terminate(ctx)
+}
+
 func (o *overseer) OnDealFinish(ctx context.Context, containerID string) error {
 	log.S(ctx).Debugf("overseer cleaning up %s on deal finish", containerID)
 	var isRunning bool
@@ -633,13 +1089,20 @@ func (o *overseer) OnDealFinish(ctx context.Context, containerID string) error {
 	delete(o.statuses, containerID)
 	o.mu.Unlock()
 	if sok {
-		status <- sonm.TaskStatusReply_FINISHED
-		close(status)
+		status.Close(sonm.TaskStatusReply_FINISHED)
 	}
 	if !ok {
 		return fmt.Errorf("unknown container %s", containerID)
 	}
 	result := multierror.NewMultiError()
+	descriptor.restarts.Stop()
+	descriptor.StopCheckpoints()
+	// The final checkpoint allows to restore the task within another deal.
+	if isRunning && descriptor.description.GetCheckpoint() != nil {
+		if _, err := descriptor.Checkpoint(ctx); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
 	if isRunning {
 		if err := descriptor.Kill(ctx); err != nil {
 			result = multierror.Append(result, err)
@@ -664,6 +1127,126 @@ func (o *overseer) Logs(ctx context.Context, id string, opts types.ContainerLogs
 	return o.client.ContainerLogs(ctx, id, opts)
 }
 
+func (o *overseer) CopyTo(ctx context.Context, id string, path string, archive io.Reader) error {
+	return o.client.CopyToContainer(ctx, id, path, archive, types.CopyToContainerOptions{})
+}
+
+func (o *overseer) CopyFrom(ctx context.Context, id string, path string) (io.ReadCloser, error) {
+	rd, _, err := o.client.CopyFromContainer(ctx, id, path)
+	return rd, err
+}
+
+func (o *overseer) StorageUsage(ctx context.Context, id string) (uint64, error) {
+	info, _, err := o.client.ContainerInspectWithRaw(ctx, id, true)
+	if err != nil {
+		return 0, err
+	}
+
+	if info.ContainerJSONBase == nil || info.SizeRw == nil || *info.SizeRw < 0 {
+		return 0, nil
+	}
+
+	return uint64(*info.SizeRw), nil
+}
+
+func (o *overseer) HealthLog(ctx context.Context, containerID string) ([]*sonm.TaskHealthProbe, error) {
+	info, err := o.client.ContainerInspect(ctx, containerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.State == nil || info.State.Health == nil {
+		return nil, nil
+	}
+
+	probes := make([]*sonm.TaskHealthProbe, 0, len(info.State.Health.Log))
+	for _, result := range info.State.Health.Log {
+		probes = append(probes, &sonm.TaskHealthProbe{
+			Start:    sonm.NewTimestamp(result.Start),
+			End:      sonm.NewTimestamp(result.End),
+			ExitCode: int32(result.ExitCode),
+			Output:   result.Output,
+		})
+	}
+
+	return probes, nil
+}
+
+func (o *overseer) LastCheckpoint(containerID string) *sonm.TaskCheckpoint {
+	o.mu.Lock()
+	descriptor, ok := o.containers[containerID]
+	o.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	return descriptor.LastCheckpoint()
+}
+
+func (o *overseer) Restarts(containerID string) (uint32, *sonm.TaskExit) {
+	o.mu.Lock()
+	descriptor, ok := o.containers[containerID]
+	o.mu.Unlock()
+
+	if !ok {
+		return 0, nil
+	}
+
+	return descriptor.restarts.Restarts()
+}
+
+func (o *overseer) TaskMetrics(containerID string, from, to time.Time, step time.Duration) ([]*sonm.TaskMetricsSample, error) {
+	o.mu.Lock()
+	descriptor, ok := o.containers[containerID]
+	o.mu.Unlock()
+
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "failed to find container %s", containerID)
+	}
+
+	return descriptor.metrics.Query(from, to, step), nil
+}
+
+// startCheckpoints starts periodic checkpointing of the container if it is
+// enabled in its description. Must be called before the descriptor is
+// published.
+func (o *overseer) startCheckpoints(c *containerDescriptor) {
+	interval := c.description.GetCheckpoint().GetInterval().Unwrap()
+	if interval <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(o.ctx)
+	c.stopCheckpoints = cancel
+
+	go func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-t.C:
+				if _, err := c.Checkpoint(ctx); err != nil {
+					c.log.Warnf("failed to checkpoint container: %v", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func hasRepoTag(summary types.ImageSummary, ref string) bool {
+	for _, tag := range summary.RepoTags {
+		if tag == ref {
+			return true
+		}
+	}
+
+	return false
+}
+
 // prunedImage can stream pushed image with repository and tag data removed.
 type prunedImage struct {
 	image       *tar.Reader
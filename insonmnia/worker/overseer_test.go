@@ -13,6 +13,7 @@ import (
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/client"
 	"github.com/docker/go-connections/nat"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gliderlabs/ssh"
 	"github.com/sonm-io/core/insonmnia/structs"
 	"github.com/sonm-io/core/insonmnia/worker/network"
@@ -25,17 +26,17 @@ import (
 
 func TestOvsSpool(t *testing.T) {
 	ctx := context.Background()
-	ovs, err := NewOverseer(ctx, plugin.EmptyRepository())
+	ovs, err := NewOverseer(ctx, plugin.EmptyRepository(), nil)
 	defer ovs.Close()
 	require.NoError(t, err, "failed to create Overseer")
 
 	ref, err := xdocker.NewReference("docker.io/alpine")
 	require.NoError(t, err, "failed to create Overseer")
-	err = ovs.Spool(ctx, Description{Reference: ref})
+	err = ovs.Spool(ctx, Description{Reference: ref}, nil)
 	require.NoError(t, err, "failed to pull an image")
 
 	ref, err = xdocker.NewReference("docker2.io/alpine")
-	err = ovs.Spool(ctx, Description{Reference: ref})
+	err = ovs.Spool(ctx, Description{Reference: ref}, nil)
 	require.NotNil(t, err)
 }
 
@@ -112,7 +113,7 @@ func TestOvsSpawn(t *testing.T) {
 	assrt.NoError(err)
 	defer cl.Close()
 	ctx := context.Background()
-	ovs, err := NewOverseer(ctx, plugin.EmptyRepository())
+	ovs, err := NewOverseer(ctx, plugin.EmptyRepository(), nil)
 	require.NoError(t, err)
 	ref, err := xdocker.NewReference("worker")
 	require.NoError(t, err)
@@ -153,7 +154,7 @@ func TestOvsAttach(t *testing.T) {
 	assrt.NoError(err)
 	defer cl.Close()
 	ctx := context.Background()
-	ovs, err := NewOverseer(ctx, plugin.EmptyRepository())
+	ovs, err := NewOverseer(ctx, plugin.EmptyRepository(), nil)
 	require.NoError(t, err)
 	ref, err := xdocker.NewReference("worker")
 	require.NoError(t, err)
@@ -168,10 +169,10 @@ func TestOvsAttach(t *testing.T) {
 	assrt.True(ok)
 	ovs.Close()
 
-	ovs2, err := NewOverseer(ctx, plugin.EmptyRepository())
+	ovs2, err := NewOverseer(ctx, plugin.EmptyRepository(), nil)
 	require.NoError(t, err)
 	descr := Description{Reference: ref}
-	ch, err := ovs2.Attach(ctx, info.ID, descr)
+	ch, err := ovs2.Attach(ctx, info.ID, descr, false)
 	t.Logf("attached to container %s", info.ID)
 	require.NoError(t, err)
 	wg := sync.WaitGroup{}
@@ -213,6 +214,83 @@ func TestExpose(t *testing.T) {
 	}, portBinding)
 }
 
+func TestExposeIPv6(t *testing.T) {
+	d := Description{Container: sonm.Container{Expose: []string{"[2001:db8::1]:8080:80", "[::]:53:53/udp"}}}
+
+	portSet, portBinding, err := d.Expose()
+	require.NoError(t, err)
+
+	assert.Equal(t, nat.PortSet(map[nat.Port]struct{}{"80/tcp": {}, "53/udp": {}}), portSet)
+	assert.Equal(t, nat.PortMap(map[nat.Port][]nat.PortBinding{
+		"80/tcp": {{HostIP: "2001:db8::1", HostPort: "8080"}},
+		"53/udp": {{HostIP: "::", HostPort: "53"}},
+	}), portBinding)
+
+	d = Description{Container: sonm.Container{Expose: []string{"2001:db8::1:8080:80"}}}
+	_, _, err = d.Expose()
+	assert.Error(t, err)
+}
+
+func TestExposeLoopback(t *testing.T) {
+	d := Description{
+		Container:     sonm.Container{Expose: []string{"81:80", "8.8.8.8:53:10053", "[2001:db8::1]:8080:8080", "[::]:53:53/udp"}},
+		LoopbackPorts: true,
+	}
+
+	_, portBinding, err := d.Expose()
+	require.NoError(t, err)
+
+	assert.Equal(t, nat.PortMap(map[nat.Port][]nat.PortBinding{
+		"80/tcp":    {{HostIP: "127.0.0.1", HostPort: "81"}},
+		"10053/tcp": {{HostIP: "127.0.0.1", HostPort: "53"}},
+		"8080/tcp":  {{HostIP: "::1", HostPort: "8080"}},
+		"53/udp":    {{HostIP: "::1", HostPort: "53"}},
+	}), portBinding)
+}
+
+func TestContainerInfoPortForwarding(t *testing.T) {
+	info := &ContainerInfo{
+		Ports: map[nat.Port][]nat.PortBinding{
+			"80/tcp": {{HostIP: "127.0.0.1", HostPort: "32768"}},
+			"22/tcp": {{HostIP: "127.0.0.1", HostPort: "32769"}},
+			"53/udp": {{HostIP: "127.0.0.1", HostPort: "32770"}},
+		},
+	}
+	assert.Nil(t, info.IntoProto(context.Background()).GetPortForwarding())
+
+	info.ForwardAddr = "0x8125721C2413d99a33E351e1F6Bb4e56b6b633FD"
+	info.ForwardedPorts = map[nat.Port]string{"80/tcp": "127.0.0.1:32768", "22/tcp": "127.0.0.1:32769"}
+
+	forwarding := info.IntoProto(context.Background()).GetPortForwarding()
+	require.NotNil(t, forwarding)
+	assert.Equal(t, common.HexToAddress(info.ForwardAddr), forwarding.GetAddr().Unwrap())
+	assert.Equal(t, []string{"22/tcp", "80/tcp"}, forwarding.GetPorts())
+}
+
+func TestStatusChannel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch := newStatusChannel()
+	ch.Send(ctx, sonm.TaskStatusReply_HEALTHY)
+	assert.Equal(t, sonm.TaskStatusReply_HEALTHY, <-ch.ch)
+
+	// Sending to the full channel gives up once the context is canceled.
+	ch.Send(ctx, sonm.TaskStatusReply_UNHEALTHY)
+	cancel()
+	ch.Send(ctx, sonm.TaskStatusReply_HEALTHY)
+	assert.Equal(t, sonm.TaskStatusReply_UNHEALTHY, <-ch.ch)
+
+	ch.Close(sonm.TaskStatusReply_FINISHED)
+	// Updates after closing are dropped.
+	ch.Send(context.Background(), sonm.TaskStatusReply_HEALTHY)
+	ch.Close(sonm.TaskStatusReply_BROKEN)
+
+	assert.Equal(t, sonm.TaskStatusReply_FINISHED, <-ch.ch)
+	_, ok := <-ch.ch
+	assert.False(t, ok)
+}
+
 func TestMarshalDescription(t *testing.T) {
 	ref, err := xdocker.NewReference("docker.io/sonm-io/tests:latest")
 	require.NoError(t, err)
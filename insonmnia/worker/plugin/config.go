@@ -16,7 +16,8 @@ type VolumesConfig struct {
 
 type OverlayConfig struct {
 	Drivers struct {
-		Tinc *network.TincNetworkConfig `yaml:"tinc"`
-		L2TP *network.L2TPConfig        `yaml:"l2tp"`
+		Tinc      *network.TincNetworkConfig `yaml:"tinc"`
+		L2TP      *network.L2TPConfig        `yaml:"l2tp"`
+		WireGuard *network.WireGuardConfig   `yaml:"wireguard"`
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
 
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/network"
@@ -23,6 +24,7 @@ const (
 	bridgeNetwork = "bridge"
 	tincNetwork   = "tinc"
 	l2tpNetwork   = "l2tp"
+	wgNetwork     = "wireguard"
 )
 
 // Provider unifies all possible providers for tuning.
@@ -42,8 +44,13 @@ type GPUProvider interface {
 
 // VolumeProvider describes an interface for applying volumes to the container.
 type VolumeProvider interface {
-	// ID returns a unique identifier that will be used as a new volume name.
-	ID() string
+	// VolumeID returns a unique identifier of the given volume that will be
+	// used as a new volume name.
+	VolumeID(name string) string
+	// IsVolumeOwner returns true if the provider is responsible for removing
+	// the given volume. Volumes shared between several containers are owned
+	// by only one of them.
+	IsVolumeOwner(name string) bool
 	// Volumes returns volumes specified for configuring.
 	Volumes() map[string]*sonm.Volume
 	// Mounts returns all mounts whose source equals to the volume name
@@ -71,6 +78,8 @@ type Repository struct {
 	gpuTuners         map[sonm.GPUVendorType]gpu.Tuner
 	networkTuners     map[string]minet.Tuner
 	storageQuotaTuner storage.StorageQuotaTuner
+	// storageQuotaStatus describes whether storage quotas are enforced.
+	storageQuotaStatus string
 }
 
 // NewRepository constructs a new repository for SONM plugins from the
@@ -85,14 +94,53 @@ func NewRepository(ctx context.Context, cfg Config) (*Repository, error) {
 
 	log.G(ctx).Info("initializing SONM plugins")
 
+	if storage.PlatformSupportsQuota {
+		// NOTE: not sure it's safe to do it here. Please, suggest better place
+		docker, err := client.NewEnvClient()
+		if err != nil {
+			return nil, err
+		}
+		defer docker.Close()
+
+		info, err := docker.Info(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		log.G(ctx).Debug("fetched Docker info", zap.Any("info", info))
+
+		r.storageQuotaTuner, err = storage.NewQuotaTuner(info)
+		switch err.(type) {
+		case nil:
+			r.storageQuotaStatus = fmt.Sprintf("enforced by %s driver", info.Driver)
+		case storage.ErrDriverNotSupported:
+			log.G(ctx).Warn("storage quota is not supported by current Docker driver, it won't be enforced",
+				zap.String("driver", info.Driver), zap.Error(err))
+			r.storageQuotaStatus = fmt.Sprintf("not enforced: %v", err)
+		default:
+			return nil, err
+		}
+	}
+
+	// Volumes are counted against storage quotas together with writable
+	// layers of containers, when they are on the same filesystem.
+	var volumeOptions []volume.Option
+	if tuner, ok := r.storageQuotaTuner.(storage.DirQuotaTuner); ok && tuner.SupportsDirQuota(cfg.Volumes.Root) {
+		volumeOptions = append(volumeOptions, volume.WithDirQuota(tuner.SetDirQuota))
+	} else if r.storageQuotaTuner != nil {
+		log.G(ctx).Warn("storage quota is not enforced on volumes, it is only checked when tasks start",
+			zap.String("root", cfg.Volumes.Root))
+	}
+
 	for ty, options := range cfg.Volumes.Drivers {
 		log.G(ctx).Debug("initializing Volume plugin", zap.String("type", ty))
 
-		driver, err := volume.NewVolumeDriver(ctx, ty,
+		driver, err := volume.NewVolumeDriver(ctx, ty, append(volumeOptions,
 			volume.WithPluginSocketDir(cfg.SocketDir),
+			volume.WithRootDir(cfg.Volumes.Root),
 			volume.WithOptions(options),
 			volume.WithLogger(log.S(ctx)),
-		)
+		)...)
 
 		if err != nil {
 			return nil, fmt.Errorf("cannot initialize volume plugin \"%s\": %v", ty, err)
@@ -134,30 +182,12 @@ func NewRepository(ctx context.Context, cfg Config) (*Repository, error) {
 		r.networkTuners[l2tpNetwork] = l2tpTuner
 	}
 
-	if storage.PlatformSupportsQuota {
-		// NOTE: not sure it's safe to do it here. Please, suggest better place
-		docker, err := client.NewEnvClient()
-		if err != nil {
-			return nil, nil
-		}
-		defer docker.Close()
-
-		info, err := docker.Info(ctx)
+	if cfg.Overlay.Drivers.WireGuard != nil {
+		wgTuner, err := minet.NewWireGuardTuner(ctx, cfg.Overlay.Drivers.WireGuard)
 		if err != nil {
-			return nil, err
-		}
-
-		log.G(ctx).Debug("fetched Docker info", zap.Any("info", info))
-
-		r.storageQuotaTuner, err = storage.NewQuotaTuner(info)
-		switch err.(type) {
-		case nil:
-			// pass
-		case storage.ErrDriverNotSupported:
-			log.G(ctx).Warn("storage quota is not supported by current Docker driver", zap.String("driver", info.Driver))
-		default:
-			return nil, err
+			return nil, fmt.Errorf("failed to initialize wireguard tuner - %v", err)
 		}
+		r.networkTuners[wgNetwork] = wgTuner
 	}
 
 	return r, nil
@@ -166,19 +196,20 @@ func NewRepository(ctx context.Context, cfg Config) (*Repository, error) {
 // EmptyRepository constructs an empty repository. Used primarily in tests.
 func EmptyRepository() *Repository {
 	return &Repository{
-		volumes:       make(map[string]volume.VolumeDriver),
-		gpuTuners:     make(map[sonm.GPUVendorType]gpu.Tuner),
-		networkTuners: make(map[string]minet.Tuner),
+		volumes:            make(map[string]volume.VolumeDriver),
+		gpuTuners:          make(map[sonm.GPUVendorType]gpu.Tuner),
+		networkTuners:      make(map[string]minet.Tuner),
+		storageQuotaStatus: "not enforced: not supported by the platform",
 	}
 }
 
 // Tune creates all plugin bound required for the given provider with further host config tuning.
-func (r *Repository) Tune(ctx context.Context, provider Provider, hostCfg *container.HostConfig, netCfg *network.NetworkingConfig) (Cleanup, error) {
+func (r *Repository) Tune(ctx context.Context, provider Provider, cfg *container.Config, hostCfg *container.HostConfig, netCfg *network.NetworkingConfig) (Cleanup, error) {
 	log.G(ctx).Info("tuning container")
 	// Do not specify GPU type right now,
 	// just check that GPU is required
 	if provider.IsGPURequired() {
-		if err := r.TuneGPU(provider, hostCfg); err != nil {
+		if err := r.TuneGPU(provider, cfg, hostCfg); err != nil {
 			return nil, err
 		}
 	}
@@ -228,10 +259,10 @@ func (r *Repository) ApplyHardwareInfo(hw *hardware.Hardware) {
 }
 
 // TuneGPU creates GPU bound required for the given provider with further
-// host config tuning.
-func (r *Repository) TuneGPU(provider GPUProvider, cfg *container.HostConfig) error {
+// container and host config tuning.
+func (r *Repository) TuneGPU(provider GPUProvider, cfg *container.Config, hostCfg *container.HostConfig) error {
 	for _, tuner := range r.gpuTuners {
-		err := tuner.Tune(cfg, provider.GpuDeviceIDs())
+		err := tuner.Tune(cfg, hostCfg, provider.GpuDeviceIDs())
 		if err != nil {
 			return err
 		}
@@ -246,9 +277,18 @@ func (r *Repository) TuneVolumes(ctx context.Context, provider VolumeProvider, c
 	cleanup := newNestedCleanup()
 
 	for volumeName, options := range provider.Volumes() {
+		if options.Options == nil {
+			options.Options = map[string]string{}
+		}
+
 		networkName, networkID := provider.Network()
 		options.Options[volume.OptionNetworkName] = networkName
 		options.Options[volume.OptionNetworkID] = networkID
+		options.Options[volume.OptionDealID] = provider.DealID()
+		options.Options[volume.OptionVolumeName] = volumeName
+		if quota, ok := provider.(StorageQuotaProvider); ok {
+			options.Options[volume.OptionStorageQuota] = strconv.FormatUint(quota.QuotaInBytes(), 10)
+		}
 
 		mounts := provider.Mounts(volumeName)
 		// No mounts - no volumes.
@@ -262,9 +302,9 @@ func (r *Repository) TuneVolumes(ctx context.Context, provider VolumeProvider, c
 			return nil, fmt.Errorf("volume driver not supported: %s", options.Type)
 		}
 
-		id := fmt.Sprintf("%s/%s", provider.ID(), volumeName)
+		id := provider.VolumeID(volumeName)
 
-		v, err := driver.CreateVolume(id, options.Options)
+		v, err := driver.CreateVolume(ctx, id, options.Options)
 		if err != nil {
 			cleanup.Close()
 			return nil, err
@@ -288,16 +328,64 @@ func (r *Repository) TuneVolumes(ctx context.Context, provider VolumeProvider, c
 			}
 		}
 
-		cleanup.Add(&volumeCleanup{driver: driver, id: id})
+		if provider.IsVolumeOwner(volumeName) {
+			cleanup.Add(&volumeCleanup{driver: driver, id: id})
+		}
 	}
 
 	for _, mount := range cfg.Mounts {
-		mount.VolumeOptions.Labels["DealID"] = provider.DealID()
+		// Bind mounts have no volume options.
+		if mount.VolumeOptions != nil {
+			mount.VolumeOptions.Labels["DealID"] = provider.DealID()
+		}
 	}
 
 	return cleanup, nil
 }
 
+// DealVolumes returns volumes of the given deal that outlive its tasks.
+func (r *Repository) DealVolumes(dealID string) ([]volume.DealVolume, error) {
+	var volumes []volume.DealVolume
+
+	for _, driver := range r.volumes {
+		driver, ok := driver.(volume.DealVolumeDriver)
+		if !ok {
+			continue
+		}
+
+		v, err := driver.DealVolumes(dealID)
+		if err != nil {
+			return nil, err
+		}
+
+		volumes = append(volumes, v...)
+	}
+
+	return volumes, nil
+}
+
+// RemoveDealVolumes destroys volumes of the given deal that outlive its
+// tasks. Must be called when the deal is closed.
+func (r *Repository) RemoveDealVolumes(dealID string) error {
+	errs := make([]error, 0)
+	for ty, driver := range r.volumes {
+		driver, ok := driver.(volume.DealVolumeDriver)
+		if !ok {
+			continue
+		}
+
+		if err := driver.RemoveDealVolumes(dealID); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s", ty, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to remove volumes of deal %s: %v", dealID, errs)
+	}
+
+	return nil
+}
+
 func (r *Repository) GetCleanup(ctx context.Context, provider Provider) (Cleanup, error) {
 	cleanup := newNestedCleanup()
 
@@ -318,6 +406,37 @@ func (r *Repository) GetCleanup(ctx context.Context, provider Provider) (Cleanup
 	return cleanup, nil
 }
 
+// GetStaleCleanup returns the cleanup of plugin resources, that may have
+// been partially set up for the container, which has never been recorded,
+// e.g. because the worker was stopped while starting it. Networks that have
+// not been created yet are skipped.
+func (r *Repository) GetStaleCleanup(ctx context.Context, provider Provider) (Cleanup, error) {
+	cleanup := newNestedCleanup()
+
+	for _, net := range provider.Networks() {
+		tuner, ok := r.networkTuners[net.Type]
+		if !ok || !tuner.Tuned(net.NetID) {
+			continue
+		}
+
+		c, err := tuner.GetCleaner(ctx, net.NetID)
+		if err != nil {
+			cleanup.Close()
+			return nil, err
+		}
+		cleanup.Add(c)
+	}
+
+	c, err := r.GetVolumeCleaner(ctx, provider)
+	if err != nil {
+		cleanup.Close()
+		return nil, err
+	}
+	cleanup.Add(c)
+
+	return cleanup, nil
+}
+
 func (r *Repository) GetVolumeCleaner(ctx context.Context, provider VolumeProvider) (Cleanup, error) {
 	cleanup := newNestedCleanup()
 
@@ -334,9 +453,9 @@ func (r *Repository) GetVolumeCleaner(ctx context.Context, provider VolumeProvid
 			return nil, fmt.Errorf("volume driver not supported: %s", options.Type)
 		}
 
-		id := fmt.Sprintf("%s/%s", provider.ID(), volumeName)
-
-		cleanup.Add(&volumeCleanup{driver: driver, id: id})
+		if provider.IsVolumeOwner(volumeName) {
+			cleanup.Add(&volumeCleanup{driver: driver, id: provider.VolumeID(volumeName)})
+		}
 	}
 
 	return cleanup, nil
@@ -357,6 +476,12 @@ func (r *Repository) PostCreationTune(ctx context.Context, provider Provider, cl
 	return nCleanup, nil
 }
 
+// StorageQuotaEnforced returns true if storage quotas of tasks are enforced
+// along with the human-readable description of the reason.
+func (r *Repository) StorageQuotaEnforced() (bool, string) {
+	return r.storageQuotaTuner != nil, r.storageQuotaStatus
+}
+
 func (r *Repository) TuneStorageQuota(ctx context.Context, provider StorageQuotaProvider, ID string) (Cleanup, error) {
 	return r.storageQuotaTuner.SetQuota(ctx, ID, provider.QuotaID(), provider.QuotaInBytes())
 }
@@ -0,0 +1,110 @@
+package worker
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"net"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sonm-io/core/insonmnia/npp"
+	"github.com/sonm-io/core/insonmnia/portforward"
+	"github.com/sonm-io/core/util"
+	"github.com/sonm-io/core/util/xgrpc"
+	"go.uber.org/zap"
+)
+
+// PortForwardingConfig describes publishing of ports exposed by tasks
+// through NPP, which makes them reachable even when the worker has no
+// public IP addresses.
+type PortForwardingConfig struct {
+	// Endpoint is the address for direct connections.
+	Endpoint string     `yaml:"endpoint" default:":0"`
+	NPP      npp.Config `yaml:"npp"`
+}
+
+// derivePortForwardingKey returns the key of the worker's port forwarding
+// service.
+//
+// The service needs its own ETH address, because NPP relay servers can not
+// distinguish between several services published under the same address.
+func derivePortForwardingKey(key *ecdsa.PrivateKey) (*ecdsa.PrivateKey, error) {
+	return crypto.ToECDSA(crypto.Keccak256(crypto.FromECDSA(key), []byte("port-forwarding")))
+}
+
+// forwardTarget returns the address a port binding is reachable at from the
+// worker itself.
+func forwardTarget(binding nat.PortBinding) string {
+	host := binding.HostIP
+	switch host {
+	case "", "0.0.0.0":
+		host = "127.0.0.1"
+	case "::":
+		host = "::1"
+	}
+
+	return net.JoinHostPort(host, binding.HostPort)
+}
+
+type portForwarder struct {
+	cfg         PortForwardingConfig
+	key         *ecdsa.PrivateKey
+	certRotator util.HitlessCertRotator
+	credentials *xgrpc.TransportCredentials
+	server      *portforward.Server
+	log         *zap.SugaredLogger
+}
+
+func newPortForwarder(ctx context.Context, cfg PortForwardingConfig, key *ecdsa.PrivateKey, resolve portforward.Resolver, log *zap.SugaredLogger) (*portForwarder, error) {
+	forwardingKey, err := derivePortForwardingKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	certRotator, TLSConfig, err := util.NewHitlessCertRotator(ctx, forwardingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	log = log.With(zap.String("source", "port_forwarding"))
+	credentials := xgrpc.NewTransportCredentials(TLSConfig)
+
+	return &portForwarder{
+		cfg:         cfg,
+		key:         forwardingKey,
+		certRotator: certRotator,
+		credentials: credentials,
+		server:      portforward.NewServer(credentials, resolve, log),
+		log:         log,
+	}, nil
+}
+
+// Addr returns the ETH address the service is published under.
+func (m *portForwarder) Addr() common.Address {
+	return crypto.PubkeyToAddress(m.key.PublicKey)
+}
+
+func (m *portForwarder) Run(ctx context.Context) error {
+	m.log.Infof("running port forwarding server as %s", m.Addr().Hex())
+	defer m.log.Info("stopped port forwarding server")
+
+	listener, err := npp.NewListener(ctx, m.cfg.Endpoint,
+		npp.WithNPPBacklog(m.cfg.NPP.Backlog),
+		npp.WithNPPBackoff(m.cfg.NPP.MinBackoffInterval, m.cfg.NPP.MaxBackoffInterval),
+		npp.WithRendezvous(m.cfg.NPP.Rendezvous, m.credentials),
+		npp.WithRelay(m.cfg.NPP.Relay, m.key),
+		npp.WithLogger(m.log.Desugar()),
+	)
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+
+	return m.server.Serve(listener)
+}
+
+func (m *portForwarder) Close() error {
+	m.certRotator.Close()
+	return nil
+}
@@ -0,0 +1,206 @@
+package salesman
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util/multierror"
+)
+
+const drainKey = "drain"
+
+var errDraining = errors.New("worker is drained")
+
+// drainState is kept in the storage, so the worker remains drained after
+// restarts.
+type drainState struct {
+	// Since is the time the drain has been requested.
+	Since time.Time
+	// Notices contains times tasks of spot deals have been notified about
+	// the drain by deal IDs.
+	Notices map[string]time.Time
+}
+
+func newDrainState(now time.Time) *drainState {
+	return &drainState{
+		Since:   now,
+		Notices: map[string]time.Time{},
+	}
+}
+
+// noticeExpired reports whether the notice period of the deal is over,
+// returning false for deals that have not been notified yet.
+func (m *drainState) noticeExpired(dealID string, period time.Duration, now time.Time) bool {
+	noticeTime, ok := m.Notices[dealID]
+	if !ok {
+		return false
+	}
+
+	return now.Sub(noticeTime) >= period
+}
+
+// Drain makes the worker finish its deals without taking new ones. Active
+// orders are cancelled immediately, while orders that fail to be cancelled
+// are retried on the next sync.
+func (m *Salesman) Drain(ctx context.Context) error {
+	m.mu.Lock()
+	if m.drain == nil {
+		m.drain = newDrainState(time.Now())
+		if err := m.storage.Save(drainKey, m.drain); err != nil {
+			m.drain = nil
+			m.mu.Unlock()
+			return err
+		}
+
+		m.log.Info("draining worker")
+	}
+	m.mu.Unlock()
+
+	multi := multierror.NewMultiError()
+	for _, plan := range m.AskPlans() {
+		if plan.GetDealID().IsZero() && !plan.GetOrderID().IsZero() {
+			if err := m.withdrawOrder(ctx, plan); err != nil {
+				multi = multierror.Append(multi, err)
+			}
+		}
+	}
+
+	return multi.ErrorOrNil()
+}
+
+// Undrain makes the worker take new deals again.
+func (m *Salesman) Undrain() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.drain == nil {
+		return nil
+	}
+
+	if _, err := m.storage.Remove(drainKey); err != nil {
+		return err
+	}
+
+	m.drain = nil
+	m.log.Info("worker is no longer drained")
+	return nil
+}
+
+// DrainStatus returns the drain progress, nil if the worker is not drained.
+func (m *Salesman) DrainStatus() *sonm.DrainStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.drain == nil {
+		return nil
+	}
+
+	status := &sonm.DrainStatus{
+		Since: sonm.NewTimestamp(m.drain.Since),
+	}
+
+	for _, plan := range m.askPlans {
+		switch {
+		case !plan.GetDealID().IsZero():
+			deal, ok := m.deals[plan.GetDealID().Unwrap().String()]
+			if ok && !deal.IsSpot() {
+				status.ForwardDeals++
+			} else {
+				status.SpotDeals++
+			}
+		case !plan.GetOrderID().IsZero():
+			status.Orders++
+		}
+	}
+
+	status.Done = status.Orders == 0 && status.ForwardDeals == 0 && status.SpotDeals == 0
+	return status
+}
+
+// draining returns errDraining when the worker is drained.
+func (m *Salesman) draining() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.drain != nil {
+		return errDraining
+	}
+
+	return nil
+}
+
+// drainDeal notifies tasks of the spot deal about the drain once, returning
+// true when the notice period is over and the deal should be closed.
+func (m *Salesman) drainDeal(ctx context.Context, deal *sonm.Deal) bool {
+	dealID := deal.GetId().Unwrap().String()
+
+	m.mu.Lock()
+	if m.drain == nil {
+		m.mu.Unlock()
+		return false
+	}
+	if _, ok := m.drain.Notices[dealID]; ok {
+		expired := m.drain.noticeExpired(dealID, m.config.DrainNoticePeriod, time.Now())
+		m.mu.Unlock()
+		return expired
+	}
+	m.mu.Unlock()
+
+	// Tasks that fail to be notified are still given the notice period, they
+	// are killed when the deal is closed anyway.
+	if err := m.dealDestroyer.NotifyDealTasks(ctx, deal.GetId()); err != nil {
+		m.log.Warnf("failed to notify tasks of deal %s about drain: %s", dealID, err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.drain == nil {
+		return false
+	}
+
+	m.drain.Notices[dealID] = time.Now()
+	if err := m.storage.Save(drainKey, m.drain); err != nil {
+		m.log.Warnf("failed to save drain state: %s", err)
+	}
+
+	m.log.Infof("notified tasks of deal %s, closing it in %s", dealID, m.config.DrainNoticePeriod)
+	return false
+}
+
+// forgetDrainNotice removes the notice of the closed deal. Must be called
+// with the lock held.
+func (m *Salesman) forgetDrainNotice(dealID string) {
+	if m.drain == nil {
+		return
+	}
+	if _, ok := m.drain.Notices[dealID]; !ok {
+		return
+	}
+
+	delete(m.drain.Notices, dealID)
+	if err := m.storage.Save(drainKey, m.drain); err != nil {
+		m.log.Warnf("failed to save drain state: %s", err)
+	}
+}
+
+func (m *Salesman) restoreDrain() error {
+	var drain drainState
+	ok, err := m.storage.Load(drainKey, &drain)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	if drain.Notices == nil {
+		drain.Notices = map[string]time.Time{}
+	}
+
+	m.drain = &drain
+	m.log.Infof("worker is drained since %s", drain.Since.String())
+	return nil
+}
@@ -0,0 +1,20 @@
+package salesman
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrainNoticeExpired(t *testing.T) {
+	now := time.Date(2019, time.March, 5, 10, 0, 0, 0, time.UTC)
+	drain := newDrainState(now)
+
+	assert.False(t, drain.noticeExpired("42", time.Minute, now))
+
+	drain.Notices["42"] = now
+	assert.False(t, drain.noticeExpired("42", time.Minute, now.Add(30*time.Second)))
+	assert.True(t, drain.noticeExpired("42", time.Minute, now.Add(time.Minute)))
+	assert.True(t, drain.noticeExpired("42", 0, now))
+}
@@ -0,0 +1,254 @@
+package salesman
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/sonm-io/core/proto"
+)
+
+const (
+	maintenanceKey = "maintenance_windows"
+	// legacyMaintenanceKey stores the single maintenance time point of
+	// previous versions.
+	legacyMaintenanceKey = "next_maintenance"
+	// legacyMaintenanceID is the ID of the window scheduled using the
+	// deprecated ScheduleMaintenance, which replaces the previous one.
+	legacyMaintenanceID = "legacy"
+)
+
+// maintenanceOccurrence is the active or upcoming occurrence of one of the
+// maintenance windows.
+type maintenanceOccurrence struct {
+	start time.Time
+	// end is zero for windows lasting until cancelled.
+	end time.Time
+}
+
+func (m maintenanceOccurrence) Active(now time.Time) bool {
+	return !now.Before(m.start) && (m.end.IsZero() || now.Before(m.end))
+}
+
+// Overlaps reports whether the period starting at the given time overlaps
+// the occurrence, including the gap required to prepare for it.
+func (m maintenanceOccurrence) Overlaps(from time.Time, duration time.Duration) bool {
+	if !m.end.IsZero() && !from.Before(m.end) {
+		return false
+	}
+
+	return !from.Add(duration).Before(m.start.Add(-maintenanceGap))
+}
+
+// maintenanceOccurrences returns occurrences of windows that are either
+// active at the given time or upcoming, skipping windows that are over.
+func maintenanceOccurrences(windows map[string]*sonm.MaintenanceWindow, now time.Time) []maintenanceOccurrence {
+	var occurrences []maintenanceOccurrence
+	for _, window := range windows {
+		if start, end, ok := window.Occurrence(now); ok {
+			occurrences = append(occurrences, maintenanceOccurrence{start: start, end: end})
+		}
+	}
+
+	return occurrences
+}
+
+// ScheduleMaintenance schedules maintenance lasting until cancelled, replacing
+// the one scheduled previously this way.
+//
+// Deprecated: use AddMaintenance instead.
+func (m *Salesman) ScheduleMaintenance(timePoint time.Time) error {
+	m.log.Infof("Scheduling next maintenance at %s", timePoint.String())
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.maintenance[legacyMaintenanceID] = &sonm.MaintenanceWindow{
+		ID:    legacyMaintenanceID,
+		Start: sonm.NewTimestamp(timePoint),
+	}
+
+	return m.storage.Save(maintenanceKey, m.maintenance)
+}
+
+// AddMaintenance queues the maintenance window, returning its ID.
+func (m *Salesman) AddMaintenance(window *sonm.MaintenanceWindow) (string, error) {
+	if err := window.Validate(); err != nil {
+		return "", err
+	}
+
+	window = &sonm.MaintenanceWindow{
+		ID:       uuid.New(),
+		Start:    window.GetStart(),
+		Schedule: window.GetSchedule(),
+		Duration: window.GetDuration(),
+	}
+
+	if _, _, ok := window.Occurrence(time.Now()); !ok {
+		return "", errors.New("maintenance window is already over")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.maintenance[window.ID] = window
+	if err := m.storage.Save(maintenanceKey, m.maintenance); err != nil {
+		delete(m.maintenance, window.ID)
+		return "", err
+	}
+
+	m.log.Infof("added maintenance window %s", window.ID)
+	return window.ID, nil
+}
+
+// CancelMaintenance removes the maintenance window.
+func (m *Salesman) CancelMaintenance(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	window, ok := m.maintenance[id]
+	if !ok {
+		return fmt.Errorf("maintenance window %s does not exist", id)
+	}
+
+	delete(m.maintenance, id)
+	if err := m.storage.Save(maintenanceKey, m.maintenance); err != nil {
+		m.maintenance[id] = window
+		return err
+	}
+
+	m.log.Infof("cancelled maintenance window %s", id)
+	return nil
+}
+
+// MaintenanceWindows returns queued maintenance windows with their current
+// or upcoming occurrences filled.
+func (m *Salesman) MaintenanceWindows() []*sonm.MaintenanceWindow {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	windows := make([]*sonm.MaintenanceWindow, 0, len(m.maintenance))
+	for _, window := range m.maintenance {
+		start, end, ok := window.Occurrence(now)
+		if !ok {
+			continue
+		}
+
+		reply := *window
+		reply.NextStart = sonm.NewTimestamp(start)
+		if !end.IsZero() {
+			reply.NextEnd = sonm.NewTimestamp(end)
+		}
+
+		windows = append(windows, &reply)
+	}
+
+	return windows
+}
+
+// NextMaintenance returns the beginning of the earliest active or upcoming
+// maintenance, so the worker is under maintenance when it is in the past.
+func (m *Salesman) NextMaintenance() time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	next := now.Add(defaultMaintenancePeriod)
+	for _, occurrence := range maintenanceOccurrences(m.maintenance, now) {
+		if occurrence.start.Before(next) {
+			next = occurrence.start
+		}
+	}
+
+	return next
+}
+
+// underMaintenance reports whether any maintenance window is active now.
+func (m *Salesman) underMaintenance() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	for _, occurrence := range maintenanceOccurrences(m.maintenance, now) {
+		if occurrence.Active(now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkMaintenance returns an error when an order of the given duration
+// placed now would overlap any maintenance window. Spot orders have zero
+// duration.
+func (m *Salesman) checkMaintenance(duration time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	for _, occurrence := range maintenanceOccurrences(m.maintenance, now) {
+		if occurrence.Overlaps(now, duration) {
+			return fmt.Errorf("maintenance is scheduled at %s", occurrence.start.String())
+		}
+	}
+
+	return nil
+}
+
+// pruneMaintenance removes windows that are over.
+func (m *Salesman) pruneMaintenance() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	pruned := false
+	for id, window := range m.maintenance {
+		if _, _, ok := window.Occurrence(now); !ok {
+			m.log.Infof("maintenance window %s is over", id)
+			delete(m.maintenance, id)
+			pruned = true
+		}
+	}
+
+	if pruned {
+		if err := m.storage.Save(maintenanceKey, m.maintenance); err != nil {
+			m.log.Warnf("failed to save maintenance windows: %s", err)
+		}
+	}
+}
+
+// restoreMaintenance loads maintenance windows, converting the maintenance
+// scheduled by previous versions. Maintenance in the past is considered
+// done, because otherwise it would last forever.
+func (m *Salesman) restoreMaintenance() error {
+	if _, err := m.storage.Load(maintenanceKey, &m.maintenance); err != nil {
+		return fmt.Errorf("failed to load maintenance windows: %s", err)
+	}
+
+	var legacy time.Time
+	ok, err := m.storage.Load(legacyMaintenanceKey, &legacy)
+	if err != nil {
+		return fmt.Errorf("failed to load next maintenance: %s", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	if legacy.After(time.Now()) {
+		m.log.Infof("converting maintenance scheduled at %s", legacy.String())
+		m.maintenance[legacyMaintenanceID] = &sonm.MaintenanceWindow{
+			ID:    legacyMaintenanceID,
+			Start: sonm.NewTimestamp(legacy),
+		}
+		if err := m.storage.Save(maintenanceKey, m.maintenance); err != nil {
+			return fmt.Errorf("failed to save maintenance windows: %s", err)
+		}
+	}
+
+	if _, err := m.storage.Remove(legacyMaintenanceKey); err != nil {
+		return fmt.Errorf("failed to remove next maintenance: %s", err)
+	}
+
+	return nil
+}
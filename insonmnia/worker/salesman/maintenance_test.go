@@ -0,0 +1,55 @@
+package salesman
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceOccurrence(t *testing.T) {
+	now := time.Date(2019, time.March, 5, 10, 0, 0, 0, time.UTC)
+	occurrence := maintenanceOccurrence{start: now.Add(time.Hour), end: now.Add(2 * time.Hour)}
+
+	assert.False(t, occurrence.Active(now))
+	assert.True(t, occurrence.Active(now.Add(time.Hour)))
+	assert.False(t, occurrence.Active(now.Add(2*time.Hour)))
+
+	// Spot orders are not placed slightly before the maintenance.
+	assert.False(t, occurrence.Overlaps(now, 0))
+	assert.True(t, occurrence.Overlaps(now.Add(time.Hour-maintenanceGap), 0))
+	assert.True(t, occurrence.Overlaps(now.Add(90*time.Minute), 0))
+	assert.False(t, occurrence.Overlaps(now.Add(2*time.Hour), 0))
+
+	assert.False(t, occurrence.Overlaps(now, 30*time.Minute))
+	assert.True(t, occurrence.Overlaps(now, 24*time.Hour))
+
+	// Maintenance lasting until cancelled.
+	occurrence.end = time.Time{}
+	assert.True(t, occurrence.Active(now.Add(24*time.Hour)))
+	assert.True(t, occurrence.Overlaps(now.Add(24*time.Hour), 0))
+}
+
+func TestMaintenanceOccurrences(t *testing.T) {
+	now := time.Date(2019, time.March, 5, 10, 0, 0, 0, time.UTC)
+	hour := &sonm.Duration{Nanoseconds: int64(time.Hour)}
+
+	windows := map[string]*sonm.MaintenanceWindow{
+		"over":      {Start: sonm.NewTimestamp(now.Add(-2 * time.Hour)), Duration: hour},
+		"active":    {Start: sonm.NewTimestamp(now.Add(-30 * time.Minute)), Duration: hour},
+		"recurring": {Schedule: "0 3 * * *", Duration: hour},
+	}
+
+	occurrences := maintenanceOccurrences(windows, now)
+	require.Len(t, occurrences, 2)
+
+	starts := map[time.Time]bool{}
+	for _, occurrence := range occurrences {
+		starts[occurrence.start] = true
+	}
+
+	assert.True(t, starts[now.Add(-30*time.Minute)])
+	assert.True(t, starts[time.Date(2019, time.March, 6, 3, 0, 0, 0, time.UTC)])
+}
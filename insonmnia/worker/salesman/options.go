@@ -19,6 +19,18 @@ import (
 
 type DealDestroyer interface {
 	CancelDealTasks(ctx context.Context, dealID *sonm.BigInt) error
+	// RemoveDealVolumes destroys volumes that persist between tasks of the
+	// deal.
+	RemoveDealVolumes(ctx context.Context, dealID *sonm.BigInt) error
+	// NotifyDealTasks asks tasks of the deal to finish gracefully, because
+	// the deal is going to be closed.
+	NotifyDealTasks(ctx context.Context, dealID *sonm.BigInt) error
+}
+
+// GPUHealth reports faulty GPUs.
+type GPUHealth interface {
+	// Faults returns fault reasons by IDs of faulty physical devices.
+	Faults() map[string]string
 }
 
 type options struct {
@@ -33,6 +45,8 @@ type options struct {
 	config        *YAMLConfig
 	networkConfig network.NetworkConfig
 	dealDestroyer DealDestroyer
+	gpuHealth     GPUHealth
+	dwh           sonm.DWHClient
 }
 
 func WithLogger(log *zap.SugaredLogger) Option {
@@ -93,6 +107,21 @@ func WithDealDestroyer(destroyer DealDestroyer) Option {
 		opts.dealDestroyer = destroyer
 	}
 }
+
+func WithGPUHealth(health GPUHealth) Option {
+	return func(opts *options) {
+		opts.gpuHealth = health
+	}
+}
+
+// WithDWH specifies DWH used to look up competing orders when repricing ask
+// plans, optional.
+func WithDWH(dwh sonm.DWHClient) Option {
+	return func(opts *options) {
+		opts.dwh = dwh
+	}
+}
+
 func (m *options) Validate() error {
 	err := multierror.NewMultiError()
 
@@ -0,0 +1,165 @@
+package salesman
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sonm-io/core/proto"
+)
+
+const (
+	// maxPriceHistory is the number of recent price changes kept per plan.
+	maxPriceHistory = 100
+	// competingAsksLimit limits the number of asks fetched from DWH when
+	// looking for the best competing one, as some of them may be ours.
+	competingAsksLimit = 100
+)
+
+// appendPriceChange appends the change to the plan's price history, keeping
+// only the most recent ones.
+func appendPriceChange(history []*sonm.AskPlanPriceChange, change *sonm.AskPlanPriceChange) []*sonm.AskPlanPriceChange {
+	history = append(history, change)
+	if len(history) > maxPriceHistory {
+		history = history[len(history)-maxPriceHistory:]
+	}
+
+	return history
+}
+
+// initRepricing makes plans with repricing rules use the price they are
+// created with as the base one, unless specified explicitly.
+func initRepricing(plan *sonm.AskPlan) {
+	if plan.GetRepricing() != nil && plan.GetRepricing().GetBase().GetPerSecond().IsZero() {
+		plan.Repricing.Base = &sonm.Price{PerSecond: plan.GetPrice().GetPerSecond()}
+	}
+}
+
+// AskPlanHistory returns price changes of the plan.
+func (m *Salesman) AskPlanHistory(planID string) ([]*sonm.AskPlanPriceChange, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.askPlans[planID]; !ok {
+		return nil, errors.New("specified ask-plan does not exist")
+	}
+
+	return append([]*sonm.AskPlanPriceChange{}, m.priceHistory[planID]...), nil
+}
+
+// dropPriceHistory removes the price history of the plan. Must be called with
+// the lock held.
+func (m *Salesman) dropPriceHistory(planID string) {
+	if _, ok := m.priceHistory[planID]; !ok {
+		return
+	}
+
+	delete(m.priceHistory, planID)
+	if err := m.historyStorage.Save(m.priceHistory); err != nil {
+		m.log.Warnf("failed to save price history: %s", err)
+	}
+}
+
+// maybeReprice withdraws the active order of the plan when its target price
+// moves past the threshold. The order is placed again with the new price on
+// the next sync.
+func (m *Salesman) maybeReprice(ctx context.Context, plan *sonm.AskPlan, order *sonm.Order) error {
+	repricing := plan.GetRepricing()
+	if repricing == nil {
+		return nil
+	}
+
+	var market *big.Int
+	if repricing.GetMarket() != nil {
+		// Falling back to the base price when DWH is unavailable would make
+		// the price jump back and forth, so repricing is postponed instead.
+		price, err := m.bestCompetingAsk(ctx, order)
+		if err != nil {
+			return fmt.Errorf("could not get best competing ask: %v", err)
+		}
+		market = price
+	}
+
+	current := plan.GetPrice().GetPerSecond().Unwrap()
+	target, reason := repricing.TargetPrice(market, time.Now())
+	if !repricing.ExceedsThreshold(current, target) {
+		return nil
+	}
+
+	if err := m.cancelOrder(ctx, plan.GetOrderID()); err != nil {
+		return err
+	}
+
+	return m.setPrice(plan.ID, target, reason)
+}
+
+// setPrice changes the price of the plan, dropping its cancelled order.
+func (m *Salesman) setPrice(planID string, price *big.Int, reason string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	plan, ok := m.askPlans[planID]
+	if !ok {
+		return fmt.Errorf("failed to fetch ask plan by id %s", planID)
+	}
+
+	change := &sonm.AskPlanPriceChange{
+		Time:     sonm.CurrentTimestamp(),
+		OldPrice: plan.GetPrice(),
+		NewPrice: &sonm.Price{PerSecond: sonm.NewBigInt(price)},
+		Reason:   reason,
+	}
+
+	plan.Price = change.NewPrice
+	plan.OrderID = nil
+	if err := m.askPlanStorage.Save(m.askPlans); err != nil {
+		return err
+	}
+
+	m.priceHistory[planID] = appendPriceChange(m.priceHistory[planID], change)
+	if err := m.historyStorage.Save(m.priceHistory); err != nil {
+		m.log.Warnf("failed to save price history: %s", err)
+	}
+
+	m.log.Infof("repriced plan %s from %s to %s USD/h: %s", planID,
+		change.GetOldPrice().GetPerSecond().PricePerHour(), change.GetNewPrice().GetPerSecond().PricePerHour(), reason)
+	return nil
+}
+
+// bestCompetingAsk returns the price of the cheapest ask of other suppliers
+// acceptable by the best bid matching the order, nil if there is none.
+func (m *Salesman) bestCompetingAsk(ctx context.Context, order *sonm.Order) (*big.Int, error) {
+	if m.dwh == nil {
+		return nil, errors.New("DWH is not configured")
+	}
+
+	bids, err := m.dwh.GetMatchingOrders(ctx, &sonm.MatchingOrdersRequest{Id: order.GetId(), Limit: 1})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bids matching order %s: %v", order.GetId().Unwrap().String(), err)
+	}
+
+	if len(bids.GetOrders()) == 0 {
+		return nil, nil
+	}
+
+	bid := bids.GetOrders()[0].GetOrder()
+	asks, err := m.dwh.GetMatchingOrders(ctx, &sonm.MatchingOrdersRequest{Id: bid.GetId(), Limit: competingAsksLimit})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get asks matching bid %s: %v", bid.GetId().Unwrap().String(), err)
+	}
+
+	self := crypto.PubkeyToAddress(m.ethkey.PublicKey)
+	// Matching asks are sorted by price in ascending order.
+	for _, ask := range asks.GetOrders() {
+		if ask.GetOrder().GetAuthorID().Unwrap() == self {
+			continue
+		}
+
+		return ask.GetOrder().GetPrice().Unwrap(), nil
+	}
+
+	return nil, nil
+}
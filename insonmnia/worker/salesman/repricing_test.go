@@ -0,0 +1,36 @@
+package salesman
+
+import (
+	"testing"
+
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendPriceChange(t *testing.T) {
+	var history []*sonm.AskPlanPriceChange
+	for i := 0; i < maxPriceHistory+10; i++ {
+		history = appendPriceChange(history, &sonm.AskPlanPriceChange{NewPrice: &sonm.Price{PerSecond: sonm.NewBigIntFromInt(int64(i))}})
+	}
+
+	assert.Len(t, history, maxPriceHistory)
+	assert.Equal(t, int64(10), history[0].GetNewPrice().GetPerSecond().Unwrap().Int64())
+	assert.Equal(t, int64(maxPriceHistory+9), history[maxPriceHistory-1].GetNewPrice().GetPerSecond().Unwrap().Int64())
+}
+
+func TestInitRepricing(t *testing.T) {
+	price := &sonm.Price{PerSecond: sonm.NewBigIntFromInt(1000)}
+
+	plan := &sonm.AskPlan{Price: price}
+	initRepricing(plan)
+	assert.Nil(t, plan.GetRepricing())
+
+	plan = &sonm.AskPlan{Price: price, Repricing: &sonm.AskPlanRepricing{}}
+	initRepricing(plan)
+	assert.Equal(t, int64(1000), plan.GetRepricing().GetBase().GetPerSecond().Unwrap().Int64())
+
+	base := &sonm.Price{PerSecond: sonm.NewBigIntFromInt(500)}
+	plan = &sonm.AskPlan{Price: price, Repricing: &sonm.AskPlanRepricing{Base: base}}
+	initRepricing(plan)
+	assert.Equal(t, base, plan.GetRepricing().GetBase())
+}
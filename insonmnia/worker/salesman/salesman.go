@@ -50,11 +50,20 @@ type YAMLConfig struct {
 	SyncInterval            time.Duration `yaml:"sync_interval" default:"10s"`
 	MatcherRetryInterval    time.Duration `yaml:"matcher_retry_interval" default:"10s"`
 	DealCancellationTimeout time.Duration `yaml:"deal_cancellation_timeout" default:"3m"`
+	// TrafficAccountingInterval specifies how often traffic counters of ask
+	// plan networks are collected and quotas are checked.
+	TrafficAccountingInterval time.Duration `yaml:"traffic_accounting_interval" default:"1m"`
+	// DrainNoticePeriod is the time tasks of spot deals are given to finish
+	// after being notified about the drain.
+	DrainNoticePeriod time.Duration `yaml:"drain_notice_period" default:"5m"`
 }
 
 type Salesman struct {
 	*options
 	askPlanStorage *state.KeyedStorage
+	trafficStorage *state.KeyedStorage
+	// historyStorage keeps price changes of repriced plans.
+	historyStorage *state.KeyedStorage
 
 	askPlans        map[string]*sonm.AskPlan
 	askPlanCGroups  map[string]cgroups.CGroup
@@ -62,9 +71,18 @@ type Salesman struct {
 	deals           map[string]*sonm.Deal
 
 	networkManager *network.NetworkManager
+	traffic        map[string]*trafficAccount
+	// throttled contains plans whose networks are currently limited to the
+	// quota floor rate.
+	throttled map[string]bool
+	// priceHistory contains recent price changes of plans by their IDs.
+	priceHistory map[string][]*sonm.AskPlanPriceChange
+	// maintenance contains queued maintenance windows by their IDs.
+	maintenance map[string]*sonm.MaintenanceWindow
+	// drain is set while the worker is drained.
+	drain *drainState
 
-	nextMaintenance time.Time
-	mu              sync.Mutex
+	mu sync.Mutex
 }
 
 func NewSalesman(ctx context.Context, opts ...Option) (*Salesman, error) {
@@ -77,8 +95,10 @@ func NewSalesman(ctx context.Context, opts ...Option) (*Salesman, error) {
 	}
 
 	askPlansKey := o.eth.ContractRegistry().MarketAddress().Hex() + "/ask_plans"
+	trafficKey := o.eth.ContractRegistry().MarketAddress().Hex() + "/traffic"
+	priceHistoryKey := o.eth.ContractRegistry().MarketAddress().Hex() + "/ask_plan_price_history"
 
-	networkManager, err := network.NewNetworkManager(network.WithLog(o.log), network.WithRemote(o.networkConfig.RemoteQOS))
+	networkManager, err := network.NewNetworkManager(network.WithLog(o.log), network.WithRemote(o.networkConfig.RemoteQOS), network.WithIPv6Subnet(o.networkConfig.IPv6Subnet))
 	if err != nil {
 		return nil, err
 	}
@@ -86,11 +106,16 @@ func NewSalesman(ctx context.Context, opts ...Option) (*Salesman, error) {
 	s := &Salesman{
 		options:         o,
 		askPlanStorage:  state.NewKeyedStorage(askPlansKey, o.storage),
+		trafficStorage:  state.NewKeyedStorage(trafficKey, o.storage),
+		historyStorage:  state.NewKeyedStorage(priceHistoryKey, o.storage),
 		askPlanCGroups:  map[string]cgroups.CGroup{},
 		askPlanNetworks: map[string]*network.Network{},
 		deals:           map[string]*sonm.Deal{},
-		nextMaintenance: time.Now().Add(defaultMaintenancePeriod),
 		networkManager:  networkManager,
+		traffic:         map[string]*trafficAccount{},
+		throttled:       map[string]bool{},
+		priceHistory:    map[string][]*sonm.AskPlanPriceChange{},
+		maintenance:     map[string]*sonm.MaintenanceWindow{},
 	}
 
 	if err := s.restoreState(ctx); err != nil {
@@ -118,6 +143,7 @@ func (m *Salesman) Run(ctx context.Context) {
 			}
 		}
 		go m.syncRoutine(ctx)
+		go m.trafficRoutine(ctx)
 	}()
 }
 
@@ -138,20 +164,6 @@ func (m *Salesman) DebugDump() *sonm.SalesmanData {
 	return reply
 }
 
-func (m *Salesman) ScheduleMaintenance(timePoint time.Time) error {
-	m.log.Infof("Scheduling next maintenance at %s", timePoint.String())
-	m.mu.Lock()
-	defer m.mu.Unlock()
-	m.nextMaintenance = timePoint
-	return m.storage.Save("next_maintenance", m.nextMaintenance)
-}
-
-func (m *Salesman) NextMaintenance() time.Time {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-	return m.nextMaintenance
-}
-
 func (m *Salesman) AskPlan(planID string) (*sonm.AskPlan, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -174,6 +186,7 @@ func (m *Salesman) CreateAskPlan(askPlan *sonm.AskPlan) (string, error) {
 	id := uuid.New()
 	askPlan.ID = id
 	askPlan.CreateTime = sonm.CurrentTimestamp()
+	initRepricing(askPlan)
 	if err := askPlan.GetResources().GetGPU().Normalize(m.hardware); err != nil {
 		return "", err
 	}
@@ -276,6 +289,7 @@ func (m *Salesman) RemoveAskPlan(ctx context.Context, planID string) error {
 	if err := m.askPlanStorage.Save(m.askPlans); err != nil {
 		return fmt.Errorf("failed to remove ask plan %s: failed to save ask plans state in storage", planID)
 	}
+	m.dropPriceHistory(planID)
 	return nil
 }
 
@@ -356,9 +370,16 @@ func (m *Salesman) syncPlanWithBlockchain(ctx context.Context, plan *sonm.AskPla
 			m.log.Warnf("could not check deal %s for plan %s: %s", dealId.Unwrap().String(), plan.ID, err)
 		}
 	} else if !orderId.IsZero() {
-		if err := m.checkOrder(ctxWithTimeout, plan); err != nil {
+		if err := m.orderBlocked(plan); err != nil {
+			m.log.Warnf("withdrawing order %s for plan %s: %s", orderId.Unwrap().String(), plan.ID, err)
+			if err := m.withdrawOrder(ctxWithTimeout, plan); err != nil {
+				m.log.Warnf("could not withdraw order %s for plan %s: %s", orderId.Unwrap().String(), plan.ID, err)
+			}
+		} else if err := m.checkOrder(ctxWithTimeout, plan); err != nil {
 			m.log.Warnf("could not check order %s for plan %s: %s", orderId.Unwrap().String(), plan.ID, err)
 		}
+	} else if err := m.orderBlocked(plan); err != nil {
+		m.log.Debugf("not placing order for plan %s: %s", plan.ID, err)
 	} else {
 		order, err := m.placeOrder(ctxWithTimeout, plan)
 		if err != nil {
@@ -371,6 +392,7 @@ func (m *Salesman) syncPlanWithBlockchain(ctx context.Context, plan *sonm.AskPla
 
 func (m *Salesman) syncWithBlockchain(ctx context.Context) {
 	m.log.Debugf("syncing salesman with blockchain")
+	m.pruneMaintenance()
 	xconcurrency.Run(blockchainProcessConcurrency, m.AskPlans(), func(elem interface{}) {
 		plan := elem.(*sonm.AskPlan)
 		m.syncPlanWithBlockchain(ctx, plan)
@@ -382,6 +404,12 @@ func (m *Salesman) restoreState(ctx context.Context) error {
 	if _, err := m.askPlanStorage.Load(&m.askPlans); err != nil {
 		return fmt.Errorf("could not restore salesman state: %s", err)
 	}
+	if _, err := m.trafficStorage.Load(&m.traffic); err != nil {
+		return fmt.Errorf("could not restore traffic state: %s", err)
+	}
+	if _, err := m.historyStorage.Load(&m.priceHistory); err != nil {
+		return fmt.Errorf("could not restore price history: %s", err)
+	}
 
 	pruneReply, err := m.networkManager.Prune(ctx, &network.PruneRequest{})
 	if err != nil {
@@ -413,8 +441,21 @@ func (m *Salesman) restoreState(ctx context.Context) error {
 			}
 		}
 	}
-	if _, err := m.storage.Load("next_maintenance", &m.nextMaintenance); err != nil {
-		return fmt.Errorf("failed to load next maintenance: %s", err)
+	for planID := range m.traffic {
+		if _, ok := m.askPlans[planID]; !ok {
+			delete(m.traffic, planID)
+		}
+	}
+	for planID := range m.priceHistory {
+		if _, ok := m.askPlans[planID]; !ok {
+			delete(m.priceHistory, planID)
+		}
+	}
+	if err := m.restoreMaintenance(); err != nil {
+		return err
+	}
+	if err := m.restoreDrain(); err != nil {
+		return fmt.Errorf("failed to load drain state: %s", err)
 	}
 	//TODO: restore tasks
 	return nil
@@ -449,6 +490,7 @@ func (m *Salesman) createNetwork(plan *sonm.AskPlan) error {
 		ID:               plan.ID,
 		RateLimitIngress: plan.GetResources().GetNetwork().GetThroughputIn().GetBitsPerSecond(),
 		RateLimitEgress:  plan.GetResources().GetNetwork().GetThroughputOut().GetBitsPerSecond(),
+		Egress:           plan.GetResources().GetNetwork().GetEgress(),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create network - more detailed information can be found in worker logs")
@@ -456,6 +498,7 @@ func (m *Salesman) createNetwork(plan *sonm.AskPlan) error {
 
 	m.log.Infof("created network %s for ask plan %s", net.Name, plan.ID)
 	m.askPlanNetworks[plan.ID] = net
+	m.initTraffic(plan.ID)
 
 	return nil
 }
@@ -467,6 +510,7 @@ func (m *Salesman) dropNetwork(planID string) error {
 	}
 
 	delete(m.askPlanNetworks, planID)
+	m.dropTraffic(planID)
 
 	if err := m.networkManager.RemoveNetwork(net); err != nil {
 		return err
@@ -496,8 +540,12 @@ func (m *Salesman) closeDeal(ctx context.Context, dealID *sonm.BigInt) error {
 	if err := m.dealDestroyer.CancelDealTasks(cancellationCtx, dealID); err != nil {
 		return fmt.Errorf("failed to cancel deal's %s tasks: %s", dealID, err)
 	}
+	if err := m.dealDestroyer.RemoveDealVolumes(ctx, dealID); err != nil {
+		return fmt.Errorf("failed to remove deal's %s volumes: %s", dealID, err)
+	}
 	m.mu.Lock()
 	delete(m.deals, dealID.Unwrap().String())
+	m.forgetDrainNotice(dealID.Unwrap().String())
 	m.mu.Unlock()
 	return nil
 }
@@ -519,6 +567,63 @@ func (m *Salesman) cancelOrder(ctx context.Context, orderID *sonm.BigInt) error
 	return nil
 }
 
+// withdrawOrder cancels the order of the plan, keeping the plan itself.
+// The order is placed again on the next sync once the plan can be served.
+func (m *Salesman) withdrawOrder(ctx context.Context, plan *sonm.AskPlan) error {
+	if err := m.cancelOrder(ctx, plan.GetOrderID()); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// We have a deep copy of ask-plan, so refetch original one
+	original, ok := m.askPlans[plan.ID]
+	if !ok {
+		return fmt.Errorf("failed to fetch ask plan by id %s", plan.ID)
+	}
+	original.OrderID = nil
+	if err := m.askPlanStorage.Save(m.askPlans); err != nil {
+		return err
+	}
+	m.log.Infof("withdrew order %s for plan %s", plan.GetOrderID().Unwrap().String(), plan.ID)
+	return nil
+}
+
+// orderBlocked returns the reason the plan must not have an active order.
+func (m *Salesman) orderBlocked(plan *sonm.AskPlan) error {
+	if err := m.draining(); err != nil {
+		return err
+	}
+
+	return m.gpuFault(plan)
+}
+
+// gpuFault returns an error when the plan includes GPUs considered faulty.
+func (m *Salesman) gpuFault(plan *sonm.AskPlan) error {
+	if m.gpuHealth == nil {
+		return nil
+	}
+
+	faults := m.gpuHealth.Faults()
+	if len(faults) == 0 {
+		return nil
+	}
+
+	for _, hash := range plan.GetResources().GetGPU().GetHashes() {
+		for _, gpu := range m.hardware.GPU {
+			dev := gpu.GetDevice()
+			if dev.GetHash() != hash {
+				continue
+			}
+			if reason, ok := faults[dev.PhysicalID()]; ok {
+				return fmt.Errorf("GPU %s is faulty: %s", dev.GetID(), reason)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (m *Salesman) checkDeal(ctx context.Context, plan *sonm.AskPlan) error {
 	dealID := plan.DealID.Unwrap()
 
@@ -592,7 +697,10 @@ func (m *Salesman) shouldCloseDeal(ctx context.Context, plan *sonm.AskPlan, deal
 		if plan.Status == sonm.AskPlan_PENDING_DELETION {
 			return true
 		}
-		if time.Now().After(m.NextMaintenance()) {
+		if m.underMaintenance() {
+			return true
+		}
+		if m.drainDeal(ctx, deal) {
 			return true
 		}
 	}
@@ -703,7 +811,7 @@ func (m *Salesman) checkOrder(ctx context.Context, plan *sonm.AskPlan) error {
 	} else if order.OrderStatus != sonm.OrderStatus_ORDER_ACTIVE {
 		return m.RemoveAskPlan(ctx, plan.ID)
 	}
-	return nil
+	return m.maybeReprice(ctx, plan, order)
 }
 
 func (m *Salesman) placeOrder(ctx context.Context, plan *sonm.AskPlan) (*sonm.Order, error) {
@@ -711,22 +819,11 @@ func (m *Salesman) placeOrder(ctx context.Context, plan *sonm.AskPlan) (*sonm.Or
 	if err != nil {
 		return nil, fmt.Errorf("could not get benchmarks for ask plan %s: %s", plan.ID, err)
 	}
-	maintenanceTime := m.NextMaintenance()
-	// we add some "gap" here to be ready for maintenance slightly before it occurs
-	clearTime := maintenanceTime.Add(-maintenanceGap)
-	now := time.Now()
-	if now.After(clearTime) {
-		return nil, fmt.Errorf("faiiled to place order: maintenance is scheduled at %s", maintenanceTime.String())
-	}
 	duration := plan.GetDuration().Unwrap()
-	if duration != 0 && now.Add(duration).After(clearTime) {
-		duration = clearTime.Sub(now)
-		//rare case but still possible
-		if uint64(duration) == 0 {
-			return nil, fmt.Errorf("faiiled to place order: maintenance is scheduled at %s", maintenanceTime.String())
-		}
-		m.log.Infof("reducing order duration from %d to %d due to maintenance at %s",
-			uint64(plan.GetDuration().Unwrap().Seconds()), uint64(duration.Seconds()), clearTime.String())
+	// Forward orders are not placed at all when the deal could overlap the
+	// maintenance, they are placed once it is over.
+	if err := m.checkMaintenance(duration); err != nil {
+		return nil, fmt.Errorf("failed to place order: %s", err)
 	}
 
 	net := plan.GetResources().GetNetwork()
@@ -0,0 +1,251 @@
+package salesman
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sonm-io/core/insonmnia/worker/network"
+	"github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util"
+)
+
+// minThrottledRate is used instead of zero floor rates, because HTB classes
+// can not have zero rate.
+const minThrottledRate = 8
+
+// trafficAccount tracks the traffic of an ask plan's network.
+//
+// Kernel counters are reset each time the network is recreated, e.g. on
+// worker restart, so only deltas are accumulated.
+type trafficAccount struct {
+	// BytesIn and BytesOut are totals within the current quota period.
+	BytesIn  uint64
+	BytesOut uint64
+	// LastIn and LastOut are the most recent counter values.
+	LastIn  uint64
+	LastOut uint64
+	// Since is the beginning of the current quota period.
+	Since     time.Time
+	Throttled bool
+}
+
+func newTrafficAccount(now time.Time) *trafficAccount {
+	return &trafficAccount{
+		Since: now,
+	}
+}
+
+func counterDelta(current, last uint64) uint64 {
+	// Counters that went backwards have been reset.
+	if current < last {
+		return current
+	}
+
+	return current - last
+}
+
+func (m *trafficAccount) update(bytesIn, bytesOut uint64) {
+	m.BytesIn += counterDelta(bytesIn, m.LastIn)
+	m.BytesOut += counterDelta(bytesOut, m.LastOut)
+	m.LastIn = bytesIn
+	m.LastOut = bytesOut
+}
+
+// maybeStartPeriod resets totals when a new quota period has begun.
+func (m *trafficAccount) maybeStartPeriod(quota *sonm.TrafficQuota, now time.Time) {
+	periodStart := quota.PeriodStart(m.Since, now)
+	if !periodStart.After(m.Since) {
+		return
+	}
+
+	m.BytesIn = 0
+	m.BytesOut = 0
+	m.Since = periodStart
+	m.Throttled = false
+}
+
+func (m *trafficAccount) exceeds(quota *sonm.TrafficQuota) bool {
+	if quota == nil {
+		return false
+	}
+
+	return m.BytesIn+m.BytesOut >= quota.GetLimit().GetBytes()
+}
+
+// throttledRate returns the rate a plan's network is limited to once its
+// quota is exhausted.
+func throttledRate(floor, rate uint64) uint64 {
+	if rate != 0 && rate < floor {
+		floor = rate
+	}
+	if floor < minThrottledRate {
+		floor = minThrottledRate
+	}
+
+	return floor
+}
+
+func (m *trafficAccount) Unwrap() *sonm.NetworkTraffic {
+	return &sonm.NetworkTraffic{
+		BytesIn:     m.BytesIn,
+		BytesOut:    m.BytesOut,
+		PeriodStart: sonm.NewTimestamp(m.Since),
+		Throttled:   m.Throttled,
+	}
+}
+
+// initTraffic starts accounting for the plan's network, which has just been
+// created. Must be called with the lock held.
+func (m *Salesman) initTraffic(planID string) {
+	delete(m.throttled, planID)
+
+	account, ok := m.traffic[planID]
+	if !ok {
+		m.traffic[planID] = newTrafficAccount(time.Now())
+		return
+	}
+
+	account.LastIn = 0
+	account.LastOut = 0
+}
+
+// dropTraffic stops accounting for the plan's network. Must be called with
+// the lock held.
+func (m *Salesman) dropTraffic(planID string) {
+	delete(m.traffic, planID)
+	delete(m.throttled, planID)
+
+	if err := m.trafficStorage.Save(m.traffic); err != nil {
+		m.log.Warnf("failed to save traffic state: %s", err)
+	}
+}
+
+// Traffic returns the traffic of the given ask plan within the current quota
+// period.
+func (m *Salesman) Traffic(planID string) (*sonm.NetworkTraffic, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	account, ok := m.traffic[planID]
+	if !ok {
+		return nil, fmt.Errorf("no traffic accounted for ask plan %s", planID)
+	}
+
+	return account.Unwrap(), nil
+}
+
+// TrafficMetrics returns the traffic summed over all ask plans.
+func (m *Salesman) TrafficMetrics() map[string]float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	metrics := map[string]float64{
+		sonm.MetricsKeyNetworkBytesIn:   0,
+		sonm.MetricsKeyNetworkBytesOut:  0,
+		sonm.MetricsKeyNetworkThrottled: 0,
+	}
+
+	for _, account := range m.traffic {
+		metrics[sonm.MetricsKeyNetworkBytesIn] += float64(account.BytesIn)
+		metrics[sonm.MetricsKeyNetworkBytesOut] += float64(account.BytesOut)
+		if account.Throttled {
+			metrics[sonm.MetricsKeyNetworkThrottled]++
+		}
+	}
+
+	return metrics
+}
+
+func (m *Salesman) trafficRoutine(ctx context.Context) {
+	m.log.Debugf("starting traffic accounting routine")
+	ticker := util.NewImmediateTicker(m.config.TrafficAccountingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := m.syncTraffic(); err != nil {
+				if err == network.ErrTrafficUnsupported {
+					m.log.Infof("stopping traffic accounting: %s", err)
+					return
+				}
+				m.log.Warnf("failed to account traffic: %s", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (m *Salesman) syncTraffic() error {
+	m.mu.Lock()
+	networks := make(map[string]*network.Network, len(m.askPlanNetworks))
+	for planID, net := range m.askPlanNetworks {
+		networks[planID] = net
+	}
+	m.mu.Unlock()
+
+	counters := map[string]*network.Traffic{}
+	for planID, net := range networks {
+		traffic, err := m.networkManager.Traffic(net)
+		if err == network.ErrTrafficUnsupported {
+			return err
+		}
+		if err != nil {
+			m.log.Warnf("failed to read traffic counters for ask plan %s: %s", planID, err)
+			continue
+		}
+		counters[planID] = traffic
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	for planID, traffic := range counters {
+		plan, ok := m.askPlans[planID]
+		if !ok {
+			continue
+		}
+		account, ok := m.traffic[planID]
+		if !ok {
+			continue
+		}
+		// The network may have been recreated while counters were read.
+		net, ok := m.askPlanNetworks[planID]
+		if !ok || net != networks[planID] {
+			continue
+		}
+
+		quota := plan.GetResources().GetNetwork().GetTrafficQuota()
+		account.maybeStartPeriod(quota, now)
+		account.update(traffic.BytesIn, traffic.BytesOut)
+		if account.exceeds(quota) && !account.Throttled {
+			m.log.Infof("ask plan %s has exhausted its traffic quota, throttling", planID)
+			account.Throttled = true
+		}
+
+		if account.Throttled != m.throttled[planID] {
+			if err := m.applyThrottling(net, plan, account.Throttled); err != nil {
+				m.log.Warnf("failed to change rate limits for ask plan %s: %s", planID, err)
+				continue
+			}
+			m.throttled[planID] = account.Throttled
+		}
+	}
+
+	return m.trafficStorage.Save(m.traffic)
+}
+
+func (m *Salesman) applyThrottling(net *network.Network, plan *sonm.AskPlan, throttled bool) error {
+	ingress := plan.GetResources().GetNetwork().GetThroughputIn().GetBitsPerSecond()
+	egress := plan.GetResources().GetNetwork().GetThroughputOut().GetBitsPerSecond()
+
+	if throttled {
+		floor := plan.GetResources().GetNetwork().GetTrafficQuota().GetFloorRate().GetBitsPerSecond()
+		ingress = throttledRate(floor, ingress)
+		egress = throttledRate(floor, egress)
+	}
+
+	return m.networkManager.SetRateLimits(net, ingress, egress)
+}
@@ -0,0 +1,61 @@
+package salesman
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrafficAccountUpdate(t *testing.T) {
+	account := newTrafficAccount(time.Now())
+
+	account.update(100, 200)
+	account.update(150, 300)
+	assert.Equal(t, uint64(150), account.BytesIn)
+	assert.Equal(t, uint64(300), account.BytesOut)
+
+	// Counters are reset when the network is recreated.
+	account.update(10, 20)
+	assert.Equal(t, uint64(160), account.BytesIn)
+	assert.Equal(t, uint64(320), account.BytesOut)
+}
+
+func TestTrafficAccountQuota(t *testing.T) {
+	since := time.Date(2019, time.March, 20, 12, 0, 0, 0, time.UTC)
+	quota := &sonm.TrafficQuota{
+		Limit:  &sonm.DataSize{Bytes: 1000},
+		Period: sonm.TrafficQuota_MONTHLY,
+	}
+
+	account := newTrafficAccount(since)
+	assert.False(t, account.exceeds(nil))
+
+	account.update(600, 300)
+	assert.False(t, account.exceeds(quota))
+	account.update(700, 300)
+	assert.True(t, account.exceeds(quota))
+	account.Throttled = true
+
+	account.maybeStartPeriod(quota, since.Add(time.Hour))
+	assert.Equal(t, since, account.Since)
+	assert.True(t, account.Throttled)
+
+	account.maybeStartPeriod(quota, time.Date(2019, time.April, 2, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2019, time.April, 1, 0, 0, 0, 0, time.UTC), account.Since)
+	assert.False(t, account.Throttled)
+	assert.False(t, account.exceeds(quota))
+	assert.Equal(t, uint64(700), account.LastIn)
+
+	quota.Period = sonm.TrafficQuota_DEAL
+	account.maybeStartPeriod(quota, time.Date(2019, time.May, 2, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2019, time.April, 1, 0, 0, 0, 0, time.UTC), account.Since)
+}
+
+func TestThrottledRate(t *testing.T) {
+	assert.Equal(t, uint64(1000), throttledRate(1000, 0))
+	assert.Equal(t, uint64(1000), throttledRate(1000, 5000))
+	assert.Equal(t, uint64(500), throttledRate(1000, 500))
+	assert.Equal(t, uint64(minThrottledRate), throttledRate(0, 500))
+}
@@ -15,7 +15,9 @@ import (
 	"math/big"
 	"net"
 	"os"
+	"path"
 	"reflect"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -41,7 +43,6 @@ import (
 	"github.com/sonm-io/core/insonmnia/benchmarks"
 	"github.com/sonm-io/core/insonmnia/cgroups"
 	"github.com/sonm-io/core/insonmnia/hardware"
-	"github.com/sonm-io/core/insonmnia/hardware/disk"
 	"github.com/sonm-io/core/insonmnia/inspect"
 	"github.com/sonm-io/core/insonmnia/logging"
 	"github.com/sonm-io/core/insonmnia/matcher"
@@ -94,11 +95,19 @@ var (
 		workerAPIPrefix + "PurgeAskPlansDetailed",
 		workerAPIPrefix + "ScheduleMaintenance",
 		workerAPIPrefix + "NextMaintenance",
+		workerAPIPrefix + "AddMaintenance",
+		workerAPIPrefix + "CancelMaintenance",
+		workerAPIPrefix + "Maintenance",
+		workerAPIPrefix + "Drain",
+		workerAPIPrefix + "Undrain",
 		workerAPIPrefix + "DebugState",
 		workerAPIPrefix + "RemoveBenchmark",
 		workerAPIPrefix + "PurgeBenchmarks",
 		workerAPIPrefix + "AddCapability",
 		workerAPIPrefix + "RemoveCapability",
+		workerAPIPrefix + "PrefetchImages",
+		workerAPIPrefix + "Images",
+		workerAPIPrefix + "AskPlanHistory",
 	}
 
 	inspectMethods = []string{
@@ -158,8 +167,12 @@ type Worker struct {
 	ctx     context.Context
 	cfg     *Config
 	storage *state.Storage
+	// tasks keeps records of tasks to restore them after restart.
+	tasks *taskStore
 
 	ovs         Overseer
+	logs        *logArchive
+	images      *imageCache
 	ssh         SSH
 	key         *ecdsa.PrivateKey
 	publicIPs   []string
@@ -173,6 +186,13 @@ type Worker struct {
 	matcher     matcher.Matcher
 	version     string
 
+	// benchmarkRunners measure benchmarks in order of preference.
+	benchmarkRunners []benchmarks.Runner
+	// benchmarkResults are kept by storage keys of benchmark values to be
+	// reported.
+	benchmarkResultsMu sync.Mutex
+	benchmarkResults   map[string]*sonm.BenchmarkResult
+
 	mu        sync.Mutex
 	hardware  *hardware.Hardware
 	resources *resource.Scheduler
@@ -189,6 +209,8 @@ type Worker struct {
 	cGroupManager cgroups.CGroupManager
 	listener      *npp.Listener
 	externalGrpc  *grpc.Server
+	// portForwarder publishes exposed ports through NPP, nil if disabled.
+	portForwarder *portForwarder
 
 	startTime           time.Time
 	isMasterConfirmed   bool
@@ -198,6 +220,8 @@ type Worker struct {
 	country *geoip2.Country
 	// Hardware metrics for various hardware types.
 	metrics *metrics.Handler
+	// GPU health monitor, fed by GPU metrics handlers.
+	gpuHealth *gpu.HealthMonitor
 	// Embedded inspection service.
 	*inspect.InspectService
 }
@@ -212,6 +236,7 @@ func NewWorker(cfg *Config, storage *state.Storage, options ...Option) (*Worker,
 		cfg:        cfg,
 		ctx:        opts.ctx,
 		storage:    storage,
+		tasks:      newTaskStore(storage),
 		version:    opts.version,
 		containers: map[string]*ContainerInfo{},
 	}
@@ -273,6 +298,10 @@ func NewWorker(cfg *Config, storage *state.Storage, options ...Option) (*Worker,
 		return nil, err
 	}
 
+	if err := m.setupPortForwarding(); err != nil {
+		return nil, err
+	}
+
 	if err := m.setupInspectService(cfg, m.inspectAuthorization, opts.logWatcher); err != nil {
 		return nil, err
 	}
@@ -331,6 +360,14 @@ func (m *Worker) init() error {
 		return err
 	}
 
+	if err := m.setupLogs(); err != nil {
+		return err
+	}
+
+	if err := m.setupImages(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -441,6 +478,14 @@ func (m *Worker) setupMetrics() error {
 
 	m.metrics = h
 	m.metrics.Run(m.ctx)
+
+	var handlers []gpu.MetricsHandler
+	for _, handler := range h.GPUs {
+		handlers = append(handlers, handler)
+	}
+
+	m.gpuHealth = gpu.NewHealthMonitor(m.cfg.GPUHealth, handlers, log.G(m.ctx))
+	go m.gpuHealth.Run(m.ctx)
 	return nil
 }
 
@@ -522,6 +567,13 @@ func (m *Worker) setupBenchmarks() error {
 		m.benchmarks = benchList
 	}
 
+	runners, err := m.newBenchmarkRunners(m.cfg.Benchmarks)
+	if err != nil {
+		return err
+	}
+
+	m.benchmarkRunners = runners
+	m.benchmarkResults = map[string]*sonm.BenchmarkResult{}
 	return nil
 }
 
@@ -547,6 +599,28 @@ func (m *Worker) setupNetworkOptions() error {
 	return nil
 }
 
+// bindingPublicIPs returns public IPs the port bound on the given host IP is
+// reachable at. Ports bound on unspecified addresses are reachable at all
+// public IPs of both families, because Docker proxies them.
+func bindingPublicIPs(publicIPs []string, hostIP string) []string {
+	ip := net.ParseIP(hostIP)
+	if ip == nil || ip.IsUnspecified() {
+		return publicIPs
+	}
+
+	return []string{ip.String()}
+}
+
+func containsPortBinding(portBindings []nat.PortBinding, portBinding nat.PortBinding) bool {
+	for _, binding := range portBindings {
+		if binding == portBinding {
+			return true
+		}
+	}
+
+	return false
+}
+
 func encodeRSAPrivateKeyToPEM(privateKey *rsa.PrivateKey) []byte {
 	privateKeyData := x509.MarshalPKCS1PrivateKey(privateKey)
 	block := pem.Block{
@@ -590,6 +664,51 @@ func (m *Worker) setupSSH(view OverseerView) error {
 	return nil
 }
 
+func (m *Worker) setupPortForwarding() error {
+	if m.cfg.PortForwarding == nil {
+		return nil
+	}
+
+	forwarder, err := newPortForwarder(m.ctx, *m.cfg.PortForwarding, m.key, m.forwardedPortAddr, log.S(m.ctx))
+	if err != nil {
+		return err
+	}
+
+	m.portForwarder = forwarder
+	return nil
+}
+
+// forwardedPortAddr returns the address the published port of the running
+// task is reachable at. Only the consumer of the task's deal is allowed to
+// connect to it.
+func (m *Worker) forwardedPortAddr(peer common.Address, taskID, port string) (string, error) {
+	m.mu.Lock()
+	info, ok := m.containers[taskID]
+	if !ok || !sonm.IsTaskStatusRunning(info.status) {
+		m.mu.Unlock()
+		return "", fmt.Errorf("task %s is not running", taskID)
+	}
+
+	dealID := info.DealID
+	addr, ok := info.ForwardedPorts[nat.Port(port)]
+	m.mu.Unlock()
+
+	if !ok {
+		return "", fmt.Errorf("port %s of task %s is not published", port, taskID)
+	}
+
+	deal, err := m.salesman.Deal(dealID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get deal %s: %v", dealID, err)
+	}
+
+	if deal.GetConsumerID().Unwrap() != peer {
+		return "", fmt.Errorf("%s is not allowed to connect to task %s", peer.Hex(), taskID)
+	}
+
+	return addr, nil
+}
+
 func (m *Worker) setupInspectService(cfg *Config, authWatcher *auth.AnyOfTransportCredentialsAuthorization, loggingWatcher *logging.WatcherCore) error {
 	inspectService, err := inspect.NewInspectService(&workerConfigProvider{cfg: cfg}, authWatcher, loggingWatcher)
 	if err != nil {
@@ -626,7 +745,7 @@ func (m *Worker) loadOrGenerateSSHSigner() (ssh.Signer, error) {
 
 func (m *Worker) setupOverseer() error {
 	if m.ovs == nil {
-		ovs, err := NewOverseer(m.ctx, m.plugins)
+		ovs, err := NewOverseer(m.ctx, m.plugins, m.metrics.GPUUtilization)
 		if err != nil {
 			return err
 		}
@@ -637,6 +756,46 @@ func (m *Worker) setupOverseer() error {
 	return nil
 }
 
+func (m *Worker) setupLogs() error {
+	logs, err := newLogArchive(m.cfg.Logs, log.S(m.ctx).With("source", "logs"))
+	if err != nil {
+		return err
+	}
+
+	m.logs = logs
+	return nil
+}
+
+func (m *Worker) setupImages() error {
+	dockerClient, err := client.NewEnvClient()
+	if err != nil {
+		return err
+	}
+
+	images, err := newImageCache(m.cfg.Images, dockerClient, m.ovs.Spool, m.storage, m.askPlanImages, log.S(m.ctx).With("source", "images"))
+	if err != nil {
+		dockerClient.Close()
+		return err
+	}
+
+	m.images = images
+	return nil
+}
+
+// askPlanImages returns images listed for prefetching by ask plans.
+func (m *Worker) askPlanImages() []string {
+	if m.salesman == nil {
+		return nil
+	}
+
+	var images []string
+	for _, plan := range m.salesman.AskPlans() {
+		images = append(images, plan.GetPrefetchImages()...)
+	}
+
+	return images
+}
+
 // Serve starts handling incoming API gRPC requests
 func (m *Worker) Serve() error {
 	m.startTime = time.Now()
@@ -656,6 +815,18 @@ func (m *Worker) Serve() error {
 	wg.Go(func() error {
 		return m.RunSSH(ctx)
 	})
+	wg.Go(func() error {
+		return m.logs.Run(ctx)
+	})
+	if m.portForwarder != nil {
+		wg.Go(func() error {
+			return m.portForwarder.Run(ctx)
+		})
+	}
+	wg.Go(func() error {
+		m.images.PrefetchPinned(ctx)
+		return m.images.Run(ctx)
+	})
 	wg.Go(func() error {
 		log.S(m.ctx).Infof("listening for gRPC API connections on %s", m.listener.Addr())
 		defer log.S(m.ctx).Infof("finished listening for gRPC API connections on %s", m.listener.Addr())
@@ -820,10 +991,37 @@ func (m *Worker) setupAuthorization() error {
 		auth.Allow(taskAPIPrefix+"StartTask").With(newDealAuthorization(m.ctx, m, newRequestDealExtractor(func(request interface{}) (*sonm.BigInt, error) {
 			return request.(*sonm.StartTaskRequest).GetDealID(), nil
 		}))),
+		auth.Allow(taskAPIPrefix+"RestoreTask").With(newDealAuthorization(m.ctx, m, newRequestDealExtractor(func(request interface{}) (*sonm.BigInt, error) {
+			return request.(*sonm.RestoreTaskRequest).GetDealID(), nil
+		}))),
+		auth.Allow(taskAPIPrefix+"StartTaskGroup").With(newDealAuthorization(m.ctx, m, newRequestDealExtractor(func(request interface{}) (*sonm.BigInt, error) {
+			return request.(*sonm.StartTaskGroupRequest).GetDealID(), nil
+		}))),
+		auth.Allow(taskAPIPrefix+"StopTaskGroup").With(newDealAuthorization(m.ctx, m, newFromTaskGroupDealExtractor(m))),
+		auth.Allow(taskAPIPrefix+"TaskGroupStatus").With(newAnyOfAuth(
+			managementAuth,
+			newDealAuthorization(m.ctx, m, newFromTaskGroupDealExtractor(m)),
+		)),
+		auth.Allow(taskAPIPrefix+"DealVolumes").With(newAnyOfAuth(
+			managementAuth,
+			newDealAuthorization(m.ctx, m, newRequestDealExtractor(func(request interface{}) (*sonm.BigInt, error) {
+				return sonm.NewBigIntFromString(request.(*sonm.ID).GetId())
+			})),
+		)),
 		auth.Allow(taskAPIPrefix+"PurgeTasks").With(newDealAuthorization(m.ctx, m, newRequestDealExtractor(func(request interface{}) (*sonm.BigInt, error) {
 			return request.(*sonm.PurgeTasksRequest).GetDealID(), nil
 		}))),
-		auth.Allow(taskAPIPrefix+"TaskLogs").With(newDealAuthorization(m.ctx, m, newFromTaskDealExtractor(m))),
+		auth.Allow(taskAPIPrefix+"TaskLogs").With(newAnyOfAuth(
+			newDealAuthorization(m.ctx, m, newFromTaskDealExtractor(m)),
+			newArchivedLogsAuthorization(m.logs),
+		)),
+		auth.Allow(taskAPIPrefix+"TaskMetrics").With(newAnyOfAuth(
+			managementAuth,
+			newDealAuthorization(m.ctx, m, newFromTaskDealExtractor(m)),
+		)),
+		auth.Allow(taskAPIPrefix+"ExecTask").With(newDealAuthorization(m.ctx, m, newFromTaskDealExtractor(m))),
+		auth.Allow(taskAPIPrefix+"CopyToTask").With(newDealAuthorization(m.ctx, m, newFromTaskDealExtractor(m))),
+		auth.Allow(taskAPIPrefix+"CopyFromTask").With(newDealAuthorization(m.ctx, m, newFromTaskDealExtractor(m))),
 		auth.Allow(taskAPIPrefix+"PushTask").With(newAllOfAuth(
 			newDealAuthorization(m.ctx, m, newContextDealExtractor()),
 			newKYCAuthorization(m.ctx, m.cfg.Whitelist.PrivilegedIdentityLevel, m.eth.ProfileRegistry())),
@@ -834,6 +1032,7 @@ func (m *Worker) setupAuthorization() error {
 		auth.Allow(taskAPIPrefix+"GetDealInfo").With(newDealAuthorization(m.ctx, m, newRequestDealExtractor(func(request interface{}) (*sonm.BigInt, error) {
 			return sonm.NewBigIntFromString(request.(*sonm.ID).GetId())
 		}))),
+		auth.Allow(taskAPIPrefix+"BenchmarkReport").With(auth.NewNilAuthorization()),
 		auth.Allow(workerAPIPrefix+"Metrics").With(newAnyOfAuth(metricsCollectorAuth...)),
 		auth.Allow(workerAPIPrefix+"Devices").With(newAnyOfAuth(metricsCollectorAuth...)),
 		auth.Allow(inspectMethods...).With(inspectAuthorization),
@@ -901,38 +1100,73 @@ func (m *Worker) CancelDealTasks(ctx context.Context, dealID *sonm.BigInt) error
 	result := multierror.NewTSMultiError()
 	xconcurrency.Run(32, toDelete, func(elem interface{}) {
 		container := elem.(*ContainerInfo)
-		if err := m.ovs.OnDealFinish(ctx, container.ID); err != nil {
-			result.Append(err)
-		}
-		if err := m.resources.OnDealFinish(container.TaskId); err != nil {
-			result.Append(err)
+		// Tasks without containers have nothing to clean up but records.
+		if !container.detached {
+			m.archiveTaskLogs(ctx, container)
+			if err := m.ovs.OnDealFinish(ctx, container.ID); err != nil {
+				result.Append(err)
+			}
+			if err := m.resources.OnDealFinish(container.TaskId); err != nil {
+				result.Append(err)
+			}
 		}
-		if _, err := m.storage.Remove(container.ID); err != nil {
+		if err := m.tasks.Remove(container.TaskId); err != nil {
 			result.Append(err)
 		}
 	})
 	return result.ErrorOrNil()
 }
 
-type runningContainerInfo struct {
-	Description Description   `json:"description,omitempty"`
-	Cinfo       ContainerInfo `json:"cinfo,omitempty"`
-	Spec        sonm.TaskSpec `json:"spec,omitempty"`
+// archiveTaskLogs saves logs of the task before its container is removed.
+// Failures are only logged, because they must not prevent the deal from
+// being finished.
+func (m *Worker) archiveTaskLogs(ctx context.Context, container *ContainerInfo) {
+	meta := archivedLogsMeta{
+		DealID:     container.DealID,
+		FinishedAt: time.Now(),
+	}
+	if deal, err := m.salesman.Deal(container.DealID); err == nil {
+		meta.Consumer = deal.GetConsumerID().Unwrap()
+	} else {
+		log.S(ctx).Warnf("archived logs of task %s will not be available for the consumer: %v", container.TaskId, err)
+	}
+
+	if err := m.logs.Save(ctx, container.TaskId, meta, func(ctx context.Context, opts types.ContainerLogsOptions) (io.ReadCloser, error) {
+		return m.ovs.Logs(ctx, container.ID, opts)
+	}); err != nil {
+		log.S(ctx).Warnf("failed to archive logs of task %s: %v", container.TaskId, err)
+	}
 }
 
 func (m *Worker) saveContainerInfo(id string, info ContainerInfo, d Description, spec sonm.TaskSpec) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	m.storage.Save(info.ID, runningContainerInfo{
+	m.saveTaskRecord(id, &taskRecord{
 		Description: d,
 		Cinfo:       info,
 		Spec:        spec,
+		Status:      info.status,
 	})
 
 	m.containers[id] = &info
 }
 
+// saveTaskRecord writes the record of the task. Failures are only logged,
+// because the task is able to run without it, but is lost on restart.
+func (m *Worker) saveTaskRecord(id string, record *taskRecord) {
+	if err := m.tasks.Put(id, record); err != nil {
+		log.S(m.ctx).Warnf("failed to save record of task %s: %v", id, err)
+	}
+}
+
+// dropTaskRecord removes the record of the task that has failed to start.
+func (m *Worker) dropTaskRecord(id string) {
+	if err := m.tasks.Remove(id); err != nil {
+		log.S(m.ctx).Warnf("failed to remove record of task %s: %v", id, err)
+	}
+}
+
 func (m *Worker) GetContainerInfo(id string) (*ContainerInfo, bool) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -941,7 +1175,19 @@ func (m *Worker) GetContainerInfo(id string) (*ContainerInfo, bool) {
 }
 
 func (m *Worker) Devices(ctx context.Context, request *sonm.Empty) (*sonm.DevicesReply, error) {
-	return m.hardware.IntoProto(), nil
+	reply := m.hardware.IntoProto()
+
+	faults := m.gpuHealth.Faults()
+	for _, dev := range m.hardware.GPU {
+		if reason, ok := faults[dev.GetDevice().PhysicalID()]; ok {
+			if reply.FaultyGPUs == nil {
+				reply.FaultyGPUs = map[string]string{}
+			}
+			reply.FaultyGPUs[dev.GetDevice().GetHash()] = reason
+		}
+	}
+
+	return reply, nil
 }
 
 // Status returns internal worker statistic
@@ -964,12 +1210,14 @@ func (m *Worker) Status(ctx context.Context, _ *sonm.Empty) (*sonm.StatusReply,
 		adminAddr = sonm.NewEthAddress(*m.cfg.Admin)
 	}
 
+	isStorageQuotaEnforced, storageQuotaStatus := m.plugins.StorageQuotaEnforced()
+
 	reply := &sonm.StatusReply{
 		Uptime:              uptime,
 		Version:             m.version,
 		Platform:            util.GetPlatformName(),
 		EthAddr:             m.ethAddr().Hex(),
-		TaskCount:           uint32(len(m.CollectTasksStatuses(sonm.TaskStatusReply_RUNNING))),
+		TaskCount:           uint32(m.runningTaskCount()),
 		DWHStatus:           m.cfg.Endpoint,
 		RendezvousStatus:    rendezvousStatus,
 		Master:              sonm.NewEthAddress(m.cfg.Master),
@@ -981,6 +1229,9 @@ func (m *Worker) Status(ctx context.Context, _ *sonm.Empty) (*sonm.StatusReply,
 				IsoCode: m.country.Country.IsoCode,
 			},
 		},
+		IsStorageQuotaEnforced: isStorageQuotaEnforced,
+		StorageQuotaStatus:     storageQuotaStatus,
+		Drain:                  m.salesman.DrainStatus(),
 	}
 
 	return reply, nil
@@ -1013,20 +1264,41 @@ func (m *Worker) setStatus(status *sonm.TaskStatusReply, id string) {
 	}
 
 	m.containers[id].status = status.GetStatus()
+	if status.GetStatus() != sonm.TaskStatusReply_SPOOLING {
+		m.containers[id].spoolProgress = nil
+	}
+	if err := m.tasks.SetStatus(id, status.GetStatus()); err != nil {
+		log.S(m.ctx).Warnf("failed to save status of task %s: %v", id, err)
+	}
 	if sonm.IsTaskStatusTerminated(status.Status) {
 		m.resources.ReleaseTask(id)
 	}
 }
 
+// setSpoolProgress updates the image pull progress of the task being
+// spooled.
+func (m *Worker) setSpoolProgress(id string, progress xdocker.PullProgress) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if info, ok := m.containers[id]; ok && info.status == sonm.TaskStatusReply_SPOOLING {
+		info.spoolProgress = newImagePullProgress(progress)
+	}
+}
+
 func (m *Worker) listenForStatus(statusListener chan sonm.TaskStatusReply_Status, id string) {
-	select {
-	case newStatus, ok := <-statusListener:
-		if !ok {
+	// Health check transitions may be reported several times before the
+	// task terminates, so keep listening until the channel is closed.
+	for {
+		select {
+		case newStatus, ok := <-statusListener:
+			if !ok {
+				return
+			}
+			m.setStatus(&sonm.TaskStatusReply{Status: newStatus}, id)
+		case <-m.ctx.Done():
 			return
 		}
-		m.setStatus(&sonm.TaskStatusReply{Status: newStatus}, id)
-	case <-m.ctx.Done():
-		return
 	}
 }
 
@@ -1064,18 +1336,10 @@ func (m *Worker) PullTask(request *sonm.PullTaskRequest, stream sonm.Worker_Pull
 		return err
 	}
 
-	named, err := reference.ParseNormalizedNamed(task.GetImageName())
-	if err != nil {
-		log.G(m.ctx).Warn("could not parse image to reference", zap.Error(err), zap.String("image", task.GetImageName()))
-		return err
-	}
-
-	tagged, err := reference.WithTag(named, fmt.Sprintf("%s_%s", request.GetDealId(), request.GetTaskId()))
+	imageID, err := m.pullTaskImageID(request, task)
 	if err != nil {
-		log.G(m.ctx).Warn("could not tag image", zap.Error(err), zap.String("image", task.GetImageName()))
 		return err
 	}
-	imageID := tagged.String()
 
 	log.G(ctx).Debug("pulling image", zap.String("imageID", imageID))
 
@@ -1106,6 +1370,32 @@ func (m *Worker) PullTask(request *sonm.PullTaskRequest, stream sonm.Worker_Pull
 	return nil
 }
 
+// pullTaskImageID returns the image to be pulled, which is either the
+// committed task image or its latest checkpoint.
+func (m *Worker) pullTaskImageID(request *sonm.PullTaskRequest, task *sonm.TaskStatusReply) (string, error) {
+	if request.GetCheckpoint() {
+		if task.GetLastCheckpoint() == nil {
+			return "", status.Errorf(codes.NotFound, "task %s has no checkpoints", request.GetTaskId())
+		}
+
+		return task.GetLastCheckpoint().GetImage(), nil
+	}
+
+	named, err := reference.ParseNormalizedNamed(task.GetImageName())
+	if err != nil {
+		log.G(m.ctx).Warn("could not parse image to reference", zap.Error(err), zap.String("image", task.GetImageName()))
+		return "", err
+	}
+
+	tagged, err := reference.WithTag(named, fmt.Sprintf("%s_%s", request.GetDealId(), request.GetTaskId()))
+	if err != nil {
+		log.G(m.ctx).Warn("could not tag image", zap.Error(err), zap.String("image", task.GetImageName()))
+		return "", err
+	}
+
+	return tagged.String(), nil
+}
+
 func (m *Worker) taskAllowed(ctx context.Context, request *sonm.StartTaskRequest) (bool, xdocker.Reference, error) {
 	spec := request.GetSpec()
 	ref, err := xdocker.NewReference(spec.GetContainer().GetImage())
@@ -1133,7 +1423,47 @@ func (m *Worker) taskAllowed(ctx context.Context, request *sonm.StartTaskRequest
 	return true, ref, nil
 }
 
+// taskGroupMember describes how a task is bound to its group.
+type taskGroupMember struct {
+	groupID string
+	index   int
+	// networkContainer is the ID of the container whose network namespace
+	// should be joined. Empty for the first task in the group.
+	networkContainer string
+	volumes          map[string]*sonm.Volume
+}
+
+// startTaskOptions describes optional parameters of starting a task.
+type startTaskOptions struct {
+	group taskGroupMember
+	// restore marks that the task is started from a checkpoint.
+	restore bool
+}
+
 func (m *Worker) StartTask(ctx context.Context, request *sonm.StartTaskRequest) (*sonm.StartTaskReply, error) {
+	return m.startTask(ctx, request, startTaskOptions{})
+}
+
+// RestoreTask starts a task from the given checkpoint image, including
+// contents of the checkpointed volumes.
+func (m *Worker) RestoreTask(ctx context.Context, request *sonm.RestoreTaskRequest) (*sonm.StartTaskReply, error) {
+	spec := proto.Clone(request.GetSpec()).(*sonm.TaskSpec)
+	spec.Container.Image = request.GetCheckpoint()
+
+	log.G(ctx).Info("restoring task from checkpoint", zap.String("checkpoint", request.GetCheckpoint()))
+
+	return m.startTask(ctx, &sonm.StartTaskRequest{DealID: request.GetDealID(), Spec: spec}, startTaskOptions{restore: true})
+}
+
+func (m *Worker) startTask(ctx context.Context, request *sonm.StartTaskRequest, opts startTaskOptions) (*sonm.StartTaskReply, error) {
+	member := opts.group
+
+	for _, registry := range []*sonm.Registry{request.GetSpec().GetRegistry(), request.GetSpec().GetPushRegistry()} {
+		if name := registry.GetCredential(); len(name) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "registry credential %s must be resolved by the node", name)
+		}
+	}
+
 	allowed, ref, err := m.taskAllowed(ctx, request)
 	if err != nil {
 		return nil, err
@@ -1187,6 +1517,17 @@ func (m *Worker) StartTask(ctx context.Context, request *sonm.StartTaskRequest)
 		return nil, status.Errorf(codes.Internal, "could not normalize GPU resources: %s", err)
 	}
 
+	if !spec.GetContainer().GetEgress().IsEmpty() {
+		if len(m.cfg.Network.RemoteQOS) > 0 {
+			m.setStatus(&sonm.TaskStatusReply{Status: sonm.TaskStatusReply_BROKEN}, taskID)
+			return nil, status.Errorf(codes.FailedPrecondition, "egress policies are not supported with remote QOS")
+		}
+		if len(member.networkContainer) > 0 {
+			m.setStatus(&sonm.TaskStatusReply{Status: sonm.TaskStatusReply_BROKEN}, taskID)
+			return nil, status.Errorf(codes.InvalidArgument, "tasks of the group share the network, so only the first one can specify egress policy")
+		}
+	}
+
 	//TODO: generate ID
 	if err := m.resources.ConsumeTask(ask.ID, taskID, spec.Resources); err != nil {
 		return nil, fmt.Errorf("could not start task: %s", err)
@@ -1215,34 +1556,78 @@ func (m *Worker) StartTask(ctx context.Context, request *sonm.StartTaskRequest)
 		return nil, status.Errorf(codes.Internal, "failed to fetch GPU IDs: %s", err)
 	}
 
+	incoming := ask.GetResources().GetNetwork().GetNetFlags().GetIncoming()
 	if len(spec.GetContainer().GetExpose()) > 0 {
-		if !ask.GetResources().GetNetwork().GetNetFlags().GetIncoming() {
+		// Ports can still be published through NPP without public IPs.
+		if !incoming && m.portForwarder == nil {
 			m.setStatus(&sonm.TaskStatusReply{Status: sonm.TaskStatusReply_BROKEN}, taskID)
 			return nil, fmt.Errorf("incoming network is required due to explicit `expose` settings, but not allowed for `%s` deal", dealID.Unwrap())
 		}
 	}
 
 	var d = Description{
-		Container:      *request.Spec.Container,
-		Reference:      ref,
-		Auth:           spec.Registry.Auth(),
-		CGroupParent:   cgroup.Suffix(),
-		Resources:      spec.Resources,
-		DealId:         request.GetDealID().Unwrap().String(),
-		TaskId:         taskID,
-		GPUDevices:     gpuids,
-		mounts:         mounts,
-		NetworkOptions: network,
-		NetworkSpecs:   networks,
+		Container:        *request.Spec.Container,
+		Reference:        ref,
+		Auth:             spec.Registry.Auth(),
+		PushAuth:         spec.PushAuth(),
+		CGroupParent:     cgroup.Suffix(),
+		Resources:        spec.Resources,
+		DealId:           request.GetDealID().Unwrap().String(),
+		TaskId:           taskID,
+		GPUDevices:       gpuids,
+		mounts:           mounts,
+		NetworkOptions:   network,
+		NetworkSpecs:     networks,
+		GroupId:          member.groupID,
+		GroupIndex:       member.index,
+		NetworkContainer: member.networkContainer,
+		Restore:          opts.restore,
+		// Without incoming network exposed ports must be reachable
+		// through the port forwarding service only.
+		LoopbackPorts: !incoming,
+	}
+
+	if len(member.volumes) > 0 {
+		volumes := make(map[string]*sonm.Volume, len(d.Container.Volumes)+len(member.volumes))
+		for name, v := range d.Container.Volumes {
+			volumes[name] = v
+		}
+		for name, v := range member.volumes {
+			volumes[name] = v
+			d.SharedVolumes = append(d.SharedVolumes, name)
+		}
+		d.Container.Volumes = volumes
 	}
 
 	// TODO: Detect whether it's the first time allocation. If so - release resources on error.
 
 	m.setStatus(&sonm.TaskStatusReply{Status: sonm.TaskStatusReply_SPOOLING}, taskID)
+	// The record allows to clean up the task when the worker is stopped
+	// before the task is started.
+	m.saveTaskRecord(taskID, &taskRecord{
+		Description: d,
+		Cinfo: ContainerInfo{
+			TaskId:     taskID,
+			DealID:     dealID,
+			AskID:      ask.ID,
+			GroupID:    member.groupID,
+			GroupIndex: member.index,
+		},
+		Spec:   *spec,
+		Status: sonm.TaskStatusReply_SPOOLING,
+	})
+
+	// The image must not be collected until the container is created.
+	releaseImage := m.images.Use(d.Reference, time.Now())
+	defer releaseImage()
+
 	log.G(m.ctx).Info("spooling an image")
-	if err := m.ovs.Spool(ctx, d); err != nil {
+	if err := m.ovs.Spool(ctx, d, func(progress xdocker.PullProgress) {
+		m.setSpoolProgress(taskID, progress)
+	}); err != nil {
 		log.G(ctx).Error("failed to Spool an image", zap.Error(err))
 		m.setStatus(&sonm.TaskStatusReply{Status: sonm.TaskStatusReply_BROKEN}, taskID)
+		m.dropTaskRecord(taskID)
 		return nil, status.Errorf(codes.Internal, "failed to Spool %v", err)
 	}
 	log.G(m.ctx).Info("spooled an image")
@@ -1253,6 +1638,7 @@ func (m *Worker) StartTask(ctx context.Context, request *sonm.StartTaskRequest)
 	if err != nil {
 		log.G(ctx).Error("failed to spawn an image", zap.Error(err))
 		m.setStatus(&sonm.TaskStatusReply{Status: sonm.TaskStatusReply_BROKEN}, taskID)
+		m.dropTaskRecord(taskID)
 		return nil, status.Errorf(codes.Internal, "failed to Spawn %v", err)
 	}
 
@@ -1264,6 +1650,8 @@ func (m *Worker) StartTask(ctx context.Context, request *sonm.StartTaskRequest)
 	containerInfo.Tag = request.GetSpec().GetTag()
 	containerInfo.TaskId = taskID
 	containerInfo.AskID = ask.ID
+	containerInfo.GroupID = member.groupID
+	containerInfo.GroupIndex = member.index
 
 	var reply = sonm.StartTaskReply{
 		Id:         taskID,
@@ -1271,6 +1659,11 @@ func (m *Worker) StartTask(ctx context.Context, request *sonm.StartTaskRequest)
 		NetworkIDs: containerInfo.NetworkIDs,
 	}
 
+	if m.portForwarder != nil {
+		containerInfo.ForwardAddr = m.portForwarder.Addr().Hex()
+		containerInfo.ForwardedPorts = map[nat.Port]string{}
+	}
+
 	for internalPort, portBindings := range containerInfo.Ports {
 		if len(portBindings) < 1 {
 			continue
@@ -1279,6 +1672,10 @@ func (m *Worker) StartTask(ctx context.Context, request *sonm.StartTaskRequest)
 		var socketAddrs []*sonm.SocketAddr
 		var pubPortBindings []nat.PortBinding
 
+		if m.portForwarder != nil && internalPort.Proto() == "tcp" {
+			containerInfo.ForwardedPorts[internalPort] = forwardTarget(portBindings[0])
+		}
+
 		for _, portBinding := range portBindings {
 			hostPort := portBinding.HostPort
 			hostPortInt, err := nat.ParsePort(hostPort)
@@ -1287,21 +1684,33 @@ func (m *Worker) StartTask(ctx context.Context, request *sonm.StartTaskRequest)
 				return nil, err
 			}
 
-			for _, publicIP := range m.publicIPs {
+			if !incoming {
+				continue
+			}
+
+			for _, publicIP := range bindingPublicIPs(m.publicIPs, portBinding.HostIP) {
+				pubPortBinding := nat.PortBinding{HostIP: publicIP, HostPort: hostPort}
+				// Docker may report the same port bound on unspecified
+				// addresses of both IP families.
+				if containsPortBinding(pubPortBindings, pubPortBinding) {
+					continue
+				}
+
 				socketAddrs = append(socketAddrs, &sonm.SocketAddr{
 					Addr: publicIP,
 					Port: uint32(hostPortInt),
 				})
 
-				pubPortBindings = append(pubPortBindings, nat.PortBinding{HostIP: publicIP, HostPort: hostPort})
+				pubPortBindings = append(pubPortBindings, pubPortBinding)
 			}
 		}
 
 		containerInfo.Ports[internalPort] = pubPortBindings
-
 		reply.PortMap[string(internalPort)] = &sonm.Endpoints{Endpoints: socketAddrs}
 	}
 
+	reply.PortForwarding = containerInfo.portForwarding()
+
 	m.saveContainerInfo(taskID, containerInfo, d, *spec)
 
 	go m.listenForStatus(statusListener, taskID)
@@ -1318,80 +1727,376 @@ func (m *Worker) StartTask(ctx context.Context, request *sonm.StartTaskRequest)
 	return &reply, nil
 }
 
-// StopTask request forces to kill container
-func (m *Worker) StopTask(ctx context.Context, request *sonm.ID) (*sonm.Empty, error) {
-	m.mu.Lock()
-	containerInfo, ok := m.containers[request.Id]
-	m.mu.Unlock()
+// StartTaskGroup starts all tasks of the group in order. If any of them fails
+// to start, already started ones are stopped.
+func (m *Worker) StartTaskGroup(ctx context.Context, request *sonm.StartTaskGroupRequest) (*sonm.StartTaskGroupReply, error) {
+	groupID := uuid.New()
+	log.G(ctx).Info("starting task group", zap.String("group_id", groupID))
 
-	if !ok {
-		return nil, status.Errorf(codes.NotFound, "no job with id %s", request.Id)
+	tasks := request.GetSpec().GetTasks()
+	reply := &sonm.StartTaskGroupReply{
+		Id:    groupID,
+		Tasks: make([]*sonm.StartTaskReply, 0, len(tasks)),
 	}
 
-	if err := m.stopTask(ctx, containerInfo.ID); err != nil {
-		log.G(ctx).Error("failed to Stop container", zap.Error(err))
-		return nil, err
+	member := taskGroupMember{
+		groupID: groupID,
+		volumes: request.GetSpec().GetVolumes(),
 	}
 
-	return &sonm.Empty{}, nil
-}
-
-func (m *Worker) stopTask(ctx context.Context, id string) error {
-	if err := m.ovs.Stop(ctx, id); err != nil {
-		m.setStatus(&sonm.TaskStatusReply{Status: sonm.TaskStatusReply_BROKEN}, id)
-		return status.Errorf(codes.Internal, "failed to stop container %v", err)
-	}
+	for id, spec := range tasks {
+		member.index = id
 
-	m.setStatus(&sonm.TaskStatusReply{Status: sonm.TaskStatusReply_FINISHED}, id)
-	return nil
-}
+		taskReply, err := m.startTask(ctx, &sonm.StartTaskRequest{DealID: request.GetDealID(), Spec: spec}, startTaskOptions{group: member})
+		if err != nil {
+			m.abortTaskGroup(ctx, groupID)
+			return nil, fmt.Errorf("failed to start task #%d of the group: %v", id, err)
+		}
 
-func (m *Worker) PurgeTasks(ctx context.Context, request *sonm.PurgeTasksRequest) (*sonm.ErrorByStringID, error) {
-	var toDelete []*ContainerInfo
+		reply.Tasks = append(reply.Tasks, taskReply)
 
-	m.mu.Lock()
-	for _, task := range m.containers {
-		if task.DealID.Cmp(request.GetDealID()) == 0 && task.status == sonm.TaskStatusReply_RUNNING {
-			toDelete = append(toDelete, task)
+		if id == 0 {
+			info, ok := m.GetContainerInfo(taskReply.GetId())
+			if !ok {
+				m.abortTaskGroup(ctx, groupID)
+				return nil, status.Errorf(codes.Internal, "task %s has disappeared while starting the group", taskReply.GetId())
+			}
+			member.networkContainer = info.ID
 		}
-	}
-	m.mu.Unlock()
 
-	errs := &sonm.ErrorByStringID{Response: []*sonm.ErrorByStringID_Item{}}
-	for _, task := range toDelete {
-		item := &sonm.ErrorByStringID_Item{ID: task.TaskId}
-		if err := m.stopTask(ctx, task.ID); err != nil {
-			item.Error = err.Error()
+		if spec.GetContainer().GetHealthcheck() != nil && id < len(tasks)-1 {
+			if err := m.waitTaskHealthy(ctx, taskReply.GetId()); err != nil {
+				m.abortTaskGroup(ctx, groupID)
+				return nil, fmt.Errorf("task #%d of the group has not become healthy: %v", id, err)
+			}
 		}
-		errs.Response = append(errs.Response, item)
 	}
 
-	return errs, nil
+	return reply, nil
 }
 
-func (m *Worker) Tasks(ctx context.Context, request *sonm.Empty) (*sonm.TaskListReply, error) {
-	return &sonm.TaskListReply{Info: m.CollectTasksStatuses()}, nil
+func (m *Worker) abortTaskGroup(ctx context.Context, groupID string) {
+	if err := m.stopTaskGroup(ctx, groupID); err != nil {
+		log.G(ctx).Warn("failed to stop partially started task group", zap.String("group_id", groupID), zap.Error(err))
+	}
 }
 
-func (m *Worker) CollectTasksStatuses(statuses ...sonm.TaskStatusReply_Status) map[string]*sonm.TaskStatusReply {
-	result := map[string]*sonm.TaskStatusReply{}
-	m.mu.Lock()
-	defer m.mu.Unlock()
+// waitTaskHealthy blocks until the task with the given ID becomes healthy.
+func (m *Worker) waitTaskHealthy(ctx context.Context, id string) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
 
-	for id, info := range m.containers {
-		if len(statuses) > 0 {
-			for _, s := range statuses {
-				if s == info.status {
-					result[id] = info.IntoProto(m.ctx)
-					break
-				}
-			}
-		} else {
-			result[id] = info.IntoProto(m.ctx)
+	for {
+		m.mu.Lock()
+		info, ok := m.containers[id]
+		var taskStatus sonm.TaskStatusReply_Status
+		if ok {
+			taskStatus = info.status
 		}
-	}
-	return result
-}
+		m.mu.Unlock()
+
+		switch {
+		case !ok:
+			return fmt.Errorf("no task with id %s", id)
+		case taskStatus == sonm.TaskStatusReply_HEALTHY:
+			return nil
+		case taskStatus == sonm.TaskStatusReply_UNHEALTHY, sonm.IsTaskStatusTerminated(taskStatus):
+			return fmt.Errorf("task is %s", taskStatus)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// StopTaskGroup stops all running tasks of the group in the reverse order.
+func (m *Worker) StopTaskGroup(ctx context.Context, request *sonm.ID) (*sonm.Empty, error) {
+	if len(m.taskGroup(request.GetId())) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no task group with id %s", request.GetId())
+	}
+
+	if err := m.stopTaskGroup(ctx, request.GetId()); err != nil {
+		log.G(ctx).Error("failed to stop task group", zap.Error(err))
+		return nil, err
+	}
+
+	return &sonm.Empty{}, nil
+}
+
+func (m *Worker) stopTaskGroup(ctx context.Context, groupID string) error {
+	tasks := m.taskGroup(groupID)
+
+	result := multierror.NewMultiError()
+	for id := len(tasks) - 1; id >= 0; id-- {
+		if !sonm.IsTaskStatusRunning(tasks[id].status) {
+			continue
+		}
+
+		if err := m.stopTask(ctx, tasks[id]); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func (m *Worker) TaskGroupStatus(ctx context.Context, request *sonm.ID) (*sonm.TaskGroupStatusReply, error) {
+	tasks := m.taskGroup(request.GetId())
+	if len(tasks) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no task group with id %s", request.GetId())
+	}
+
+	reply := &sonm.TaskGroupStatusReply{
+		TaskIDs: make([]string, 0, len(tasks)),
+		Tasks:   make(map[string]*sonm.TaskStatusReply, len(tasks)),
+	}
+
+	statuses := make([]sonm.TaskStatusReply_Status, 0, len(tasks))
+
+	m.mu.Lock()
+	for _, task := range tasks {
+		reply.TaskIDs = append(reply.TaskIDs, task.TaskId)
+		reply.Tasks[task.TaskId] = task.IntoProto(m.ctx)
+		statuses = append(statuses, task.status)
+	}
+	m.mu.Unlock()
+
+	reply.Status = taskGroupStatus(statuses...)
+
+	return reply, nil
+}
+
+// DealVolumes returns local volumes of the deal, that persist between its
+// tasks.
+func (m *Worker) DealVolumes(ctx context.Context, request *sonm.ID) (*sonm.DealVolumesReply, error) {
+	dealID, err := sonm.NewBigIntFromString(request.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid deal ID: %v", err)
+	}
+
+	volumes, err := m.plugins.DealVolumes(dealID.Unwrap().String())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get deal volumes: %v", err)
+	}
+
+	reply := &sonm.DealVolumesReply{
+		Volumes: make([]*sonm.DealVolume, 0, len(volumes)),
+	}
+	for _, v := range volumes {
+		reply.Volumes = append(reply.Volumes, &sonm.DealVolume{
+			Name: v.Name,
+			Size: v.Size,
+		})
+	}
+
+	if plan, err := m.salesman.AskPlanByDeal(dealID); err == nil {
+		reply.Quota = plan.GetResources().GetStorage().GetSize().GetBytes()
+	}
+
+	return reply, nil
+}
+
+// NotifyDealTasks sends SIGTERM to tasks of the deal, so they can finish
+// gracefully before the deal is closed. Called by the salesman when the
+// worker is drained.
+func (m *Worker) NotifyDealTasks(ctx context.Context, dealID *sonm.BigInt) error {
+	log.S(ctx).Debugf("notifying deal's %s tasks", dealID)
+	var containerIDs []string
+
+	m.mu.Lock()
+	for _, container := range m.containers {
+		if container.DealID.Cmp(dealID) == 0 {
+			containerIDs = append(containerIDs, container.ID)
+		}
+	}
+	m.mu.Unlock()
+
+	result := multierror.NewMultiError()
+	for _, id := range containerIDs {
+		if err := m.ovs.Terminate(ctx, id); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+	return result.ErrorOrNil()
+}
+
+// RemoveDealVolumes destroys local volumes of the deal. Called by the
+// salesman when the deal is closed.
+func (m *Worker) RemoveDealVolumes(ctx context.Context, dealID *sonm.BigInt) error {
+	log.S(ctx).Debugf("removing deal's %s volumes", dealID)
+	return m.plugins.RemoveDealVolumes(dealID.Unwrap().String())
+}
+
+// taskGroup returns tasks of the given group ordered by their start order.
+func (m *Worker) taskGroup(groupID string) []*ContainerInfo {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var tasks []*ContainerInfo
+	for _, info := range m.containers {
+		if len(groupID) > 0 && info.GroupID == groupID {
+			tasks = append(tasks, info)
+		}
+	}
+
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].GroupIndex < tasks[j].GroupIndex
+	})
+
+	return tasks
+}
+
+// taskGroupStatus aggregates statuses of tasks in a group.
+//
+// A group is broken if any of its tasks is broken, unhealthy if any of its
+// tasks is unhealthy or restarting and healthy only if all of its running tasks are healthy.
+func taskGroupStatus(statuses ...sonm.TaskStatusReply_Status) sonm.TaskStatusReply_Status {
+	if len(statuses) == 0 {
+		return sonm.TaskStatusReply_UNKNOWN
+	}
+
+	var broken, unhealthy, spawning bool
+	var running, healthy int
+	for _, s := range statuses {
+		switch s {
+		case sonm.TaskStatusReply_BROKEN, sonm.TaskStatusReply_KILLED_OOM:
+			broken = true
+		case sonm.TaskStatusReply_UNHEALTHY, sonm.TaskStatusReply_RESTARTING:
+			unhealthy = true
+			running++
+		case sonm.TaskStatusReply_UNKNOWN, sonm.TaskStatusReply_SPOOLING, sonm.TaskStatusReply_SPAWNING:
+			spawning = true
+		case sonm.TaskStatusReply_HEALTHY:
+			healthy++
+			running++
+		case sonm.TaskStatusReply_RUNNING:
+			running++
+		}
+	}
+
+	switch {
+	case broken:
+		return sonm.TaskStatusReply_BROKEN
+	case unhealthy:
+		return sonm.TaskStatusReply_UNHEALTHY
+	case spawning:
+		return sonm.TaskStatusReply_SPAWNING
+	case running == 0:
+		return sonm.TaskStatusReply_FINISHED
+	case running == healthy:
+		return sonm.TaskStatusReply_HEALTHY
+	default:
+		return sonm.TaskStatusReply_RUNNING
+	}
+}
+
+func (m *Worker) taskGroupDealID(groupID string) (*sonm.BigInt, error) {
+	tasks := m.taskGroup(groupID)
+	if len(tasks) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no task group with id %s", groupID)
+	}
+
+	return tasks[0].DealID, nil
+}
+
+// StopTask request forces to kill container
+func (m *Worker) StopTask(ctx context.Context, request *sonm.ID) (*sonm.Empty, error) {
+	m.mu.Lock()
+	containerInfo, ok := m.containers[request.Id]
+	m.mu.Unlock()
+
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no job with id %s", request.Id)
+	}
+
+	if err := m.stopTask(ctx, containerInfo); err != nil {
+		log.G(ctx).Error("failed to Stop container", zap.Error(err))
+		return nil, err
+	}
+
+	return &sonm.Empty{}, nil
+}
+
+func (m *Worker) stopTask(ctx context.Context, container *ContainerInfo) error {
+	id := container.ID
+	if err := m.ovs.Stop(ctx, id); err != nil {
+		m.setStatus(&sonm.TaskStatusReply{Status: sonm.TaskStatusReply_BROKEN}, id)
+		return status.Errorf(codes.Internal, "failed to stop container %v", err)
+	}
+
+	// Logs are archived as soon as the task is stopped, so they are kept
+	// even if the container is gone by the time the deal is finished.
+	m.archiveTaskLogs(ctx, container)
+
+	m.setStatus(&sonm.TaskStatusReply{Status: sonm.TaskStatusReply_FINISHED}, id)
+	return nil
+}
+
+func (m *Worker) PurgeTasks(ctx context.Context, request *sonm.PurgeTasksRequest) (*sonm.ErrorByStringID, error) {
+	var toDelete []*ContainerInfo
+
+	m.mu.Lock()
+	for _, task := range m.containers {
+		if task.DealID.Cmp(request.GetDealID()) == 0 && sonm.IsTaskStatusRunning(task.status) {
+			toDelete = append(toDelete, task)
+		}
+	}
+	m.mu.Unlock()
+
+	errs := &sonm.ErrorByStringID{Response: []*sonm.ErrorByStringID_Item{}}
+	for _, task := range toDelete {
+		item := &sonm.ErrorByStringID_Item{ID: task.TaskId}
+		if err := m.stopTask(ctx, task); err != nil {
+			item.Error = err.Error()
+		}
+		errs.Response = append(errs.Response, item)
+	}
+
+	return errs, nil
+}
+
+func (m *Worker) Tasks(ctx context.Context, request *sonm.Empty) (*sonm.TaskListReply, error) {
+	return &sonm.TaskListReply{Info: m.CollectTasksStatuses()}, nil
+}
+
+func (m *Worker) CollectTasksStatuses(statuses ...sonm.TaskStatusReply_Status) map[string]*sonm.TaskStatusReply {
+	result := map[string]*sonm.TaskStatusReply{}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, info := range m.containers {
+		if len(statuses) > 0 {
+			for _, s := range statuses {
+				if s == info.status {
+					result[id] = info.IntoProto(m.ctx)
+					break
+				}
+			}
+		} else {
+			result[id] = info.IntoProto(m.ctx)
+		}
+	}
+	return result
+}
+
+// runningTaskCount returns the number of running tasks, including ones being
+// restarted.
+func (m *Worker) runningTaskCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	count := 0
+	for _, info := range m.containers {
+		if sonm.IsTaskStatusRunning(info.status) {
+			count++
+		}
+	}
+
+	return count
+}
 
 // TaskLogs returns logs from container
 func (m *Worker) TaskLogs(request *sonm.TaskLogsRequest, server sonm.Worker_TaskLogsServer) error {
@@ -1400,7 +2105,13 @@ func (m *Worker) TaskLogs(request *sonm.TaskLogsRequest, server sonm.Worker_Task
 	}
 	containerInfo, ok := m.GetContainerInfo(request.Id)
 	if !ok {
-		return status.Errorf(codes.NotFound, "no job with id %s", request.Id)
+		reader, err := m.logs.Open(request.Id, request, time.Now())
+		if err != nil {
+			return status.Errorf(codes.NotFound, "no job with id %s", request.Id)
+		}
+		defer reader.Close()
+
+		return sendTaskLogs(server, reader)
 	}
 	opts := types.ContainerLogsOptions{
 		ShowStdout: request.Type == sonm.TaskLogsRequest_STDOUT || request.Type == sonm.TaskLogsRequest_BOTH,
@@ -1416,6 +2127,11 @@ func (m *Worker) TaskLogs(request *sonm.TaskLogsRequest, server sonm.Worker_Task
 		return err
 	}
 	defer reader.Close()
+
+	return sendTaskLogs(server, reader)
+}
+
+func sendTaskLogs(server sonm.Worker_TaskLogsServer, reader io.Reader) error {
 	buffer := make([]byte, 100*1024)
 	for {
 		readCnt, err := reader.Read(buffer)
@@ -1431,6 +2147,229 @@ func (m *Worker) TaskLogs(request *sonm.TaskLogsRequest, server sonm.Worker_Task
 	}
 }
 
+// TaskMetrics returns the resource usage time series of the task.
+func (m *Worker) TaskMetrics(ctx context.Context, request *sonm.TaskMetricsRequest) (*sonm.TaskMetricsReply, error) {
+	containerInfo, ok := m.GetContainerInfo(request.GetId())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no task with id %s", request.GetId())
+	}
+
+	var from time.Time
+	if request.GetFrom() != nil {
+		from = request.GetFrom().Unix()
+	}
+	to := time.Now()
+	if request.GetTo() != nil {
+		to = request.GetTo().Unix()
+	}
+
+	samples, err := m.ovs.TaskMetrics(containerInfo.ID, from, to, request.GetStep().Unwrap())
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get task metrics: %v", err)
+	}
+
+	return &sonm.TaskMetricsReply{Samples: samples}, nil
+}
+
+// ExecTask executes a command inside the running task, forwarding its
+// standard streams and the exit code.
+func (m *Worker) ExecTask(stream sonm.Worker_ExecTaskServer) error {
+	request, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if err := m.eventAuthorization.Authorize(stream.Context(), auth.Event(taskAPIPrefix+"ExecTask"), request); err != nil {
+		return err
+	}
+	if len(request.GetCmd()) == 0 {
+		return status.Errorf(codes.InvalidArgument, "command to execute is required")
+	}
+
+	containerInfo, ok := m.GetContainerInfo(request.GetId())
+	if !ok {
+		return status.Errorf(codes.NotFound, "no task with id %s", request.GetId())
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	log.S(ctx).Infof("executing %v in task %s", request.GetCmd(), request.GetId())
+
+	windows := make(chan sshd.Window, 1)
+	session, err := m.ovs.ExecSession(ctx, containerInfo.ID, request.GetCmd(), request.GetEnv(), request.GetTty(), windows)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to execute command: %v", err)
+	}
+	defer session.Close()
+
+	go forwardExecInput(ctx, stream, request, session, windows)
+
+	stdout := &execReplyWriter{stream: stream}
+	if request.GetTty() {
+		_, err = io.Copy(stdout, session.Reader)
+	} else {
+		_, err = stdcopy.StdCopy(stdout, &execReplyWriter{stream: stream, stderr: true}, session.Reader)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "I/O error during command execution: %v", err)
+	}
+
+	exitCode, err := session.ExitCode(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get command exit code: %v", err)
+	}
+
+	return stream.Send(&sonm.TaskExecReply{Exited: true, ExitCode: int32(exitCode)})
+}
+
+// forwardExecInput forwards stdin data and terminal resizes from the exec
+// stream to the command, starting with the given first request.
+func forwardExecInput(ctx context.Context, stream sonm.Worker_ExecTaskServer, request *sonm.TaskExecRequest, session *ExecSession, windows chan<- sshd.Window) {
+	defer close(windows)
+
+	for {
+		if len(request.GetStdin()) > 0 {
+			if _, err := session.Conn.Write(request.GetStdin()); err != nil {
+				log.S(ctx).Warnf("failed to write command stdin: %v", err)
+				return
+			}
+		}
+		if request.GetCloseStdin() {
+			session.CloseWrite()
+		}
+		if window := request.GetWindow(); window != nil {
+			select {
+			case windows <- sshd.Window{Width: int(window.GetWidth()), Height: int(window.GetHeight())}:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		var err error
+		request, err = stream.Recv()
+		if err == io.EOF {
+			session.CloseWrite()
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// execReplyWriter sends everything written as either stdout or stderr
+// exec replies.
+type execReplyWriter struct {
+	stream sonm.Worker_ExecTaskServer
+	stderr bool
+}
+
+func (m *execReplyWriter) Write(p []byte) (int, error) {
+	reply := &sonm.TaskExecReply{Stdout: p}
+	if m.stderr {
+		reply = &sonm.TaskExecReply{Stderr: p}
+	}
+
+	if err := m.stream.Send(reply); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// CopyToTask extracts a tar archive into the directory of the running task.
+// The archive size is limited by the space left in the task storage quota.
+func (m *Worker) CopyToTask(stream sonm.Worker_CopyToTaskServer) error {
+	request, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if err := m.eventAuthorization.Authorize(stream.Context(), auth.Event(taskAPIPrefix+"CopyToTask"), request); err != nil {
+		return err
+	}
+	if !path.IsAbs(request.GetPath()) {
+		return status.Errorf(codes.InvalidArgument, "absolute destination path is required")
+	}
+
+	containerInfo, ok := m.GetContainerInfo(request.GetId())
+	if !ok {
+		return status.Errorf(codes.NotFound, "no task with id %s", request.GetId())
+	}
+
+	resources, err := m.resources.ResourceByTask(request.GetId())
+	if err != nil {
+		return status.Errorf(codes.NotFound, "cannot get resources for task %s: %v", request.GetId(), err)
+	}
+
+	// Archives are extracted into the writable layer, hence only the space
+	// left in the quota is available.
+	limit := resources.GetStorage().GetSize().GetBytes()
+	if limit > 0 {
+		usage, err := m.ovs.StorageUsage(stream.Context(), containerInfo.ID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get storage usage of task %s: %v", request.GetId(), err)
+		}
+		if usage >= limit {
+			return status.Errorf(codes.ResourceExhausted, "task %s has used up its storage quota of %d bytes", request.GetId(), limit)
+		}
+		limit -= usage
+	}
+
+	log.S(stream.Context()).Infof("copying archive to %s of task %s", request.GetPath(), request.GetId())
+
+	archive := newCopyChunkReader(stream, request.GetChunk(), limit)
+	if err := m.ovs.CopyTo(stream.Context(), containerInfo.ID, request.GetPath(), archive); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Errorf(codes.Internal, "failed to copy archive: %v", err)
+	}
+
+	return nil
+}
+
+// CopyFromTask streams a tar archive of the path inside the task.
+func (m *Worker) CopyFromTask(request *sonm.TaskCopyFromRequest, stream sonm.Worker_CopyFromTaskServer) error {
+	if err := m.eventAuthorization.Authorize(stream.Context(), auth.Event(taskAPIPrefix+"CopyFromTask"), request); err != nil {
+		return err
+	}
+	if !path.IsAbs(request.GetPath()) {
+		return status.Errorf(codes.InvalidArgument, "absolute source path is required")
+	}
+
+	containerInfo, ok := m.GetContainerInfo(request.GetId())
+	if !ok {
+		return status.Errorf(codes.NotFound, "no task with id %s", request.GetId())
+	}
+
+	log.S(stream.Context()).Infof("copying %s from task %s", request.GetPath(), request.GetId())
+
+	rd, err := m.ovs.CopyFrom(stream.Context(), containerInfo.ID, request.GetPath())
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to copy %s: %v", request.GetPath(), err)
+	}
+	defer rd.Close()
+
+	buf := make([]byte, 1*1024*1024)
+	for {
+		n, err := rd.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&sonm.Chunk{Chunk: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
 //TODO: proper request
 func (m *Worker) JoinNetwork(ctx context.Context, request *sonm.WorkerJoinNetworkRequest) (*sonm.NetworkSpec, error) {
 	spec, err := m.plugins.JoinNetwork(request.NetworkID)
@@ -1455,8 +2394,9 @@ func (m *Worker) TaskStatus(ctx context.Context, req *sonm.ID) (*sonm.TaskStatus
 
 	var metric ContainerMetrics
 	var resources *sonm.AskPlanResources
+	var healthLog []*sonm.TaskHealthProbe
 	// If a container has been stoped, ovs.Info has no metrics for such container
-	if info.status == sonm.TaskStatusReply_RUNNING {
+	if sonm.IsTaskStatusRunning(info.status) {
 		metrics, err := m.ovs.Info(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "cannot get container metrics: %s", err.Error())
@@ -1471,11 +2411,19 @@ func (m *Worker) TaskStatus(ctx context.Context, req *sonm.ID) (*sonm.TaskStatus
 		if err != nil {
 			return nil, status.Errorf(codes.NotFound, "cannot get resources for container %s", req.GetId())
 		}
+
+		healthLog, err = m.ovs.HealthLog(ctx, info.ID)
+		if err != nil {
+			log.G(ctx).Warn("failed to get container health log", zap.String("id", info.ID), zap.Error(err))
+		}
 	}
 
 	reply := info.IntoProto(m.ctx)
 	reply.Usage = metric.Marshal()
 	reply.AllocatedResources = resources
+	reply.HealthLog = healthLog
+	reply.LastCheckpoint = m.ovs.LastCheckpoint(info.ID)
+	reply.Restarts, reply.LastExit = m.ovs.Restarts(info.ID)
 
 	return reply, nil
 }
@@ -1524,6 +2472,8 @@ func (m *Worker) setupSalesman() error {
 		salesman.WithConfig(&m.cfg.Salesman),
 		salesman.WithNetworkConfig(m.cfg.Network),
 		salesman.WithDealDestroyer(m),
+		salesman.WithGPUHealth(m.gpuHealth),
+		salesman.WithDWH(m.dwh),
 	)
 	if err != nil {
 		return err
@@ -1542,76 +2492,111 @@ func (m *Worker) setupRunningContainers() error {
 	// Overseer maintains it's own instance of docker.Client
 	defer dockerClient.Close()
 
+	return m.restoreTasks(dockerClient)
+}
+
+// restoreTasks reconciles task records with containers labelled by the
+// worker, restoring tasks and removing what is left of unknown ones.
+func (m *Worker) restoreTasks(dockerClient client.APIClient) error {
 	containers, err := dockerClient.ContainerList(m.ctx, types.ContainerListOptions{All: true})
 	if err != nil {
 		return err
 	}
 
-	var closedDeals = map[string]*sonm.Deal{}
-	for _, container := range containers {
-		var info runningContainerInfo
+	records, err := m.tasks.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load task records: %v", err)
+	}
+
+	// Consumers of tasks interrupted while starting have never received
+	// their IDs, so such tasks are dropped along with their containers.
+	interrupted := map[string]*taskRecord{}
+	for taskID, record := range records {
+		if record.interrupted() {
+			interrupted[taskID] = record
+			delete(records, taskID)
+		}
+	}
 
-		if _, ok := container.Labels[overseerTag]; ok {
-			loaded, err := m.storage.Load(container.ID, &info)
+	recorded := map[string]bool{}
+	for _, record := range records {
+		recorded[record.Cinfo.ID] = true
+	}
 
+	found := map[string]bool{}
+	for _, container := range containers {
+		if _, ok := container.Labels[overseerTag]; !ok {
+			continue
+		}
+
+		if !recorded[container.ID] {
+			record, err := m.migrateContainerInfo(container.ID)
 			if err != nil {
 				log.S(m.ctx).Warnf("failed to load running container info %s", err)
 				continue
 			}
-			if !loaded {
-				log.S(m.ctx).Warnf("loaded an empty container info")
-				continue
-			}
 
-			contJson, err := dockerClient.ContainerInspect(m.ctx, container.ID)
-			if err != nil {
-				log.S(m.ctx).Error("failed to inspect container", zap.String("id", container.ID), zap.Error(err))
-				return err
+			if record == nil {
+				if taskID, ok := container.Labels[taskIDTag]; ok {
+					log.G(m.ctx).Info("removing container of the unknown task",
+						zap.String("id", container.ID), zap.String("task_id", taskID))
+					if err := dockerClient.ContainerRemove(m.ctx, container.ID, types.ContainerRemoveOptions{Force: true}); err != nil {
+						log.S(m.ctx).Warnf("failed to remove container %s: %v", container.ID, err)
+					}
+				} else {
+					log.S(m.ctx).Warnf("loaded an empty container info")
+				}
+				continue
 			}
 
-			bigDealID, ok := big.NewInt(0).SetString(info.Description.DealId, 10)
-			if !ok {
-				return fmt.Errorf("failed to parse container's DealID (%s)", info.Description.DealId)
-			}
+			records[record.Cinfo.TaskId] = record
+		}
 
-			deal, err := m.eth.Market().GetDealInfo(m.ctx, bigDealID)
-			if err != nil {
-				return fmt.Errorf("failed to get deal %v status: %v", info.Description.DealId, err)
-			}
+		found[container.ID] = true
+	}
 
-			if deal.Status == sonm.DealStatus_DEAL_CLOSED {
-				log.G(m.ctx).Info("found task assigned to closed deal, going to cancel it",
-					zap.String("deal_id", info.Description.DealId), zap.String("task_id", info.Cinfo.TaskId))
-				closedDeals[deal.Id.Unwrap().String()] = deal
-			}
+	// Containers of interrupted tasks are removed above as unknown ones, so
+	// their networks are no longer in use.
+	for taskID, record := range interrupted {
+		log.G(m.ctx).Info("dropping task interrupted while starting", zap.String("task_id", taskID))
+		m.cleanupInterruptedTask(taskID, record)
+		if err := m.tasks.Remove(taskID); err != nil {
+			return fmt.Errorf("failed to remove record of task %s: %v", taskID, err)
+		}
+	}
 
-			// TODO: Match our proto status constants with docker's statuses
-			switch contJson.State.Status {
-			case "created", "paused", "restarting", "removing":
-				info.Cinfo.status = sonm.TaskStatusReply_UNKNOWN
-			case "running":
-				info.Cinfo.status = sonm.TaskStatusReply_RUNNING
-			case "exited":
-				info.Cinfo.status = sonm.TaskStatusReply_FINISHED
-			case "dead":
-				info.Cinfo.status = sonm.TaskStatusReply_BROKEN
-			}
+	var closedDeals = map[string]*sonm.Deal{}
+	for taskID, record := range records {
+		bigDealID, ok := big.NewInt(0).SetString(record.Description.DealId, 10)
+		if !ok {
+			return fmt.Errorf("failed to parse container's DealID (%s)", record.Description.DealId)
+		}
 
-			m.containers[info.Cinfo.TaskId] = &info.Cinfo
-			mounts := make([]volume.Mount, 0)
+		deal, err := m.eth.Market().GetDealInfo(m.ctx, bigDealID)
+		if err != nil {
+			return fmt.Errorf("failed to get deal %v status: %v", record.Description.DealId, err)
+		}
 
-			for _, spec := range info.Spec.Container.Mounts {
-				mount, err := volume.NewMount(spec)
-				if err != nil {
-					return err
+		if !found[record.Cinfo.ID] {
+			if deal.Status == sonm.DealStatus_DEAL_CLOSED {
+				if err := m.tasks.Remove(taskID); err != nil {
+					return fmt.Errorf("failed to remove record of task %s: %v", taskID, err)
 				}
-				mounts = append(mounts, mount)
+				continue
 			}
 
-			info.Description.mounts = mounts
+			m.restoreDetachedTask(taskID, record)
+			continue
+		}
+
+		if deal.Status == sonm.DealStatus_DEAL_CLOSED {
+			log.G(m.ctx).Info("found task assigned to closed deal, going to cancel it",
+				zap.String("deal_id", record.Description.DealId), zap.String("task_id", taskID))
+			closedDeals[deal.Id.Unwrap().String()] = deal
+		}
 
-			m.ovs.Attach(m.ctx, container.ID, info.Description)
-			m.resources.ConsumeTask(info.Cinfo.AskID, info.Cinfo.TaskId, info.Spec.Resources)
+		if err := m.restoreTask(dockerClient, taskID, record); err != nil {
+			return err
 		}
 	}
 
@@ -1624,6 +2609,129 @@ func (m *Worker) setupRunningContainers() error {
 	return nil
 }
 
+// migrateContainerInfo converts the container info kept by previous versions
+// of the worker into the task record. Returns nil if there is no info.
+func (m *Worker) migrateContainerInfo(containerID string) (*taskRecord, error) {
+	var data json.RawMessage
+	loaded, err := m.storage.Load(containerID, &data)
+	if err != nil || !loaded {
+		return nil, err
+	}
+
+	record, err := decodeTaskRecord(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.tasks.Put(record.Cinfo.TaskId, record); err != nil {
+		return nil, err
+	}
+	if _, err := m.storage.Remove(containerID); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// restoreTask attaches to the container of the task, updating its status
+// according to the container state.
+func (m *Worker) restoreTask(dockerClient client.APIClient, taskID string, record *taskRecord) error {
+	contJson, err := dockerClient.ContainerInspect(m.ctx, record.Cinfo.ID)
+	if err != nil {
+		log.G(m.ctx).Error("failed to inspect container", zap.String("id", record.Cinfo.ID), zap.Error(err))
+		return err
+	}
+
+	info := record.Cinfo
+	info.status = containerStatus(contJson.State, record.Spec.GetContainer().GetHealthcheck() != nil)
+	if err := m.tasks.SetStatus(taskID, info.status); err != nil {
+		log.S(m.ctx).Warnf("failed to save status of task %s: %v", taskID, err)
+	}
+
+	m.containers[taskID] = &info
+
+	mounts, err := recordMounts(record)
+	if err != nil {
+		return err
+	}
+
+	record.Description.mounts = mounts
+
+	// Resources are consumed before attaching, because the restored task may
+	// finish and release them right away.
+	m.resources.ConsumeTask(info.AskID, taskID, record.Spec.Resources)
+
+	// Containers of tasks that were live when the worker went down are
+	// restarted according to their restart policy if they have exited
+	// meanwhile, e.g. due to dockerd or host restart. Records of previous
+	// versions have no status, but their containers were restarted by Docker.
+	restart := sonm.IsTaskStatusRunning(record.Status) || record.Status == sonm.TaskStatusReply_UNKNOWN
+	statusListener, err := m.ovs.Attach(m.ctx, record.Cinfo.ID, record.Description, restart)
+	if err != nil {
+		log.S(m.ctx).Warnf("failed to attach to container %s: %v", record.Cinfo.ID, err)
+	} else {
+		go m.listenForStatus(statusListener, taskID)
+	}
+
+	return nil
+}
+
+// cleanupInterruptedTask releases networks and volumes, that may have been
+// set up for the container of the task interrupted while starting. GPUs and
+// published ports are bound to the container only, so they are released by
+// its removal. Failures are only logged, because the task is gone anyway.
+func (m *Worker) cleanupInterruptedTask(taskID string, record *taskRecord) {
+	mounts, err := recordMounts(record)
+	if err != nil {
+		log.S(m.ctx).Warnf("failed to parse mounts of interrupted task %s: %v", taskID, err)
+		return
+	}
+
+	d := record.Description
+	d.mounts = mounts
+
+	cleanup, err := m.plugins.GetStaleCleanup(m.ctx, &d)
+	if err != nil {
+		log.S(m.ctx).Warnf("failed to clean up interrupted task %s: %v", taskID, err)
+		return
+	}
+
+	if err := cleanup.Close(); err != nil {
+		log.S(m.ctx).Warnf("failed to clean up interrupted task %s: %v", taskID, err)
+	}
+}
+
+func recordMounts(record *taskRecord) ([]volume.Mount, error) {
+	mounts := make([]volume.Mount, 0)
+	for _, spec := range record.Spec.GetContainer().GetMounts() {
+		mount, err := volume.NewMount(spec)
+		if err != nil {
+			return nil, err
+		}
+		mounts = append(mounts, mount)
+	}
+
+	return mounts, nil
+}
+
+// restoreDetachedTask keeps the task whose container is gone, so its final
+// status is available until the deal is closed.
+func (m *Worker) restoreDetachedTask(taskID string, record *taskRecord) {
+	info := record.Cinfo
+	info.status = record.Status
+	info.detached = true
+
+	if !sonm.IsTaskStatusTerminated(info.status) {
+		log.G(m.ctx).Warn("container of the task is gone", zap.String("task_id", taskID), zap.String("id", info.ID))
+		info.status = sonm.TaskStatusReply_BROKEN
+		if err := m.tasks.SetStatus(taskID, info.status); err != nil {
+			log.S(m.ctx).Warnf("failed to save status of task %s: %v", taskID, err)
+		}
+	}
+
+	m.containers[taskID] = &info
+}
+
 func (m *Worker) setupServer() error {
 	if m.externalGrpc != nil {
 		log.G(m.ctx).Info("stopping previously running gRPC server")
@@ -1683,7 +2791,7 @@ func (m *Worker) createServer(logger *zap.Logger, authRouter *auth.AuthRouter) *
 	return xgrpc.NewServer(logger,
 		xgrpc.Credentials(m.credentials),
 		xgrpc.DefaultTraceInterceptor(),
-		xgrpc.RequestLogInterceptor([]string{"PushTask", "PullTask"}),
+		xgrpc.RequestLogInterceptor([]string{"PushTask", "PullTask", "ExecTask", "CopyToTask", "CopyFromTask"}),
 		xgrpc.AuthorizationInterceptor(authRouter),
 		xgrpc.VerifyInterceptor(),
 		xgrpc.RateLimitInterceptor(m.ctx, 100.0, map[string]float64{
@@ -1705,45 +2813,64 @@ func benchKey(bench *sonm.Benchmark, device interface{}) string {
 	return deviceKey(device) + "/benchmarks/" + fmt.Sprintf("%x", structhash.Md5(bench, 1))
 }
 
+// runnerBenchKey returns the key the benchmark value measured by the given
+// runner is cached under, so values measured by different runners are never
+// mixed. Values measured in containers keep keys of previous versions.
+func runnerBenchKey(bench *sonm.Benchmark, device interface{}, runner string) string {
+	if runner == benchmarks.DockerRunnerName {
+		return benchKey(bench, device)
+	}
+
+	return benchKey(bench, device) + "/" + runner
+}
+
 func deviceKey(device interface{}) string {
+	return "hardware/" + deviceName(device)
+}
+
+func deviceName(device interface{}) string {
 	if dev, ok := device.(DeviceKeyer); ok {
-		return "hardware/" + dev.StorageKey()
+		return dev.StorageKey()
 	} else {
-		return "hardware/" + reflect.TypeOf(device).Elem().Name()
+		return reflect.TypeOf(device).Elem().Name()
 	}
 }
 
-func (m *Worker) getCachedValue(bench *sonm.Benchmark, device interface{}) (uint64, error) {
-	var hash string
+// deviceHash returns the hash of the device hardware, empty if hashing is
+// disabled for the device.
+func deviceHash(device interface{}) string {
 	if dev, ok := device.(BenchmarkHasher); ok {
-		hash = dev.HardwareHash()
-	} else {
-		hash = fmt.Sprintf("%x", structhash.Md5(device, 1))
+		return dev.HardwareHash()
 	}
+	return fmt.Sprintf("%x", structhash.Md5(device, 1))
+}
+
+func (m *Worker) getCachedValue(bench *sonm.Benchmark, device interface{}, runner string) (*benchmarkRecord, error) {
+	hash := deviceHash(device)
 	if hash == "" {
-		return 0, fmt.Errorf("hashing is disabled for device")
+		return nil, fmt.Errorf("hashing is disabled for device")
 	}
 
 	var storedHash string
 	loaded, err := m.storage.Load(deviceKey(device), &storedHash)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	if loaded && hash == storedHash {
-		var storedValue uint64
-		loaded, err := m.storage.Load(benchKey(bench, device), &storedValue)
+		storedValue := &benchmarkRecord{}
+		loaded, err := m.storage.Load(runnerBenchKey(bench, device, runner), storedValue)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
 		if !loaded {
-			return 0, errors.New("benchmark value not found")
+			return nil, errors.New("benchmark value not found")
 		}
 		return storedValue, nil
 	}
 	if err := m.storage.Save(deviceKey(device), hash); err != nil {
-		return 0, fmt.Errorf("failed to save hardware hash: %s", err)
+		return nil, fmt.Errorf("failed to save hardware hash: %s", err)
 	}
-	return 0, fmt.Errorf("hardware hashes do not match, current %s, stored %s", hash, storedHash)
+	return nil, fmt.Errorf("hardware hashes do not match, current %s, stored %s", hash, storedHash)
 }
 
 func (m *Worker) dropCachedValue(benchID uint64) error {
@@ -1752,8 +2879,12 @@ func (m *Worker) dropCachedValue(benchID uint64) error {
 		return fmt.Errorf("benchmark with id %d not found", benchID)
 	}
 	drop := func(bench *sonm.Benchmark, device interface{}) error {
-		_, err := m.storage.Remove(benchKey(bench, device))
-		return err
+		for _, runner := range m.benchmarkRunners {
+			if _, err := m.storage.Remove(runnerBenchKey(bench, device, runner.Name())); err != nil {
+				return err
+			}
+		}
+		return nil
 	}
 	bench := benches[benchID]
 	switch bench.GetType() {
@@ -1780,98 +2911,58 @@ func (m *Worker) dropCachedValue(benchID uint64) error {
 	}
 }
 
-func (m *Worker) getBenchValue(bench *sonm.Benchmark, device interface{}) (uint64, error) {
-	if bench.GetID() == benchmarks.CPUCores {
-		return uint64(m.hardware.CPU.Device.Cores), nil
-	}
-	if bench.GetID() == benchmarks.RamSize {
-		return m.hardware.RAM.Device.Total, nil
-	}
-	if bench.GetID() == benchmarks.StorageSize {
-		info, err := disk.FreeDiskSpace(m.ctx)
-		if err != nil {
-			return 0, err
-		}
-		return info.FreeBytes, nil
-	}
-	if bench.GetID() == benchmarks.GPUCount {
-		//GPU count is always 1 for each GPU device.
-		return uint64(1), nil
-	}
-	gpuDevice, isGpu := device.(*sonm.GPUDevice)
-	if bench.GetID() == benchmarks.GPUMem {
-		if !isGpu {
-			return uint64(0), fmt.Errorf("invalid device for GPUMem benchmark")
-		}
-		return gpuDevice.GetMemory(), nil
-	}
-
-	if bench.GetID() == benchmarks.GPURadeon {
-		if !isGpu {
-			return uint64(0), fmt.Errorf("invalid device for GPU-Radeon benchmark")
-		}
-
-		if gpuDevice.VendorType() == sonm.GPUVendorType_RADEON {
-			return 1, nil
+func (m *Worker) getBenchValue(bench *sonm.Benchmark, device interface{}) (*benchmarkRecord, error) {
+	runner, err := benchmarks.SelectRunner(m.benchmarkRunners, bench)
+	if err != nil {
+		log.S(m.ctx).Warnf("skipping benchmark %s (setting explicitly to 0): %s", bench.Code, err)
+		return &benchmarkRecord{Timestamp: time.Now()}, nil
+	}
+
+	if !benchmarks.IsHostValue(bench) {
+		record, err := m.getCachedValue(bench, device, runner.Name())
+		switch {
+		case err != nil:
+			log.S(m.ctx).Infof("failed to get cached benchmark value for benchmark %s(%d): %s", bench.GetCode(), bench.GetID(), err)
+		case record.Runner != runner.Name():
+			log.S(m.ctx).Infof("cached value for benchmark %s(%d) is measured by %s runner, measuring by %s",
+				bench.GetCode(), bench.GetID(), record.Runner, runner.Name())
+		default:
+			log.S(m.ctx).Debugf("using cached benchmark value for benchmark %s(%d) - %d", bench.GetCode(), bench.GetID(), record.Result)
+			return record, nil
 		}
-		return 0, nil
 	}
 
-	if bench.GetID() == benchmarks.GPUNVidia {
-		if !isGpu {
-			return uint64(0), fmt.Errorf("invalid device for GPU-nVidia benchmark")
-		}
-
-		if gpuDevice.VendorType() == sonm.GPUVendorType_NVIDIA {
-			return 1, nil
-		}
-
-		return 0, nil
+	value, err := runner.Run(m.ctx, bench, device)
+	if err != nil {
+		return nil, err
 	}
 
-	val, err := m.getCachedValue(bench, device)
-	if err == nil {
-		log.S(m.ctx).Debugf("using cached benchmark value for benchmark %s(%d) - %d", bench.GetCode(), bench.GetID(), val)
-		return val, nil
-	} else {
-		log.S(m.ctx).Infof("failed to get cached benchmark value for benchmark %s(%d): %s", bench.GetCode(), bench.GetID(), err)
+	record := &benchmarkRecord{
+		Result:    value,
+		Runner:    runner.Name(),
+		Timestamp: time.Now(),
 	}
 
-	if len(bench.GetImage()) != 0 {
-		d, err := getDescriptionForBenchmark(bench)
-		if err != nil {
-			return uint64(0), fmt.Errorf("could not create description for benchmark: %s", err)
+	if !benchmarks.IsHostValue(bench) {
+		key := runnerBenchKey(bench, device, runner.Name())
+		if err := m.storage.Save(key, record); err != nil {
+			log.S(m.ctx).Warnf("failed to save benchmark result in %s", key)
 		}
-		d.Env[benchmarks.CPUCountBenchParam] = fmt.Sprintf("%d", m.hardware.CPU.Device.Cores)
-
-		if isGpu {
-			d.Env[benchmarks.GPUVendorParam] = gpuDevice.VendorType().String()
-			d.GPUDevices = []gpu.GPUID{gpu.GPUID(gpuDevice.GetID())}
-		}
-		res, err := m.execBenchmarkContainer(bench, d)
-		if err != nil {
-
-			return uint64(0), err
-		}
-		if err := m.storage.Save(benchKey(bench, device), res.Result); err != nil {
-			log.S(m.ctx).Warnf("failed to save benchmark result in %s", benchKey(bench, device))
-		}
-		return res.Result, nil
-	} else {
-		log.S(m.ctx).Warnf("skipping benchmark %s (setting explicitly to 0)", bench.Code)
-		return uint64(0), nil
 	}
+
+	return record, nil
 }
 
 func (m *Worker) setBenchmark(bench *sonm.Benchmark, device interface{}, benchMap map[uint64]*sonm.Benchmark) error {
-	value, err := m.getBenchValue(bench, device)
+	record, err := m.getBenchValue(bench, device)
 	if err != nil {
 		return err
 	}
 
 	clone := proto.Clone(bench).(*sonm.Benchmark)
-	clone.Result = value
+	clone.Result = record.Result
 	benchMap[bench.GetID()] = clone
+	m.setBenchmarkResult(bench, device, record)
 	return nil
 }
 
@@ -1890,11 +2981,24 @@ func (m *Worker) runBenchmark(bench *sonm.Benchmark) error {
 		return m.setBenchmark(bench, m.hardware.Network, m.hardware.Network.BenchmarksOut)
 	case sonm.DeviceType_DEV_GPU:
 		//TODO: use context to prevent useless benchmarking in case of error
-		group := errgroup.Group{}
+		// Partitions of the same physical device are benchmarked one by
+		// one to not affect results of each other.
+		physical := map[string][]*sonm.GPU{}
 		for _, dev := range m.hardware.GPU {
-			g := dev
+			id := dev.GetDevice().PhysicalID()
+			physical[id] = append(physical[id], dev)
+		}
+
+		group := errgroup.Group{}
+		for _, devices := range physical {
+			devices := devices
 			group.Go(func() error {
-				return m.setBenchmark(bench, g.Device, g.Benchmarks)
+				for _, g := range devices {
+					if err := m.setBenchmark(bench, g.Device, g.Benchmarks); err != nil {
+						return err
+					}
+				}
+				return nil
 			})
 		}
 		if err := group.Wait(); err != nil {
@@ -1910,7 +3014,7 @@ func (m *Worker) runBenchmark(bench *sonm.Benchmark) error {
 // returns JSON output with measured values.
 func (m *Worker) execBenchmarkContainerWithResults(d Description) (map[string]*benchmarks.ResultJSON, error) {
 	logTime := time.Now().Add(-time.Minute)
-	err := m.ovs.Spool(m.ctx, d)
+	err := m.ovs.Spool(m.ctx, d, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2017,11 +3121,21 @@ func (m *Worker) CreateAskPlan(ctx context.Context, request *sonm.AskPlan) (*son
 	if request.GetCreateTime().Unix().UnixNano() != 0 || request.GetLastOrderPlacedTime().Unix().UnixNano() != 0 {
 		return nil, errors.New("creating ask plans with predefined timestamps is not supported")
 	}
+	for _, image := range request.GetPrefetchImages() {
+		if _, err := imageKey(image); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid prefetch image %s: %v", image, err)
+		}
+	}
+
 	id, err := m.salesman.CreateAskPlan(request)
 	if err != nil {
 		return nil, err
 	}
 
+	if images := request.GetPrefetchImages(); len(images) != 0 {
+		m.images.Prefetch(m.ctx, images, "", false)
+	}
+
 	return &sonm.ID{Id: id}, nil
 }
 
@@ -2032,6 +3146,15 @@ func (m *Worker) RemoveAskPlan(ctx context.Context, request *sonm.ID) (*sonm.Emp
 	return &sonm.Empty{}, nil
 }
 
+func (m *Worker) AskPlanHistory(ctx context.Context, request *sonm.ID) (*sonm.AskPlanHistoryReply, error) {
+	changes, err := m.salesman.AskPlanHistory(request.GetId())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &sonm.AskPlanHistoryReply{Changes: changes}, nil
+}
+
 func (m *Worker) PurgeAskPlans(ctx context.Context, _ *sonm.Empty) (*sonm.Empty, error) {
 	m.salesman.PurgeAskPlans(ctx)
 	return &sonm.Empty{}, nil
@@ -2055,6 +3178,43 @@ func (m *Worker) NextMaintenance(ctx context.Context, _ *sonm.Empty) (*sonm.Time
 	}, nil
 }
 
+func (m *Worker) AddMaintenance(ctx context.Context, request *sonm.MaintenanceWindow) (*sonm.ID, error) {
+	id, err := m.salesman.AddMaintenance(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sonm.ID{Id: id}, nil
+}
+
+func (m *Worker) CancelMaintenance(ctx context.Context, request *sonm.ID) (*sonm.Empty, error) {
+	if err := m.salesman.CancelMaintenance(request.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &sonm.Empty{}, nil
+}
+
+func (m *Worker) Maintenance(ctx context.Context, _ *sonm.Empty) (*sonm.MaintenanceReply, error) {
+	return &sonm.MaintenanceReply{Windows: m.salesman.MaintenanceWindows()}, nil
+}
+
+func (m *Worker) Drain(ctx context.Context, _ *sonm.Empty) (*sonm.Empty, error) {
+	if err := m.salesman.Drain(ctx); err != nil {
+		return nil, err
+	}
+
+	return &sonm.Empty{}, nil
+}
+
+func (m *Worker) Undrain(ctx context.Context, _ *sonm.Empty) (*sonm.Empty, error) {
+	if err := m.salesman.Undrain(); err != nil {
+		return nil, err
+	}
+
+	return &sonm.Empty{}, nil
+}
+
 func (m *Worker) DebugState(ctx context.Context, _ *sonm.Empty) (*sonm.DebugStateReply, error) {
 	return &sonm.DebugStateReply{
 		SchedulerData: m.resources.DebugDump(),
@@ -2089,6 +3249,10 @@ func (m *Worker) PurgeBenchmarks(ctx context.Context, _ *sonm.Empty) (*sonm.Empt
 	return &sonm.Empty{}, multi.ErrorOrNil()
 }
 
+func (m *Worker) BenchmarkReport(ctx context.Context, _ *sonm.Empty) (*sonm.BenchmarkReportReply, error) {
+	return m.benchmarkReport()
+}
+
 func (m *Worker) getDealInfo(dealID *sonm.BigInt) (*sonm.DealInfoReply, error) {
 	deal, err := m.salesman.Deal(dealID)
 	if err != nil {
@@ -2115,7 +3279,7 @@ func (m *Worker) getDealInfo(dealID *sonm.BigInt) (*sonm.DealInfoReply, error) {
 			// task is running or preparing to start
 			if c.status == sonm.TaskStatusReply_SPOOLING ||
 				c.status == sonm.TaskStatusReply_SPAWNING ||
-				c.status == sonm.TaskStatusReply_RUNNING {
+				sonm.IsTaskStatusRunning(c.status) {
 				running[id] = task
 			} else {
 				completed[id] = task
@@ -2132,6 +3296,9 @@ func (m *Worker) getDealInfo(dealID *sonm.BigInt) (*sonm.DealInfoReply, error) {
 	if resources.GetNetwork().GetNetFlags().GetIncoming() {
 		reply.PublicIPs = m.publicIPs
 	}
+	if traffic, err := m.salesman.Traffic(ask.GetID()); err == nil {
+		reply.Traffic = traffic
+	}
 
 	return reply, nil
 }
@@ -2145,7 +3312,9 @@ func (m *Worker) AskPlanByTaskID(taskID string) (*sonm.AskPlan, error) {
 }
 
 func (m *Worker) Metrics(ctx context.Context, req *sonm.WorkerMetricsRequest) (*sonm.WorkerMetricsResponse, error) {
-	return m.metrics.Get(), nil
+	// Collected metrics are shared, so the response is built from a copy.
+	reply := &sonm.WorkerMetricsResponse{}
+	return reply.Append(m.metrics.Get().GetMetrics(), m.salesman.TrafficMetrics()), nil
 }
 
 func (m *Worker) AddCapability(ctx context.Context, request *sonm.WorkerAddCapabilityRequest) (*sonm.WorkerAddCapabilityResponse, error) {
@@ -2174,6 +3343,25 @@ func (m *Worker) RemoveCapability(ctx context.Context, request *sonm.WorkerRemov
 	return &sonm.WorkerRemoveCapabilityResponse{}, nil
 }
 
+func (m *Worker) PrefetchImages(ctx context.Context, request *sonm.PrefetchImagesRequest) (*sonm.ErrorByStringID, error) {
+	if name := request.GetRegistry().GetCredential(); len(name) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "registry credential %s must be resolved by the node", name)
+	}
+
+	// Images are pulled in the background, so the worker's context is used
+	// instead of the request one.
+	return m.images.Prefetch(m.ctx, request.GetImages(), request.GetRegistry().Auth(), request.GetPin()), nil
+}
+
+func (m *Worker) Images(ctx context.Context, request *sonm.Empty) (*sonm.ImagesReply, error) {
+	reply, err := m.images.Images(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get image cache state: %v", err)
+	}
+
+	return reply, nil
+}
+
 // Close disposes all resources related to the Worker
 func (m *Worker) Close() {
 	log.G(m.ctx).Info("closing worker")
@@ -2181,6 +3369,9 @@ func (m *Worker) Close() {
 	if m.ssh != nil {
 		m.ssh.Close()
 	}
+	if m.portForwarder != nil {
+		m.portForwarder.Close()
+	}
 	if m.ovs != nil {
 		m.ovs.Close()
 	}
@@ -2190,6 +3381,9 @@ func (m *Worker) Close() {
 	if m.plugins != nil {
 		m.plugins.Close()
 	}
+	if m.images != nil {
+		m.images.Close()
+	}
 	if m.externalGrpc != nil {
 		m.externalGrpc.Stop()
 	}
@@ -3,15 +3,35 @@ package worker
 import (
 	"context"
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/sonm-io/core/blockchain"
+	"github.com/sonm-io/core/insonmnia/hardware"
+	"github.com/sonm-io/core/insonmnia/resource"
+	"github.com/sonm-io/core/insonmnia/structs"
+	"github.com/sonm-io/core/insonmnia/worker/plugin"
+	"github.com/sonm-io/core/insonmnia/worker/salesman"
 	"github.com/sonm-io/core/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -80,3 +100,243 @@ func TestCollectTasksStatuses(t *testing.T) {
 	result4 := m.CollectTasksStatuses(sonm.TaskStatusReply_RUNNING, sonm.TaskStatusReply_SPOOLING, sonm.TaskStatusReply_BROKEN)
 	assert.Equal(t, 5, len(result4))
 }
+
+func TestRunningTaskCount(t *testing.T) {
+	m := Worker{
+		ctx: context.Background(),
+		containers: map[string]*ContainerInfo{
+			"aaa1": {status: sonm.TaskStatusReply_SPOOLING},
+			"bbb1": {status: sonm.TaskStatusReply_RUNNING},
+			"bbb2": {status: sonm.TaskStatusReply_HEALTHY},
+			"bbb3": {status: sonm.TaskStatusReply_UNHEALTHY},
+			"bbb4": {status: sonm.TaskStatusReply_RESTARTING},
+			"ccc1": {status: sonm.TaskStatusReply_FINISHED},
+			"ccc2": {status: sonm.TaskStatusReply_BROKEN},
+		},
+	}
+
+	assert.Equal(t, 4, m.runningTaskCount())
+}
+
+func TestTaskGroupStatus(t *testing.T) {
+	assert.Equal(t, sonm.TaskStatusReply_UNKNOWN, taskGroupStatus())
+	assert.Equal(t, sonm.TaskStatusReply_RUNNING, taskGroupStatus(sonm.TaskStatusReply_RUNNING, sonm.TaskStatusReply_HEALTHY))
+	assert.Equal(t, sonm.TaskStatusReply_HEALTHY, taskGroupStatus(sonm.TaskStatusReply_HEALTHY, sonm.TaskStatusReply_HEALTHY))
+	assert.Equal(t, sonm.TaskStatusReply_HEALTHY, taskGroupStatus(sonm.TaskStatusReply_HEALTHY, sonm.TaskStatusReply_FINISHED))
+	assert.Equal(t, sonm.TaskStatusReply_UNHEALTHY, taskGroupStatus(sonm.TaskStatusReply_HEALTHY, sonm.TaskStatusReply_UNHEALTHY))
+	assert.Equal(t, sonm.TaskStatusReply_UNHEALTHY, taskGroupStatus(sonm.TaskStatusReply_RUNNING, sonm.TaskStatusReply_RESTARTING))
+	assert.Equal(t, sonm.TaskStatusReply_SPAWNING, taskGroupStatus(sonm.TaskStatusReply_RUNNING, sonm.TaskStatusReply_SPOOLING))
+	assert.Equal(t, sonm.TaskStatusReply_FINISHED, taskGroupStatus(sonm.TaskStatusReply_FINISHED, sonm.TaskStatusReply_FINISHED))
+	assert.Equal(t, sonm.TaskStatusReply_BROKEN, taskGroupStatus(sonm.TaskStatusReply_UNHEALTHY, sonm.TaskStatusReply_KILLED_OOM))
+}
+
+func TestTaskGroupOrder(t *testing.T) {
+	m := Worker{
+		ctx: context.Background(),
+		containers: map[string]*ContainerInfo{
+			"aaa1": {TaskId: "aaa1", GroupID: "group", GroupIndex: 2},
+			"aaa2": {TaskId: "aaa2", GroupID: "group", GroupIndex: 0},
+			"aaa3": {TaskId: "aaa3", GroupID: "group", GroupIndex: 1},
+			"bbb1": {TaskId: "bbb1", GroupID: "other"},
+			"ccc1": {TaskId: "ccc1"},
+		},
+	}
+
+	tasks := m.taskGroup("group")
+	require.Len(t, tasks, 3)
+	assert.Equal(t, "aaa2", tasks[0].TaskId)
+	assert.Equal(t, "aaa3", tasks[1].TaskId)
+	assert.Equal(t, "aaa1", tasks[2].TaskId)
+
+	assert.Empty(t, m.taskGroup(""))
+	assert.Empty(t, m.taskGroup("unknown"))
+}
+
+// stopRecordingOverseer records containers it has been asked to stop.
+type stopRecordingOverseer struct {
+	Overseer
+	stopped []string
+}
+
+func (m *stopRecordingOverseer) Stop(ctx context.Context, containerID string) error {
+	m.stopped = append(m.stopped, containerID)
+	return nil
+}
+
+func (m *stopRecordingOverseer) Logs(ctx context.Context, id string, opts types.ContainerLogsOptions) (io.ReadCloser, error) {
+	return ioutil.NopCloser(strings.NewReader("")), nil
+}
+
+func TestPurgeTasksDuringRestartBackoff(t *testing.T) {
+	hw, err := hardware.NewHardware()
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "sonm-logs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	logs, err := newLogArchive(LogsConfig{Dir: dir}, zap.NewNop().Sugar())
+	require.NoError(t, err)
+
+	ovs := &stopRecordingOverseer{}
+	m := Worker{
+		ctx:       context.Background(),
+		ovs:       ovs,
+		tasks:     newTaskStore(nil),
+		logs:      logs,
+		salesman:  &salesman.Salesman{},
+		resources: resource.NewScheduler(context.Background(), hw),
+		containers: map[string]*ContainerInfo{
+			"restarting": {ID: "c1", TaskId: "restarting", DealID: sonm.NewBigIntFromInt(42), status: sonm.TaskStatusReply_RESTARTING},
+			"finished":   {ID: "c2", TaskId: "finished", DealID: sonm.NewBigIntFromInt(42), status: sonm.TaskStatusReply_FINISHED},
+			"other":      {ID: "c3", TaskId: "other", DealID: sonm.NewBigIntFromInt(43), status: sonm.TaskStatusReply_RUNNING},
+		},
+	}
+
+	// The task waiting for restart must be stopped, otherwise it is brought
+	// back after the purge.
+	reply, err := m.PurgeTasks(context.Background(), &sonm.PurgeTasksRequest{DealID: sonm.NewBigIntFromInt(42)})
+	require.NoError(t, err)
+	require.Len(t, reply.GetResponse(), 1)
+	assert.Equal(t, "restarting", reply.GetResponse()[0].GetID())
+	assert.Empty(t, reply.GetResponse()[0].GetError())
+	assert.Equal(t, []string{"c1"}, ovs.stopped)
+
+	// Logs of the stopped task are archived right away.
+	_, err = os.Stat(filepath.Join(dir, "restarting.log.gz"))
+	assert.NoError(t, err)
+}
+
+// failingMetricsOverseer fails to query metrics of any container.
+type failingMetricsOverseer struct {
+	Overseer
+}
+
+func (m *failingMetricsOverseer) TaskMetrics(containerID string, from, to time.Time, step time.Duration) ([]*sonm.TaskMetricsSample, error) {
+	return nil, errors.New("storage is broken")
+}
+
+func TestTaskMetricsErrors(t *testing.T) {
+	m := Worker{
+		ctx: context.Background(),
+		ovs: &overseer{containers: map[string]*containerDescriptor{}},
+		containers: map[string]*ContainerInfo{
+			"task": {ID: "c1", TaskId: "task", status: sonm.TaskStatusReply_RUNNING},
+		},
+	}
+
+	_, err := m.TaskMetrics(context.Background(), &sonm.TaskMetricsRequest{Id: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// The task is known, but its container is gone.
+	_, err = m.TaskMetrics(context.Background(), &sonm.TaskMetricsRequest{Id: "task"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	m.ovs = &failingMetricsOverseer{}
+	_, err = m.TaskMetrics(context.Background(), &sonm.TaskMetricsRequest{Id: "task"})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+// fakeTaskDocker lists the given containers, recording removed ones.
+type fakeTaskDocker struct {
+	client.APIClient
+	containers []types.Container
+	removed    []string
+}
+
+func (m *fakeTaskDocker) ContainerList(ctx context.Context, options types.ContainerListOptions) ([]types.Container, error) {
+	return m.containers, nil
+}
+
+func (m *fakeTaskDocker) ContainerRemove(ctx context.Context, containerID string, options types.ContainerRemoveOptions) error {
+	m.removed = append(m.removed, containerID)
+	return nil
+}
+
+// fakeDealAPI reports all deals with the same status.
+type fakeDealAPI struct {
+	blockchain.API
+	market fakeDealMarket
+}
+
+func (m *fakeDealAPI) Market() blockchain.MarketAPI {
+	return &m.market
+}
+
+type fakeDealMarket struct {
+	blockchain.MarketAPI
+	status sonm.DealStatus
+}
+
+func (m *fakeDealMarket) GetDealInfo(ctx context.Context, dealID *big.Int) (*sonm.Deal, error) {
+	return &sonm.Deal{Id: sonm.NewBigInt(dealID), Status: m.status}, nil
+}
+
+func TestRestoreTasks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sonm-tasks")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	storage := newTestTaskStorage(t, filepath.Join(dir, "worker.boltdb"))
+	tasks := newTaskStore(storage)
+
+	// The worker has been stopped while spawning the container, so its
+	// network may have not been created yet.
+	require.NoError(t, tasks.Put("interrupted", &taskRecord{
+		Description: Description{
+			TaskId:       "interrupted",
+			DealId:       "42",
+			NetworkSpecs: []*structs.NetworkSpec{{NetworkSpec: &sonm.NetworkSpec{Type: "tinc"}, NetID: "net"}},
+		},
+		Cinfo:  ContainerInfo{TaskId: "interrupted"},
+		Status: sonm.TaskStatusReply_SPAWNING,
+	}))
+	require.NoError(t, tasks.Put("gone", &taskRecord{
+		Description: Description{TaskId: "gone", DealId: "42"},
+		Cinfo:       ContainerInfo{ID: "gone-container", TaskId: "gone", DealID: sonm.NewBigIntFromInt(42)},
+		Status:      sonm.TaskStatusReply_RUNNING,
+	}))
+
+	docker := &fakeTaskDocker{
+		containers: []types.Container{
+			{ID: "interrupted-container", Labels: map[string]string{overseerTag: "", taskIDTag: "interrupted"}},
+			// Containers not labelled by the worker must be left intact.
+			{ID: "foreign"},
+		},
+	}
+
+	m := Worker{
+		ctx:        context.Background(),
+		storage:    storage,
+		tasks:      tasks,
+		eth:        &fakeDealAPI{market: fakeDealMarket{status: sonm.DealStatus_DEAL_ACCEPTED}},
+		plugins:    plugin.EmptyRepository(),
+		containers: map[string]*ContainerInfo{},
+	}
+
+	require.NoError(t, m.restoreTasks(docker))
+
+	// The container left by the interrupted task has no record.
+	assert.Equal(t, []string{"interrupted-container"}, docker.removed)
+
+	records, err := tasks.Load()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Contains(t, records, "gone")
+	assert.Equal(t, sonm.TaskStatusReply_BROKEN, records["gone"].Status)
+
+	require.Len(t, m.containers, 1)
+	require.Contains(t, m.containers, "gone")
+	assert.Equal(t, sonm.TaskStatusReply_BROKEN, m.containers["gone"].status)
+	assert.True(t, m.containers["gone"].detached)
+}
+
+func TestBindingPublicIPs(t *testing.T) {
+	publicIPs := []string{"2001:db8::1", "1.2.3.4"}
+
+	assert.Equal(t, publicIPs, bindingPublicIPs(publicIPs, ""))
+	assert.Equal(t, publicIPs, bindingPublicIPs(publicIPs, "0.0.0.0"))
+	assert.Equal(t, publicIPs, bindingPublicIPs(publicIPs, "::"))
+	assert.Equal(t, []string{"1.2.3.4"}, bindingPublicIPs(publicIPs, "1.2.3.4"))
+	assert.Equal(t, []string{"2001:db8::1"}, bindingPublicIPs(publicIPs, "2001:db8:0::1"))
+}
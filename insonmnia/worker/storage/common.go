@@ -15,3 +15,14 @@ type Cleanup interface {
 type StorageQuotaTuner interface {
 	SetQuota(ctx context.Context, ID string, quotaID string, bytes uint64) (Cleanup, error)
 }
+
+// DirQuotaTuner is optionally implemented by storage quota tuners, that are
+// able to count files of host directories, e.g. volumes, against the same
+// quotas as writable layers of containers.
+type DirQuotaTuner interface {
+	// SupportsDirQuota reports whether quotas can be set on the directory.
+	SupportsDirQuota(path string) bool
+	// SetDirQuota counts files of the directory against the quota with the
+	// given ID.
+	SetDirQuota(ctx context.Context, path string, quotaID string, bytes uint64) error
+}
@@ -4,8 +4,15 @@ import "fmt"
 
 type ErrDriverNotSupported struct {
 	driver string
+	// reason optionally describes why the driver is not supported in the
+	// current configuration.
+	reason string
 }
 
 func (e ErrDriverNotSupported) Error() string {
-	return fmt.Sprintf("driver %s not supported", e.driver)
+	if len(e.reason) == 0 {
+		return fmt.Sprintf("driver %s not supported", e.driver)
+	}
+
+	return fmt.Sprintf("driver %s not supported: %s", e.driver, e.reason)
 }
@@ -0,0 +1,215 @@
+// +build linux
+
+package storage
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/sonm-io/core/insonmnia/worker/storage/xfs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindMountPoint(t *testing.T) {
+	const mountinfo = `
+23 28 0:22 / /proc rw,relatime - proc proc rw
+28 1 8:1 / / rw,relatime - ext4 /dev/sda1 rw
+40 28 8:17 / /var/lib rw,relatime - ext4 /dev/sdb1 rw
+41 40 8:33 / /var/lib/docker rw,relatime - xfs /dev/sdc1 rw,prjquota
+42 40 8:49 / /var/lib/docker-volumes rw,relatime - xfs /dev/sdd1 rw
+43 28 8:65 / /mnt/with\040space rw,relatime - xfs /dev/sde1 rw
+`
+
+	fixtures := []struct {
+		path       string
+		mountPoint string
+	}{
+		{"/var/lib/docker", "/var/lib/docker"},
+		{"/var/lib/docker/overlay2", "/var/lib/docker"},
+		{"/var/lib/docker-volumes/local", "/var/lib/docker-volumes"},
+		{"/var/lib/dockerd", "/var/lib"},
+		{"/var/log", "/"},
+		{"/mnt/with space/docker", "/mnt/with space"},
+	}
+
+	for _, fixture := range fixtures {
+		mountPoint, err := findMountPoint(strings.NewReader(mountinfo), fixture.path)
+		require.NoError(t, err)
+		assert.Equal(t, fixture.mountPoint, mountPoint, fixture.path)
+	}
+}
+
+func TestOverlayQuotaNotSupportedOnExt4(t *testing.T) {
+	info := types.Info{
+		Driver:        "overlay2",
+		DockerRootDir: "/var/lib/docker",
+		DriverStatus: [][2]string{
+			{"Backing Filesystem", "extfs"},
+			{"Supports d_type", "true"},
+		},
+	}
+
+	_, err := NewQuotaTuner(info)
+	require.Error(t, err)
+	assert.IsType(t, ErrDriverNotSupported{}, err)
+	assert.Contains(t, err.Error(), "extfs")
+}
+
+func TestXFSProjectID(t *testing.T) {
+	assert.Equal(t, xfsProjectID("42"), xfsProjectID("42"))
+	assert.NotEqual(t, xfsProjectID("42"), xfsProjectID("43"))
+	assert.NotZero(t, xfsProjectID("42")&xfsProjectIDMask)
+}
+
+// newLoopbackXFS creates and mounts a loopback XFS image with project quotas
+// enabled, returning its mount point.
+func newLoopbackXFS(t *testing.T) (string, func()) {
+	if os.Getuid() != 0 {
+		t.Skip("root permissions required for the test")
+	}
+	for _, tool := range []string{"mkfs.xfs", "xfs_quota", "mount", "umount"} {
+		if _, err := exec.LookPath(tool); err != nil {
+			t.Skipf("%s is required for the test", tool)
+		}
+	}
+
+	dir, err := ioutil.TempDir("", "xfs-quota")
+	require.NoError(t, err)
+
+	image := filepath.Join(dir, "xfs.img")
+	file, err := os.Create(image)
+	require.NoError(t, err)
+	require.NoError(t, file.Truncate(512*1024*1024))
+	require.NoError(t, file.Close())
+
+	output, err := exec.Command("mkfs.xfs", "-q", image).CombinedOutput()
+	require.NoError(t, err, string(output))
+
+	mountPoint := filepath.Join(dir, "mnt")
+	require.NoError(t, os.Mkdir(mountPoint, 0755))
+
+	output, err = exec.Command("mount", "-o", "loop,prjquota", image, mountPoint).CombinedOutput()
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Skipf("failed to mount loopback XFS image: %v: %s", err, output)
+	}
+
+	return mountPoint, func() {
+		exec.Command("umount", mountPoint).Run()
+		os.RemoveAll(dir)
+	}
+}
+
+// newTestOverlayContainer emulates the overlay2 layout of a container's
+// writable layer, returning its upper directory.
+func newTestOverlayContainer(t *testing.T, dockerRootDir, ID string) string {
+	mountID := "mount-" + ID
+
+	layerDir := filepath.Join(dockerRootDir, "image", overlayDriver, "layerdb/mounts", ID)
+	require.NoError(t, os.MkdirAll(layerDir, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(layerDir, "mount-id"), []byte(mountID), 0644))
+
+	upperDir := filepath.Join(dockerRootDir, overlayDriver, mountID, "diff")
+	require.NoError(t, os.MkdirAll(upperDir, 0755))
+
+	return upperDir
+}
+
+func writeTestFile(path string, size int) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(make([]byte, size))
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+func TestOverlayQuotaLoopbackXFS(t *testing.T) {
+	mountPoint, cleanup := newLoopbackXFS(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	api, err := xfs.NewAPI()
+	require.NoError(t, err)
+
+	enforced, err := api.ProjectQuotaEnforced(ctx, mountPoint)
+	require.NoError(t, err)
+	require.True(t, enforced)
+
+	dockerRootDir := filepath.Join(mountPoint, "docker")
+	tuner := overlayQuotaTuner{
+		dockerRootDir: dockerRootDir,
+		mountPoint:    mountPoint,
+		API:           api,
+	}
+
+	const limit = 16 * 1024 * 1024
+	const size = 10 * 1024 * 1024
+
+	upperDirs := map[string]string{}
+	for ID, quotaID := range map[string]string{"aaa": "deal-1", "bbb": "deal-1", "ccc": "deal-2"} {
+		upperDirs[ID] = newTestOverlayContainer(t, dockerRootDir, ID)
+
+		cleanup, err := tuner.SetQuota(ctx, ID, quotaID, limit)
+		require.NoError(t, err)
+		defer cleanup.Close()
+	}
+
+	require.NoError(t, writeTestFile(filepath.Join(upperDirs["aaa"], "FILE"), size))
+	// Containers of the same deal share the limit.
+	require.Error(t, writeTestFile(filepath.Join(upperDirs["bbb"], "FILE"), size))
+	// While other deals have their own.
+	require.NoError(t, writeTestFile(filepath.Join(upperDirs["ccc"], "FILE"), size))
+}
+
+func TestOverlayDirQuotaLoopbackXFS(t *testing.T) {
+	mountPoint, cleanup := newLoopbackXFS(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	api, err := xfs.NewAPI()
+	require.NoError(t, err)
+
+	dockerRootDir := filepath.Join(mountPoint, "docker")
+	tuner := overlayQuotaTuner{
+		dockerRootDir: dockerRootDir,
+		mountPoint:    mountPoint,
+		API:           api,
+	}
+
+	const limit = 16 * 1024 * 1024
+	const size = 10 * 1024 * 1024
+
+	upperDir := newTestOverlayContainer(t, dockerRootDir, "aaa")
+	quotaCleanup, err := tuner.SetQuota(ctx, "aaa", "deal-1", limit)
+	require.NoError(t, err)
+	defer quotaCleanup.Close()
+
+	volumeDir := filepath.Join(mountPoint, "volumes", "deal-1")
+	require.NoError(t, os.MkdirAll(volumeDir, 0755))
+
+	assert.True(t, tuner.SupportsDirQuota(volumeDir))
+	assert.False(t, tuner.SupportsDirQuota(os.TempDir()))
+	require.NoError(t, tuner.SetDirQuota(ctx, volumeDir, "deal-1", limit))
+
+	require.NoError(t, writeTestFile(filepath.Join(upperDir, "FILE"), size))
+	// Volumes share the limit with writable layers of the deal's containers.
+	require.Error(t, writeTestFile(filepath.Join(volumeDir, "FILE"), size))
+}
@@ -0,0 +1,212 @@
+// +build linux
+
+package storage
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/sonm-io/core/insonmnia/worker/storage/xfs"
+)
+
+const (
+	overlayDriver = "overlay2"
+	// xfsProjectIDMask keeps project IDs derived from quota IDs away from
+	// sequential IDs Docker allocates for containers started with
+	// "--storage-opt size".
+	xfsProjectIDMask = 0x80000000
+	xfsCheckTimeout  = 30 * time.Second
+)
+
+// overlayQuotaTuner limits disk space taken by writable layers of overlay2
+// containers using XFS project quotas, similar to Docker's
+// "--storage-opt size", but the limit is shared by all containers with the
+// same quota ID, i.e. by all tasks of a deal.
+//
+// The backing XFS filesystem must be mounted with project quotas enabled.
+type overlayQuotaTuner struct {
+	dockerRootDir string
+	mountPoint    string
+	xfs.API
+}
+
+type overlayQuotaCleaner struct{}
+
+// Close does nothing. The project limit is kept, because other containers
+// may share it, while the container's files are removed together with it.
+func (overlayQuotaCleaner) Close() error {
+	return nil
+}
+
+func newOverlayQuotaTuner(info types.Info) (StorageQuotaTuner, error) {
+	if info.Driver != overlayDriver {
+		return nil, fmt.Errorf("%s is not supported", info.Driver)
+	}
+
+	// Workers must still be able to start without quotas, so all errors are
+	// reported as the driver is not supported.
+	notSupported := func(format string, args ...interface{}) error {
+		return ErrDriverNotSupported{driver: info.Driver, reason: fmt.Sprintf(format, args...)}
+	}
+
+	backingFS := driverStatus(info, "Backing Filesystem")
+	if backingFS != "xfs" {
+		return nil, notSupported("backing filesystem %q has no project quotas support, xfs is required", backingFS)
+	}
+
+	xfsAPI, err := xfs.NewAPI()
+	if err != nil {
+		return nil, notSupported("%v", err)
+	}
+
+	mountPoint, err := mountPointOf(info.DockerRootDir)
+	if err != nil {
+		return nil, notSupported("%v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), xfsCheckTimeout)
+	defer cancel()
+
+	enforced, err := xfsAPI.ProjectQuotaEnforced(ctx, mountPoint)
+	if err != nil {
+		return nil, notSupported("failed to check project quota state on %s: %v", mountPoint, err)
+	}
+	if !enforced {
+		return nil, notSupported("project quotas are not enforced on %s, mount it with \"prjquota\" option", mountPoint)
+	}
+
+	return overlayQuotaTuner{
+		dockerRootDir: info.DockerRootDir,
+		mountPoint:    mountPoint,
+		API:           xfsAPI,
+	}, nil
+}
+
+func (m overlayQuotaTuner) SetQuota(ctx context.Context, ID string, quotaID string, bytes uint64) (Cleanup, error) {
+	mountID, err := ioutil.ReadFile(filepath.Join(m.dockerRootDir, "image", overlayDriver, "layerdb/mounts", ID, "mount-id"))
+	if err != nil {
+		return nil, err
+	}
+
+	projectID := xfsProjectID(quotaID)
+
+	// The limit is set before assigning the project, so the container's
+	// files are never accounted without it.
+	if err := m.API.ProjectLimit(ctx, projectID, bytes, m.mountPoint); err != nil {
+		return nil, err
+	}
+
+	upperDir := filepath.Join(m.dockerRootDir, overlayDriver, strings.TrimSpace(string(mountID)), "diff")
+	if err := m.API.ProjectSet(ctx, projectID, upperDir, m.mountPoint); err != nil {
+		return nil, err
+	}
+
+	return overlayQuotaCleaner{}, nil
+}
+
+// SupportsDirQuota reports whether the directory is on the same filesystem
+// as Docker, because XFS projects do not span filesystems.
+func (m overlayQuotaTuner) SupportsDirQuota(path string) bool {
+	mountPoint, err := mountPointOf(path)
+	return err == nil && mountPoint == m.mountPoint
+}
+
+// SetDirQuota assigns the directory to the same XFS project as writable
+// layers of containers with the quota ID, so all of them share the limit.
+func (m overlayQuotaTuner) SetDirQuota(ctx context.Context, path string, quotaID string, bytes uint64) error {
+	if !m.SupportsDirQuota(path) {
+		return fmt.Errorf("%s is not on %s filesystem", path, m.mountPoint)
+	}
+
+	projectID := xfsProjectID(quotaID)
+	if err := m.API.ProjectLimit(ctx, projectID, bytes, m.mountPoint); err != nil {
+		return err
+	}
+
+	return m.API.ProjectSet(ctx, projectID, path, m.mountPoint)
+}
+
+func xfsProjectID(quotaID string) uint32 {
+	h := fnv.New32a()
+	io.WriteString(h, quotaID)
+	return h.Sum32() | xfsProjectIDMask
+}
+
+// driverStatus returns the value of Docker storage driver status entry.
+func driverStatus(info types.Info, key string) string {
+	for _, pair := range info.DriverStatus {
+		if pair[0] == key {
+			return pair[1]
+		}
+	}
+
+	return ""
+}
+
+// mountPointOf returns the mount point of the filesystem the given path
+// belongs to on this host.
+func mountPointOf(path string) (string, error) {
+	mountinfo, err := os.Open("/proc/self/mountinfo")
+	if err != nil {
+		return "", err
+	}
+	defer mountinfo.Close()
+
+	return findMountPoint(mountinfo, path)
+}
+
+// findMountPoint returns the mount point of the filesystem the given path
+// belongs to, using the mountinfo table format described in proc(5).
+func findMountPoint(mountinfo io.Reader, path string) (string, error) {
+	path = filepath.Clean(path)
+	mountPoint := ""
+
+	scanner := bufio.NewScanner(mountinfo)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 {
+			continue
+		}
+
+		candidate := unescapeMountPath(fields[4])
+		if !isSubPath(candidate, path) {
+			continue
+		}
+
+		if len(candidate) >= len(mountPoint) {
+			mountPoint = candidate
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	if len(mountPoint) == 0 {
+		return "", fmt.Errorf("failed to find mount point of %s", path)
+	}
+
+	return mountPoint, nil
+}
+
+func isSubPath(parent, path string) bool {
+	if parent == "/" || parent == path {
+		return true
+	}
+
+	return strings.HasPrefix(path, parent+"/")
+}
+
+// unescapeMountPath decodes octal escapes of whitespace and backslashes used
+// in mount paths.
+func unescapeMountPath(path string) string {
+	return strings.NewReplacer(`\040`, " ", `\011`, "\t", `\012`, "\n", `\134`, `\`).Replace(path)
+}
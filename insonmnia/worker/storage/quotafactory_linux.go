@@ -13,6 +13,8 @@ func NewQuotaTuner(info types.Info) (StorageQuotaTuner, error) {
 	switch info.Driver {
 	case "btrfs":
 		return newBtrfsQuotaTuner(info)
+	case overlayDriver:
+		return newOverlayQuotaTuner(info)
 	default:
 		return nil, ErrDriverNotSupported{driver: info.Driver}
 	}
@@ -0,0 +1,70 @@
+// +build linux
+
+package xfs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupProjectEnforcementInStateOutput(t *testing.T) {
+	assert := assert.New(t)
+
+	const enforced = `User quota state on /var/lib/docker (/dev/sdb1)
+  Accounting: OFF
+  Enforcement: OFF
+  Inode: #0 (0 blocks, 0 extents)
+Group quota state on /var/lib/docker (/dev/sdb1)
+  Accounting: OFF
+  Enforcement: OFF
+  Inode: #0 (0 blocks, 0 extents)
+Project quota state on /var/lib/docker (/dev/sdb1)
+  Accounting: ON
+  Enforcement: ON
+  Inode: #131 (1 blocks, 1 extents)
+Blocks grace time: [7 days]
+Inodes grace time: [7 days]
+Realtime Blocks grace time: [7 days]
+`
+
+	const accountedOnly = `Project quota state on /var/lib/docker (/dev/sdb1)
+  Accounting: ON
+  Enforcement: OFF
+  Inode: #131 (1 blocks, 1 extents)
+`
+
+	const noProjectSection = `User quota state on /var/lib/docker (/dev/sdb1)
+  Accounting: ON
+  Enforcement: ON
+`
+
+	const truncatedSection = `Project quota state on /var/lib/docker (/dev/sdb1)
+  Accounting: ON
+User quota state on /var/lib/docker (/dev/sdb1)
+  Accounting: ON
+  Enforcement: ON
+`
+
+	fixtures := []struct {
+		output   string
+		enforced bool
+		err      bool
+	}{
+		{enforced, true, false},
+		{accountedOnly, false, false},
+		{noProjectSection, false, true},
+		{truncatedSection, false, true},
+		{"", false, true},
+	}
+
+	for _, fixture := range fixtures {
+		value, err := lookupProjectEnforcementInStateOutput([]byte(fixture.output))
+		assert.Equal(fixture.enforced, value)
+		if fixture.err {
+			assert.Error(err)
+		} else {
+			assert.NoError(err)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// +build linux
+
+package xfs
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"go.uber.org/zap"
+)
+
+// API describes XFS project quota management.
+//
+// All methods require the mount point of the XFS filesystem, which must be
+// mounted with project quotas enabled, i.e. with "prjquota" or "pquota"
+// option.
+type API interface {
+	// ProjectQuotaEnforced checks whether project quotas are enforced on the
+	// filesystem.
+	ProjectQuotaEnforced(ctx context.Context, mountPoint string) (bool, error)
+	// ProjectSet assigns the project to the directory recursively. Files
+	// created within the directory later inherit the project.
+	ProjectSet(ctx context.Context, projectID uint32, path string, mountPoint string) error
+	// ProjectLimit sets the hard limit of disk space taken by files of the
+	// project. Zero removes the limit.
+	ProjectLimit(ctx context.Context, projectID uint32, sizeInBytes uint64, mountPoint string) error
+}
+
+var _ API = xfsCLI{}
+
+func NewAPI() (API, error) {
+	if _, err := exec.LookPath("xfs_quota"); err != nil {
+		return nil, fmt.Errorf("xfs_quota executable is not found. Install xfsprogs")
+	}
+	return xfsCLI{}, nil
+}
+
+type xfsCLI struct{}
+
+func (xfsCLI) ProjectQuotaEnforced(ctx context.Context, mountPoint string) (bool, error) {
+	output, err := exec.CommandContext(ctx, "xfs_quota", "-x", "-c", "state -p", mountPoint).Output()
+	if err != nil {
+		log.G(ctx).Error("failed to get xfs quota state", zap.String("path", mountPoint), zap.Error(err), zap.ByteString("output", output))
+		return false, err
+	}
+
+	return lookupProjectEnforcementInStateOutput(output)
+}
+
+func lookupProjectEnforcementInStateOutput(output []byte) (bool, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	foundHeader := false
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if foundHeader {
+			if strings.HasPrefix(line, "Enforcement:") {
+				return strings.TrimSpace(strings.TrimPrefix(line, "Enforcement:")) == "ON", nil
+			}
+			// Next section has started.
+			if strings.Contains(line, "quota state on") {
+				break
+			}
+		} else {
+			foundHeader = strings.HasPrefix(line, "Project quota state on")
+		}
+	}
+	if scanner.Err() != nil {
+		return false, scanner.Err()
+	}
+	return false, errors.New("project quota state not found")
+}
+
+func (xfsCLI) ProjectSet(ctx context.Context, projectID uint32, path string, mountPoint string) error {
+	command := fmt.Sprintf("project -s -p %s %d", path, projectID)
+	output, err := exec.CommandContext(ctx, "xfs_quota", "-x", "-c", command, mountPoint).CombinedOutput()
+	if err != nil {
+		log.G(ctx).Error("failed to set xfs project", zap.String("path", path), zap.Uint32("project", projectID), zap.Error(err), zap.ByteString("output", output))
+		return err
+	}
+	return nil
+}
+
+func (xfsCLI) ProjectLimit(ctx context.Context, projectID uint32, sizeInBytes uint64, mountPoint string) error {
+	command := "limit -p bhard=" + strconv.FormatUint(sizeInBytes, 10) + " " + strconv.FormatUint(uint64(projectID), 10)
+	output, err := exec.CommandContext(ctx, "xfs_quota", "-x", "-c", command, mountPoint).CombinedOutput()
+	if err != nil {
+		log.G(ctx).Error("failed to limit xfs project", zap.Uint32("project", projectID), zap.Error(err), zap.ByteString("output", output))
+		return err
+	}
+	return nil
+}
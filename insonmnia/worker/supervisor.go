@@ -0,0 +1,195 @@
+package worker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/sonm-io/core/proto"
+)
+
+const (
+	restartBackoffMin = time.Second
+	restartBackoffMax = 5 * time.Minute
+	// crashLoopWindow is the minimum run duration for the task to be
+	// considered stable. Shorter runs are counted as crashes.
+	crashLoopWindow = time.Minute
+	// crashLoopThreshold is the number of consecutive crashes after which
+	// the task is no longer restarted.
+	crashLoopThreshold = 10
+)
+
+// taskExit describes a single exit of the task container.
+type taskExit struct {
+	ExitCode   int
+	OOMKilled  bool
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+func newTaskExit(state *types.ContainerState) taskExit {
+	exit := taskExit{}
+	if state == nil {
+		return exit
+	}
+
+	exit.ExitCode = state.ExitCode
+	exit.OOMKilled = state.OOMKilled
+	// Docker reports zero time for containers that have never been started
+	// or stopped, which is fine to parse.
+	exit.StartedAt, _ = time.Parse(time.RFC3339Nano, state.StartedAt)
+	exit.FinishedAt, _ = time.Parse(time.RFC3339Nano, state.FinishedAt)
+
+	return exit
+}
+
+// Status returns the task status corresponding to the exit. OOM kills take
+// precedence over the exit code, because killed processes may still report
+// a zero one.
+func (m taskExit) Status() sonm.TaskStatusReply_Status {
+	switch {
+	case m.OOMKilled:
+		return sonm.TaskStatusReply_KILLED_OOM
+	case m.ExitCode == 0:
+		return sonm.TaskStatusReply_FINISHED
+	default:
+		return sonm.TaskStatusReply_BROKEN
+	}
+}
+
+// restartSupervisor decides whether the exited task should be restarted
+// according to its restart policy, backing off exponentially between
+// crashes and giving up when the task is in a crash loop.
+//
+// It also keeps the restart history that is reported in the task status.
+type restartSupervisor struct {
+	mu sync.Mutex
+
+	policy     string
+	maxRetries uint32
+	restarts   uint32
+	// crashes is the number of consecutive runs shorter than the crash loop
+	// window.
+	crashes   int
+	crashLoop bool
+	lastExit  *taskExit
+	// oomKilled is set when an OOM event is received during the current run.
+	oomKilled bool
+	// pending is set while the restart is scheduled, but the container is
+	// not started yet.
+	pending bool
+	stopped bool
+}
+
+func newRestartSupervisor(policy *sonm.ContainerRestartPolicy) *restartSupervisor {
+	return &restartSupervisor{
+		policy:     policy.GetName(),
+		maxRetries: policy.GetMaximumRetryCount(),
+	}
+}
+
+// OnOOM marks the current run of the task as killed by the OOM killer.
+func (m *restartSupervisor) OnOOM() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.oomKilled = true
+}
+
+// OnExit records the exit of the task, returning RESTARTING status with the
+// delay the task should be restarted after, or the final task status
+// otherwise.
+func (m *restartSupervisor) OnExit(exit taskExit) (sonm.TaskStatusReply_Status, time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	exit.OOMKilled = exit.OOMKilled || m.oomKilled
+	m.oomKilled = false
+	m.lastExit = &exit
+
+	if exit.FinishedAt.Sub(exit.StartedAt) < crashLoopWindow {
+		m.crashes++
+	} else {
+		m.crashes = 0
+	}
+
+	if m.stopped || !m.shouldRestart(exit) {
+		return exit.Status(), 0
+	}
+
+	if m.crashes >= crashLoopThreshold {
+		m.crashLoop = true
+		return sonm.TaskStatusReply_BROKEN, 0
+	}
+
+	m.restarts++
+	m.pending = true
+
+	return sonm.TaskStatusReply_RESTARTING, m.backoff()
+}
+
+func (m *restartSupervisor) shouldRestart(exit taskExit) bool {
+	switch m.policy {
+	case "always", "unless-stopped":
+		return true
+	case "on-failure":
+		failed := exit.ExitCode != 0 || exit.OOMKilled
+		return failed && (m.maxRetries == 0 || m.restarts < m.maxRetries)
+	default:
+		return false
+	}
+}
+
+func (m *restartSupervisor) backoff() time.Duration {
+	delay := restartBackoffMin
+	for id := 1; id < m.crashes && delay < restartBackoffMax; id++ {
+		delay *= 2
+	}
+	if delay > restartBackoffMax {
+		delay = restartBackoffMax
+	}
+
+	return delay
+}
+
+// Resume is called when the restart delay expires, returning false if the
+// task has been stopped meanwhile.
+func (m *restartSupervisor) Resume() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.pending = false
+	return !m.stopped
+}
+
+// Stop prevents further restarts of the task, because it is stopped by the
+// worker. Returns true if the restart was pending, i.e. the container is not
+// running.
+func (m *restartSupervisor) Stop() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.stopped = true
+	return m.pending
+}
+
+// Restarts returns the number of restarts and the last exit of the task.
+func (m *restartSupervisor) Restarts() (uint32, *sonm.TaskExit) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.lastExit == nil {
+		return m.restarts, nil
+	}
+
+	lastExit := &sonm.TaskExit{
+		ExitCode:  int32(m.lastExit.ExitCode),
+		OomKilled: m.lastExit.OOMKilled,
+		CrashLoop: m.crashLoop,
+	}
+	if !m.lastExit.FinishedAt.IsZero() {
+		lastExit.FinishedAt = sonm.NewTimestamp(m.lastExit.FinishedAt)
+	}
+
+	return m.restarts, lastExit
+}
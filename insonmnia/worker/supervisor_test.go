@@ -0,0 +1,129 @@
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testExitEpoch = time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func testTaskExit(exitCode int, duration time.Duration) taskExit {
+	return taskExit{
+		ExitCode:   exitCode,
+		StartedAt:  testExitEpoch,
+		FinishedAt: testExitEpoch.Add(duration),
+	}
+}
+
+func TestTaskExitStatus(t *testing.T) {
+	assert.Equal(t, sonm.TaskStatusReply_FINISHED, taskExit{}.Status())
+	assert.Equal(t, sonm.TaskStatusReply_BROKEN, taskExit{ExitCode: 1}.Status())
+	assert.Equal(t, sonm.TaskStatusReply_KILLED_OOM, taskExit{ExitCode: 137, OOMKilled: true}.Status())
+	assert.Equal(t, sonm.TaskStatusReply_KILLED_OOM, taskExit{OOMKilled: true}.Status())
+}
+
+func TestNewTaskExit(t *testing.T) {
+	exit := newTaskExit(&types.ContainerState{
+		ExitCode:   2,
+		OOMKilled:  true,
+		StartedAt:  "2018-06-01T12:00:00.5Z",
+		FinishedAt: "2018-06-01T12:01:00Z",
+	})
+
+	assert.Equal(t, 2, exit.ExitCode)
+	assert.True(t, exit.OOMKilled)
+	assert.Equal(t, 59500*time.Millisecond, exit.FinishedAt.Sub(exit.StartedAt))
+	assert.Equal(t, taskExit{}, newTaskExit(nil))
+}
+
+func TestRestartSupervisorNoPolicy(t *testing.T) {
+	for _, policy := range []*sonm.ContainerRestartPolicy{nil, {Name: "no"}} {
+		supervisor := newRestartSupervisor(policy)
+
+		status, _ := supervisor.OnExit(testTaskExit(1, time.Second))
+		assert.Equal(t, sonm.TaskStatusReply_BROKEN, status)
+
+		restarts, lastExit := supervisor.Restarts()
+		assert.Equal(t, uint32(0), restarts)
+		require.NotNil(t, lastExit)
+		assert.Equal(t, int32(1), lastExit.GetExitCode())
+		assert.False(t, lastExit.GetCrashLoop())
+	}
+}
+
+func TestRestartSupervisorOnFailure(t *testing.T) {
+	supervisor := newRestartSupervisor(&sonm.ContainerRestartPolicy{Name: "on-failure", MaximumRetryCount: 2})
+
+	status, _ := supervisor.OnExit(testTaskExit(0, time.Hour))
+	assert.Equal(t, sonm.TaskStatusReply_FINISHED, status)
+
+	for id := 0; id < 2; id++ {
+		status, _ = supervisor.OnExit(testTaskExit(1, time.Hour))
+		assert.Equal(t, sonm.TaskStatusReply_RESTARTING, status)
+		assert.True(t, supervisor.Resume())
+	}
+
+	status, _ = supervisor.OnExit(testTaskExit(1, time.Hour))
+	assert.Equal(t, sonm.TaskStatusReply_BROKEN, status)
+
+	restarts, _ := supervisor.Restarts()
+	assert.Equal(t, uint32(2), restarts)
+}
+
+func TestRestartSupervisorBackoff(t *testing.T) {
+	supervisor := newRestartSupervisor(&sonm.ContainerRestartPolicy{Name: "always"})
+
+	var delays []time.Duration
+	for id := 0; id < 4; id++ {
+		status, delay := supervisor.OnExit(testTaskExit(1, time.Second))
+		require.Equal(t, sonm.TaskStatusReply_RESTARTING, status)
+		supervisor.Resume()
+		delays = append(delays, delay)
+	}
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second}, delays)
+
+	// A stable run resets the backoff.
+	_, delay := supervisor.OnExit(testTaskExit(0, time.Hour))
+	assert.Equal(t, restartBackoffMin, delay)
+}
+
+func TestRestartSupervisorCrashLoop(t *testing.T) {
+	supervisor := newRestartSupervisor(&sonm.ContainerRestartPolicy{Name: "always"})
+
+	for id := 1; id < crashLoopThreshold; id++ {
+		status, delay := supervisor.OnExit(testTaskExit(1, time.Second))
+		require.Equal(t, sonm.TaskStatusReply_RESTARTING, status)
+		assert.True(t, delay <= restartBackoffMax)
+		supervisor.Resume()
+	}
+
+	supervisor.OnOOM()
+	status, _ := supervisor.OnExit(testTaskExit(137, time.Second))
+	assert.Equal(t, sonm.TaskStatusReply_BROKEN, status)
+
+	restarts, lastExit := supervisor.Restarts()
+	assert.Equal(t, uint32(crashLoopThreshold-1), restarts)
+	require.NotNil(t, lastExit)
+	assert.Equal(t, int32(137), lastExit.GetExitCode())
+	assert.True(t, lastExit.GetOomKilled())
+	assert.True(t, lastExit.GetCrashLoop())
+	assert.Equal(t, testExitEpoch.Add(time.Second), lastExit.GetFinishedAt().Unix())
+}
+
+func TestRestartSupervisorStop(t *testing.T) {
+	supervisor := newRestartSupervisor(&sonm.ContainerRestartPolicy{Name: "always"})
+
+	status, _ := supervisor.OnExit(testTaskExit(1, time.Second))
+	require.Equal(t, sonm.TaskStatusReply_RESTARTING, status)
+
+	assert.True(t, supervisor.Stop())
+	assert.False(t, supervisor.Resume())
+
+	status, _ = supervisor.OnExit(testTaskExit(1, time.Second))
+	assert.Equal(t, sonm.TaskStatusReply_BROKEN, status)
+}
@@ -0,0 +1,193 @@
+package worker
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/sonm-io/core/insonmnia/worker/gpu"
+	sonm "github.com/sonm-io/core/proto"
+)
+
+// taskMetricsCapacity is the number of samples kept for each task, which is
+// a day of history with the default collection interval.
+const taskMetricsCapacity = 2880
+
+// GPUUtilization returns the current utilization of GPU devices in percents.
+type GPUUtilization func() map[gpu.GPUID]float64
+
+// taskMetricsSeries is a ring buffer of resource usage samples of a task.
+type taskMetricsSeries struct {
+	mu       sync.Mutex
+	samples  []*sonm.TaskMetricsSample
+	next     int
+	previous *types.StatsJSON
+}
+
+func newTaskMetricsSeries(capacity int) *taskMetricsSeries {
+	return &taskMetricsSeries{
+		samples: make([]*sonm.TaskMetricsSample, 0, capacity),
+	}
+}
+
+// Append records a new sample from the given Docker stats. Rates are
+// calculated relative to the previous stats, so the very first call only
+// remembers them.
+func (m *taskMetricsSeries) Append(stats types.StatsJSON, gpuUtilization float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	previous := m.previous
+	m.previous = &stats
+	if previous == nil {
+		return
+	}
+
+	elapsed := stats.Read.Sub(previous.Read).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+
+	currRx, currTx := networkBytes(stats)
+	prevRx, prevTx := networkBytes(*previous)
+	currRead, currWrite := blockBytes(stats)
+	prevRead, prevWrite := blockBytes(*previous)
+
+	sample := &sonm.TaskMetricsSample{
+		Timestamp:      sonm.NewTimestamp(stats.Read),
+		Cpu:            counterRate(stats.CPUStats.CPUUsage.TotalUsage, previous.CPUStats.CPUUsage.TotalUsage, elapsed) / float64(time.Second) * 100,
+		Memory:         stats.MemoryStats.Usage,
+		RxBytesRate:    counterRate(currRx, prevRx, elapsed),
+		TxBytesRate:    counterRate(currTx, prevTx, elapsed),
+		BlockReadRate:  counterRate(currRead, prevRead, elapsed),
+		BlockWriteRate: counterRate(currWrite, prevWrite, elapsed),
+		Gpu:            gpuUtilization,
+	}
+
+	if len(m.samples) < cap(m.samples) {
+		m.samples = append(m.samples, sample)
+		return
+	}
+
+	m.samples[m.next] = sample
+	m.next = (m.next + 1) % len(m.samples)
+}
+
+// Query returns samples within the given time range. When the step is
+// positive, samples are grouped into buckets aligned to the step and
+// averaged.
+func (m *taskMetricsSeries) Query(from, to time.Time, step time.Duration) []*sonm.TaskMetricsSample {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := make([]*sonm.TaskMetricsSample, 0)
+	var bucket []*sonm.TaskMetricsSample
+	var bucketStart time.Time
+
+	flush := func() {
+		if len(bucket) != 0 {
+			result = append(result, averageTaskMetrics(bucketStart, bucket))
+			bucket = nil
+		}
+	}
+
+	for id := range m.samples {
+		sample := m.samples[(m.next+id)%len(m.samples)]
+		timestamp := sample.GetTimestamp().Unix()
+		if timestamp.Before(from) || timestamp.After(to) {
+			continue
+		}
+
+		if step <= 0 {
+			result = append(result, sample)
+			continue
+		}
+
+		if start := timestamp.Truncate(step); !start.Equal(bucketStart) {
+			flush()
+			bucketStart = start
+		}
+		bucket = append(bucket, sample)
+	}
+	flush()
+
+	return result
+}
+
+func averageTaskMetrics(timestamp time.Time, samples []*sonm.TaskMetricsSample) *sonm.TaskMetricsSample {
+	result := &sonm.TaskMetricsSample{
+		Timestamp: sonm.NewTimestamp(timestamp),
+	}
+
+	var memory uint64
+	for _, sample := range samples {
+		result.Cpu += sample.GetCpu()
+		memory += sample.GetMemory()
+		result.RxBytesRate += sample.GetRxBytesRate()
+		result.TxBytesRate += sample.GetTxBytesRate()
+		result.BlockReadRate += sample.GetBlockReadRate()
+		result.BlockWriteRate += sample.GetBlockWriteRate()
+		result.Gpu += sample.GetGpu()
+	}
+
+	count := float64(len(samples))
+	result.Cpu /= count
+	result.Memory = memory / uint64(len(samples))
+	result.RxBytesRate /= count
+	result.TxBytesRate /= count
+	result.BlockReadRate /= count
+	result.BlockWriteRate /= count
+	result.Gpu /= count
+
+	return result
+}
+
+// counterRate returns the per-second rate of the monotonic counter. The
+// counter is considered reset if it decreases, for example when the
+// container restarts.
+func counterRate(current, previous uint64, elapsed float64) float64 {
+	if current < previous {
+		return float64(current) / elapsed
+	}
+
+	return float64(current-previous) / elapsed
+}
+
+func networkBytes(stats types.StatsJSON) (rx uint64, tx uint64) {
+	for _, network := range stats.Networks {
+		rx += network.RxBytes
+		tx += network.TxBytes
+	}
+
+	return rx, tx
+}
+
+func blockBytes(stats types.StatsJSON) (read uint64, write uint64) {
+	for _, entry := range stats.BlkioStats.IoServiceBytesRecursive {
+		switch {
+		case strings.EqualFold(entry.Op, "read"):
+			read += entry.Value
+		case strings.EqualFold(entry.Op, "write"):
+			write += entry.Value
+		}
+	}
+
+	return read, write
+}
+
+// averageGPUUtilization returns the average utilization of the given GPU
+// devices. Devices without known utilization are counted as idle.
+// Partitions report utilization of the whole physical device.
+func averageGPUUtilization(utilization map[gpu.GPUID]float64, devices []gpu.GPUID) float64 {
+	if len(devices) == 0 {
+		return 0
+	}
+
+	total := 0.0
+	for _, id := range devices {
+		total += utilization[id.Physical()]
+	}
+
+	return total / float64(len(devices))
+}
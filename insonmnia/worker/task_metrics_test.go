@@ -0,0 +1,84 @@
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/sonm-io/core/insonmnia/worker/gpu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMetricsEpoch = time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func testContainerStats(id int) types.StatsJSON {
+	stats := types.StatsJSON{
+		Networks: map[string]types.NetworkStats{
+			"eth0": {RxBytes: uint64(id) * 3000, TxBytes: uint64(id) * 300},
+			"eth1": {RxBytes: uint64(id) * 3000},
+		},
+	}
+	stats.Read = testMetricsEpoch.Add(time.Duration(id) * 30 * time.Second)
+	// Half of a core.
+	stats.CPUStats.CPUUsage.TotalUsage = uint64(id) * uint64(15*time.Second)
+	stats.MemoryStats.Usage = uint64(id) * 1024
+	stats.BlkioStats.IoServiceBytesRecursive = []types.BlkioStatEntry{
+		{Op: "Read", Value: uint64(id) * 60},
+		{Op: "Write", Value: uint64(id) * 30},
+		{Op: "Total", Value: uint64(id) * 90},
+	}
+
+	return stats
+}
+
+func TestTaskMetricsSeries(t *testing.T) {
+	series := newTaskMetricsSeries(4)
+	for id := 0; id <= 6; id++ {
+		series.Append(testContainerStats(id), float64(id))
+	}
+
+	samples := series.Query(time.Time{}, testMetricsEpoch.Add(time.Hour), 0)
+	require.Len(t, samples, 4)
+	for id, sample := range samples {
+		assert.Equal(t, testMetricsEpoch.Add(time.Duration(id+3)*30*time.Second), sample.GetTimestamp().Unix())
+		assert.InDelta(t, 50.0, sample.GetCpu(), 1e-9)
+		assert.Equal(t, uint64(id+3)*1024, sample.GetMemory())
+		assert.InDelta(t, 200.0, sample.GetRxBytesRate(), 1e-9)
+		assert.InDelta(t, 10.0, sample.GetTxBytesRate(), 1e-9)
+		assert.InDelta(t, 2.0, sample.GetBlockReadRate(), 1e-9)
+		assert.InDelta(t, 1.0, sample.GetBlockWriteRate(), 1e-9)
+		assert.Equal(t, float64(id+3), sample.GetGpu())
+	}
+
+	samples = series.Query(testMetricsEpoch.Add(2*time.Minute), testMetricsEpoch.Add(3*time.Minute), time.Minute)
+	require.Len(t, samples, 2)
+	assert.Equal(t, testMetricsEpoch.Add(2*time.Minute), samples[0].GetTimestamp().Unix())
+	assert.Equal(t, 4.5, samples[0].GetGpu())
+	assert.Equal(t, uint64(4608), samples[0].GetMemory())
+	assert.Equal(t, testMetricsEpoch.Add(3*time.Minute), samples[1].GetTimestamp().Unix())
+	assert.Equal(t, 6.0, samples[1].GetGpu())
+}
+
+func TestTaskMetricsSeriesCounterReset(t *testing.T) {
+	series := newTaskMetricsSeries(4)
+	series.Append(testContainerStats(10), 0)
+	series.Append(testContainerStats(11), 0)
+
+	restarted := testContainerStats(1)
+	restarted.Read = testContainerStats(12).Read
+	series.Append(restarted, 0)
+
+	samples := series.Query(time.Time{}, testMetricsEpoch.Add(time.Hour), 0)
+	require.Len(t, samples, 2)
+	assert.InDelta(t, 50.0, samples[1].GetCpu(), 1e-9)
+}
+
+func TestAverageGPUUtilization(t *testing.T) {
+	utilization := map[gpu.GPUID]float64{"0000:01:00.0": 80, "0000:02:00.0": 40}
+
+	assert.Equal(t, 0.0, averageGPUUtilization(utilization, nil))
+	assert.Equal(t, 60.0, averageGPUUtilization(utilization, []gpu.GPUID{"0000:01:00.0", "0000:02:00.0"}))
+	assert.Equal(t, 40.0, averageGPUUtilization(utilization, []gpu.GPUID{"0000:01:00.0", "0000:03:00.0"}))
+	assert.Equal(t, 80.0, averageGPUUtilization(utilization, []gpu.GPUID{"0000:01:00.0/mig1"}))
+}
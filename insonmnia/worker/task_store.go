@@ -0,0 +1,218 @@
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/sonm-io/core/insonmnia/state"
+	"github.com/sonm-io/core/proto"
+)
+
+const (
+	taskRecordsKey = "tasks"
+	// taskRecordVersion is the version of task records written by this
+	// worker. Records of previous versions are upgraded on load.
+	taskRecordVersion = 1
+)
+
+// taskRecord is the persistent state of the task. It is written on every
+// task state transition, so the worker is able to restore tasks after it is
+// restarted or crashed.
+type taskRecord struct {
+	Version     int                         `json:"version"`
+	Description Description                 `json:"description,omitempty"`
+	Cinfo       ContainerInfo               `json:"cinfo,omitempty"`
+	Spec        sonm.TaskSpec               `json:"spec,omitempty"`
+	Status      sonm.TaskStatusReply_Status `json:"status"`
+	UpdatedAt   time.Time                   `json:"updatedAt"`
+}
+
+// taskRecordMigration upgrades the record of the previous version in place.
+type taskRecordMigration func(record map[string]json.RawMessage) error
+
+// taskRecordMigrations contains migrations by versions they upgrade from,
+// i.e. the first one upgrades records from version 0 to version 1. New
+// fields of the record require a migration only when their zero value is
+// not suitable for old records.
+var taskRecordMigrations = []taskRecordMigration{
+	migrateTaskRecordV0,
+}
+
+// migrateTaskRecordV0 upgrades records of running containers kept by
+// previous versions of the worker, which had no status. The status is
+// restored from Docker later.
+func migrateTaskRecordV0(record map[string]json.RawMessage) error {
+	status, err := json.Marshal(sonm.TaskStatusReply_UNKNOWN)
+	if err != nil {
+		return err
+	}
+
+	record["status"] = status
+	return nil
+}
+
+func decodeTaskRecord(data []byte) (*taskRecord, error) {
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	version := 0
+	if value, ok := raw["version"]; ok {
+		if err := json.Unmarshal(value, &version); err != nil {
+			return nil, fmt.Errorf("invalid version: %v", err)
+		}
+	}
+
+	if version > taskRecordVersion {
+		return nil, fmt.Errorf("record version %d is newer than supported %d", version, taskRecordVersion)
+	}
+
+	for ; version < taskRecordVersion; version++ {
+		if err := taskRecordMigrations[version](raw); err != nil {
+			return nil, fmt.Errorf("failed to migrate record from version %d: %v", version, err)
+		}
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &taskRecord{}
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, err
+	}
+
+	record.Version = taskRecordVersion
+	return record, nil
+}
+
+// interrupted reports whether the worker has been stopped while starting
+// the task, i.e. before its container has been recorded.
+func (m *taskRecord) interrupted() bool {
+	return len(m.Cinfo.ID) == 0
+}
+
+// containerStatus maps the state of the Docker container to the task status.
+func containerStatus(state *types.ContainerState, hasHealthcheck bool) sonm.TaskStatusReply_Status {
+	switch state.Status {
+	case "running":
+		if hasHealthcheck && state.Health != nil {
+			switch state.Health.Status {
+			case types.Healthy:
+				return sonm.TaskStatusReply_HEALTHY
+			case types.Unhealthy:
+				return sonm.TaskStatusReply_UNHEALTHY
+			}
+		}
+		return sonm.TaskStatusReply_RUNNING
+	case "exited":
+		return newTaskExit(state).Status()
+	case "dead":
+		return sonm.TaskStatusReply_BROKEN
+	default:
+		return sonm.TaskStatusReply_UNKNOWN
+	}
+}
+
+// taskStore keeps task records by task IDs.
+type taskStore struct {
+	mu      sync.Mutex
+	storage *state.KeyedStorage
+	records map[string]*taskRecord
+}
+
+func newTaskStore(storage *state.Storage) *taskStore {
+	return &taskStore{
+		storage: state.NewKeyedStorage(taskRecordsKey, storage),
+		records: map[string]*taskRecord{},
+	}
+}
+
+// Load reads task records from the storage, upgrading them to the current
+// version. Records are never dropped silently, so an error is returned when
+// any of them can not be decoded, e.g. after downgrading the worker.
+func (m *taskStore) Load() (map[string]*taskRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	raw := map[string]json.RawMessage{}
+	if _, err := m.storage.Load(&raw); err != nil {
+		return nil, err
+	}
+
+	m.records = map[string]*taskRecord{}
+	for id, data := range raw {
+		record, err := decodeTaskRecord(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode record of task %s: %v", id, err)
+		}
+
+		m.records[id] = record
+	}
+
+	if err := m.save(); err != nil {
+		return nil, err
+	}
+
+	return m.copyRecords(), nil
+}
+
+// Put writes the record of the task.
+func (m *taskStore) Put(id string, record *taskRecord) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	record.Version = taskRecordVersion
+	record.UpdatedAt = time.Now()
+	m.records[id] = record
+
+	return m.save()
+}
+
+// SetStatus updates the status of the task, if it has the record.
+func (m *taskStore) SetStatus(id string, status sonm.TaskStatusReply_Status) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	record, ok := m.records[id]
+	if !ok || record.Status == status {
+		return nil
+	}
+
+	record.Status = status
+	record.UpdatedAt = time.Now()
+
+	return m.save()
+}
+
+// Remove drops the record of the task.
+func (m *taskStore) Remove(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.records[id]; !ok {
+		return nil
+	}
+
+	delete(m.records, id)
+	return m.save()
+}
+
+func (m *taskStore) save() error {
+	return m.storage.Save(m.records)
+}
+
+func (m *taskStore) copyRecords() map[string]*taskRecord {
+	records := make(map[string]*taskRecord, len(m.records))
+	for id, record := range m.records {
+		copied := *record
+		records[id] = &copied
+	}
+
+	return records
+}
@@ -0,0 +1,102 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/sonm-io/core/insonmnia/state"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTaskStorage(t *testing.T, path string) *state.Storage {
+	storage, err := state.NewState(context.Background(), &state.StorageConfig{
+		Endpoint: path,
+		Bucket:   "sonm",
+	})
+	require.NoError(t, err)
+
+	return storage
+}
+
+func TestDecodeLegacyTaskRecord(t *testing.T) {
+	// Records of running containers kept by previous versions of the worker.
+	data, err := json.Marshal(map[string]interface{}{
+		"description": Description{TaskId: "task", DealId: "42"},
+		"cinfo": ContainerInfo{
+			ID:     "container",
+			TaskId: "task",
+			DealID: sonm.NewBigIntFromInt(42),
+			Tag:    &sonm.TaskTag{Data: []byte("tag")},
+		},
+		"spec": sonm.TaskSpec{Tag: &sonm.TaskTag{Data: []byte("tag")}},
+	})
+	require.NoError(t, err)
+
+	record, err := decodeTaskRecord(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, taskRecordVersion, record.Version)
+	assert.Equal(t, sonm.TaskStatusReply_UNKNOWN, record.Status)
+	assert.Equal(t, "task", record.Description.TaskId)
+	assert.Equal(t, "container", record.Cinfo.ID)
+	assert.Equal(t, int64(42), record.Cinfo.DealID.Unwrap().Int64())
+	assert.Equal(t, []byte("tag"), record.Cinfo.Tag.GetData())
+	assert.False(t, record.interrupted())
+}
+
+func TestDecodeTaskRecordNewerVersion(t *testing.T) {
+	_, err := decodeTaskRecord([]byte(`{"version": 100}`))
+	assert.Error(t, err)
+}
+
+func TestTaskStoreRestart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sonm-tasks")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "worker.boltdb")
+
+	tasks := newTaskStore(newTestTaskStorage(t, path))
+	require.NoError(t, tasks.Put("running", &taskRecord{
+		Cinfo:  ContainerInfo{ID: "container", TaskId: "running"},
+		Status: sonm.TaskStatusReply_SPAWNING,
+	}))
+	require.NoError(t, tasks.SetStatus("running", sonm.TaskStatusReply_RUNNING))
+	require.NoError(t, tasks.Put("removed", &taskRecord{Cinfo: ContainerInfo{ID: "removed", TaskId: "removed"}}))
+	require.NoError(t, tasks.Remove("removed"))
+
+	// The worker is killed in the middle of StartTask, i.e. while spooling
+	// the image, before the container is recorded.
+	require.NoError(t, tasks.Put("starting", &taskRecord{
+		Cinfo:  ContainerInfo{TaskId: "starting"},
+		Status: sonm.TaskStatusReply_SPOOLING,
+	}))
+
+	// The worker is started again using the same storage.
+	records, err := newTaskStore(newTestTaskStorage(t, path)).Load()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	assert.Equal(t, sonm.TaskStatusReply_RUNNING, records["running"].Status)
+	assert.Equal(t, "container", records["running"].Cinfo.ID)
+	assert.False(t, records["running"].interrupted())
+
+	assert.Equal(t, sonm.TaskStatusReply_SPOOLING, records["starting"].Status)
+	assert.True(t, records["starting"].interrupted())
+}
+
+func TestContainerStatus(t *testing.T) {
+	running := &types.ContainerState{Status: "running", Health: &types.Health{Status: types.Unhealthy}}
+	assert.Equal(t, sonm.TaskStatusReply_UNHEALTHY, containerStatus(running, true))
+	assert.Equal(t, sonm.TaskStatusReply_RUNNING, containerStatus(running, false))
+
+	assert.Equal(t, sonm.TaskStatusReply_BROKEN, containerStatus(&types.ContainerState{Status: "dead"}, false))
+	assert.Equal(t, sonm.TaskStatusReply_UNKNOWN, containerStatus(&types.ContainerState{Status: "paused"}, false))
+}
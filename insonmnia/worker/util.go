@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/sonm-io/core/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // BackoffTimer implementation
@@ -86,3 +88,42 @@ func (r *chunkReader) Read(p []byte) (n int, err error) {
 
 	return size, nil
 }
+
+// copyChunkReader reads a tar archive sent via CopyToTask stream, reporting
+// progress back and refusing to read more than the specified limit.
+type copyChunkReader struct {
+	stream sonm.Worker_CopyToTaskServer
+	buf    []byte
+	// limit is the maximum archive size in bytes. Zero means no limit.
+	limit uint64
+	read  uint64
+}
+
+func newCopyChunkReader(stream sonm.Worker_CopyToTaskServer, chunk []byte, limit uint64) io.Reader {
+	return &copyChunkReader{stream: stream, buf: chunk, limit: limit}
+}
+
+func (r *copyChunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		request, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		r.buf = request.GetChunk()
+	}
+
+	size := copy(p, r.buf)
+	r.buf = r.buf[size:]
+	r.read += uint64(size)
+
+	if r.limit > 0 && r.read > r.limit {
+		return 0, status.Errorf(codes.ResourceExhausted, "archive exceeds %d bytes left in task storage quota", r.limit)
+	}
+
+	if err := r.stream.Send(&sonm.Progress{Size: int64(size)}); err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
@@ -55,7 +55,7 @@ func NewBTFSDriver(options ...Option) (*BTFSDriver, error) {
 	return m, nil
 }
 
-func (m *BTFSDriver) CreateVolume(name string, options map[string]string) (Volume, error) {
+func (m *BTFSDriver) CreateVolume(ctx context.Context, name string, options map[string]string) (Volume, error) {
 	m.log.Debugw("handling Worker.CreateVolume request", zap.String("name", name), zap.Any("options", options))
 
 	return &BTFSVolume{Options: options}, nil
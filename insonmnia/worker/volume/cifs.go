@@ -62,7 +62,7 @@ func NewCIFSVolumeDriver(ctx context.Context, options ...Option) (VolumeDriver,
 	return &cifsVolumeDriver{driver, listener, log.G(ctx)}, nil
 }
 
-func (d *cifsVolumeDriver) CreateVolume(name string, options map[string]string) (Volume, error) {
+func (d *cifsVolumeDriver) CreateVolume(ctx context.Context, name string, options map[string]string) (Volume, error) {
 	d.logger.Info("creating volume", zap.String("name", name))
 
 	if version, ok := options["vers"]; ok {
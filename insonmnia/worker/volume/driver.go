@@ -41,8 +41,8 @@ type VolumeDriver interface {
 	// filesystem and tries to fetch the provided magnet URL.
 	//
 	// Both "name" and "options" parameters are passed directly from the
-	// task specification.
-	CreateVolume(name string, options map[string]string) (Volume, error)
+	// task specification. The context limits the time of the preparation.
+	CreateVolume(ctx context.Context, name string, options map[string]string) (Volume, error)
 	// RemoveVolume removes an existing volume.
 	RemoveVolume(name string) error
 	// Close closes this driver, freeing all associated resources.
@@ -51,7 +51,7 @@ type VolumeDriver interface {
 
 type nilVolumeDriver struct{}
 
-func (nilVolumeDriver) CreateVolume(name string, options map[string]string) (Volume, error) {
+func (nilVolumeDriver) CreateVolume(ctx context.Context, name string, options map[string]string) (Volume, error) {
 	return &nilVolume{}, nil
 }
 
@@ -78,6 +78,14 @@ func NewVolumeDriver(ctx context.Context, ty string, options ...Option) (VolumeD
 	switch ty {
 	case drivers.CIFS.String():
 		return NewCIFSVolumeDriver(ctx, options...)
+	case drivers.NFS.String():
+		return NewNFSVolumeDriver(ctx, options...)
+	case LocalDirDriverName:
+		return NewLocalDirVolumeDriver(ctx, options...)
+	case S3DriverName:
+		return NewS3VolumeDriver(ctx, options...)
+	case DealVolumeDriverName:
+		return NewDealVolumeDriver(ctx, options...)
 	case BTFSDriverName:
 		return NewBTFSDriver(options...)
 	default:
@@ -0,0 +1,253 @@
+// Deal-scoped local volumes.
+
+package volume
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"go.uber.org/zap"
+)
+
+const (
+	DealVolumeDriverName = "local"
+	// OptionDealID is the volume option with the ID of the deal the volume
+	// is created for.
+	OptionDealID = "DealID"
+	// OptionVolumeName is the volume option with the volume name as it is
+	// specified in the task spec.
+	OptionVolumeName = "VolumeName"
+	// OptionStorageQuota is the volume option with the storage quota of the
+	// deal in bytes.
+	OptionStorageQuota = "StorageQuota"
+)
+
+// DealVolume describes a volume, whose lifetime is bound to a deal.
+type DealVolume struct {
+	Name string
+	Path string
+	// Size is the disk space taken by the volume in bytes.
+	Size uint64
+}
+
+// DealVolumeDriver describes volume drivers, whose volumes outlive tasks and
+// are destroyed only when the deal is closed.
+type DealVolumeDriver interface {
+	VolumeDriver
+	// DealVolumes returns all volumes of the given deal.
+	DealVolumes(dealID string) ([]DealVolume, error)
+	// RemoveDealVolumes destroys all volumes of the given deal.
+	RemoveDealVolumes(dealID string) error
+}
+
+// dealVolumeDriver provides directories on the worker's disk, that persist
+// between tasks of the same deal, allowing them to share state.
+//
+// A volume is created on the first use and is mountable by any task of the
+// deal, that refers to it by the same name. The disk space taken by volumes
+// is counted against the deal's storage quota together with writable layers
+// of the deal's containers, when the storage quota tuner supports that.
+// Otherwise the quota is only checked each time a task using them starts.
+type dealVolumeDriver struct {
+	rootDir  string
+	dirQuota DirQuotaFunc
+	logger   *zap.Logger
+}
+
+// NewDealVolumeDriver constructs a new deal-scoped local volume driver.
+func NewDealVolumeDriver(ctx context.Context, options ...Option) (VolumeDriver, error) {
+	opts := newOptions()
+
+	for _, option := range options {
+		if err := option(opts); err != nil {
+			return nil, err
+		}
+	}
+
+	rootDir := filepath.Join(opts.rootDir, DealVolumeDriverName)
+	if err := os.MkdirAll(rootDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create deal volumes directory: %v", err)
+	}
+
+	return &dealVolumeDriver{
+		rootDir:  rootDir,
+		dirQuota: opts.dirQuota,
+		logger:   log.G(ctx),
+	}, nil
+}
+
+func (d *dealVolumeDriver) CreateVolume(ctx context.Context, name string, options map[string]string) (Volume, error) {
+	d.logger.Info("creating volume", zap.String("name", name))
+
+	dealID := options[OptionDealID]
+	dealDir, err := d.dealDir(dealID)
+	if err != nil {
+		return nil, err
+	}
+
+	volumeName := options[OptionVolumeName]
+	if !isValidPathComponent(volumeName) {
+		return nil, fmt.Errorf("invalid volume name %q", volumeName)
+	}
+
+	quota := uint64(0)
+	if value, ok := options[OptionStorageQuota]; ok && len(value) > 0 {
+		quota, err = strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid storage quota %q: %v", value, err)
+		}
+	}
+
+	if quota > 0 && d.dirQuota == nil {
+		usage, err := diskUsage(dealDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate deal volumes usage: %v", err)
+		}
+
+		if usage >= quota {
+			return nil, fmt.Errorf("deal volumes take %d bytes exceeding the storage quota of %d bytes", usage, quota)
+		}
+	}
+
+	path := filepath.Join(dealDir, volumeName)
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create volume directory: %v", err)
+	}
+
+	// Deal volumes share the quota with writable layers of the deal's
+	// containers, which use the deal ID as the quota ID.
+	if quota > 0 && d.dirQuota != nil {
+		if err := d.dirQuota(ctx, dealDir, dealID, quota); err != nil {
+			return nil, fmt.Errorf("failed to set storage quota on deal volumes: %v", err)
+		}
+	}
+
+	return &dealVolume{path: path}, nil
+}
+
+// RemoveVolume does nothing, because volumes must survive tasks. They are
+// removed using RemoveDealVolumes when the deal is closed.
+func (d *dealVolumeDriver) RemoveVolume(name string) error {
+	return nil
+}
+
+func (d *dealVolumeDriver) DealVolumes(dealID string) ([]DealVolume, error) {
+	dealDir, err := d.dealDir(dealID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := ioutil.ReadDir(dealDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	volumes := make([]DealVolume, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		path := filepath.Join(dealDir, entry.Name())
+		size, err := diskUsage(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate volume %s usage: %v", entry.Name(), err)
+		}
+
+		volumes = append(volumes, DealVolume{
+			Name: entry.Name(),
+			Path: path,
+			Size: size,
+		})
+	}
+
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].Name < volumes[j].Name
+	})
+
+	return volumes, nil
+}
+
+func (d *dealVolumeDriver) RemoveDealVolumes(dealID string) error {
+	dealDir, err := d.dealDir(dealID)
+	if err != nil {
+		return err
+	}
+
+	d.logger.Info("removing deal volumes", zap.String("dealID", dealID))
+
+	return os.RemoveAll(dealDir)
+}
+
+func (d *dealVolumeDriver) dealDir(dealID string) (string, error) {
+	if !isValidPathComponent(dealID) {
+		return "", fmt.Errorf("invalid deal ID %q", dealID)
+	}
+
+	return filepath.Join(d.rootDir, dealID), nil
+}
+
+func (d *dealVolumeDriver) Close() error {
+	return nil
+}
+
+type dealVolume struct {
+	path string
+}
+
+func (v *dealVolume) Configure(m Mount, cfg *container.HostConfig) error {
+	cfg.Mounts = append(cfg.Mounts, mount.Mount{
+		Type:        mount.TypeBind,
+		Source:      v.path,
+		Target:      m.Target,
+		ReadOnly:    m.ReadOnly(),
+		Consistency: mount.ConsistencyDefault,
+
+		BindOptions: &mount.BindOptions{
+			Propagation: mount.PropagationRPrivate,
+		},
+		VolumeOptions: nil,
+		TmpfsOptions:  nil,
+	})
+
+	return nil
+}
+
+func isValidPathComponent(name string) bool {
+	return len(name) > 0 && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
+
+// diskUsage returns the total size of regular files within the given
+// directory. Missing directories take no space.
+func diskUsage(path string) (uint64, error) {
+	size := uint64(0)
+
+	err := filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if info.Mode().IsRegular() {
+			size += uint64(info.Size())
+		}
+
+		return nil
+	})
+
+	return size, err
+}
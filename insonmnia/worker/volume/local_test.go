@@ -0,0 +1,180 @@
+package volume
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDealVolumeDriver(t *testing.T) (DealVolumeDriver, string) {
+	rootDir, err := ioutil.TempDir("", "deal-volume")
+	require.NoError(t, err)
+
+	driver, err := NewDealVolumeDriver(context.Background(), WithRootDir(rootDir))
+	require.NoError(t, err)
+
+	return driver.(DealVolumeDriver), rootDir
+}
+
+func dealVolumeOptions(dealID, name string, quota string) map[string]string {
+	return map[string]string{
+		OptionDealID:       dealID,
+		OptionVolumeName:   name,
+		OptionStorageQuota: quota,
+	}
+}
+
+func TestDealVolumeSurvivesTasks(t *testing.T) {
+	driver, rootDir := newTestDealVolumeDriver(t)
+	defer os.RemoveAll(rootDir)
+
+	v, err := driver.CreateVolume(context.Background(), "task-1/state", dealVolumeOptions("42", "state", "0"))
+	require.NoError(t, err)
+
+	cfg := &container.HostConfig{}
+	require.NoError(t, v.Configure(Mount{Source: "task-1/state", Target: "/state", Permission: RW}, cfg))
+	require.Len(t, cfg.Mounts, 1)
+	assert.False(t, cfg.Mounts[0].ReadOnly)
+
+	path := cfg.Mounts[0].Source
+	require.NoError(t, ioutil.WriteFile(filepath.Join(path, "checkpoint"), []byte("epoch=1"), 0644))
+
+	// The task finishes.
+	require.NoError(t, driver.RemoveVolume("task-1/state"))
+
+	// The next task of the same deal sees the state.
+	v, err = driver.CreateVolume(context.Background(), "task-2/state", dealVolumeOptions("42", "state", "0"))
+	require.NoError(t, err)
+
+	cfg = &container.HostConfig{}
+	require.NoError(t, v.Configure(Mount{Source: "task-2/state", Target: "/state", Permission: RO}, cfg))
+	require.Len(t, cfg.Mounts, 1)
+	assert.Equal(t, path, cfg.Mounts[0].Source)
+	assert.True(t, cfg.Mounts[0].ReadOnly)
+
+	data, err := ioutil.ReadFile(filepath.Join(cfg.Mounts[0].Source, "checkpoint"))
+	require.NoError(t, err)
+	assert.Equal(t, "epoch=1", string(data))
+
+	// Tasks of other deals don't.
+	v, err = driver.CreateVolume(context.Background(), "task-3/state", dealVolumeOptions("43", "state", "0"))
+	require.NoError(t, err)
+
+	cfg = &container.HostConfig{}
+	require.NoError(t, v.Configure(Mount{Source: "task-3/state", Target: "/state", Permission: RW}, cfg))
+	assert.NotEqual(t, path, cfg.Mounts[0].Source)
+}
+
+func TestDealVolumeListAndRemove(t *testing.T) {
+	driver, rootDir := newTestDealVolumeDriver(t)
+	defer os.RemoveAll(rootDir)
+
+	for _, name := range []string{"state", "cache"} {
+		v, err := driver.CreateVolume(context.Background(), "task-1/"+name, dealVolumeOptions("42", name, ""))
+		require.NoError(t, err)
+
+		cfg := &container.HostConfig{}
+		require.NoError(t, v.Configure(Mount{Source: "task-1/" + name, Target: "/" + name, Permission: RW}, cfg))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(cfg.Mounts[0].Source, "data"), make([]byte, 100), 0644))
+	}
+
+	volumes, err := driver.DealVolumes("42")
+	require.NoError(t, err)
+	require.Len(t, volumes, 2)
+	assert.Equal(t, "cache", volumes[0].Name)
+	assert.Equal(t, uint64(100), volumes[0].Size)
+	assert.Equal(t, "state", volumes[1].Name)
+	assert.Equal(t, uint64(100), volumes[1].Size)
+
+	volumes, err = driver.DealVolumes("43")
+	require.NoError(t, err)
+	assert.Len(t, volumes, 0)
+
+	require.NoError(t, driver.RemoveDealVolumes("42"))
+
+	volumes, err = driver.DealVolumes("42")
+	require.NoError(t, err)
+	assert.Len(t, volumes, 0)
+
+	_, err = os.Stat(filepath.Join(rootDir, DealVolumeDriverName, "42"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestDealVolumeQuota(t *testing.T) {
+	driver, rootDir := newTestDealVolumeDriver(t)
+	defer os.RemoveAll(rootDir)
+
+	v, err := driver.CreateVolume(context.Background(), "task-1/state", dealVolumeOptions("42", "state", "1024"))
+	require.NoError(t, err)
+
+	cfg := &container.HostConfig{}
+	require.NoError(t, v.Configure(Mount{Source: "task-1/state", Target: "/state", Permission: RW}, cfg))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(cfg.Mounts[0].Source, "data"), make([]byte, 2048), 0644))
+
+	_, err = driver.CreateVolume(context.Background(), "task-2/state", dealVolumeOptions("42", "state", "1024"))
+	assert.Error(t, err)
+
+	_, err = driver.CreateVolume(context.Background(), "task-2/other", dealVolumeOptions("42", "other", "1024"))
+	assert.Error(t, err)
+}
+
+func TestDealVolumeDirQuota(t *testing.T) {
+	rootDir, err := ioutil.TempDir("", "deal-volume")
+	require.NoError(t, err)
+	defer os.RemoveAll(rootDir)
+
+	type call struct {
+		path    string
+		quotaID string
+		bytes   uint64
+	}
+
+	var calls []call
+	dirQuota := func(ctx context.Context, path string, quotaID string, bytes uint64) error {
+		calls = append(calls, call{path: path, quotaID: quotaID, bytes: bytes})
+		return nil
+	}
+
+	driver, err := NewDealVolumeDriver(context.Background(), WithRootDir(rootDir), WithDirQuota(dirQuota))
+	require.NoError(t, err)
+
+	v, err := driver.CreateVolume(context.Background(), "task-1/state", dealVolumeOptions("42", "state", "1024"))
+	require.NoError(t, err)
+
+	cfg := &container.HostConfig{}
+	require.NoError(t, v.Configure(Mount{Source: "task-1/state", Target: "/state", Permission: RW}, cfg))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(cfg.Mounts[0].Source, "data"), make([]byte, 2048), 0644))
+
+	// The quota is enforced by the filesystem instead of the usage check.
+	_, err = driver.CreateVolume(context.Background(), "task-2/state", dealVolumeOptions("42", "state", "1024"))
+	require.NoError(t, err)
+
+	// No quota - nothing to enforce.
+	_, err = driver.CreateVolume(context.Background(), "task-1/state", dealVolumeOptions("43", "state", ""))
+	require.NoError(t, err)
+
+	dealDir := filepath.Join(rootDir, DealVolumeDriverName, "42")
+	assert.Equal(t, []call{{dealDir, "42", 1024}, {dealDir, "42", 1024}}, calls)
+}
+
+func TestDealVolumeInvalidNames(t *testing.T) {
+	driver, rootDir := newTestDealVolumeDriver(t)
+	defer os.RemoveAll(rootDir)
+
+	_, err := driver.CreateVolume(context.Background(), "task-1/state", dealVolumeOptions("", "state", ""))
+	assert.Error(t, err)
+
+	_, err = driver.CreateVolume(context.Background(), "task-1/state", dealVolumeOptions("..", "state", ""))
+	assert.Error(t, err)
+
+	_, err = driver.CreateVolume(context.Background(), "task-1/state", dealVolumeOptions("42", "../43", ""))
+	assert.Error(t, err)
+
+	assert.Error(t, driver.RemoveDealVolumes("../local"))
+}
@@ -0,0 +1,167 @@
+package volume
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"go.uber.org/zap"
+)
+
+const (
+	LocalDirDriverName = "localdir"
+	// localDirOptionPaths is the config option with comma-separated host
+	// directories tasks are allowed to mount, including their
+	// subdirectories.
+	localDirOptionPaths = "paths"
+	// localDirOptionWritable is the config option allowing tasks to mount
+	// directories in read-write mode. Mounts are forced to be read-only
+	// otherwise.
+	localDirOptionWritable = "writable"
+)
+
+// localDirVolumeDriver bind-mounts host directories whitelisted by the
+// supplier into containers.
+//
+// Tasks specify the directory to be mounted using the "path" volume option.
+type localDirVolumeDriver struct {
+	paths    []string
+	writable bool
+	logger   *zap.Logger
+}
+
+// NewLocalDirVolumeDriver constructs a new host directory volume driver.
+func NewLocalDirVolumeDriver(ctx context.Context, options ...Option) (VolumeDriver, error) {
+	opts := newOptions()
+
+	for _, option := range options {
+		if err := option(opts); err != nil {
+			return nil, err
+		}
+	}
+
+	var paths []string
+	for _, path := range strings.Split(opts.driverOptions[localDirOptionPaths], ",") {
+		path = strings.TrimSpace(path)
+		if len(path) == 0 {
+			continue
+		}
+
+		if !filepath.IsAbs(path) {
+			return nil, fmt.Errorf("whitelisted path %s must be absolute", path)
+		}
+
+		resolved, err := filepath.EvalSymlinks(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve whitelisted path %s: %v", path, err)
+		}
+
+		paths = append(paths, resolved)
+	}
+
+	if len(paths) == 0 {
+		return nil, errors.New("at least one whitelisted path is required")
+	}
+
+	writable := false
+	if value, ok := opts.driverOptions[localDirOptionWritable]; ok {
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid `%s` option: %v", localDirOptionWritable, err)
+		}
+		writable = v
+	}
+
+	return &localDirVolumeDriver{
+		paths:    paths,
+		writable: writable,
+		logger:   log.G(ctx),
+	}, nil
+}
+
+func (d *localDirVolumeDriver) CreateVolume(ctx context.Context, name string, options map[string]string) (Volume, error) {
+	d.logger.Info("creating volume", zap.String("name", name))
+
+	path, err := d.resolve(options["path"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &localDirVolume{path: path, writable: d.writable}, nil
+}
+
+// resolve returns the real path of the given directory, checking that it is
+// within one of whitelisted directories. Symlinks are resolved to prevent
+// escaping from the whitelist.
+func (d *localDirVolumeDriver) resolve(path string) (string, error) {
+	if len(path) == 0 {
+		return "", errors.New("`path` option is required")
+	}
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("path %s must be absolute", path)
+	}
+
+	resolved, err := filepath.EvalSymlinks(filepath.Clean(path))
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve path %s: %v", path, err)
+	}
+
+	stat, err := os.Stat(resolved)
+	if err != nil {
+		return "", err
+	}
+	if !stat.IsDir() {
+		return "", fmt.Errorf("path %s is not a directory", path)
+	}
+
+	for _, allowed := range d.paths {
+		rel, err := filepath.Rel(allowed, resolved)
+		if err != nil {
+			continue
+		}
+
+		if rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return resolved, nil
+		}
+	}
+
+	return "", fmt.Errorf("path %s is not whitelisted", path)
+}
+
+func (d *localDirVolumeDriver) RemoveVolume(name string) error {
+	return nil
+}
+
+func (d *localDirVolumeDriver) Close() error {
+	return nil
+}
+
+type localDirVolume struct {
+	path     string
+	writable bool
+}
+
+func (v *localDirVolume) Configure(m Mount, cfg *container.HostConfig) error {
+	cfg.Mounts = append(cfg.Mounts, mount.Mount{
+		Type:        mount.TypeBind,
+		Source:      v.path,
+		Target:      m.Target,
+		ReadOnly:    m.ReadOnly() || !v.writable,
+		Consistency: mount.ConsistencyDefault,
+
+		BindOptions: &mount.BindOptions{
+			Propagation: mount.PropagationRPrivate,
+		},
+		VolumeOptions: nil,
+		TmpfsOptions:  nil,
+	})
+
+	return nil
+}
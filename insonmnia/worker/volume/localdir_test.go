@@ -0,0 +1,91 @@
+package volume
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLocalDirDriver(t *testing.T, options map[string]string) (VolumeDriver, string) {
+	dir, err := ioutil.TempDir("", "localdir-volume")
+	require.NoError(t, err)
+	dir, err = filepath.EvalSymlinks(dir)
+	require.NoError(t, err)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "allowed", "datasets"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "private"), 0755))
+
+	driverOptions := map[string]string{
+		localDirOptionPaths: filepath.Join(dir, "allowed"),
+	}
+	for key, value := range options {
+		driverOptions[key] = value
+	}
+
+	driver, err := NewLocalDirVolumeDriver(context.Background(), WithOptions(driverOptions))
+	require.NoError(t, err)
+
+	return driver, dir
+}
+
+func TestLocalDirVolume(t *testing.T) {
+	driver, dir := newTestLocalDirDriver(t, nil)
+	defer os.RemoveAll(dir)
+
+	v, err := driver.CreateVolume(context.Background(), "data", map[string]string{"path": filepath.Join(dir, "allowed", "datasets")})
+	require.NoError(t, err)
+
+	cfg := &container.HostConfig{}
+	require.NoError(t, v.Configure(Mount{Source: "data", Target: "/data", Permission: RW}, cfg))
+	require.Len(t, cfg.Mounts, 1)
+	assert.Equal(t, filepath.Join(dir, "allowed", "datasets"), cfg.Mounts[0].Source)
+	assert.Equal(t, "/data", cfg.Mounts[0].Target)
+	// Read-only is forced unless the supplier allows writing.
+	assert.True(t, cfg.Mounts[0].ReadOnly)
+}
+
+func TestLocalDirVolumeWritable(t *testing.T) {
+	driver, dir := newTestLocalDirDriver(t, map[string]string{localDirOptionWritable: "true"})
+	defer os.RemoveAll(dir)
+
+	v, err := driver.CreateVolume(context.Background(), "data", map[string]string{"path": filepath.Join(dir, "allowed")})
+	require.NoError(t, err)
+
+	cfg := &container.HostConfig{}
+	require.NoError(t, v.Configure(Mount{Source: "data", Target: "/data", Permission: RW}, cfg))
+	require.Len(t, cfg.Mounts, 1)
+	assert.False(t, cfg.Mounts[0].ReadOnly)
+}
+
+func TestLocalDirVolumeNotWhitelisted(t *testing.T) {
+	driver, dir := newTestLocalDirDriver(t, nil)
+	defer os.RemoveAll(dir)
+
+	for _, path := range []string{
+		filepath.Join(dir, "private"),
+		filepath.Join(dir, "allowed", "..", "private"),
+		"/",
+		"relative/path",
+		"",
+	} {
+		_, err := driver.CreateVolume(context.Background(), "data", map[string]string{"path": path})
+		assert.Error(t, err, path)
+	}
+}
+
+func TestLocalDirVolumeSymlinkEscape(t *testing.T) {
+	driver, dir := newTestLocalDirDriver(t, nil)
+	defer os.RemoveAll(dir)
+
+	link := filepath.Join(dir, "allowed", "escape")
+	require.NoError(t, os.Symlink(filepath.Join(dir, "private"), link))
+
+	_, err := driver.CreateVolume(context.Background(), "data", map[string]string{"path": link})
+	assert.Error(t, err)
+}
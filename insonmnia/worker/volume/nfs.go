@@ -0,0 +1,149 @@
+// Network File System (NFS) Docker integration.
+
+package volume
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path/filepath"
+	"strconv"
+	"syscall"
+
+	"github.com/ContainX/docker-volume-netshare/netshare/drivers"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/go-connections/sockets"
+	"github.com/docker/go-plugins-helpers/volume"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultNFSVersion = 4
+	// nfsOptionVersion is the config option specifying the NFS protocol
+	// version, either 3 or 4.
+	nfsOptionVersion = "version"
+	// nfsOptionOptions is the config option specifying default NFS mount
+	// options, that can be overridden by tasks using the "nfsopts" volume
+	// option.
+	nfsOptionOptions = "options"
+)
+
+type nfsVolumeDriver struct {
+	drivers.NFSDriver
+
+	listener net.Listener
+
+	logger *zap.Logger
+}
+
+// NewNFSVolumeDriver constructs and runs a new NFS volume driver.
+//
+// Tasks specify the export to be mounted using the "share" volume option,
+// for example "nfs-host.ru:/export/datasets".
+func NewNFSVolumeDriver(ctx context.Context, options ...Option) (VolumeDriver, error) {
+	opts := newOptions()
+
+	for _, option := range options {
+		if err := option(opts); err != nil {
+			return nil, err
+		}
+	}
+
+	version := defaultNFSVersion
+	if value, ok := opts.driverOptions[nfsOptionVersion]; ok {
+		v, err := strconv.Atoi(value)
+		if err != nil || (v != 3 && v != 4) {
+			return nil, fmt.Errorf("invalid NFS version %q: must be either 3 or 4", value)
+		}
+		version = v
+	}
+
+	driverName := drivers.NFS.String()
+
+	rootDir := filepath.Join(opts.rootDir, driverName)
+	socketPath, err := fullSocketPath(opts.socketDir, driverName)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := sockets.NewUnixSocket(socketPath, syscall.Getgid())
+	if err != nil {
+		return nil, err
+	}
+
+	driver := drivers.NewNFSDriver(rootDir, version, opts.driverOptions[nfsOptionOptions])
+	handle := volume.NewHandler(driver)
+
+	go func() {
+		log.G(ctx).Info("NFS volume plugin has been initialized", zap.Int("version", version))
+		handle.Serve(listener)
+	}()
+
+	return &nfsVolumeDriver{driver, listener, log.G(ctx)}, nil
+}
+
+func (d *nfsVolumeDriver) CreateVolume(ctx context.Context, name string, options map[string]string) (Volume, error) {
+	d.logger.Info("creating volume", zap.String("name", name))
+
+	if share, ok := options["share"]; !ok || len(share) == 0 {
+		return nil, fmt.Errorf("`share` option is required")
+	}
+
+	request := &volume.CreateRequest{
+		Name:    name,
+		Options: options,
+	}
+
+	if err := d.Create(request); err != nil {
+		return nil, err
+	}
+
+	return &nfsVolume{}, nil
+}
+
+func (d *nfsVolumeDriver) RemoveVolume(name string) error {
+	d.logger.Info("removing volume", zap.String("name", name))
+
+	request := &volume.RemoveRequest{
+		Name: name,
+	}
+
+	if err := d.Remove(request); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *nfsVolumeDriver) Close() error {
+	d.logger.Info("shutting down volume plugin")
+	return d.listener.Close()
+}
+
+type nfsVolume struct {
+}
+
+func (v *nfsVolume) Configure(m Mount, cfg *container.HostConfig) error {
+	cfg.Mounts = append(cfg.Mounts, mount.Mount{
+		Type:        mount.TypeVolume,
+		Source:      m.Source,
+		Target:      m.Target,
+		ReadOnly:    m.ReadOnly(),
+		Consistency: mount.ConsistencyDefault,
+
+		BindOptions: nil,
+		VolumeOptions: &mount.VolumeOptions{
+			NoCopy: true,
+			Labels: map[string]string{},
+			DriverConfig: &mount.Driver{
+				Name:    drivers.NFS.String(),
+				Options: map[string]string{},
+			},
+		},
+		TmpfsOptions: nil,
+	})
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package volume
 
 import (
+	"context"
 	"fmt"
 
 	"go.uber.org/zap"
@@ -12,13 +13,23 @@ type Option func(options interface{}) error
 // Options describes generic volume plugin options.
 type options struct {
 	socketDir string
+	rootDir   string
 	log       *zap.SugaredLogger
+	// driverOptions are driver specific options from the Worker config.
+	driverOptions map[string]string
+	dirQuota      DirQuotaFunc
 }
 
+// DirQuotaFunc counts files of the directory against the storage quota with
+// the given ID, shared with writable layers of containers.
+type DirQuotaFunc func(ctx context.Context, path string, quotaID string, bytes uint64) error
+
 func newOptions() *options {
 	return &options{
-		socketDir: defaultPluginSockDir,
-		log:       zap.NewNop().Sugar(),
+		socketDir:     defaultPluginSockDir,
+		rootDir:       DefaultDockerRootDirectory,
+		log:           zap.NewNop().Sugar(),
+		driverOptions: map[string]string{},
 	}
 }
 
@@ -36,6 +47,20 @@ func WithPluginSocketDir(path string) Option {
 	}
 }
 
+// WithRootDir constructs an option that specifies the directory where
+// drivers keep volume data.
+func WithRootDir(path string) Option {
+	return func(o interface{}) error {
+		option, ok := o.(*options)
+		if !ok {
+			return fmt.Errorf("invalid option type: %T", o)
+		}
+
+		option.rootDir = path
+		return nil
+	}
+}
+
 func WithLogger(log *zap.SugaredLogger) Option {
 	return func(o interface{}) error {
 		option, ok := o.(*options)
@@ -48,12 +73,29 @@ func WithLogger(log *zap.SugaredLogger) Option {
 	}
 }
 
+// WithDirQuota constructs an option that makes drivers enforce storage
+// quotas on their volumes using the given function.
+func WithDirQuota(fn DirQuotaFunc) Option {
+	return func(o interface{}) error {
+		option, ok := o.(*options)
+		if !ok {
+			return fmt.Errorf("invalid option type: %T", o)
+		}
+
+		option.dirQuota = fn
+		return nil
+	}
+}
+
 // WithOptions constructs an option that forwards the given generic options
 // to the plugin.
 func WithOptions(opts map[string]string) Option {
 	return func(o interface{}) error {
-		switch o.(type) {
+		switch option := o.(type) {
 		case *options:
+			for key, value := range opts {
+				option.driverOptions[key] = value
+			}
 			return nil
 		default:
 			return fmt.Errorf("invalid option type: %T", o)
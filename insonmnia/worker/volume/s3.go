@@ -0,0 +1,449 @@
+// S3-compatible object store Docker integration.
+
+package volume
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"github.com/sonm-io/core/util/datasize"
+	"go.uber.org/zap"
+)
+
+const (
+	S3DriverName    = "s3"
+	defaultS3Region = "us-east-1"
+	// s3OptionEndpoints is the config option with comma-separated endpoints
+	// tasks are allowed to use. The first one is used by default.
+	s3OptionEndpoints = "endpoints"
+	// s3OptionMaxSize is the config option limiting the total size of
+	// objects synchronized into a single volume.
+	s3OptionMaxSize = "max_size"
+	// s3OptionRegion is the config option specifying the default region,
+	// that can be overridden by tasks using the "region" volume option.
+	s3OptionRegion = "region"
+	// s3OptionSyncTimeout is the config option limiting the time objects
+	// are synchronized into a volume for.
+	s3OptionSyncTimeout = "sync_timeout"
+
+	defaultS3SyncTimeout = 30 * time.Minute
+)
+
+// s3VolumeDriver mounts objects stored in S3-compatible object stores into
+// containers.
+//
+// Objects are synchronized into a local directory when the volume is
+// created, i.e. before the container starts, and the directory is bind-mounted
+// into the container. When the "upload" volume option is set, new and
+// modified files are uploaded back when the volume is removed. Deleted files
+// are not removed from the bucket.
+//
+// Tasks specify the objects using "bucket" and optional "prefix", "endpoint",
+// "region", "access_key" and "secret_key" volume options.
+type s3VolumeDriver struct {
+	rootDir     string
+	endpoints   []string
+	region      string
+	maxSize     uint64
+	syncTimeout time.Duration
+	logger      *zap.Logger
+
+	mu      sync.Mutex
+	volumes map[string]*s3Volume
+}
+
+// NewS3VolumeDriver constructs a new S3 volume driver.
+func NewS3VolumeDriver(ctx context.Context, options ...Option) (VolumeDriver, error) {
+	opts := newOptions()
+
+	for _, option := range options {
+		if err := option(opts); err != nil {
+			return nil, err
+		}
+	}
+
+	var endpoints []string
+	for _, endpoint := range strings.Split(opts.driverOptions[s3OptionEndpoints], ",") {
+		endpoint = strings.TrimSpace(endpoint)
+		if len(endpoint) == 0 {
+			continue
+		}
+
+		if _, err := newS3Client(endpoint, defaultS3Region, "", ""); err != nil {
+			return nil, err
+		}
+
+		endpoints = append(endpoints, endpoint)
+	}
+
+	if len(endpoints) == 0 {
+		return nil, errors.New("at least one S3 endpoint is required")
+	}
+
+	var maxSize uint64
+	if value, ok := opts.driverOptions[s3OptionMaxSize]; ok {
+		var size datasize.ByteSize
+		if err := size.UnmarshalText([]byte(value)); err != nil {
+			return nil, fmt.Errorf("invalid `%s` option: %v", s3OptionMaxSize, err)
+		}
+		maxSize = size.Bytes()
+	}
+
+	region := opts.driverOptions[s3OptionRegion]
+	if len(region) == 0 {
+		region = defaultS3Region
+	}
+
+	syncTimeout := defaultS3SyncTimeout
+	if value, ok := opts.driverOptions[s3OptionSyncTimeout]; ok {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			return nil, fmt.Errorf("invalid `%s` option: %q", s3OptionSyncTimeout, value)
+		}
+		syncTimeout = timeout
+	}
+
+	rootDir := filepath.Join(opts.rootDir, S3DriverName)
+	if err := os.MkdirAll(rootDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create S3 volumes directory: %v", err)
+	}
+
+	return &s3VolumeDriver{
+		rootDir:     rootDir,
+		endpoints:   endpoints,
+		region:      region,
+		maxSize:     maxSize,
+		syncTimeout: syncTimeout,
+		logger:      log.G(ctx),
+		volumes:     map[string]*s3Volume{},
+	}, nil
+}
+
+func (d *s3VolumeDriver) CreateVolume(ctx context.Context, name string, options map[string]string) (Volume, error) {
+	d.logger.Info("creating volume", zap.String("name", name))
+
+	dir, err := d.volumeDir(name)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	// Volumes can be shared between tasks of a group, in that case only the
+	// first task synchronizes the objects, while others wait for it.
+	if existing, ok := d.volumes[name]; ok {
+		d.mu.Unlock()
+
+		if err := existing.Wait(ctx); err != nil {
+			return nil, err
+		}
+		return existing, nil
+	}
+
+	v, err := d.newVolume(dir, options)
+	if err != nil {
+		d.mu.Unlock()
+		return nil, err
+	}
+
+	d.volumes[name] = v
+	d.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, d.syncTimeout)
+	defer cancel()
+
+	if err := v.Sync(ctx, d.maxSize); err != nil {
+		err = fmt.Errorf("failed to synchronize S3 volume %s: %v", name, err)
+		os.RemoveAll(dir)
+
+		d.mu.Lock()
+		delete(d.volumes, name)
+		d.mu.Unlock()
+
+		v.finishSync(err)
+		return nil, err
+	}
+
+	v.finishSync(nil)
+
+	return v, nil
+}
+
+func (d *s3VolumeDriver) newVolume(dir string, options map[string]string) (*s3Volume, error) {
+	bucket := options["bucket"]
+	if len(bucket) == 0 || strings.Contains(bucket, "/") {
+		return nil, errors.New("`bucket` option is required and must not contain slashes")
+	}
+
+	endpoint := d.endpoints[0]
+	if value, ok := options["endpoint"]; ok && len(value) > 0 {
+		if !d.isEndpointAllowed(value) {
+			return nil, fmt.Errorf("S3 endpoint %s is not allowed", value)
+		}
+		endpoint = value
+	}
+
+	region := options["region"]
+	if len(region) == 0 {
+		region = d.region
+	}
+
+	upload := false
+	if value, ok := options["upload"]; ok {
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid `upload` option: %v", err)
+		}
+		upload = v
+	}
+
+	client, err := newS3Client(endpoint, region, options["access_key"], options["secret_key"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Volume{
+		client: client,
+		bucket: bucket,
+		prefix: options["prefix"],
+		dir:    dir,
+		upload: upload,
+		files:  map[string]s3FileState{},
+		synced: make(chan struct{}),
+	}, nil
+}
+
+func (d *s3VolumeDriver) isEndpointAllowed(endpoint string) bool {
+	for _, allowed := range d.endpoints {
+		if endpoint == allowed {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *s3VolumeDriver) volumeDir(name string) (string, error) {
+	if !isValidPathComponent(name) {
+		return "", fmt.Errorf("invalid volume name %q", name)
+	}
+
+	return filepath.Join(d.rootDir, name), nil
+}
+
+func (d *s3VolumeDriver) RemoveVolume(name string) error {
+	d.logger.Info("removing volume", zap.String("name", name))
+
+	dir, err := d.volumeDir(name)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	v, ok := d.volumes[name]
+	delete(d.volumes, name)
+	d.mu.Unlock()
+
+	if ok && v.upload && v.Wait(context.Background()) == nil {
+		if err := v.Upload(context.Background()); err != nil {
+			// Keep the data for manual recovery.
+			return fmt.Errorf("failed to upload S3 volume %s, its data is kept in %s: %v", name, dir, err)
+		}
+	}
+	if !ok {
+		d.logger.Warn("removing unknown volume, possibly created before the restart, its data is not uploaded", zap.String("name", name))
+	}
+
+	return os.RemoveAll(dir)
+}
+
+func (d *s3VolumeDriver) Close() error {
+	return nil
+}
+
+// s3FileState describes a synchronized file to detect its modifications.
+type s3FileState struct {
+	Size    int64
+	ModTime time.Time
+}
+
+type s3Volume struct {
+	client *s3Client
+	bucket string
+	prefix string
+	dir    string
+	upload bool
+	// files contains states of synchronized files by their keys.
+	files map[string]s3FileState
+	// synced is closed when the synchronization is finished, with syncErr
+	// set if it has failed.
+	synced  chan struct{}
+	syncErr error
+}
+
+func (v *s3Volume) finishSync(err error) {
+	v.syncErr = err
+	close(v.synced)
+}
+
+// Wait waits for the volume synchronization started by another task to
+// finish, returning its error.
+func (v *s3Volume) Wait(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-v.synced:
+		return v.syncErr
+	}
+}
+
+// Sync downloads all objects with the volume prefix into the volume
+// directory, failing if their total size exceeds the limit, if any.
+func (v *s3Volume) Sync(ctx context.Context, maxSize uint64) error {
+	objects, err := v.client.List(ctx, v.bucket, v.prefix)
+	if err != nil {
+		return err
+	}
+
+	size := uint64(0)
+	for _, object := range objects {
+		size += uint64(object.Size)
+	}
+	if maxSize > 0 && size > maxSize {
+		return fmt.Errorf("objects take %d bytes exceeding the limit of %d bytes", size, maxSize)
+	}
+
+	if err := os.MkdirAll(v.dir, 0755); err != nil {
+		return err
+	}
+
+	for _, object := range objects {
+		// Directory placeholders created by some S3 clients.
+		if strings.HasSuffix(object.Key, "/") {
+			continue
+		}
+
+		path, err := v.path(object.Key)
+		if err != nil {
+			return err
+		}
+
+		if err := v.download(ctx, object.Key, path); err != nil {
+			return fmt.Errorf("failed to download %s: %v", object.Key, err)
+		}
+
+		stat, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+
+		v.files[object.Key] = s3FileState{Size: stat.Size(), ModTime: stat.ModTime()}
+	}
+
+	return nil
+}
+
+func (v *s3Volume) download(ctx context.Context, key, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	file, err := ioutil.TempFile(filepath.Dir(path), ".s3-download")
+	if err != nil {
+		return err
+	}
+
+	err = v.client.Get(ctx, v.bucket, key, file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(file.Name(), 0644)
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		os.Remove(file.Name())
+	}
+
+	return err
+}
+
+// Upload uploads files that are created or modified since the
+// synchronization.
+func (v *s3Volume) Upload(ctx context.Context) error {
+	return filepath.Walk(v.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		// Symlinks and other special files are skipped, because they can
+		// point outside the volume.
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(v.dir, path)
+		if err != nil {
+			return err
+		}
+
+		key := v.prefix + filepath.ToSlash(rel)
+		if state, ok := v.files[key]; ok && state.Size == info.Size() && state.ModTime.Equal(info.ModTime()) {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		if err := v.client.Put(ctx, v.bucket, key, file, info.Size()); err != nil {
+			return fmt.Errorf("failed to upload %s: %v", key, err)
+		}
+
+		return nil
+	})
+}
+
+// path returns the local path of the object, ensuring it doesn't escape the
+// volume directory.
+func (v *s3Volume) path(key string) (string, error) {
+	rel := strings.TrimPrefix(strings.TrimPrefix(key, v.prefix), "/")
+	path := filepath.Join(v.dir, filepath.FromSlash(rel))
+
+	if len(rel) == 0 || !strings.HasPrefix(path, v.dir+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid object key %q", key)
+	}
+
+	return path, nil
+}
+
+func (v *s3Volume) Configure(m Mount, cfg *container.HostConfig) error {
+	cfg.Mounts = append(cfg.Mounts, mount.Mount{
+		Type:        mount.TypeBind,
+		Source:      v.dir,
+		Target:      m.Target,
+		ReadOnly:    m.ReadOnly(),
+		Consistency: mount.ConsistencyDefault,
+
+		BindOptions: &mount.BindOptions{
+			Propagation: mount.PropagationRPrivate,
+		},
+		VolumeOptions: nil,
+		TmpfsOptions:  nil,
+	})
+
+	return nil
+}
@@ -0,0 +1,310 @@
+package volume
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	s3Service            = "s3"
+	s3Algorithm          = "AWS4-HMAC-SHA256"
+	s3DateFormat         = "20060102"
+	s3DateTimeFormat     = "20060102T150405Z"
+	s3UnsignedPayload    = "UNSIGNED-PAYLOAD"
+	s3MaxErrorBodyLength = 64 * 1024
+)
+
+// s3EmptyPayloadHash is the SHA256 hash of an empty request body.
+var s3EmptyPayloadHash = hex.EncodeToString(sha256.New().Sum(nil))
+
+type s3Object struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// s3Client is a minimal client of S3-compatible object stores, such as
+// MinIO, supporting listing, downloading and uploading objects.
+//
+// Buckets are addressed using path-style URLs, which are supported by all
+// S3-compatible stores. Requests are signed using AWS Signature Version 4
+// unless the access key is empty, which allows to access public buckets.
+type s3Client struct {
+	endpoint  *url.URL
+	region    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+}
+
+func newS3Client(endpoint, region, accessKey, secretKey string) (*s3Client, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid S3 endpoint: %v", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid S3 endpoint %s: scheme must be either http or https", endpoint)
+	}
+	if len(u.Host) == 0 {
+		return nil, fmt.Errorf("invalid S3 endpoint %s: host is required", endpoint)
+	}
+
+	return &s3Client{
+		endpoint:  u,
+		region:    region,
+		accessKey: accessKey,
+		secretKey: secretKey,
+		client:    &http.Client{},
+	}, nil
+}
+
+// List returns all objects of the bucket whose keys start with the given
+// prefix.
+func (m *s3Client) List(ctx context.Context, bucket, prefix string) ([]s3Object, error) {
+	var objects []s3Object
+
+	query := map[string]string{
+		"list-type": "2",
+		"prefix":    prefix,
+	}
+
+	for {
+		response, err := m.do(ctx, http.MethodGet, "/"+bucket, query, nil, s3EmptyPayloadHash)
+		if err != nil {
+			return nil, err
+		}
+
+		var result struct {
+			IsTruncated           bool
+			NextContinuationToken string
+			Contents              []struct {
+				Key          string
+				Size         int64
+				LastModified time.Time
+			}
+		}
+
+		err = xml.NewDecoder(response.Body).Decode(&result)
+		response.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode S3 objects list: %v", err)
+		}
+
+		for _, object := range result.Contents {
+			objects = append(objects, s3Object{
+				Key:          object.Key,
+				Size:         object.Size,
+				LastModified: object.LastModified,
+			})
+		}
+
+		if !result.IsTruncated || len(result.NextContinuationToken) == 0 {
+			return objects, nil
+		}
+
+		query["continuation-token"] = result.NextContinuationToken
+	}
+}
+
+// Get downloads the object into the given writer.
+func (m *s3Client) Get(ctx context.Context, bucket, key string, wr io.Writer) error {
+	response, err := m.do(ctx, http.MethodGet, "/"+bucket+"/"+key, nil, nil, s3EmptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	_, err = io.Copy(wr, response.Body)
+	return err
+}
+
+// Put uploads the object of the specified size from the given reader.
+func (m *s3Client) Put(ctx context.Context, bucket, key string, rd io.Reader, size int64) error {
+	response, err := m.do(ctx, http.MethodPut, "/"+bucket+"/"+key, nil, &sizedReader{rd, size}, s3UnsignedPayload)
+	if err != nil {
+		return err
+	}
+
+	return response.Body.Close()
+}
+
+type sizedReader struct {
+	io.Reader
+	size int64
+}
+
+func (m *s3Client) do(ctx context.Context, method, path string, query map[string]string, body *sizedReader, payloadHash string) (*http.Response, error) {
+	target := fmt.Sprintf("%s://%s%s", m.endpoint.Scheme, m.endpoint.Host, s3EscapePath(path))
+	if len(query) > 0 {
+		target += "?" + s3CanonicalQuery(query)
+	}
+
+	// Empty bodies must be nil, otherwise the request is sent using chunked
+	// transfer encoding, which S3 doesn't support for uploads.
+	var rd io.Reader
+	if body != nil && body.size > 0 {
+		rd = body.Reader
+	}
+
+	request, err := http.NewRequest(method, target, rd)
+	if err != nil {
+		return nil, err
+	}
+	if rd != nil {
+		request.ContentLength = body.size
+	}
+
+	m.sign(request, payloadHash, time.Now())
+
+	response, err := m.client.Do(request.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		defer response.Body.Close()
+		return nil, newS3Error(response)
+	}
+
+	return response, nil
+}
+
+// sign signs the request using AWS Signature Version 4, including the host
+// and all request headers into the signature.
+func (m *s3Client) sign(request *http.Request, payloadHash string, now time.Time) {
+	if len(m.accessKey) == 0 {
+		return
+	}
+
+	now = now.UTC()
+	request.Header.Set("X-Amz-Date", now.Format(s3DateTimeFormat))
+	request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{
+		"host": request.URL.Host,
+	}
+	for name, values := range request.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	canonicalHeaders := ""
+	for _, name := range names {
+		canonicalHeaders += name + ":" + headers[name] + "\n"
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	query := map[string]string{}
+	for key, values := range request.URL.Query() {
+		query[key] = values[0]
+	}
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		s3EscapePath(request.URL.Path),
+		s3CanonicalQuery(query),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{now.Format(s3DateFormat), m.region, s3Service, "aws4_request"}, "/")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		now.Format(s3DateTimeFormat),
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	key := []byte("AWS4" + m.secretKey)
+	for _, part := range []string{now.Format(s3DateFormat), m.region, s3Service, "aws4_request"} {
+		key = s3HMAC(key, part)
+	}
+	signature := hex.EncodeToString(s3HMAC(key, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s,SignedHeaders=%s,Signature=%s",
+		s3Algorithm, m.accessKey, scope, signedHeaders, signature))
+}
+
+func s3HMAC(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// s3Escape percent-encodes all characters except unreserved ones as
+// required by AWS Signature Version 4.
+func s3Escape(value string, keepSlash bool) string {
+	result := strings.Builder{}
+	for _, c := range []byte(value) {
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			result.WriteByte(c)
+		case c == '/' && keepSlash:
+			result.WriteByte(c)
+		default:
+			fmt.Fprintf(&result, "%%%02X", c)
+		}
+	}
+
+	return result.String()
+}
+
+func s3EscapePath(path string) string {
+	return s3Escape(path, true)
+}
+
+func s3CanonicalQuery(query map[string]string) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts = append(parts, s3Escape(key, false)+"="+s3Escape(query[key], false))
+	}
+
+	return strings.Join(parts, "&")
+}
+
+type s3Error struct {
+	StatusCode int
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+}
+
+func newS3Error(response *http.Response) error {
+	err := &s3Error{StatusCode: response.StatusCode}
+
+	body, _ := ioutil.ReadAll(io.LimitReader(response.Body, s3MaxErrorBodyLength))
+	xml.Unmarshal(body, err)
+
+	return err
+}
+
+func (m *s3Error) Error() string {
+	if len(m.Code) == 0 {
+		return fmt.Sprintf("S3 request failed with status %d", m.StatusCode)
+	}
+
+	return fmt.Sprintf("S3 request failed with status %d: %s: %s", m.StatusCode, m.Code, m.Message)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/docker/docker/api/types/container"
@@ -59,6 +60,18 @@ func (m *AskPlan) Validate() error {
 		return errors.New("storage size is too low")
 	}
 
+	if err := m.GetResources().GetNetwork().GetEgress().Validate(); err != nil {
+		return fmt.Errorf("invalid egress policy: %v", err)
+	}
+
+	if err := m.GetResources().GetNetwork().GetTrafficQuota().Validate(); err != nil {
+		return fmt.Errorf("invalid traffic quota: %v", err)
+	}
+
+	if err := m.GetRepricing().Validate(); err != nil {
+		return fmt.Errorf("invalid repricing rules: %v", err)
+	}
+
 	return m.GetResources().GetGPU().Validate()
 }
 
@@ -361,6 +374,8 @@ func (m *AskPlanNetwork) UnmarshalYAML(unmarshal func(interface{}) error) error
 		Overlay       bool
 		Outbound      bool
 		Incoming      bool
+		Egress        *EgressPolicy
+		TrafficQuota  *TrafficQuota
 	}
 	impl := &Impl{}
 
@@ -374,10 +389,160 @@ func (m *AskPlanNetwork) UnmarshalYAML(unmarshal func(interface{}) error) error
 	m.NetFlags.SetOverlay(impl.Overlay)
 	m.NetFlags.SetOutbound(impl.Outbound)
 	m.NetFlags.SetIncoming(impl.Incoming)
+	m.Egress = impl.Egress
+	m.TrafficQuota = impl.TrafficQuota
 
 	return nil
 }
 
+// Validate checks the quota, nil quota means no limit.
+//
+// Zero floor rate is allowed, which practically stops the traffic once the
+// limit is reached.
+func (m *TrafficQuota) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetLimit().GetBytes() == 0 {
+		return errors.New("limit is required")
+	}
+
+	if _, ok := TrafficQuota_Period_name[int32(m.GetPeriod())]; !ok {
+		return fmt.Errorf("unknown period: %d", m.GetPeriod())
+	}
+
+	return nil
+}
+
+// PeriodStart returns the beginning of the quota period the given time
+// belongs to. Deal-long periods start with the plan itself, i.e. at "since".
+func (m *TrafficQuota) PeriodStart(since, now time.Time) time.Time {
+	if m.GetPeriod() != TrafficQuota_MONTHLY {
+		return since
+	}
+
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (m *TrafficQuota_Period) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	period, ok := TrafficQuota_Period_value[strings.ToUpper(v)]
+	if !ok {
+		return fmt.Errorf("unknown traffic quota period: %s", v)
+	}
+
+	*m = TrafficQuota_Period(period)
+	return nil
+}
+
+// Validate checks the repricing rules, nil rules mean the price is fixed.
+func (m *AskPlanRepricing) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	floor := m.GetFloor().GetPerSecond()
+	ceiling := m.GetCeiling().GetPerSecond()
+	if !floor.IsZero() && !ceiling.IsZero() && floor.Cmp(ceiling) > 0 {
+		return errors.New("floor must not exceed ceiling")
+	}
+
+	if m.GetThreshold() < 0 {
+		return errors.New("threshold must not be negative")
+	}
+
+	if undercut := m.GetMarket().GetUndercut(); undercut < 0 || undercut >= 100 {
+		return fmt.Errorf("undercut must be within [0, 100) percents, got %v", undercut)
+	}
+
+	for _, entry := range m.GetSchedule() {
+		if entry.GetFrom() >= 24 || entry.GetTo() > 24 || entry.GetFrom() == entry.GetTo() {
+			return fmt.Errorf("invalid schedule hours: %d-%d", entry.GetFrom(), entry.GetTo())
+		}
+		if entry.GetMultiplier() <= 0 {
+			return fmt.Errorf("schedule multiplier must be positive, got %v", entry.GetMultiplier())
+		}
+	}
+
+	return nil
+}
+
+// TargetPrice returns the price per second orders should be placed with at
+// the given time, along with the description of what it is derived from.
+// Nil market price means there is no competing ask known.
+func (m *AskPlanRepricing) TargetPrice(market *big.Int, now time.Time) (*big.Int, string) {
+	price := m.GetBase().GetPerSecond().Unwrap()
+	reasons := []string{"base price"}
+
+	if market != nil && m.GetMarket() != nil {
+		price = scalePrice(market, 1-m.GetMarket().GetUndercut()/100)
+		reasons = []string{"best competing ask"}
+		if undercut := m.GetMarket().GetUndercut(); undercut > 0 {
+			reasons = append(reasons, fmt.Sprintf("undercut by %v%%", undercut))
+		}
+	}
+
+	hour := uint32(now.UTC().Hour())
+	for _, entry := range m.GetSchedule() {
+		if entry.Contains(hour) {
+			price = scalePrice(price, entry.GetMultiplier())
+			reasons = append(reasons, fmt.Sprintf("x%v within %02d-%02d UTC", entry.GetMultiplier(), entry.GetFrom(), entry.GetTo()))
+			break
+		}
+	}
+
+	if floor := m.GetFloor().GetPerSecond(); !floor.IsZero() && price.Cmp(floor.Unwrap()) < 0 {
+		price = floor.Unwrap()
+		reasons = append(reasons, "raised to floor")
+	}
+
+	if ceiling := m.GetCeiling().GetPerSecond(); !ceiling.IsZero() && price.Cmp(ceiling.Unwrap()) > 0 {
+		price = ceiling.Unwrap()
+		reasons = append(reasons, "lowered to ceiling")
+	}
+
+	return price, strings.Join(reasons, ", ")
+}
+
+// ExceedsThreshold checks whether the target price differs from the current
+// one enough to re-place the order.
+func (m *AskPlanRepricing) ExceedsThreshold(current, target *big.Int) bool {
+	if current.Cmp(target) == 0 {
+		return false
+	}
+
+	diff := new(big.Int).Sub(target, current)
+	diff.Abs(diff)
+
+	return diff.Cmp(scalePrice(current, m.GetThreshold()/100)) > 0
+}
+
+// Contains checks whether the hour of day belongs to the entry.
+func (m *PriceScheduleEntry) Contains(hour uint32) bool {
+	if m.GetFrom() < m.GetTo() {
+		return hour >= m.GetFrom() && hour < m.GetTo()
+	}
+
+	return hour >= m.GetFrom() || hour < m.GetTo()
+}
+
+// scalePrice multiplies the non-negative price by the factor, rounding to
+// the nearest integer.
+func scalePrice(price *big.Int, factor float64) *big.Int {
+	v := new(big.Float).SetInt(price)
+	v.Mul(v, big.NewFloat(factor))
+	v.Add(v, big.NewFloat(0.5))
+	result, _ := v.Int(nil)
+
+	return result
+}
+
 func SumPrice(plans []*AskPlan) *Price {
 	sum := big.NewInt(0)
 	for _, plan := range plans {
@@ -34,7 +34,13 @@ It has these top-level messages:
 	AskPlanRAM
 	AskPlanStorage
 	AskPlanNetwork
+	TrafficQuota
+	NetworkTraffic
 	AskPlanResources
+	AskPlanRepricing
+	MarketRepricing
+	PriceScheduleEntry
+	AskPlanPriceChange
 	AskPlan
 	Benchmark
 	BigInt
@@ -43,14 +49,18 @@ It has these top-level messages:
 	RAMDevice
 	RAM
 	GPUDevice
+	GPUPartition
 	GPU
 	NetFlags
+	EgressPolicy
 	Network
 	StorageDevice
 	Storage
 	Registry
 	ContainerRestartPolicy
 	NetworkSpec
+	ContainerHealthCheck
+	ContainerCheckpoint
 	Container
 	SortingOption
 	DealsRequest
@@ -157,6 +167,8 @@ It has these top-level messages:
 	WorkerListReply
 	BalanceReply
 	TokenTransferRequest
+	RegistryCredential
+	RegistryCredentialsReply
 	NPPMetricsReply
 	NamedMetrics
 	NamedMetric
@@ -193,15 +205,44 @@ It has these top-level messages:
 	TaskTag
 	TaskSpec
 	StartTaskRequest
+	RestoreTaskRequest
 	WorkerJoinNetworkRequest
 	StartTaskReply
+	PortForwarding
+	TaskGroupSpec
+	StartTaskGroupRequest
+	StartTaskGroupReply
+	TaskGroupStatusReply
 	StatusReply
+	DrainStatus
 	AskPlansReply
 	TaskListReply
+	BenchmarkResult
+	BenchmarkReportReply
 	DevicesReply
+	MaintenanceWindow
+	MaintenanceReply
+	AskPlanHistoryReply
 	PullTaskRequest
 	DealInfoReply
 	TaskStatusReply
+	ImagePullProgress
+	PrefetchImagesRequest
+	CachedImage
+	ImagesReply
+	DealVolume
+	DealVolumesReply
+	TaskCheckpoint
+	TaskExit
+	TaskExecRequest
+	TaskExecWindow
+	TaskExecReply
+	TaskCopyToRequest
+	TaskCopyFromRequest
+	TaskMetricsRequest
+	TaskMetricsSample
+	TaskMetricsReply
+	TaskHealthProbe
 	TaskPool
 	AskPlanPool
 	SchedulerData
@@ -232,6 +273,30 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type TrafficQuota_Period int32
+
+const (
+	// Traffic is counted during the whole ask plan's lifetime, which is
+	// bound to its deal.
+	TrafficQuota_DEAL TrafficQuota_Period = 0
+	// Traffic is counted within calendar months in UTC.
+	TrafficQuota_MONTHLY TrafficQuota_Period = 1
+)
+
+var TrafficQuota_Period_name = map[int32]string{
+	0: "DEAL",
+	1: "MONTHLY",
+}
+var TrafficQuota_Period_value = map[string]int32{
+	"DEAL":    0,
+	"MONTHLY": 1,
+}
+
+func (x TrafficQuota_Period) String() string {
+	return proto.EnumName(TrafficQuota_Period_name, int32(x))
+}
+func (TrafficQuota_Period) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{5, 0} }
+
 type AskPlan_Status int32
 
 const (
@@ -251,7 +316,7 @@ var AskPlan_Status_value = map[string]int32{
 func (x AskPlan_Status) String() string {
 	return proto.EnumName(AskPlan_Status_name, int32(x))
 }
-func (AskPlan_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{6, 0} }
+func (AskPlan_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{12, 0} }
 
 type AskPlanCPU struct {
 	CorePercents uint64 `protobuf:"varint,1,opt,name=core_percents,json=corePercents" json:"core_percents,omitempty"`
@@ -329,6 +394,10 @@ type AskPlanNetwork struct {
 	ThroughputIn  *DataSizeRate `protobuf:"bytes,1,opt,name=throughputIn" json:"throughputIn,omitempty"`
 	ThroughputOut *DataSizeRate `protobuf:"bytes,2,opt,name=throughputOut" json:"throughputOut,omitempty"`
 	NetFlags      *NetFlags     `protobuf:"bytes,3,opt,name=netFlags" json:"netFlags,omitempty"`
+	// Egress restricts outbound connections of all tasks within the plan.
+	Egress *EgressPolicy `protobuf:"bytes,4,opt,name=egress" json:"egress,omitempty"`
+	// TrafficQuota caps the total traffic of all tasks within the plan.
+	TrafficQuota *TrafficQuota `protobuf:"bytes,5,opt,name=trafficQuota" json:"trafficQuota,omitempty"`
 }
 
 func (m *AskPlanNetwork) Reset()                    { *m = AskPlanNetwork{} }
@@ -357,6 +426,105 @@ func (m *AskPlanNetwork) GetNetFlags() *NetFlags {
 	return nil
 }
 
+func (m *AskPlanNetwork) GetEgress() *EgressPolicy {
+	if m != nil {
+		return m.Egress
+	}
+	return nil
+}
+
+func (m *AskPlanNetwork) GetTrafficQuota() *TrafficQuota {
+	if m != nil {
+		return m.TrafficQuota
+	}
+	return nil
+}
+
+// TrafficQuota limits the number of bytes transferred by the plan's tasks in
+// both directions. Once the limit is reached the plan's throughput is
+// reduced to the floor rate until the end of the period.
+type TrafficQuota struct {
+	Limit  *DataSize           `protobuf:"bytes,1,opt,name=limit" json:"limit,omitempty"`
+	Period TrafficQuota_Period `protobuf:"varint,2,opt,name=period,enum=sonm.TrafficQuota_Period" json:"period,omitempty"`
+	// FloorRate is the throughput left in each direction after the limit is
+	// reached.
+	FloorRate *DataSizeRate `protobuf:"bytes,3,opt,name=floorRate" json:"floorRate,omitempty"`
+}
+
+func (m *TrafficQuota) Reset()                    { *m = TrafficQuota{} }
+func (m *TrafficQuota) String() string            { return proto.CompactTextString(m) }
+func (*TrafficQuota) ProtoMessage()               {}
+func (*TrafficQuota) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *TrafficQuota) GetLimit() *DataSize {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+func (m *TrafficQuota) GetPeriod() TrafficQuota_Period {
+	if m != nil {
+		return m.Period
+	}
+	return TrafficQuota_DEAL
+}
+
+func (m *TrafficQuota) GetFloorRate() *DataSizeRate {
+	if m != nil {
+		return m.FloorRate
+	}
+	return nil
+}
+
+// NetworkTraffic describes the traffic transferred by an ask plan's tasks.
+type NetworkTraffic struct {
+	// BytesIn is the number of bytes received by tasks within the current
+	// quota period.
+	BytesIn uint64 `protobuf:"varint,1,opt,name=bytesIn" json:"bytesIn,omitempty"`
+	// BytesOut is the number of bytes sent by tasks within the current quota
+	// period.
+	BytesOut uint64 `protobuf:"varint,2,opt,name=bytesOut" json:"bytesOut,omitempty"`
+	// PeriodStart is the time counting has been started at.
+	PeriodStart *Timestamp `protobuf:"bytes,3,opt,name=periodStart" json:"periodStart,omitempty"`
+	// Throttled shows whether the traffic quota is exceeded and the plan's
+	// throughput is reduced to the floor rate.
+	Throttled bool `protobuf:"varint,4,opt,name=throttled" json:"throttled,omitempty"`
+}
+
+func (m *NetworkTraffic) Reset()                    { *m = NetworkTraffic{} }
+func (m *NetworkTraffic) String() string            { return proto.CompactTextString(m) }
+func (*NetworkTraffic) ProtoMessage()               {}
+func (*NetworkTraffic) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *NetworkTraffic) GetBytesIn() uint64 {
+	if m != nil {
+		return m.BytesIn
+	}
+	return 0
+}
+
+func (m *NetworkTraffic) GetBytesOut() uint64 {
+	if m != nil {
+		return m.BytesOut
+	}
+	return 0
+}
+
+func (m *NetworkTraffic) GetPeriodStart() *Timestamp {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return nil
+}
+
+func (m *NetworkTraffic) GetThrottled() bool {
+	if m != nil {
+		return m.Throttled
+	}
+	return false
+}
+
 type AskPlanResources struct {
 	CPU     *AskPlanCPU     `protobuf:"bytes,1,opt,name=CPU" json:"CPU,omitempty"`
 	RAM     *AskPlanRAM     `protobuf:"bytes,2,opt,name=RAM" json:"RAM,omitempty"`
@@ -368,7 +536,7 @@ type AskPlanResources struct {
 func (m *AskPlanResources) Reset()                    { *m = AskPlanResources{} }
 func (m *AskPlanResources) String() string            { return proto.CompactTextString(m) }
 func (*AskPlanResources) ProtoMessage()               {}
-func (*AskPlanResources) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*AskPlanResources) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *AskPlanResources) GetCPU() *AskPlanCPU {
 	if m != nil {
@@ -405,6 +573,175 @@ func (m *AskPlanResources) GetNetwork() *AskPlanNetwork {
 	return nil
 }
 
+// AskPlanRepricing describes how the price of the plan's orders is derived
+// from the market and the time of day. The target price is computed from
+// the base price, replaced by the market price when known, multiplied by the
+// current schedule entry and clamped between floor and ceiling. Orders are
+// re-placed when the target price moves past the threshold.
+type AskPlanRepricing struct {
+	// Base is the price used when no market price is known. Defaults to the
+	// price the plan was created with.
+	Base *Price `protobuf:"bytes,1,opt,name=base" json:"base,omitempty"`
+	// Floor is the minimum price orders are placed with.
+	Floor *Price `protobuf:"bytes,2,opt,name=floor" json:"floor,omitempty"`
+	// Ceiling is the maximum price orders are placed with.
+	Ceiling *Price `protobuf:"bytes,3,opt,name=ceiling" json:"ceiling,omitempty"`
+	// Market makes the price track the best competing ask.
+	Market *MarketRepricing `protobuf:"bytes,4,opt,name=market" json:"market,omitempty"`
+	// Schedule adjusts the price depending on the time of day.
+	Schedule []*PriceScheduleEntry `protobuf:"bytes,5,rep,name=schedule" json:"schedule,omitempty"`
+	// Threshold is the price change in percents of the current price that
+	// triggers re-placing of the order.
+	Threshold float64 `protobuf:"fixed64,6,opt,name=threshold" json:"threshold,omitempty"`
+}
+
+func (m *AskPlanRepricing) Reset()                    { *m = AskPlanRepricing{} }
+func (m *AskPlanRepricing) String() string            { return proto.CompactTextString(m) }
+func (*AskPlanRepricing) ProtoMessage()               {}
+func (*AskPlanRepricing) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *AskPlanRepricing) GetBase() *Price {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *AskPlanRepricing) GetFloor() *Price {
+	if m != nil {
+		return m.Floor
+	}
+	return nil
+}
+
+func (m *AskPlanRepricing) GetCeiling() *Price {
+	if m != nil {
+		return m.Ceiling
+	}
+	return nil
+}
+
+func (m *AskPlanRepricing) GetMarket() *MarketRepricing {
+	if m != nil {
+		return m.Market
+	}
+	return nil
+}
+
+func (m *AskPlanRepricing) GetSchedule() []*PriceScheduleEntry {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *AskPlanRepricing) GetThreshold() float64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+// MarketRepricing makes the price track the best competing ask, i.e. the
+// cheapest ask of other suppliers acceptable by bids matching the plan's
+// order.
+type MarketRepricing struct {
+	// Undercut is how many percents below the best competing ask the price
+	// is set.
+	Undercut float64 `protobuf:"fixed64,1,opt,name=undercut" json:"undercut,omitempty"`
+}
+
+func (m *MarketRepricing) Reset()                    { *m = MarketRepricing{} }
+func (m *MarketRepricing) String() string            { return proto.CompactTextString(m) }
+func (*MarketRepricing) ProtoMessage()               {}
+func (*MarketRepricing) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *MarketRepricing) GetUndercut() float64 {
+	if m != nil {
+		return m.Undercut
+	}
+	return 0
+}
+
+// PriceScheduleEntry adjusts the price within hours of day.
+type PriceScheduleEntry struct {
+	// From is the hour of day in UTC the entry starts at.
+	From uint32 `protobuf:"varint,1,opt,name=from" json:"from,omitempty"`
+	// To is the hour of day in UTC the entry ends before. Entries with "to"
+	// less than "from" wrap around midnight.
+	To uint32 `protobuf:"varint,2,opt,name=to" json:"to,omitempty"`
+	// Multiplier is applied to the price within the entry's hours.
+	Multiplier float64 `protobuf:"fixed64,3,opt,name=multiplier" json:"multiplier,omitempty"`
+}
+
+func (m *PriceScheduleEntry) Reset()                    { *m = PriceScheduleEntry{} }
+func (m *PriceScheduleEntry) String() string            { return proto.CompactTextString(m) }
+func (*PriceScheduleEntry) ProtoMessage()               {}
+func (*PriceScheduleEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *PriceScheduleEntry) GetFrom() uint32 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *PriceScheduleEntry) GetTo() uint32 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *PriceScheduleEntry) GetMultiplier() float64 {
+	if m != nil {
+		return m.Multiplier
+	}
+	return 0
+}
+
+// AskPlanPriceChange records repricing of an ask plan.
+type AskPlanPriceChange struct {
+	Time     *Timestamp `protobuf:"bytes,1,opt,name=time" json:"time,omitempty"`
+	OldPrice *Price     `protobuf:"bytes,2,opt,name=oldPrice" json:"oldPrice,omitempty"`
+	NewPrice *Price     `protobuf:"bytes,3,opt,name=newPrice" json:"newPrice,omitempty"`
+	// Reason describes what the new price is derived from.
+	Reason string `protobuf:"bytes,4,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *AskPlanPriceChange) Reset()                    { *m = AskPlanPriceChange{} }
+func (m *AskPlanPriceChange) String() string            { return proto.CompactTextString(m) }
+func (*AskPlanPriceChange) ProtoMessage()               {}
+func (*AskPlanPriceChange) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *AskPlanPriceChange) GetTime() *Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *AskPlanPriceChange) GetOldPrice() *Price {
+	if m != nil {
+		return m.OldPrice
+	}
+	return nil
+}
+
+func (m *AskPlanPriceChange) GetNewPrice() *Price {
+	if m != nil {
+		return m.NewPrice
+	}
+	return nil
+}
+
+func (m *AskPlanPriceChange) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type AskPlan struct {
 	ID                  string            `protobuf:"bytes,1,opt,name=ID" json:"ID,omitempty"`
 	OrderID             *BigInt           `protobuf:"bytes,2,opt,name=orderID" json:"orderID,omitempty"`
@@ -419,12 +756,18 @@ type AskPlan struct {
 	Status              AskPlan_Status    `protobuf:"varint,11,opt,name=status,enum=sonm.AskPlan_Status" json:"status,omitempty"`
 	CreateTime          *Timestamp        `protobuf:"bytes,12,opt,name=createTime" json:"createTime,omitempty"`
 	LastOrderPlacedTime *Timestamp        `protobuf:"bytes,13,opt,name=lastOrderPlacedTime" json:"lastOrderPlacedTime,omitempty"`
+	// PrefetchImages are the images that are pulled in advance and kept in
+	// the worker's image cache while the plan exists, so tasks using them
+	// start fast.
+	PrefetchImages []string `protobuf:"bytes,14,rep,name=prefetchImages" json:"prefetchImages,omitempty"`
+	// Repricing makes the plan's price follow the market, optional.
+	Repricing *AskPlanRepricing `protobuf:"bytes,15,opt,name=repricing" json:"repricing,omitempty"`
 }
 
 func (m *AskPlan) Reset()                    { *m = AskPlan{} }
 func (m *AskPlan) String() string            { return proto.CompactTextString(m) }
 func (*AskPlan) ProtoMessage()               {}
-func (*AskPlan) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*AskPlan) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *AskPlan) GetID() string {
 	if m != nil {
@@ -517,63 +860,111 @@ func (m *AskPlan) GetLastOrderPlacedTime() *Timestamp {
 	return nil
 }
 
+func (m *AskPlan) GetPrefetchImages() []string {
+	if m != nil {
+		return m.PrefetchImages
+	}
+	return nil
+}
+
+func (m *AskPlan) GetRepricing() *AskPlanRepricing {
+	if m != nil {
+		return m.Repricing
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AskPlanCPU)(nil), "sonm.AskPlanCPU")
 	proto.RegisterType((*AskPlanGPU)(nil), "sonm.AskPlanGPU")
 	proto.RegisterType((*AskPlanRAM)(nil), "sonm.AskPlanRAM")
 	proto.RegisterType((*AskPlanStorage)(nil), "sonm.AskPlanStorage")
 	proto.RegisterType((*AskPlanNetwork)(nil), "sonm.AskPlanNetwork")
+	proto.RegisterType((*TrafficQuota)(nil), "sonm.TrafficQuota")
+	proto.RegisterType((*NetworkTraffic)(nil), "sonm.NetworkTraffic")
 	proto.RegisterType((*AskPlanResources)(nil), "sonm.AskPlanResources")
+	proto.RegisterType((*AskPlanRepricing)(nil), "sonm.AskPlanRepricing")
+	proto.RegisterType((*MarketRepricing)(nil), "sonm.MarketRepricing")
+	proto.RegisterType((*PriceScheduleEntry)(nil), "sonm.PriceScheduleEntry")
+	proto.RegisterType((*AskPlanPriceChange)(nil), "sonm.AskPlanPriceChange")
 	proto.RegisterType((*AskPlan)(nil), "sonm.AskPlan")
+	proto.RegisterEnum("sonm.TrafficQuota_Period", TrafficQuota_Period_name, TrafficQuota_Period_value)
 	proto.RegisterEnum("sonm.AskPlan_Status", AskPlan_Status_name, AskPlan_Status_value)
 }
 
 func init() { proto.RegisterFile("ask_plan.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x5f, 0x6b, 0xdb, 0x3a,
-	0x18, 0xc6, 0x4f, 0xfe, 0x34, 0x69, 0xde, 0xa6, 0x69, 0x8e, 0x5a, 0x8a, 0xe9, 0x55, 0x8e, 0x4f,
-	0x19, 0xa1, 0x6c, 0xce, 0xd6, 0x95, 0x31, 0x18, 0x0c, 0xd2, 0x26, 0x0b, 0x86, 0x36, 0x35, 0x6a,
-	0xb2, 0x8b, 0xdd, 0x14, 0xc5, 0x16, 0x89, 0x88, 0x23, 0x1b, 0x49, 0xde, 0xd6, 0x7e, 0xa8, 0x7d,
-	0xa4, 0xdd, 0xec, 0x8b, 0x0c, 0xd9, 0x72, 0x52, 0x8f, 0x14, 0x76, 0x67, 0x3f, 0xcf, 0xef, 0x91,
-	0xde, 0xf7, 0x95, 0x10, 0xb4, 0x88, 0x5c, 0xde, 0xc7, 0x21, 0xe1, 0x4e, 0x2c, 0x22, 0x15, 0xa1,
-	0xaa, 0x8c, 0xf8, 0xea, 0xa4, 0x39, 0x63, 0x73, 0xc6, 0x55, 0xa6, 0x9d, 0x20, 0x9f, 0xc4, 0x64,
-	0xc6, 0x42, 0xa6, 0x18, 0x95, 0x46, 0x3b, 0x60, 0x5c, 0x93, 0x9c, 0x11, 0x23, 0xfc, 0xbb, 0x22,
-	0x62, 0x49, 0x55, 0x1c, 0x12, 0x9f, 0xe6, 0x8c, 0x62, 0x2b, 0x2a, 0x15, 0x59, 0xc5, 0x99, 0x60,
-	0xbf, 0x01, 0xe8, 0xcb, 0xa5, 0x17, 0x12, 0x7e, 0xe5, 0x4d, 0xd1, 0xff, 0xb0, 0xef, 0x47, 0x82,
-	0xde, 0xc7, 0x54, 0xf8, 0x94, 0x2b, 0x69, 0x95, 0x3a, 0xa5, 0x6e, 0x15, 0x37, 0xb5, 0xe8, 0x19,
-	0xcd, 0xfe, 0xb8, 0x8e, 0x8c, 0xbc, 0x29, 0xb2, 0xa0, 0xce, 0x78, 0x40, 0xbf, 0x53, 0x0d, 0x57,
-	0xba, 0x55, 0x9c, 0xff, 0xa2, 0x63, 0xa8, 0x2d, 0x88, 0x5c, 0x50, 0x69, 0x95, 0x3b, 0x95, 0x6e,
-	0x03, 0x9b, 0x3f, 0xfb, 0xf5, 0x3a, 0x8f, 0xfb, 0x37, 0xc8, 0x86, 0xaa, 0x64, 0x8f, 0x34, 0xdd,
-	0x69, 0xef, 0xbc, 0xe5, 0xe8, 0x16, 0x9c, 0x01, 0x51, 0xe4, 0x8e, 0x3d, 0x52, 0x9c, 0x7a, 0xf6,
-	0x05, 0xb4, 0x4c, 0xe2, 0x4e, 0x45, 0x82, 0xcc, 0xe9, 0x5f, 0xa5, 0x7e, 0x94, 0xd6, 0xb1, 0x31,
-	0x55, 0xdf, 0x22, 0xb1, 0x44, 0xef, 0xa0, 0xa9, 0x16, 0x22, 0x4a, 0xe6, 0x8b, 0x38, 0x51, 0x2e,
-	0x37, 0x71, 0xf4, 0x47, 0x9c, 0x28, 0x8a, 0x0b, 0x1c, 0x7a, 0x0f, 0xfb, 0x9b, 0xff, 0xdb, 0x44,
-	0x59, 0xe5, 0x67, 0x83, 0x45, 0x10, 0x9d, 0xc1, 0x2e, 0xa7, 0xea, 0x53, 0x48, 0xe6, 0xd2, 0xaa,
-	0x3c, 0x2d, 0x76, 0x6c, 0x54, 0xbc, 0xf6, 0xed, 0x9f, 0x25, 0x68, 0xe7, 0x93, 0xa1, 0x32, 0x4a,
-	0x84, 0x4f, 0x25, 0xb2, 0xa1, 0x72, 0xe5, 0x4d, 0x4d, 0xa5, 0xed, 0x2c, 0xbb, 0x39, 0x31, 0xac,
-	0x4d, 0xcd, 0xe0, 0xfe, 0x8d, 0x29, 0xaa, 0xc8, 0xe0, 0xfe, 0x0d, 0xd6, 0x26, 0x72, 0xa0, 0x2e,
-	0xb3, 0xe1, 0x99, 0x3a, 0x8e, 0x0a, 0x9c, 0x19, 0x2c, 0xce, 0x21, 0xbd, 0xe6, 0xc8, 0x9b, 0x5a,
-	0xd5, 0x2d, 0x6b, 0x8e, 0xf4, 0xbe, 0xfa, 0xec, 0x1d, 0xa8, 0xf3, 0x6c, 0xb2, 0xd6, 0xce, 0x96,
-	0x35, 0xcd, 0xd4, 0x71, 0x0e, 0xd9, 0xbf, 0xaa, 0x50, 0x37, 0x1e, 0x6a, 0x41, 0xd9, 0x1d, 0xa4,
-	0x6d, 0x35, 0x70, 0xd9, 0x1d, 0xa0, 0x17, 0x50, 0x8f, 0x44, 0x40, 0x85, 0x3b, 0x30, 0x7d, 0x34,
-	0xb3, 0xb5, 0x2e, 0xd9, 0xdc, 0xe5, 0x0a, 0xe7, 0x26, 0x3a, 0x85, 0x5a, 0x40, 0x49, 0xe8, 0x0e,
-	0x4c, 0x1b, 0x45, 0xcc, 0x78, 0x7a, 0xec, 0x41, 0x22, 0x88, 0x62, 0x11, 0x37, 0x2d, 0xe4, 0x77,
-	0xc4, 0xa8, 0x78, 0xed, 0xa3, 0xff, 0x60, 0x27, 0x16, 0xcc, 0xa7, 0xa6, 0x87, 0xbd, 0x0c, 0xf4,
-	0xb4, 0x84, 0x33, 0x07, 0x39, 0xd0, 0x98, 0x85, 0xc4, 0x5f, 0x86, 0x4c, 0x2a, 0xab, 0xf6, 0x74,
-	0x24, 0x43, 0xb5, 0xe8, 0x07, 0x81, 0xa0, 0x52, 0xe2, 0x0d, 0x82, 0x2e, 0xa0, 0xe9, 0x47, 0x09,
-	0x57, 0x54, 0xc4, 0x44, 0xa8, 0x07, 0xab, 0xfe, 0x4c, 0xa4, 0x40, 0xa1, 0x1e, 0xec, 0xb2, 0x80,
-	0x72, 0xc5, 0xd4, 0x83, 0xb5, 0xdb, 0x29, 0x75, 0x5b, 0xe7, 0x87, 0x59, 0xc2, 0x35, 0xea, 0x35,
-	0xfd, 0x4a, 0x43, 0xbc, 0x86, 0x50, 0x1b, 0x2a, 0x8a, 0xcc, 0xad, 0x46, 0xa7, 0xd4, 0x6d, 0x62,
-	0xfd, 0x89, 0x2e, 0xa0, 0x21, 0xf2, 0xab, 0x63, 0x41, 0xba, 0xeb, 0x71, 0xf1, 0x3e, 0xe4, 0x2e,
-	0xde, 0x80, 0xe8, 0x25, 0xd4, 0xa4, 0x22, 0x2a, 0x91, 0xd6, 0x5e, 0xba, 0x6d, 0xf1, 0x18, 0x9d,
-	0xbb, 0xd4, 0xc3, 0x86, 0x41, 0x3d, 0x00, 0x5f, 0x50, 0xa2, 0xe8, 0x84, 0xad, 0xa8, 0xd5, 0x4c,
-	0x37, 0x39, 0xc8, 0x12, 0x93, 0xfc, 0x75, 0xc1, 0x4f, 0x10, 0xd4, 0x87, 0xc3, 0x90, 0x48, 0x75,
-	0xab, 0x4f, 0xd0, 0xd3, 0x8f, 0x51, 0x90, 0x26, 0xf7, 0xb7, 0x27, 0xb7, 0xb1, 0xf6, 0x19, 0xd4,
-	0xb2, 0x2a, 0x10, 0x40, 0xad, 0x7f, 0x35, 0x71, 0x3f, 0x0f, 0xdb, 0xff, 0xa0, 0x23, 0x68, 0x7b,
-	0xc3, 0xf1, 0xc0, 0x1d, 0x8f, 0xee, 0x07, 0xc3, 0xeb, 0xe1, 0xc4, 0xbd, 0x1d, 0xb7, 0x4b, 0x97,
-	0xa7, 0x5f, 0xec, 0x39, 0x53, 0x8b, 0x64, 0xe6, 0xf8, 0xd1, 0xaa, 0xa7, 0x57, 0x7f, 0xc5, 0xa2,
-	0x9e, 0x7e, 0xc2, 0x7a, 0xe9, 0x9b, 0xf7, 0x41, 0x4b, 0xb3, 0x5a, 0xfa, 0xfd, 0xf6, 0x77, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x01, 0xcc, 0xa3, 0xf7, 0x6e, 0x05, 0x00, 0x00,
+	// 1126 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdb, 0x8e, 0xdb, 0x44,
+	0x18, 0xae, 0x73, 0x70, 0x92, 0x7f, 0xb3, 0xd9, 0x30, 0x2d, 0xd5, 0x50, 0x21, 0xba, 0xb8, 0xa5,
+	0xac, 0x2a, 0x9a, 0x6d, 0xcb, 0x0a, 0x21, 0x21, 0x21, 0xa5, 0x9b, 0xb0, 0x44, 0xda, 0x43, 0x98,
+	0xdd, 0x45, 0xc0, 0x4d, 0x35, 0xb1, 0x67, 0x9d, 0xd1, 0x3a, 0xb6, 0x35, 0x33, 0xa6, 0x6c, 0xaf,
+	0x78, 0x06, 0x6e, 0x78, 0x00, 0xde, 0x82, 0x3b, 0x5e, 0x84, 0x67, 0x41, 0x33, 0x1e, 0x3b, 0x4e,
+	0xd7, 0x95, 0xb8, 0xf3, 0xfc, 0xdf, 0xf7, 0x9f, 0x0f, 0x32, 0x0c, 0xa8, 0xbc, 0x7e, 0x9d, 0x46,
+	0x34, 0x1e, 0xa5, 0x22, 0x51, 0x09, 0x6a, 0xc9, 0x24, 0x5e, 0x3d, 0xe8, 0x2f, 0x78, 0xc8, 0x63,
+	0x95, 0xcb, 0x1e, 0x20, 0x9f, 0xa6, 0x74, 0xc1, 0x23, 0xae, 0x38, 0x93, 0x56, 0xb6, 0xc3, 0x63,
+	0xcd, 0x8c, 0x39, 0xb5, 0x82, 0x0f, 0x56, 0x54, 0x5c, 0x33, 0x95, 0x46, 0xd4, 0x67, 0x05, 0x47,
+	0xf1, 0x15, 0x93, 0x8a, 0xae, 0xd2, 0x5c, 0xe0, 0xbd, 0x00, 0x18, 0xcb, 0xeb, 0x79, 0x44, 0xe3,
+	0xc3, 0xf9, 0x25, 0x7a, 0x04, 0xdb, 0x7e, 0x22, 0xd8, 0xeb, 0x94, 0x09, 0x9f, 0xc5, 0x4a, 0x62,
+	0x67, 0xd7, 0xd9, 0x6b, 0x91, 0xbe, 0x16, 0xce, 0xad, 0xcc, 0xfb, 0xb6, 0x54, 0x39, 0x9a, 0x5f,
+	0x22, 0x0c, 0x1d, 0x1e, 0x07, 0xec, 0x37, 0xa6, 0xc9, 0xcd, 0xbd, 0x16, 0x29, 0x9e, 0xe8, 0x3e,
+	0xb8, 0x4b, 0x2a, 0x97, 0x4c, 0xe2, 0xc6, 0x6e, 0x73, 0xaf, 0x47, 0xec, 0xcb, 0x7b, 0x5e, 0xea,
+	0x93, 0xf1, 0x09, 0xf2, 0xa0, 0x25, 0xf9, 0x5b, 0x66, 0x3c, 0x6d, 0xbd, 0x1c, 0x8c, 0x74, 0x0a,
+	0xa3, 0x09, 0x55, 0xf4, 0x9c, 0xbf, 0x65, 0xc4, 0x60, 0xde, 0x01, 0x0c, 0xac, 0xc6, 0xb9, 0x4a,
+	0x04, 0x0d, 0xd9, 0xff, 0xd2, 0xfa, 0xa3, 0x51, 0xaa, 0x9d, 0x32, 0xf5, 0x26, 0x11, 0xd7, 0xe8,
+	0x2b, 0xe8, 0xab, 0xa5, 0x48, 0xb2, 0x70, 0x99, 0x66, 0x6a, 0x16, 0x5b, 0x75, 0xf4, 0x8e, 0x3a,
+	0x55, 0x8c, 0x6c, 0xf0, 0xd0, 0xd7, 0xb0, 0xbd, 0x7e, 0x9f, 0x65, 0x0a, 0x37, 0xde, 0xab, 0xb8,
+	0x49, 0x44, 0x4f, 0xa1, 0x1b, 0x33, 0xf5, 0x5d, 0x44, 0x43, 0x89, 0x9b, 0xd5, 0x60, 0x4f, 0xad,
+	0x94, 0x94, 0x38, 0x7a, 0x0a, 0x2e, 0x0b, 0x05, 0x93, 0x12, 0xb7, 0xaa, 0xe6, 0xa7, 0x46, 0x36,
+	0x4f, 0x22, 0xee, 0xdf, 0x10, 0xcb, 0x30, 0x99, 0x08, 0x7a, 0x75, 0xc5, 0xfd, 0x1f, 0xb2, 0x44,
+	0x51, 0xdc, 0xae, 0x6a, 0x5c, 0x54, 0x10, 0xb2, 0xc1, 0xf3, 0xfe, 0x76, 0xa0, 0x5f, 0x85, 0xd1,
+	0x63, 0x68, 0x47, 0x7c, 0xc5, 0xd5, 0x7b, 0x4a, 0x99, 0x83, 0xe8, 0x05, 0xb8, 0x29, 0x13, 0x3c,
+	0x09, 0x4c, 0xe6, 0x83, 0x97, 0x1f, 0xdd, 0x76, 0x34, 0x9a, 0x1b, 0x02, 0xb1, 0x44, 0xf4, 0x1c,
+	0x7a, 0x57, 0x51, 0x92, 0x08, 0x5d, 0x15, 0xdc, 0xac, 0x86, 0xb7, 0x51, 0xaf, 0x35, 0xc9, 0x7b,
+	0x08, 0x6e, 0x6e, 0x03, 0x75, 0xa1, 0x35, 0x99, 0x8e, 0x8f, 0x87, 0x77, 0xd0, 0x16, 0x74, 0x4e,
+	0xce, 0x4e, 0x2f, 0xbe, 0x3f, 0xfe, 0x79, 0xe8, 0x78, 0x7f, 0x3a, 0x30, 0xb0, 0xad, 0xb4, 0x9e,
+	0xf5, 0xf8, 0x2d, 0x6e, 0x14, 0x93, 0xb6, 0x99, 0x2d, 0x52, 0x3c, 0xd1, 0x03, 0xe8, 0x9a, 0xcf,
+	0xa2, 0x5d, 0x2d, 0x52, 0xbe, 0xd1, 0x0b, 0xd8, 0xca, 0xa3, 0x3c, 0x57, 0x54, 0x28, 0x1b, 0xdd,
+	0x8e, 0xcd, 0xa9, 0xd8, 0x10, 0x52, 0xe5, 0xa0, 0x8f, 0xa1, 0xa7, 0x3b, 0xab, 0x54, 0xc4, 0x02,
+	0xd3, 0x9f, 0x2e, 0x59, 0x0b, 0xbc, 0x7f, 0x1d, 0x18, 0x16, 0x43, 0xcd, 0x64, 0x92, 0x09, 0x9f,
+	0x49, 0xe4, 0x41, 0xf3, 0x70, 0x7e, 0x69, 0x0b, 0x3b, 0xcc, 0xad, 0xaf, 0x97, 0x8d, 0x68, 0x50,
+	0x73, 0xc8, 0xf8, 0x04, 0x37, 0x6a, 0x38, 0x64, 0x7c, 0x42, 0x34, 0x88, 0x46, 0xd0, 0x91, 0xf9,
+	0xdc, 0xdb, 0x48, 0xef, 0x6d, 0xf0, 0xec, 0x4e, 0x90, 0x82, 0xa4, 0x6d, 0x1e, 0xcd, 0x2f, 0x71,
+	0xab, 0xc6, 0xe6, 0x91, 0xf6, 0xab, 0xd7, 0x76, 0x04, 0x9d, 0x38, 0xaf, 0x24, 0x6e, 0xd7, 0xd8,
+	0xb4, 0x55, 0x26, 0x05, 0xc9, 0xfb, 0xbd, 0x51, 0x49, 0x30, 0x15, 0xdc, 0xe7, 0x71, 0x88, 0x1e,
+	0x42, 0x6b, 0x41, 0x65, 0xb1, 0x85, 0x5b, 0xb9, 0x85, 0xb9, 0xe0, 0x3e, 0x23, 0x06, 0x40, 0x9f,
+	0x42, 0xdb, 0xb4, 0x17, 0x37, 0x6e, 0x33, 0x72, 0x04, 0x7d, 0x06, 0x1d, 0x9f, 0xf1, 0x88, 0xc7,
+	0x21, 0x6e, 0xde, 0x26, 0x15, 0x18, 0x7a, 0x06, 0x6e, 0x7e, 0xcd, 0x6c, 0x5a, 0x1f, 0xe6, 0xac,
+	0x13, 0x23, 0x2b, 0x23, 0x22, 0x96, 0x84, 0x0e, 0xa0, 0x2b, 0xfd, 0x25, 0x0b, 0xb2, 0x88, 0xe1,
+	0xf6, 0x6e, 0x73, 0x6f, 0xeb, 0x25, 0xae, 0x98, 0x3d, 0xb7, 0xd0, 0x34, 0x56, 0xe2, 0x86, 0x94,
+	0x4c, 0xdb, 0x63, 0x26, 0x97, 0x49, 0x14, 0x60, 0x77, 0xd7, 0xd9, 0x73, 0xc8, 0x5a, 0xe0, 0x3d,
+	0x83, 0x9d, 0x77, 0xdc, 0xe9, 0x19, 0xcb, 0xe2, 0x80, 0x09, 0x3f, 0xcb, 0xf7, 0xc7, 0x21, 0xe5,
+	0xdb, 0xfb, 0x09, 0xd0, 0x6d, 0x67, 0x08, 0x41, 0xeb, 0x4a, 0x24, 0x2b, 0xc3, 0xde, 0x26, 0xe6,
+	0x1b, 0x0d, 0xa0, 0xa1, 0x12, 0x53, 0xa2, 0x6d, 0xd2, 0x50, 0x09, 0xfa, 0x04, 0x60, 0x95, 0x45,
+	0x8a, 0xa7, 0x11, 0x67, 0xc2, 0x54, 0xc5, 0x21, 0x15, 0x89, 0xf7, 0x97, 0x03, 0xc8, 0xf6, 0xc2,
+	0x78, 0x38, 0x5c, 0xd2, 0x38, 0x64, 0xe8, 0x11, 0xb4, 0xf4, 0x75, 0xc7, 0x4e, 0xfd, 0x34, 0x1b,
+	0x10, 0x7d, 0x0e, 0xdd, 0x24, 0x0a, 0x8c, 0x5a, 0x5d, 0x53, 0x4a, 0x50, 0x13, 0x63, 0xf6, 0x26,
+	0x27, 0xd6, 0x34, 0xa6, 0x04, 0xf5, 0x99, 0x17, 0x8c, 0xca, 0x24, 0x36, 0x9d, 0xe9, 0x11, 0xfb,
+	0xf2, 0xfe, 0x69, 0x43, 0xc7, 0x46, 0xa9, 0x33, 0x9c, 0x4d, 0x4c, 0x60, 0x3d, 0xd2, 0x98, 0x4d,
+	0xd0, 0x13, 0xe8, 0x24, 0x22, 0x60, 0x62, 0x36, 0xb1, 0x41, 0xf4, 0x73, 0xdb, 0xaf, 0x78, 0x38,
+	0x8b, 0x15, 0x29, 0x40, 0xf4, 0x18, 0xdc, 0x80, 0xd1, 0x68, 0x36, 0xc1, 0xcd, 0x1a, 0x9a, 0xc5,
+	0xf4, 0x8d, 0x0d, 0x32, 0x41, 0x15, 0xb7, 0x31, 0xac, 0xaf, 0x98, 0x95, 0x92, 0x12, 0xd7, 0x13,
+	0x99, 0x9a, 0x9c, 0xda, 0x35, 0x13, 0x69, 0x10, 0x34, 0x82, 0xde, 0x22, 0xa2, 0xfe, 0x75, 0xc4,
+	0xa5, 0xc2, 0x6e, 0x75, 0x89, 0xa6, 0x6a, 0x39, 0x0e, 0x02, 0x7d, 0x7f, 0xc9, 0x9a, 0x82, 0x0e,
+	0xa0, 0xef, 0x27, 0x59, 0xac, 0x98, 0x48, 0xa9, 0x50, 0x37, 0xb8, 0xf3, 0x1e, 0x95, 0x0d, 0x16,
+	0xda, 0x87, 0x2e, 0x0f, 0x58, 0xac, 0xb8, 0xba, 0xc1, 0x5d, 0x73, 0x53, 0xef, 0xe6, 0x1a, 0x33,
+	0x2b, 0x3d, 0x66, 0xbf, 0xb2, 0x88, 0x94, 0x24, 0x34, 0x84, 0xa6, 0xa2, 0x21, 0xee, 0xed, 0x3a,
+	0x7b, 0x7d, 0xa2, 0x3f, 0xd1, 0x01, 0xf4, 0x44, 0x71, 0x6c, 0x30, 0x18, 0xaf, 0xf7, 0x37, 0x2f,
+	0x48, 0x81, 0x92, 0x35, 0x11, 0x7d, 0x01, 0xae, 0x54, 0x54, 0x65, 0x12, 0x6f, 0x19, 0xb7, 0x9b,
+	0x8b, 0x3f, 0x3a, 0x37, 0x18, 0xb1, 0x1c, 0xb4, 0x0f, 0xe0, 0x0b, 0x46, 0x15, 0xd3, 0x83, 0x84,
+	0xfb, 0xf5, 0xa3, 0x55, 0xa1, 0xa0, 0x31, 0xdc, 0x8d, 0xa8, 0x54, 0x67, 0xba, 0x83, 0x73, 0xfd,
+	0xe7, 0x11, 0x18, 0xcd, 0xed, 0x7a, 0xcd, 0x3a, 0x2e, 0x7a, 0x02, 0x83, 0x54, 0xb0, 0x2b, 0xa6,
+	0xfc, 0xe5, 0x6c, 0x45, 0x43, 0x26, 0xf1, 0xc0, 0xfc, 0x40, 0xbc, 0x23, 0xcd, 0xf3, 0xb7, 0xab,
+	0x88, 0x77, 0x6a, 0xf3, 0xb7, 0x28, 0x59, 0x13, 0xbd, 0xa7, 0xe0, 0xe6, 0x39, 0x22, 0x00, 0x77,
+	0x7c, 0x78, 0x31, 0xfb, 0x71, 0x3a, 0xbc, 0x83, 0xee, 0xc1, 0x70, 0x3e, 0x3d, 0x9d, 0xcc, 0x4e,
+	0x8f, 0x5e, 0x4f, 0xa6, 0xc7, 0xd3, 0x8b, 0xd9, 0xd9, 0xe9, 0xd0, 0x79, 0xf5, 0xf8, 0x17, 0x2f,
+	0xe4, 0x6a, 0x99, 0x2d, 0x46, 0x7e, 0xb2, 0xda, 0xd7, 0xa6, 0x9f, 0xf1, 0x64, 0x5f, 0xff, 0x0d,
+	0xed, 0x9b, 0xdf, 0xa7, 0x6f, 0xb4, 0x68, 0xe1, 0x9a, 0xef, 0x2f, 0xff, 0x1b, 0x00, 0x3c, 0x09,
+	0xb5, 0x99, 0xb9, 0x09, 0x00, 0x00,
 }
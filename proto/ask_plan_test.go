@@ -34,6 +34,14 @@ resources:
     overlay: true
     outbound: true
     incoming: true
+    egress:
+      cidrs: ["10.0.0.0/8"]
+      hosts: ["eth-eu.sparkpool.com"]
+      ports: ["3333", "8000-8100/tcp"]
+    trafficquota:
+      limit: 1TiB
+      period: monthly
+      floorrate: 1 Mbit/s
 `)
 	ask := &AskPlan{}
 	err := yaml.Unmarshal(data, ask)
@@ -63,6 +71,35 @@ resources:
 	assert.True(t, ask.Resources.GetNetwork().GetNetFlags().GetOverlay())
 	assert.True(t, ask.Resources.GetNetwork().GetNetFlags().GetOutbound())
 	assert.True(t, ask.Resources.GetNetwork().GetNetFlags().GetIncoming())
+	assert.Equal(t, []string{"10.0.0.0/8"}, ask.Resources.GetNetwork().GetEgress().GetCIDRs())
+	assert.Equal(t, []string{"eth-eu.sparkpool.com"}, ask.Resources.GetNetwork().GetEgress().GetHosts())
+	assert.Equal(t, []string{"3333", "8000-8100/tcp"}, ask.Resources.GetNetwork().GetEgress().GetPorts())
+	assert.Equal(t, uint64(1<<40), ask.Resources.GetNetwork().GetTrafficQuota().GetLimit().GetBytes())
+	assert.Equal(t, TrafficQuota_MONTHLY, ask.Resources.GetNetwork().GetTrafficQuota().GetPeriod())
+	assert.Equal(t, uint64(1e6), ask.Resources.GetNetwork().GetTrafficQuota().GetFloorRate().GetBitsPerSecond())
+}
+
+func TestTrafficQuotaValidate(t *testing.T) {
+	var quota *TrafficQuota
+	assert.NoError(t, quota.Validate())
+
+	assert.Error(t, (&TrafficQuota{}).Validate())
+	assert.Error(t, (&TrafficQuota{Limit: &DataSize{Bytes: 1}, Period: TrafficQuota_Period(42)}).Validate())
+	assert.NoError(t, (&TrafficQuota{Limit: &DataSize{Bytes: 1}}).Validate())
+}
+
+func TestTrafficQuotaPeriodStart(t *testing.T) {
+	since := time.Date(2019, 1, 20, 10, 0, 0, 0, time.UTC)
+	now := time.Date(2019, 3, 5, 1, 2, 3, 0, time.FixedZone("UTC+3", 3*3600))
+
+	quota := &TrafficQuota{Period: TrafficQuota_DEAL}
+	assert.Equal(t, since, quota.PeriodStart(since, now))
+
+	quota.Period = TrafficQuota_MONTHLY
+	assert.Equal(t, time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC), quota.PeriodStart(since, now))
+
+	now = time.Date(2019, 3, 1, 1, 0, 0, 0, time.FixedZone("UTC+3", 3*3600))
+	assert.Equal(t, time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC), quota.PeriodStart(since, now))
 }
 
 func TestAskPlanIDsAndHashes(t *testing.T) {
@@ -85,3 +122,70 @@ resources:
 	err = ask.Validate()
 	require.Error(t, err)
 }
+
+func newTestPrice(perSecond int64) *Price {
+	return &Price{PerSecond: NewBigIntFromInt(perSecond)}
+}
+
+func TestAskPlanRepricingValidate(t *testing.T) {
+	var repricing *AskPlanRepricing
+	assert.NoError(t, repricing.Validate())
+
+	assert.NoError(t, (&AskPlanRepricing{
+		Floor:    newTestPrice(10),
+		Ceiling:  newTestPrice(20),
+		Market:   &MarketRepricing{Undercut: 1},
+		Schedule: []*PriceScheduleEntry{{From: 22, To: 6, Multiplier: 0.8}},
+	}).Validate())
+
+	assert.Error(t, (&AskPlanRepricing{Floor: newTestPrice(20), Ceiling: newTestPrice(10)}).Validate())
+	assert.Error(t, (&AskPlanRepricing{Threshold: -1}).Validate())
+	assert.Error(t, (&AskPlanRepricing{Market: &MarketRepricing{Undercut: 100}}).Validate())
+	assert.Error(t, (&AskPlanRepricing{Schedule: []*PriceScheduleEntry{{From: 24, To: 1, Multiplier: 1}}}).Validate())
+	assert.Error(t, (&AskPlanRepricing{Schedule: []*PriceScheduleEntry{{From: 1, To: 1, Multiplier: 1}}}).Validate())
+	assert.Error(t, (&AskPlanRepricing{Schedule: []*PriceScheduleEntry{{From: 1, To: 2}}}).Validate())
+}
+
+func TestAskPlanRepricingTargetPrice(t *testing.T) {
+	noon := time.Date(2019, 3, 5, 12, 0, 0, 0, time.UTC)
+	night := time.Date(2019, 3, 5, 2, 0, 0, 0, time.UTC)
+
+	repricing := &AskPlanRepricing{
+		Base:     newTestPrice(1000),
+		Floor:    newTestPrice(700),
+		Ceiling:  newTestPrice(2000),
+		Market:   &MarketRepricing{Undercut: 10},
+		Schedule: []*PriceScheduleEntry{{From: 22, To: 6, Multiplier: 0.5}},
+	}
+
+	price, reason := repricing.TargetPrice(nil, noon)
+	assert.Equal(t, big.NewInt(1000), price)
+	assert.Equal(t, "base price", reason)
+
+	price, reason = repricing.TargetPrice(big.NewInt(1500), noon)
+	assert.Equal(t, big.NewInt(1350), price)
+	assert.Equal(t, "best competing ask, undercut by 10%", reason)
+
+	price, _ = repricing.TargetPrice(big.NewInt(3000), noon)
+	assert.Equal(t, big.NewInt(2000), price)
+
+	price, reason = repricing.TargetPrice(nil, night)
+	assert.Equal(t, big.NewInt(700), price)
+	assert.Equal(t, "base price, x0.5 within 22-06 UTC, raised to floor", reason)
+
+	price, _ = repricing.TargetPrice(big.NewInt(1800), night.In(time.FixedZone("UTC+3", 3*3600)))
+	assert.Equal(t, big.NewInt(810), price)
+}
+
+func TestAskPlanRepricingExceedsThreshold(t *testing.T) {
+	repricing := &AskPlanRepricing{Threshold: 5}
+
+	assert.False(t, repricing.ExceedsThreshold(big.NewInt(1000), big.NewInt(1000)))
+	assert.False(t, repricing.ExceedsThreshold(big.NewInt(1000), big.NewInt(1050)))
+	assert.False(t, repricing.ExceedsThreshold(big.NewInt(1000), big.NewInt(950)))
+	assert.True(t, repricing.ExceedsThreshold(big.NewInt(1000), big.NewInt(1051)))
+	assert.True(t, repricing.ExceedsThreshold(big.NewInt(1000), big.NewInt(900)))
+
+	repricing.Threshold = 0
+	assert.True(t, repricing.ExceedsThreshold(big.NewInt(1000), big.NewInt(1001)))
+}
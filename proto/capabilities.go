@@ -1,15 +1,74 @@
 package sonm
 
 import (
+	"errors"
 	"fmt"
+	"net"
+	"strconv"
+	"strings"
 
 	"github.com/cnf/structhash"
 )
 
+// hashableGPUDevice mirrors fields GPUDevice had before partitioning was
+// introduced, which keeps hashes of whole devices unchanged.
+type hashableGPUDevice struct {
+	ID            string
+	VendorID      uint64
+	VendorName    string
+	DeviceID      uint64
+	DeviceName    string
+	MajorNumber   uint64
+	MinorNumber   uint64
+	Memory        uint64
+	Hash          string
+	DeviceFiles   []string
+	DriverVolumes map[string]string
+}
+
 func (m *GPUDevice) FillHashID() {
 	// reset prev hash value to not affecting the current hashing
 	m.Hash = ""
-	m.Hash = fmt.Sprintf("%x", structhash.Md5(*m, 1))
+
+	dev := hashableGPUDevice{
+		ID:            m.ID,
+		VendorID:      m.VendorID,
+		VendorName:    m.VendorName,
+		DeviceID:      m.DeviceID,
+		DeviceName:    m.DeviceName,
+		MajorNumber:   m.MajorNumber,
+		MinorNumber:   m.MinorNumber,
+		Memory:        m.Memory,
+		DeviceFiles:   m.DeviceFiles,
+		DriverVolumes: m.DriverVolumes,
+	}
+
+	if m.Partition == nil {
+		m.Hash = fmt.Sprintf("%x", structhash.Md5(dev, 1))
+		return
+	}
+
+	partitioned := struct {
+		Device    hashableGPUDevice
+		Partition GPUPartition
+	}{
+		Device:    dev,
+		Partition: *m.Partition,
+	}
+	m.Hash = fmt.Sprintf("%x", structhash.Md5(partitioned, 1))
+}
+
+// IsPartition checks whether the device is a part of a physical device.
+func (m *GPUDevice) IsPartition() bool {
+	return m.GetPartition().GetType() != GPUPartition_WHOLE
+}
+
+// PhysicalID returns the ID of the physical device.
+func (m *GPUDevice) PhysicalID() string {
+	if m.IsPartition() {
+		return m.GetPartition().GetParentID()
+	}
+	return m.GetID()
 }
 
 const (
@@ -120,3 +179,142 @@ func (m *Network) HardwareHash() string {
 func (m *StorageDevice) HardwareHash() string {
 	return ""
 }
+
+// EgressPort describes a range of destination ports of an egress policy.
+type EgressPort struct {
+	// Protocol is one of "tcp", "udp" or "sctp".
+	Protocol string
+	From     uint16
+	To       uint16
+}
+
+// IsEmpty checks whether the egress policy allows everything.
+func (m *EgressPolicy) IsEmpty() bool {
+	return len(m.GetCIDRs()) == 0 && len(m.GetPorts()) == 0 && len(m.GetHosts()) == 0
+}
+
+// HasDestinations checks whether the egress policy restricts destination
+// addresses, i.e. has either CIDRs or hosts specified.
+func (m *EgressPolicy) HasDestinations() bool {
+	return len(m.GetCIDRs()) > 0 || len(m.GetHosts()) > 0
+}
+
+func (m *EgressPolicy) Validate() error {
+	if _, err := m.ParseCIDRs(); err != nil {
+		return err
+	}
+	if _, err := m.ParsePorts(); err != nil {
+		return err
+	}
+	for _, host := range m.GetHosts() {
+		if err := validateHostname(host); err != nil {
+			return fmt.Errorf("invalid host %q: %v", host, err)
+		}
+	}
+
+	return nil
+}
+
+// ParseCIDRs parses destination networks of the egress policy. Plain
+// addresses are treated as single host networks.
+func (m *EgressPolicy) ParseCIDRs() ([]*net.IPNet, error) {
+	result := make([]*net.IPNet, 0, len(m.GetCIDRs()))
+	for _, cidr := range m.GetCIDRs() {
+		if ip := net.ParseIP(cidr); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+			result = append(result, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q: %v", cidr, err)
+		}
+		result = append(result, ipNet)
+	}
+
+	return result, nil
+}
+
+// ParsePorts parses destination ports of the egress policy. Ports without
+// protocol produce both TCP and UDP ranges.
+func (m *EgressPolicy) ParsePorts() ([]EgressPort, error) {
+	result := make([]EgressPort, 0, len(m.GetPorts()))
+	for _, port := range m.GetPorts() {
+		ports, err := parseEgressPort(port)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q: %v", port, err)
+		}
+		result = append(result, ports...)
+	}
+
+	return result, nil
+}
+
+func parseEgressPort(port string) ([]EgressPort, error) {
+	protocols := []string{"tcp", "udp"}
+	if idx := strings.LastIndex(port, "/"); idx >= 0 {
+		protocol := strings.ToLower(port[idx+1:])
+		switch protocol {
+		case "tcp", "udp", "sctp":
+			protocols = []string{protocol}
+		default:
+			return nil, fmt.Errorf("unknown protocol %q", protocol)
+		}
+		port = port[:idx]
+	}
+
+	from, to := port, port
+	if idx := strings.Index(port, "-"); idx >= 0 {
+		from, to = port[:idx], port[idx+1:]
+	}
+
+	fromValue, err := strconv.ParseUint(from, 10, 16)
+	if err != nil {
+		return nil, err
+	}
+	toValue, err := strconv.ParseUint(to, 10, 16)
+	if err != nil {
+		return nil, err
+	}
+	if fromValue == 0 {
+		return nil, errors.New("port must be positive")
+	}
+	if fromValue > toValue {
+		return nil, errors.New("range start must not exceed its end")
+	}
+
+	result := make([]EgressPort, 0, len(protocols))
+	for _, protocol := range protocols {
+		result = append(result, EgressPort{Protocol: protocol, From: uint16(fromValue), To: uint16(toValue)})
+	}
+
+	return result, nil
+}
+
+func validateHostname(host string) error {
+	host = strings.TrimSuffix(host, ".")
+	if len(host) == 0 || len(host) > 253 {
+		return errors.New("length must be within [1; 253]")
+	}
+
+	for _, label := range strings.Split(host, ".") {
+		if len(label) == 0 || len(label) > 63 {
+			return errors.New("label length must be within [1; 63]")
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return errors.New("labels must not start or end with hyphen")
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+				return fmt.Errorf("unexpected character %q", c)
+			}
+		}
+	}
+
+	return nil
+}
@@ -42,6 +42,34 @@ func (x GPUVendorType) String() string {
 }
 func (GPUVendorType) EnumDescriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
 
+type GPUPartition_Type int32
+
+const (
+	GPUPartition_WHOLE GPUPartition_Type = 0
+	// MIG is a GPU instance of the NVIDIA Multi-Instance GPU feature
+	// with dedicated compute units and memory.
+	GPUPartition_MIG GPUPartition_Type = 1
+	// TIME_SLICE is a share of the device time-sliced with other tasks,
+	// with memory limited per task.
+	GPUPartition_TIME_SLICE GPUPartition_Type = 2
+)
+
+var GPUPartition_Type_name = map[int32]string{
+	0: "WHOLE",
+	1: "MIG",
+	2: "TIME_SLICE",
+}
+var GPUPartition_Type_value = map[string]int32{
+	"WHOLE":      0,
+	"MIG":        1,
+	"TIME_SLICE": 2,
+}
+
+func (x GPUPartition_Type) String() string {
+	return proto.EnumName(GPUPartition_Type_name, int32(x))
+}
+func (GPUPartition_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor3, []int{5, 0} }
+
 type CPUDevice struct {
 	// ModelName describes full model name.
 	// For example "Intel(R) Core(TM) i5-5257U CPU @ 2.70GHz".
@@ -189,6 +217,9 @@ type GPUDevice struct {
 	// "hostPath:containerPath" pair.
 	// Applicable to nvidia drivers.
 	DriverVolumes map[string]string `protobuf:"bytes,12,rep,name=driverVolumes" json:"driverVolumes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Partition describes which part of the physical device is represented,
+	// empty for whole devices.
+	Partition *GPUPartition `protobuf:"bytes,13,opt,name=partition" json:"partition,omitempty"`
 }
 
 func (m *GPUDevice) Reset()                    { *m = GPUDevice{} }
@@ -273,6 +304,65 @@ func (m *GPUDevice) GetDriverVolumes() map[string]string {
 	return nil
 }
 
+func (m *GPUDevice) GetPartition() *GPUPartition {
+	if m != nil {
+		return m.Partition
+	}
+	return nil
+}
+
+type GPUPartition struct {
+	Type GPUPartition_Type `protobuf:"varint,1,opt,name=type,enum=sonm.GPUPartition_Type" json:"type,omitempty"`
+	// ParentID is the ID of the physical device.
+	ParentID string `protobuf:"bytes,2,opt,name=parentID" json:"parentID,omitempty"`
+	// Profile is the MIG profile name, e.g. "1g.5gb".
+	Profile string `protobuf:"bytes,3,opt,name=profile" json:"profile,omitempty"`
+	// Slice is the index of the time slice.
+	Slice uint64 `protobuf:"varint,4,opt,name=slice" json:"slice,omitempty"`
+	// Slices is the total number of time slices the device is split into.
+	Slices uint64 `protobuf:"varint,5,opt,name=slices" json:"slices,omitempty"`
+}
+
+func (m *GPUPartition) Reset()                    { *m = GPUPartition{} }
+func (m *GPUPartition) String() string            { return proto.CompactTextString(m) }
+func (*GPUPartition) ProtoMessage()               {}
+func (*GPUPartition) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5} }
+
+func (m *GPUPartition) GetType() GPUPartition_Type {
+	if m != nil {
+		return m.Type
+	}
+	return GPUPartition_WHOLE
+}
+
+func (m *GPUPartition) GetParentID() string {
+	if m != nil {
+		return m.ParentID
+	}
+	return ""
+}
+
+func (m *GPUPartition) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
+func (m *GPUPartition) GetSlice() uint64 {
+	if m != nil {
+		return m.Slice
+	}
+	return 0
+}
+
+func (m *GPUPartition) GetSlices() uint64 {
+	if m != nil {
+		return m.Slices
+	}
+	return 0
+}
+
 type GPU struct {
 	Device     *GPUDevice            `protobuf:"bytes,1,opt,name=device" json:"device,omitempty"`
 	Benchmarks map[uint64]*Benchmark `protobuf:"bytes,2,rep,name=benchmarks" json:"benchmarks,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -281,7 +371,7 @@ type GPU struct {
 func (m *GPU) Reset()                    { *m = GPU{} }
 func (m *GPU) String() string            { return proto.CompactTextString(m) }
 func (*GPU) ProtoMessage()               {}
-func (*GPU) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5} }
+func (*GPU) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{6} }
 
 func (m *GPU) GetDevice() *GPUDevice {
 	if m != nil {
@@ -304,7 +394,7 @@ type NetFlags struct {
 func (m *NetFlags) Reset()                    { *m = NetFlags{} }
 func (m *NetFlags) String() string            { return proto.CompactTextString(m) }
 func (*NetFlags) ProtoMessage()               {}
-func (*NetFlags) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{6} }
+func (*NetFlags) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{7} }
 
 func (m *NetFlags) GetFlags() uint64 {
 	if m != nil {
@@ -313,6 +403,46 @@ func (m *NetFlags) GetFlags() uint64 {
 	return 0
 }
 
+// EgressPolicy restricts outbound connections of tasks. Connections are
+// allowed when they match both any destination and any port specified, an
+// empty policy allows everything.
+type EgressPolicy struct {
+	// CIDRs are destination networks, like "10.0.0.0/8" or "1.2.3.4/32".
+	CIDRs []string `protobuf:"bytes,1,rep,name=CIDRs" json:"CIDRs,omitempty"`
+	// Ports are destination ports, like "443", "53/udp" or "3333-3340/tcp".
+	// Both TCP and UDP are implied when the protocol is omitted.
+	Ports []string `protobuf:"bytes,2,rep,name=ports" json:"ports,omitempty"`
+	// Hosts are DNS names of destinations. Names are resolved into addresses
+	// once the rules are installed.
+	Hosts []string `protobuf:"bytes,3,rep,name=hosts" json:"hosts,omitempty"`
+}
+
+func (m *EgressPolicy) Reset()                    { *m = EgressPolicy{} }
+func (m *EgressPolicy) String() string            { return proto.CompactTextString(m) }
+func (*EgressPolicy) ProtoMessage()               {}
+func (*EgressPolicy) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{8} }
+
+func (m *EgressPolicy) GetCIDRs() []string {
+	if m != nil {
+		return m.CIDRs
+	}
+	return nil
+}
+
+func (m *EgressPolicy) GetPorts() []string {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+func (m *EgressPolicy) GetHosts() []string {
+	if m != nil {
+		return m.Hosts
+	}
+	return nil
+}
+
 type Network struct {
 	In            uint64                `protobuf:"varint,1,opt,name=in" json:"in,omitempty"`
 	Out           uint64                `protobuf:"varint,2,opt,name=out" json:"out,omitempty"`
@@ -324,7 +454,7 @@ type Network struct {
 func (m *Network) Reset()                    { *m = Network{} }
 func (m *Network) String() string            { return proto.CompactTextString(m) }
 func (*Network) ProtoMessage()               {}
-func (*Network) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{7} }
+func (*Network) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{9} }
 
 func (m *Network) GetIn() uint64 {
 	if m != nil {
@@ -368,7 +498,7 @@ type StorageDevice struct {
 func (m *StorageDevice) Reset()                    { *m = StorageDevice{} }
 func (m *StorageDevice) String() string            { return proto.CompactTextString(m) }
 func (*StorageDevice) ProtoMessage()               {}
-func (*StorageDevice) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{8} }
+func (*StorageDevice) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{10} }
 
 func (m *StorageDevice) GetBytesAvailable() uint64 {
 	if m != nil {
@@ -385,7 +515,7 @@ type Storage struct {
 func (m *Storage) Reset()                    { *m = Storage{} }
 func (m *Storage) String() string            { return proto.CompactTextString(m) }
 func (*Storage) ProtoMessage()               {}
-func (*Storage) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{9} }
+func (*Storage) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{11} }
 
 func (m *Storage) GetDevice() *StorageDevice {
 	if m != nil {
@@ -407,64 +537,77 @@ func init() {
 	proto.RegisterType((*RAMDevice)(nil), "sonm.RAMDevice")
 	proto.RegisterType((*RAM)(nil), "sonm.RAM")
 	proto.RegisterType((*GPUDevice)(nil), "sonm.GPUDevice")
+	proto.RegisterType((*GPUPartition)(nil), "sonm.GPUPartition")
 	proto.RegisterType((*GPU)(nil), "sonm.GPU")
 	proto.RegisterType((*NetFlags)(nil), "sonm.NetFlags")
+	proto.RegisterType((*EgressPolicy)(nil), "sonm.EgressPolicy")
 	proto.RegisterType((*Network)(nil), "sonm.Network")
 	proto.RegisterType((*StorageDevice)(nil), "sonm.StorageDevice")
 	proto.RegisterType((*Storage)(nil), "sonm.Storage")
 	proto.RegisterEnum("sonm.GPUVendorType", GPUVendorType_name, GPUVendorType_value)
+	proto.RegisterEnum("sonm.GPUPartition_Type", GPUPartition_Type_name, GPUPartition_Type_value)
 }
 
 func init() { proto.RegisterFile("capabilities.proto", fileDescriptor3) }
 
 var fileDescriptor3 = []byte{
-	// 753 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xd9, 0x6e, 0xd3, 0x40,
-	0x14, 0x25, 0x71, 0x36, 0xdf, 0x34, 0x6d, 0x18, 0x2a, 0x64, 0x22, 0x96, 0x28, 0x62, 0x89, 0x8a,
-	0x48, 0xa5, 0xf2, 0xc0, 0x26, 0x24, 0xd2, 0x3a, 0x09, 0x56, 0x15, 0x27, 0xb8, 0x4d, 0x11, 0xbc,
-	0x20, 0x27, 0x19, 0x1a, 0x13, 0xdb, 0x53, 0x79, 0x09, 0xca, 0x27, 0xf0, 0x39, 0x3c, 0xf2, 0x01,
-	0xfc, 0x17, 0x9a, 0x25, 0x63, 0xa7, 0xa5, 0x12, 0xa8, 0x52, 0xdf, 0xee, 0x76, 0xee, 0xf1, 0x9c,
-	0x7b, 0x67, 0x0c, 0x68, 0x62, 0x9f, 0xd9, 0x63, 0xc7, 0x75, 0x22, 0x07, 0x87, 0xad, 0xb3, 0x80,
-	0x44, 0x04, 0xe5, 0x42, 0xe2, 0x7b, 0xb5, 0xea, 0x18, 0xfb, 0x93, 0x99, 0x67, 0x07, 0x73, 0x11,
-	0x6f, 0x7c, 0x02, 0xf5, 0x60, 0x38, 0xd2, 0xf1, 0xc2, 0x99, 0x60, 0x74, 0x17, 0x54, 0x8f, 0x4c,
-	0xb1, 0x6b, 0xda, 0x1e, 0xd6, 0x32, 0xf5, 0x4c, 0x53, 0xb5, 0x92, 0x00, 0xda, 0x86, 0xfc, 0x84,
-	0x04, 0x38, 0xd4, 0xb2, 0xf5, 0x4c, 0xb3, 0x62, 0x71, 0x07, 0x69, 0x50, 0x0c, 0xc9, 0x64, 0x8e,
-	0xa3, 0x50, 0x53, 0x58, 0x7c, 0xe5, 0x36, 0x7e, 0x65, 0x40, 0x39, 0x18, 0x8e, 0xd0, 0x13, 0x28,
-	0x4c, 0x59, 0x7f, 0xd6, 0xb2, 0xbc, 0xb7, 0xd5, 0xa2, 0xdf, 0xd2, 0x92, 0xb4, 0x96, 0x48, 0xa3,
-	0x57, 0x00, 0xc9, 0xf7, 0x69, 0xd9, 0xba, 0xd2, 0x2c, 0xef, 0xdd, 0x91, 0xc5, 0xad, 0x7d, 0x99,
-	0xeb, 0xf8, 0x51, 0xb0, 0xb4, 0x52, 0xc5, 0x35, 0x13, 0xb6, 0xce, 0xa5, 0x51, 0x15, 0x94, 0x39,
-	0x5e, 0x32, 0xce, 0x9c, 0x45, 0x4d, 0xf4, 0x08, 0xf2, 0x0b, 0xdb, 0x8d, 0x31, 0x3b, 0x80, 0xfc,
-	0x0e, 0x89, 0xb3, 0x78, 0xf6, 0x75, 0xf6, 0x65, 0xa6, 0x71, 0x04, 0xaa, 0xd5, 0xee, 0x0b, 0x59,
-	0xb6, 0x21, 0x1f, 0x91, 0xc8, 0x76, 0x45, 0x2f, 0xee, 0x50, 0xb1, 0xec, 0x85, 0xed, 0xb8, 0xf6,
-	0xd8, 0xe5, 0x1d, 0x73, 0x56, 0x12, 0x40, 0x08, 0x72, 0x71, 0x88, 0xa7, 0x4c, 0x93, 0x9c, 0xc5,
-	0x6c, 0x26, 0x88, 0xd5, 0xee, 0x5f, 0x26, 0x88, 0x24, 0xfc, 0x17, 0x41, 0xac, 0x76, 0xff, 0x5a,
-	0x05, 0xf9, 0xa9, 0x80, 0xda, 0x93, 0x8b, 0xb2, 0x09, 0x59, 0x43, 0x17, 0x1b, 0x92, 0x35, 0x74,
-	0x54, 0x83, 0xd2, 0x02, 0xfb, 0x53, 0x12, 0x18, 0xba, 0x90, 0x42, 0xfa, 0xe8, 0x3e, 0x00, 0xb7,
-	0xd9, 0x56, 0x29, 0x0c, 0x93, 0x8a, 0x50, 0x2c, 0x3f, 0xae, 0xa1, 0x6b, 0x79, 0x8e, 0x5d, 0xf9,
-	0x14, 0xcb, 0x6d, 0x86, 0x2d, 0x70, 0x6c, 0x12, 0x41, 0x75, 0x28, 0x7b, 0xf6, 0x37, 0x12, 0x98,
-	0xb1, 0x37, 0xc6, 0x81, 0x56, 0x64, 0xf0, 0x74, 0x88, 0x55, 0x38, 0xbe, 0xac, 0x28, 0x89, 0x8a,
-	0x24, 0x84, 0x6e, 0x43, 0xa1, 0x8f, 0x3d, 0x12, 0x2c, 0x35, 0x95, 0x25, 0x85, 0x47, 0x27, 0x38,
-	0xb3, 0xc3, 0x99, 0x06, 0x8c, 0x95, 0xd9, 0xb4, 0x1b, 0x67, 0xef, 0x3a, 0x2e, 0x0e, 0xb5, 0x72,
-	0x5d, 0x69, 0xaa, 0x56, 0x3a, 0x84, 0xde, 0x43, 0x65, 0x1a, 0x38, 0x0b, 0x1c, 0x9c, 0x10, 0x37,
-	0xf6, 0x70, 0xa8, 0x6d, 0xb0, 0xa9, 0x35, 0xb8, 0xb4, 0x52, 0xc1, 0x96, 0x9e, 0x2e, 0xe2, 0xe3,
-	0x5b, 0x07, 0xd6, 0xde, 0x01, 0xba, 0x58, 0x94, 0x1e, 0xa2, 0xca, 0x87, 0xb8, 0x9d, 0x1e, 0xa2,
-	0x9a, 0x9e, 0x19, 0xdd, 0xb7, 0xde, 0xe5, 0x17, 0xb0, 0xf7, 0x3f, 0x17, 0xb0, 0x77, 0xcd, 0x17,
-	0xb0, 0x0e, 0x25, 0x13, 0x47, 0x5d, 0xd7, 0x3e, 0x0d, 0xe9, 0x09, 0xbf, 0x52, 0x63, 0x75, 0xff,
-	0x98, 0xd3, 0xf8, 0xa1, 0x40, 0xd1, 0xc4, 0xd1, 0x77, 0x12, 0xcc, 0xe9, 0x3e, 0x3a, 0xbe, 0x48,
-	0x67, 0x1d, 0x9f, 0x52, 0x93, 0x38, 0x12, 0xab, 0x48, 0x4d, 0xb4, 0x03, 0x25, 0x5f, 0xf4, 0x63,
-	0x3b, 0x58, 0xde, 0xdb, 0xe4, 0xec, 0x2b, 0x16, 0x4b, 0xe6, 0xd1, 0x01, 0x6c, 0x24, 0x27, 0x33,
-	0x7c, 0x2d, 0xc7, 0x84, 0x78, 0x20, 0xeb, 0x29, 0x65, 0x4a, 0x0c, 0xc3, 0xe7, 0x72, 0xac, 0x81,
-	0x50, 0x17, 0x2a, 0x89, 0x3f, 0x88, 0x23, 0x2d, 0xcf, 0xba, 0xd4, 0x2f, 0xeb, 0x32, 0x88, 0x23,
-	0xb1, 0x06, 0x6b, 0xb0, 0xda, 0x10, 0x6e, 0x5e, 0xa0, 0xba, 0x92, 0xb4, 0xb5, 0x0f, 0x80, 0x2e,
-	0xd2, 0x5e, 0x6d, 0x5a, 0x2f, 0xa0, 0x72, 0x14, 0x91, 0xc0, 0x3e, 0xc5, 0xe2, 0x81, 0x78, 0x0c,
-	0x9b, 0xe3, 0x65, 0x84, 0xc3, 0xb6, 0x7c, 0x21, 0x79, 0xe3, 0x73, 0xd1, 0xc6, 0xef, 0x0c, 0x14,
-	0x05, 0x12, 0x3d, 0x3d, 0xb7, 0xa6, 0xb7, 0x38, 0xe1, 0x5a, 0x63, 0xb9, 0xaa, 0x6f, 0xff, 0xb2,
-	0xaa, 0xf7, 0xd6, 0x00, 0xd7, 0xb9, 0xae, 0x3b, 0x26, 0x54, 0x7a, 0xc3, 0xd1, 0x09, 0x7b, 0xd5,
-	0x8e, 0x97, 0x67, 0x18, 0x6d, 0x41, 0xb9, 0x37, 0x1c, 0x7d, 0x19, 0x99, 0x87, 0xe6, 0xe0, 0xa3,
-	0x59, 0xbd, 0x81, 0x00, 0x0a, 0xe6, 0x89, 0xa1, 0x1b, 0xed, 0x6a, 0x86, 0xda, 0x56, 0x5b, 0xef,
-	0x0c, 0xcc, 0x6a, 0x16, 0x95, 0x20, 0xd7, 0x6d, 0x1f, 0x76, 0xaa, 0x13, 0x16, 0xed, 0xf4, 0x07,
-	0xc7, 0x9d, 0xea, 0x74, 0xff, 0xe1, 0xe7, 0xc6, 0xa9, 0x13, 0xcd, 0xe2, 0x71, 0x6b, 0x42, 0xbc,
-	0x5d, 0xca, 0xfb, 0xcc, 0x21, 0xbb, 0xf4, 0x8f, 0xbb, 0xcb, 0xfe, 0xdb, 0x6f, 0x68, 0x68, 0x5c,
-	0x60, 0xf6, 0xf3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbd, 0xc9, 0xf4, 0x25, 0xf1, 0x07, 0x00,
-	0x00,
+	// 920 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xeb, 0x6e, 0xe2, 0x46,
+	0x14, 0x5e, 0x5f, 0xb8, 0xf8, 0x10, 0x88, 0x3b, 0x8d, 0x5a, 0x17, 0xf5, 0x82, 0xac, 0x5e, 0xa2,
+	0xac, 0x4a, 0xaa, 0xf4, 0x47, 0x6f, 0xaa, 0x54, 0x16, 0x3b, 0xac, 0xb5, 0x8b, 0xa1, 0x93, 0x90,
+	0x55, 0xfb, 0x67, 0x65, 0x60, 0x36, 0xb8, 0xb1, 0x3d, 0xc8, 0x1e, 0xa8, 0x78, 0x84, 0x3e, 0x52,
+	0x1f, 0xa0, 0xbf, 0xfa, 0x18, 0x7d, 0x91, 0x6a, 0x66, 0x8c, 0x31, 0x49, 0x23, 0x75, 0x15, 0x29,
+	0xff, 0xce, 0xf7, 0xcd, 0xb9, 0x8c, 0xbf, 0x73, 0xce, 0x00, 0xa0, 0x59, 0xb0, 0x0c, 0xa6, 0x61,
+	0x14, 0xb2, 0x90, 0x64, 0xdd, 0x65, 0x4a, 0x19, 0x45, 0x7a, 0x46, 0x93, 0xb8, 0x6d, 0x4e, 0x49,
+	0x32, 0x5b, 0xc4, 0x41, 0x7a, 0x93, 0xf3, 0xf6, 0x2f, 0x60, 0xf4, 0xc7, 0x13, 0x87, 0xac, 0xc3,
+	0x19, 0x41, 0x1f, 0x82, 0x11, 0xd3, 0x39, 0x89, 0xfc, 0x20, 0x26, 0x96, 0xd2, 0x51, 0x8e, 0x0d,
+	0xbc, 0x23, 0xd0, 0x11, 0x54, 0x66, 0x34, 0x25, 0x99, 0xa5, 0x76, 0x94, 0xe3, 0x26, 0x96, 0x00,
+	0x59, 0x50, 0xcb, 0xe8, 0xec, 0x86, 0xb0, 0xcc, 0xd2, 0x04, 0xbf, 0x85, 0xf6, 0x9f, 0x0a, 0x68,
+	0xfd, 0xf1, 0x04, 0x7d, 0x01, 0xd5, 0xb9, 0xc8, 0x2f, 0x52, 0x36, 0xce, 0x0e, 0xbb, 0xfc, 0x2e,
+	0xdd, 0xa2, 0x2c, 0xce, 0x8f, 0xd1, 0x77, 0x00, 0xbb, 0xfb, 0x59, 0x6a, 0x47, 0x3b, 0x6e, 0x9c,
+	0x7d, 0x50, 0x38, 0x77, 0x9f, 0x15, 0x67, 0x6e, 0xc2, 0xd2, 0x0d, 0x2e, 0x39, 0xb7, 0x7d, 0x38,
+	0xbc, 0x75, 0x8c, 0x4c, 0xd0, 0x6e, 0xc8, 0x46, 0xd4, 0xd4, 0x31, 0x37, 0xd1, 0x67, 0x50, 0x59,
+	0x07, 0xd1, 0x8a, 0x58, 0x6a, 0xf9, 0x1e, 0x45, 0x1c, 0x96, 0xa7, 0xdf, 0xab, 0xdf, 0x2a, 0xf6,
+	0x05, 0x18, 0xb8, 0x37, 0xcc, 0x65, 0x39, 0x82, 0x0a, 0xa3, 0x2c, 0x88, 0xf2, 0x5c, 0x12, 0x70,
+	0xb1, 0x82, 0x75, 0x10, 0x46, 0xc1, 0x34, 0x92, 0x19, 0x75, 0xbc, 0x23, 0x10, 0x02, 0x7d, 0x95,
+	0x91, 0xb9, 0xd0, 0x44, 0xc7, 0xc2, 0x16, 0x82, 0xe0, 0xde, 0xf0, 0x3e, 0x41, 0x8a, 0x82, 0xff,
+	0x47, 0x10, 0xdc, 0x1b, 0x3e, 0xaa, 0x20, 0xff, 0x68, 0x60, 0x0c, 0x8a, 0x41, 0x69, 0x81, 0xea,
+	0x39, 0xf9, 0x84, 0xa8, 0x9e, 0x83, 0xda, 0x50, 0x5f, 0x93, 0x64, 0x4e, 0x53, 0xcf, 0xc9, 0xa5,
+	0x28, 0x30, 0xfa, 0x18, 0x40, 0xda, 0x62, 0xaa, 0x34, 0x11, 0x53, 0x62, 0x78, 0xac, 0xfc, 0x5c,
+	0xcf, 0xb1, 0x2a, 0x32, 0x76, 0x8b, 0x79, 0xac, 0xb4, 0x45, 0x6c, 0x55, 0xc6, 0xee, 0x18, 0xd4,
+	0x81, 0x46, 0x1c, 0xfc, 0x46, 0x53, 0x7f, 0x15, 0x4f, 0x49, 0x6a, 0xd5, 0x44, 0x78, 0x99, 0x12,
+	0x1e, 0x61, 0x52, 0x78, 0xd4, 0x73, 0x8f, 0x1d, 0x85, 0xde, 0x83, 0xea, 0x90, 0xc4, 0x34, 0xdd,
+	0x58, 0x86, 0x38, 0xcc, 0x11, 0xef, 0xe0, 0x22, 0xc8, 0x16, 0x16, 0x88, 0xaa, 0xc2, 0xe6, 0xd9,
+	0x64, 0xf5, 0xf3, 0x30, 0x22, 0x99, 0xd5, 0xe8, 0x68, 0xc7, 0x06, 0x2e, 0x53, 0xe8, 0x39, 0x34,
+	0xe7, 0x69, 0xb8, 0x26, 0xe9, 0x15, 0x8d, 0x56, 0x31, 0xc9, 0xac, 0x03, 0xd1, 0x35, 0x5b, 0x4a,
+	0x5b, 0x28, 0xd8, 0x75, 0xca, 0x4e, 0xb2, 0x7d, 0xfb, 0x81, 0xe8, 0x2b, 0x30, 0x96, 0x41, 0xca,
+	0x42, 0x16, 0xd2, 0xc4, 0x6a, 0x8a, 0x06, 0xa1, 0x22, 0xcb, 0x78, 0x7b, 0x82, 0x77, 0x4e, 0xed,
+	0x9f, 0x00, 0xdd, 0x4d, 0x5b, 0x6e, 0xbb, 0x21, 0xdb, 0x7e, 0x54, 0x6e, 0xbb, 0x51, 0xee, 0xf2,
+	0xdf, 0x0a, 0x1c, 0x94, 0xb3, 0xa3, 0xa7, 0xa0, 0xb3, 0xcd, 0x52, 0x0e, 0x6a, 0xeb, 0xec, 0xfd,
+	0xbb, 0xf5, 0xbb, 0x97, 0x9b, 0x25, 0xc1, 0xc2, 0x89, 0x77, 0x72, 0x19, 0xa4, 0x24, 0x61, 0xf9,
+	0x14, 0x18, 0xb8, 0xc0, 0xfc, 0x99, 0x58, 0xa6, 0xf4, 0x4d, 0x18, 0x6d, 0x47, 0x60, 0x0b, 0xf9,
+	0x6d, 0xb2, 0x88, 0x2f, 0x83, 0x2e, 0xb7, 0x4b, 0x00, 0xde, 0x15, 0x61, 0x64, 0xf9, 0x4c, 0xe4,
+	0xc8, 0x3e, 0x01, 0x9d, 0x57, 0x44, 0x06, 0x54, 0x5e, 0x3d, 0x1f, 0xbd, 0x74, 0xcd, 0x27, 0xa8,
+	0x06, 0xda, 0xd0, 0x1b, 0x98, 0x0a, 0x6a, 0x01, 0x5c, 0x7a, 0x43, 0xf7, 0xf5, 0xc5, 0x4b, 0xaf,
+	0xef, 0x9a, 0xaa, 0xd8, 0xb7, 0xc1, 0xfd, 0x0f, 0xd0, 0xe0, 0x6d, 0x1e, 0xa0, 0xc1, 0x23, 0x3f,
+	0x40, 0x1d, 0xa8, 0xfb, 0x84, 0x9d, 0x47, 0xc1, 0x75, 0xc6, 0x15, 0x7a, 0xc3, 0x8d, 0xed, 0xfb,
+	0x23, 0x80, 0x3d, 0x86, 0x03, 0xf7, 0x3a, 0x25, 0x59, 0x36, 0xa6, 0x51, 0x38, 0x13, 0x5d, 0xed,
+	0x7b, 0x0e, 0xe6, 0x5e, 0x7c, 0x2a, 0x25, 0xe0, 0xec, 0x92, 0xa6, 0x4c, 0x7e, 0x8d, 0x81, 0x25,
+	0xe0, 0xec, 0x82, 0x66, 0xe2, 0xc9, 0x16, 0xac, 0x00, 0xf6, 0x1f, 0x1a, 0xd4, 0x7c, 0xc2, 0x7e,
+	0xa7, 0xe9, 0x0d, 0xdf, 0xf0, 0x30, 0xc9, 0x0b, 0xaa, 0x61, 0xc2, 0x3f, 0x86, 0xae, 0x58, 0xbe,
+	0xdc, 0xdc, 0x44, 0x27, 0x50, 0x4f, 0xf2, 0x1b, 0x8a, 0x96, 0x36, 0xce, 0x5a, 0xf2, 0x7b, 0xb6,
+	0xf7, 0xc6, 0xc5, 0x39, 0xea, 0xc3, 0xc1, 0x4e, 0x2b, 0x2f, 0xb1, 0x74, 0x21, 0xed, 0x27, 0x85,
+	0x3f, 0x2f, 0x59, 0x92, 0xd7, 0x4b, 0xa4, 0xc0, 0x7b, 0x41, 0xe8, 0x1c, 0x9a, 0x3b, 0x3c, 0x5a,
+	0x31, 0xab, 0x22, 0xb2, 0x74, 0xee, 0xcb, 0x32, 0x5a, 0xb1, 0x7c, 0xb1, 0xf6, 0xc2, 0xda, 0x63,
+	0x78, 0xe7, 0x4e, 0xa9, 0x07, 0x35, 0xab, 0xfd, 0x33, 0xa0, 0xbb, 0x65, 0x1f, 0xd6, 0xff, 0x6f,
+	0xa0, 0x79, 0xc1, 0x68, 0x1a, 0x5c, 0x93, 0xfc, 0xc9, 0xfd, 0x1c, 0x5a, 0xd3, 0x0d, 0x23, 0x59,
+	0xaf, 0xf8, 0xcd, 0x91, 0x89, 0x6f, 0xb1, 0xf6, 0x5f, 0x0a, 0xd4, 0xf2, 0x48, 0xf4, 0xf4, 0xd6,
+	0xe0, 0xbf, 0x2b, 0x0b, 0xee, 0x25, 0x2e, 0x86, 0xff, 0xc7, 0xff, 0x18, 0xfe, 0x8f, 0xf6, 0x02,
+	0x1e, 0x73, 0x01, 0x4e, 0x7c, 0x68, 0x0e, 0xc6, 0x93, 0x2b, 0xf1, 0x3b, 0x21, 0x36, 0xfe, 0x10,
+	0x1a, 0x83, 0xf1, 0xe4, 0xf5, 0xc4, 0x7f, 0xe1, 0x8f, 0x5e, 0xf9, 0xe6, 0x13, 0x04, 0x50, 0xf5,
+	0xaf, 0x3c, 0xc7, 0xeb, 0x99, 0x0a, 0xb7, 0x71, 0xcf, 0x71, 0x47, 0xbe, 0xa9, 0xa2, 0x3a, 0xe8,
+	0xe7, 0xbd, 0x17, 0xae, 0x39, 0x13, 0xac, 0x3b, 0x1c, 0x5d, 0xba, 0xe6, 0xfc, 0xd9, 0xa7, 0xbf,
+	0xda, 0xd7, 0x21, 0x5b, 0xac, 0xa6, 0xdd, 0x19, 0x8d, 0x4f, 0x79, 0xdd, 0x2f, 0x43, 0x7a, 0xca,
+	0xff, 0xc3, 0x9c, 0x8a, 0x7f, 0x42, 0x3f, 0x70, 0x6a, 0x5a, 0x15, 0xf6, 0xd7, 0xff, 0x0e, 0x00,
+	0x7b, 0xbb, 0xfb, 0x6e, 0x43, 0x09, 0x00, 0x00,
 }
@@ -34,6 +34,53 @@ func TestGPUDevice_HashID(t *testing.T) {
 	assert.Equal(t, v2, v3)
 }
 
+func TestGPUDevice_HashIDStable(t *testing.T) {
+	dev := &GPUDevice{
+		ID:          "PCI:0001:0",
+		VendorID:    100,
+		VendorName:  "vendor",
+		DeviceID:    200,
+		DeviceName:  "name",
+		MajorNumber: 123,
+		MinorNumber: 234,
+		Memory:      100500,
+	}
+
+	// Hashes of whole devices must not change, because ask plans refer to
+	// them.
+	dev.FillHashID()
+	assert.Equal(t, "08ff3264c7d0e6a1071852bf27389065", dev.GetHash())
+	assert.False(t, dev.IsPartition())
+	assert.Equal(t, "PCI:0001:0", dev.PhysicalID())
+}
+
+func TestGPUDevice_HashIDPartition(t *testing.T) {
+	newSlice := func(slice uint64) *GPUDevice {
+		dev := &GPUDevice{
+			ID:     "PCI:0001:0",
+			Memory: 100500,
+			Partition: &GPUPartition{
+				Type:     GPUPartition_TIME_SLICE,
+				ParentID: "PCI:0001:0",
+				Slice:    slice,
+				Slices:   2,
+			},
+		}
+		dev.FillHashID()
+		return dev
+	}
+
+	whole := &GPUDevice{ID: "PCI:0001:0", Memory: 100500}
+	whole.FillHashID()
+
+	first, second := newSlice(0), newSlice(1)
+	assert.NotEqual(t, whole.GetHash(), first.GetHash())
+	assert.NotEqual(t, first.GetHash(), second.GetHash())
+	assert.Equal(t, first.GetHash(), newSlice(0).GetHash())
+	assert.True(t, first.IsPartition())
+	assert.Equal(t, "PCI:0001:0", first.PhysicalID())
+}
+
 func TestNetFlags_ToBoolSlice(t *testing.T) {
 	slice := make([]bool, MinNetFlagsCount)
 	netFlags := &NetFlags{}
@@ -128,3 +175,54 @@ func TestNetFlagsMirror(t *testing.T) {
 	flagsSlice := flags.ToBoolSlice()
 	require.Equal(t, NetFlagsFromBoolSlice(flagsSlice), flags)
 }
+
+func TestEgressPolicyParsePorts(t *testing.T) {
+	policy := &EgressPolicy{Ports: []string{"443", "53/udp", "3333-3340/TCP"}}
+	require.NoError(t, policy.Validate())
+
+	ports, err := policy.ParsePorts()
+	require.NoError(t, err)
+	require.Equal(t, []EgressPort{
+		{Protocol: "tcp", From: 443, To: 443},
+		{Protocol: "udp", From: 443, To: 443},
+		{Protocol: "udp", From: 53, To: 53},
+		{Protocol: "tcp", From: 3333, To: 3340},
+	}, ports)
+}
+
+func TestEgressPolicyParseCIDRs(t *testing.T) {
+	policy := &EgressPolicy{CIDRs: []string{"10.0.0.0/8", "1.2.3.4"}}
+	require.NoError(t, policy.Validate())
+
+	cidrs, err := policy.ParseCIDRs()
+	require.NoError(t, err)
+	require.Len(t, cidrs, 2)
+	assert.Equal(t, "10.0.0.0/8", cidrs[0].String())
+	assert.Equal(t, "1.2.3.4/32", cidrs[1].String())
+}
+
+func TestEgressPolicyValidate(t *testing.T) {
+	var policy *EgressPolicy
+	assert.NoError(t, policy.Validate())
+	assert.True(t, policy.IsEmpty())
+
+	assert.NoError(t, (&EgressPolicy{Hosts: []string{"eth-eu.sparkpool.com", "pool.example."}}).Validate())
+
+	invalid := []*EgressPolicy{
+		{CIDRs: []string{"10.0.0.0/33"}},
+		{CIDRs: []string{"pool.example.com"}},
+		{Ports: []string{"0"}},
+		{Ports: []string{"65536"}},
+		{Ports: []string{"80-79"}},
+		{Ports: []string{"80/icmp"}},
+		{Ports: []string{"http"}},
+		{Hosts: []string{""}},
+		{Hosts: []string{"-pool.example.com"}},
+		{Hosts: []string{"pool..example.com"}},
+		{Hosts: []string{"pool example.com"}},
+	}
+
+	for _, policy := range invalid {
+		assert.Error(t, policy.Validate(), policy.String())
+	}
+}
@@ -3,12 +3,21 @@ package sonm
 import (
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 )
 
+// MinCheckpointInterval is the minimum allowed interval between two
+// consecutive container checkpoints.
+const MinCheckpointInterval = time.Minute
+
 func (m *Registry) Auth() string {
 	if m == nil {
 		return ""
@@ -24,9 +33,30 @@ func (m *Registry) Auth() string {
 
 func (m *Registry) authConfig() types.AuthConfig {
 	return types.AuthConfig{
-		Username: m.GetUsername(),
-		Password: m.GetPassword(),
+		Username:      m.GetUsername(),
+		Password:      m.GetPassword(),
+		IdentityToken: m.GetIdentityToken(),
+		RegistryToken: m.GetRegistryToken(),
+	}
+}
+
+func (m *Registry) Validate() error {
+	secrets := 0
+	for _, secret := range []string{m.GetPassword(), m.GetIdentityToken(), m.GetRegistryToken()} {
+		if len(secret) > 0 {
+			secrets++
+		}
+	}
+
+	if secrets > 1 {
+		return errors.New("only one of password, identity token and registry token can be specified")
 	}
+
+	if len(m.GetCredential()) > 0 && (secrets > 0 || len(m.GetUsername()) > 0) {
+		return errors.New("stored credential can't be combined with inline username and secrets")
+	}
+
+	return nil
 }
 
 func (m *ContainerRestartPolicy) Unwrap() container.RestartPolicy {
@@ -44,5 +74,98 @@ func (m *Container) Validate() error {
 		return fmt.Errorf("container image name is required")
 	}
 
+	if err := m.GetHealthcheck().Validate(); err != nil {
+		return fmt.Errorf("invalid healthcheck: %v", err)
+	}
+
+	if err := m.GetCheckpoint().Validate(); err != nil {
+		return fmt.Errorf("invalid checkpoint: %v", err)
+	}
+
+	if err := m.GetEgress().Validate(); err != nil {
+		return fmt.Errorf("invalid egress policy: %v", err)
+	}
+
+	return nil
+}
+
+func (m *ContainerHealthCheck) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	probes := 0
+	if len(m.GetCommand()) > 0 {
+		probes++
+	}
+	if m.GetHttp() != "" {
+		probes++
+		if _, err := url.Parse(m.GetHttp()); err != nil {
+			return fmt.Errorf("failed to parse HTTP probe URL: %v", err)
+		}
+	}
+	if m.GetTcp() != "" {
+		probes++
+		if _, _, err := net.SplitHostPort(m.GetTcp()); err != nil {
+			return fmt.Errorf("failed to parse TCP probe address: %v", err)
+		}
+	}
+
+	if probes != 1 {
+		return errors.New("exactly one of command, http or tcp probes must be specified")
+	}
+
+	for _, d := range []*Duration{m.GetInterval(), m.GetTimeout(), m.GetStartPeriod()} {
+		if d.GetNanoseconds() < 0 {
+			return errors.New("durations must be non-negative")
+		}
+	}
+
+	return nil
+}
+
+func (m *ContainerCheckpoint) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetInterval().Unwrap() < MinCheckpointInterval {
+		return fmt.Errorf("checkpoint interval must be at least %s", MinCheckpointInterval)
+	}
+
 	return nil
 }
+
+// Unwrap converts the health check into the Docker representation.
+//
+// HTTP and TCP probes are turned into shell commands executed inside the
+// container. Returns nil if there is no health check specified.
+func (m *ContainerHealthCheck) Unwrap() *container.HealthConfig {
+	if m == nil {
+		return nil
+	}
+
+	var test []string
+	switch {
+	case len(m.GetCommand()) > 0:
+		test = append([]string{"CMD"}, m.GetCommand()...)
+	case m.GetHttp() != "":
+		target := quoteShell(m.GetHttp())
+		test = []string{"CMD-SHELL", fmt.Sprintf("curl -fsS -o /dev/null %s || wget -q -O /dev/null %s", target, target)}
+	case m.GetTcp() != "":
+		host, port, _ := net.SplitHostPort(m.GetTcp())
+		test = []string{"CMD-SHELL", fmt.Sprintf("nc -z %s %s", quoteShell(host), quoteShell(port))}
+	}
+
+	return &container.HealthConfig{
+		Test:        test,
+		Interval:    m.GetInterval().Unwrap(),
+		Timeout:     m.GetTimeout().Unwrap(),
+		StartPeriod: m.GetStartPeriod().Unwrap(),
+		Retries:     int(m.GetRetries()),
+	}
+}
+
+func quoteShell(v string) string {
+	return "'" + strings.Replace(v, "'", `'\''`, -1) + "'"
+}
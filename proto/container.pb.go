@@ -15,6 +15,16 @@ var _ = math.Inf
 type Registry struct {
 	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
 	Password string `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
+	// Credential is the name of the registry credential stored on the node.
+	// The node resolves it into the actual secrets before sending the task to
+	// the worker, so it can't be combined with other fields.
+	Credential string `protobuf:"bytes,3,opt,name=credential" json:"credential,omitempty"`
+	// IdentityToken is a refresh token that is exchanged by Docker for
+	// short-lived access tokens. Used instead of the password.
+	IdentityToken string `protobuf:"bytes,4,opt,name=identityToken" json:"identityToken,omitempty"`
+	// RegistryToken is a bearer token sent to the registry as is. Used
+	// instead of the password.
+	RegistryToken string `protobuf:"bytes,5,opt,name=registryToken" json:"registryToken,omitempty"`
 }
 
 func (m *Registry) Reset()                    { *m = Registry{} }
@@ -36,6 +46,27 @@ func (m *Registry) GetPassword() string {
 	return ""
 }
 
+func (m *Registry) GetCredential() string {
+	if m != nil {
+		return m.Credential
+	}
+	return ""
+}
+
+func (m *Registry) GetIdentityToken() string {
+	if m != nil {
+		return m.IdentityToken
+	}
+	return ""
+}
+
+func (m *Registry) GetRegistryToken() string {
+	if m != nil {
+		return m.RegistryToken
+	}
+	return ""
+}
+
 // ContainerRestartPolicy represents the restart policies of the container.
 type ContainerRestartPolicy struct {
 	// Name can be either "always" to always restart or "on-failure" to restart
@@ -70,6 +101,10 @@ type NetworkSpec struct {
 	Options map[string]string `protobuf:"bytes,2,rep,name=options" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	Subnet  string            `protobuf:"bytes,3,opt,name=subnet" json:"subnet,omitempty"`
 	Addr    string            `protobuf:"bytes,4,opt,name=addr" json:"addr,omitempty"`
+	// IPv6Subnet and IPv6Addr are IPv6 counterparts of the "subnet" and
+	// "addr" fields, making the network dual-stack. Both are optional.
+	Ipv6Subnet string `protobuf:"bytes,5,opt,name=ipv6Subnet" json:"ipv6Subnet,omitempty"`
+	Ipv6Addr   string `protobuf:"bytes,6,opt,name=ipv6Addr" json:"ipv6Addr,omitempty"`
 }
 
 func (m *NetworkSpec) Reset()                    { *m = NetworkSpec{} }
@@ -105,6 +140,142 @@ func (m *NetworkSpec) GetAddr() string {
 	return ""
 }
 
+func (m *NetworkSpec) GetIpv6Subnet() string {
+	if m != nil {
+		return m.Ipv6Subnet
+	}
+	return ""
+}
+
+func (m *NetworkSpec) GetIpv6Addr() string {
+	if m != nil {
+		return m.Ipv6Addr
+	}
+	return ""
+}
+
+// ContainerHealthCheck describes how the task should be probed to decide
+// whether the service inside the container is ready. Exactly one of the
+// "command", "http" or "tcp" probes must be specified.
+type ContainerHealthCheck struct {
+	// Command is executed inside the container. Zero exit code means that
+	// the container is healthy.
+	Command []string `protobuf:"bytes,1,rep,name=command" json:"command,omitempty"`
+	// HTTP is an URL that is requested from inside the container, for
+	// example "http://localhost:8080/health". Requires either "curl" or
+	// "wget" to be present in the image.
+	Http string `protobuf:"bytes,2,opt,name=http" json:"http,omitempty"`
+	// TCP is an "host:port" address that is dialed from inside the container.
+	// Requires "nc" to be present in the image.
+	Tcp string `protobuf:"bytes,3,opt,name=tcp" json:"tcp,omitempty"`
+	// Interval between two consecutive probes.
+	Interval *Duration `protobuf:"bytes,4,opt,name=interval" json:"interval,omitempty"`
+	// Timeout after which a single probe is considered failed.
+	Timeout *Duration `protobuf:"bytes,5,opt,name=timeout" json:"timeout,omitempty"`
+	// Retries is the number of consecutive failures required to mark the
+	// container as unhealthy.
+	Retries uint32 `protobuf:"varint,6,opt,name=retries" json:"retries,omitempty"`
+	// StartPeriod is the initialization time during which failed probes are
+	// not counted towards the retries limit.
+	StartPeriod *Duration `protobuf:"bytes,7,opt,name=startPeriod" json:"startPeriod,omitempty"`
+}
+
+func (m *ContainerHealthCheck) Reset()                    { *m = ContainerHealthCheck{} }
+func (m *ContainerHealthCheck) String() string            { return proto.CompactTextString(m) }
+func (*ContainerHealthCheck) ProtoMessage()               {}
+func (*ContainerHealthCheck) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{3} }
+
+func (m *ContainerHealthCheck) GetCommand() []string {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *ContainerHealthCheck) GetHttp() string {
+	if m != nil {
+		return m.Http
+	}
+	return ""
+}
+
+func (m *ContainerHealthCheck) GetTcp() string {
+	if m != nil {
+		return m.Tcp
+	}
+	return ""
+}
+
+func (m *ContainerHealthCheck) GetInterval() *Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *ContainerHealthCheck) GetTimeout() *Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *ContainerHealthCheck) GetRetries() uint32 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *ContainerHealthCheck) GetStartPeriod() *Duration {
+	if m != nil {
+		return m.StartPeriod
+	}
+	return nil
+}
+
+// ContainerCheckpoint describes periodic checkpoints of a running container.
+// Each checkpoint is an image committed from the container, including data
+// of the specified volumes.
+type ContainerCheckpoint struct {
+	// Interval between two consecutive checkpoints. Required.
+	Interval *Duration `protobuf:"bytes,1,opt,name=interval" json:"interval,omitempty"`
+	// Repository the checkpoint images are pushed to, for example
+	// "registry.user.io/user/app". Credentials are taken from the task push
+	// registry settings. If empty, checkpoints are kept only locally and can
+	// be fetched using "PullTask".
+	Repository string `protobuf:"bytes,2,opt,name=repository" json:"repository,omitempty"`
+	// Volumes contains names of volumes whose data is included into the
+	// checkpoint.
+	Volumes []string `protobuf:"bytes,3,rep,name=volumes" json:"volumes,omitempty"`
+}
+
+func (m *ContainerCheckpoint) Reset()                    { *m = ContainerCheckpoint{} }
+func (m *ContainerCheckpoint) String() string            { return proto.CompactTextString(m) }
+func (*ContainerCheckpoint) ProtoMessage()               {}
+func (*ContainerCheckpoint) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{4} }
+
+func (m *ContainerCheckpoint) GetInterval() *Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *ContainerCheckpoint) GetRepository() string {
+	if m != nil {
+		return m.Repository
+	}
+	return ""
+}
+
+func (m *ContainerCheckpoint) GetVolumes() []string {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
 type Container struct {
 	// Image describes a Docker image name. Required.
 	Image string `protobuf:"bytes,1,opt,name=image" json:"image,omitempty"`
@@ -130,15 +301,23 @@ type Container struct {
 	// Protocol can be "tcp", "udp", "sctp".
 	// If the "protocol" parameter is ommited "tcp" is implied.
 	// If the "public_ip" parameter is ommited then the port is being exposed on all available ips.
+	// IPv6 "public_ip" must be enclosed in square brackets, like "[2001:db8::1]:8080:80".
 	Expose []string `protobuf:"bytes,10,rep,name=expose" json:"expose,omitempty"`
 	// Push the committed image to remote repository (works only if CommitOnStop is set to `true`).
 	PushOnStop bool `protobuf:"varint,11,opt,name=pushOnStop" json:"pushOnStop,omitempty"`
+	// Healthcheck describes the readiness probe of the container.
+	Healthcheck *ContainerHealthCheck `protobuf:"bytes,12,opt,name=healthcheck" json:"healthcheck,omitempty"`
+	// Checkpoint describes periodic checkpoints of the container.
+	Checkpoint *ContainerCheckpoint `protobuf:"bytes,13,opt,name=checkpoint" json:"checkpoint,omitempty"`
+	// Egress restricts outbound connections of the container in addition to
+	// the egress policy of the ask plan.
+	Egress *EgressPolicy `protobuf:"bytes,14,opt,name=egress" json:"egress,omitempty"`
 }
 
 func (m *Container) Reset()                    { *m = Container{} }
 func (m *Container) String() string            { return proto.CompactTextString(m) }
 func (*Container) ProtoMessage()               {}
-func (*Container) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{3} }
+func (*Container) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{5} }
 
 func (m *Container) GetImage() string {
 	if m != nil {
@@ -210,46 +389,86 @@ func (m *Container) GetPushOnStop() bool {
 	return false
 }
 
+func (m *Container) GetHealthcheck() *ContainerHealthCheck {
+	if m != nil {
+		return m.Healthcheck
+	}
+	return nil
+}
+
+func (m *Container) GetCheckpoint() *ContainerCheckpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+func (m *Container) GetEgress() *EgressPolicy {
+	if m != nil {
+		return m.Egress
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Registry)(nil), "sonm.Registry")
 	proto.RegisterType((*ContainerRestartPolicy)(nil), "sonm.ContainerRestartPolicy")
 	proto.RegisterType((*NetworkSpec)(nil), "sonm.NetworkSpec")
+	proto.RegisterType((*ContainerHealthCheck)(nil), "sonm.ContainerHealthCheck")
+	proto.RegisterType((*ContainerCheckpoint)(nil), "sonm.ContainerCheckpoint")
 	proto.RegisterType((*Container)(nil), "sonm.Container")
 }
 
 func init() { proto.RegisterFile("container.proto", fileDescriptor4) }
 
 var fileDescriptor4 = []byte{
-	// 485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xd1, 0x6a, 0xdb, 0x30,
-	0x14, 0xc5, 0xb1, 0x9b, 0xb8, 0x37, 0x2e, 0x5b, 0xc5, 0x28, 0xc2, 0x8c, 0x12, 0xcc, 0x1e, 0xca,
-	0x58, 0x1d, 0xe8, 0xa0, 0x94, 0xee, 0x2d, 0xa5, 0x30, 0x18, 0xac, 0x43, 0x85, 0x3d, 0xf4, 0xcd,
-	0x71, 0x44, 0x22, 0x1a, 0x49, 0x46, 0x92, 0xd3, 0xfa, 0x07, 0xf6, 0x63, 0xfb, 0xb1, 0x21, 0xc9,
-	0x36, 0xce, 0xda, 0x97, 0xbe, 0xdd, 0x23, 0x9d, 0x7b, 0xee, 0xb9, 0xc7, 0x32, 0xbc, 0x2b, 0xa5,
-	0x30, 0x05, 0x13, 0x54, 0xe5, 0x95, 0x92, 0x46, 0xa2, 0x48, 0x4b, 0xc1, 0xd3, 0x64, 0x27, 0xb7,
-	0x35, 0xa7, 0xfe, 0x2c, 0x5b, 0x40, 0x4c, 0xe8, 0x9a, 0x69, 0xa3, 0x1a, 0x94, 0x42, 0x5c, 0x6b,
-	0xaa, 0x44, 0xc1, 0x29, 0x0e, 0x66, 0xc1, 0xd9, 0x21, 0xe9, 0xb1, 0xbd, 0xab, 0x0a, 0xad, 0x9f,
-	0xa4, 0x5a, 0xe1, 0x91, 0xbf, 0xeb, 0x70, 0xf6, 0x00, 0x27, 0x37, 0xdd, 0x28, 0x42, 0xb5, 0x29,
-	0x94, 0xf9, 0x25, 0xb7, 0xac, 0x6c, 0x10, 0x82, 0x68, 0xa0, 0xe6, 0x6a, 0xf4, 0x05, 0x8e, 0x79,
-	0xf1, 0xcc, 0x78, 0xcd, 0x09, 0x35, 0xaa, 0xb9, 0x91, 0xb5, 0x30, 0x4e, 0xf2, 0x88, 0xbc, 0xbc,
-	0xc8, 0xfe, 0x06, 0x30, 0xfd, 0x49, 0xcd, 0x93, 0x54, 0x8f, 0xf7, 0x15, 0x2d, 0xad, 0xa2, 0x69,
-	0xaa, 0x5e, 0xd1, 0xd6, 0xe8, 0x0a, 0x26, 0xb2, 0x32, 0x4c, 0x0a, 0x8d, 0x47, 0xb3, 0xf0, 0x6c,
-	0x7a, 0x71, 0x9a, 0xdb, 0x4d, 0xf3, 0x41, 0x5f, 0x7e, 0xe7, 0x09, 0xb7, 0xc2, 0xa8, 0x86, 0x74,
-	0x74, 0x74, 0x02, 0x63, 0x5d, 0x2f, 0x05, 0x35, 0x38, 0x74, 0x7a, 0x2d, 0xb2, 0x53, 0x8a, 0xd5,
-	0x4a, 0xe1, 0xc8, 0x4f, 0xb1, 0x75, 0x7a, 0x0d, 0xc9, 0x50, 0x04, 0xbd, 0x87, 0xf0, 0x91, 0x36,
-	0xad, 0x11, 0x5b, 0xa2, 0x0f, 0x70, 0xb0, 0x2b, 0xb6, 0x35, 0x6d, 0x03, 0xf2, 0xe0, 0x7a, 0x74,
-	0x15, 0x64, 0x7f, 0x22, 0x38, 0xec, 0x23, 0xb2, 0x3c, 0xc6, 0x8b, 0x75, 0xb7, 0x84, 0x07, 0xce,
-	0x8b, 0xde, 0xfc, 0xa0, 0x4d, 0xdb, 0xde, 0x22, 0x94, 0x41, 0x52, 0x4a, 0xce, 0x99, 0xb9, 0x13,
-	0xf7, 0x46, 0x56, 0xce, 0x69, 0x4c, 0xf6, 0xce, 0xd0, 0x67, 0x08, 0xa9, 0xd8, 0xe1, 0xc8, 0x6d,
-	0x8f, 0xfd, 0xf6, 0xfd, 0xbc, 0xfc, 0x56, 0xec, 0xfc, 0xde, 0x96, 0x84, 0x2e, 0x61, 0xe2, 0x5f,
-	0x80, 0xc6, 0x07, 0x8e, 0xff, 0xf1, 0x7f, 0xfe, 0x6f, 0x7f, 0xdd, 0x66, 0xd5, 0x92, 0xad, 0x3f,
-	0x6e, 0x3f, 0x89, 0xc6, 0xe3, 0x59, 0x68, 0xfd, 0x79, 0x84, 0xce, 0x21, 0x16, 0x3e, 0x68, 0x8d,
-	0x27, 0x4e, 0xf0, 0xf8, 0x45, 0xfc, 0xa4, 0xa7, 0xa0, 0x05, 0x1c, 0xa9, 0xe1, 0x1b, 0xc1, 0xf1,
-	0x2c, 0x78, 0xc5, 0xc4, 0xde, 0x3b, 0x22, 0xfb, 0x2d, 0xd6, 0x0a, 0x7d, 0xae, 0xa4, 0xa6, 0x18,
-	0xbc, 0x15, 0x8f, 0xd0, 0x29, 0x40, 0x55, 0xeb, 0x4d, 0x1b, 0xd4, 0xd4, 0x05, 0x35, 0x38, 0x49,
-	0x2f, 0x21, 0xee, 0xb2, 0x78, 0xcb, 0xe7, 0x4b, 0xbf, 0x43, 0x32, 0xcc, 0xe4, 0x95, 0xde, 0x6c,
-	0xd8, 0x3b, 0xbd, 0x48, 0xfc, 0x36, 0xbe, 0x69, 0xa0, 0xb4, 0xf8, 0xf4, 0x90, 0xad, 0x99, 0xd9,
-	0xd4, 0xcb, 0xbc, 0x94, 0x7c, 0x6e, 0x49, 0xe7, 0x4c, 0xce, 0x4b, 0xa9, 0xe8, 0xdc, 0xfd, 0x8f,
-	0xdf, 0xec, 0xd1, 0x72, 0xec, 0xea, 0xaf, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x15, 0xa4, 0xe7,
-	0xcf, 0xc2, 0x03, 0x00, 0x00,
+	// 764 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x6b, 0xeb, 0x36,
+	0x14, 0xc7, 0xcd, 0x67, 0x4f, 0x92, 0x7b, 0x77, 0xb5, 0x52, 0xb4, 0x30, 0x4a, 0x30, 0x7d, 0x08,
+	0x65, 0x4d, 0x47, 0x07, 0xa5, 0xeb, 0xf6, 0xb2, 0x76, 0x85, 0xc2, 0x60, 0x1d, 0xee, 0xd8, 0x43,
+	0xdf, 0x14, 0x47, 0x24, 0x22, 0xb1, 0x64, 0x24, 0x39, 0xad, 0xd9, 0x9f, 0x34, 0xd8, 0xdf, 0xb7,
+	0xb7, 0x8d, 0x23, 0xc9, 0xae, 0xd3, 0x16, 0xc6, 0x7d, 0x3b, 0x1f, 0xbf, 0x73, 0xf4, 0x3b, 0x5f,
+	0x36, 0x7c, 0x4c, 0x95, 0xb4, 0x4c, 0x48, 0xae, 0x67, 0xb9, 0x56, 0x56, 0x91, 0xb6, 0x51, 0x32,
+	0x1b, 0x93, 0x94, 0xe5, 0x6c, 0x2e, 0x36, 0xc2, 0x0a, 0x6e, 0xbc, 0x67, 0xfc, 0x51, 0x48, 0xf4,
+	0x49, 0xc1, 0x82, 0x61, 0xb8, 0x55, 0x9b, 0x22, 0xe3, 0x5e, 0x8b, 0xff, 0x8e, 0xa0, 0x9f, 0xf0,
+	0xa5, 0x30, 0x56, 0x97, 0x64, 0x0c, 0xfd, 0xc2, 0x70, 0x2d, 0x59, 0xc6, 0x69, 0x34, 0x89, 0xa6,
+	0xfb, 0x49, 0xad, 0xa3, 0x2f, 0x67, 0xc6, 0x3c, 0x29, 0xbd, 0xa0, 0x7b, 0xde, 0x57, 0xe9, 0xe4,
+	0x08, 0x20, 0xd5, 0x7c, 0xc1, 0xa5, 0x15, 0x6c, 0x43, 0x5b, 0xce, 0xdb, 0xb0, 0x90, 0x63, 0x18,
+	0x09, 0x27, 0xdb, 0xf2, 0x77, 0xb5, 0xe6, 0x92, 0xb6, 0x1d, 0x64, 0xd7, 0x88, 0x28, 0x1d, 0x98,
+	0x78, 0x54, 0xc7, 0xa3, 0x76, 0x8c, 0xf1, 0x23, 0x1c, 0xde, 0x54, 0xc5, 0x27, 0xdc, 0x58, 0xa6,
+	0xed, 0x6f, 0x6a, 0x23, 0xd2, 0x92, 0x10, 0x68, 0x37, 0x98, 0x3b, 0x99, 0x7c, 0x03, 0x9f, 0x32,
+	0xf6, 0x2c, 0xb2, 0x22, 0x4b, 0xb8, 0xd5, 0xe5, 0x8d, 0x2a, 0xa4, 0x75, 0xf4, 0x47, 0xc9, 0x5b,
+	0x47, 0xfc, 0x6f, 0x04, 0x83, 0x5f, 0xb9, 0x7d, 0x52, 0x7a, 0xfd, 0x90, 0xf3, 0x14, 0x33, 0xda,
+	0x32, 0xaf, 0x33, 0xa2, 0x4c, 0x2e, 0xa1, 0xa7, 0x72, 0x2b, 0x94, 0x34, 0x74, 0x6f, 0xd2, 0x9a,
+	0x0e, 0xce, 0x8f, 0x66, 0xd8, 0xdf, 0x59, 0x23, 0x6e, 0x76, 0xef, 0x01, 0xb7, 0xd2, 0xea, 0x32,
+	0xa9, 0xe0, 0xe4, 0x10, 0xba, 0xa6, 0x98, 0x4b, 0x6e, 0x43, 0x87, 0x82, 0x86, 0xaf, 0xb0, 0xc5,
+	0x42, 0x87, 0xa6, 0x38, 0x19, 0x3b, 0x2a, 0xf2, 0xed, 0xc5, 0x83, 0xc7, 0xfb, 0x46, 0x34, 0x2c,
+	0x38, 0x0d, 0xd4, 0x7e, 0xc2, 0xb8, 0xae, 0x9f, 0x46, 0xa5, 0x8f, 0xaf, 0x60, 0xd8, 0x24, 0x40,
+	0xbe, 0x80, 0xd6, 0x9a, 0x97, 0xa1, 0x08, 0x14, 0xc9, 0x01, 0x74, 0xb6, 0x6c, 0x53, 0xf0, 0x30,
+	0x48, 0xaf, 0x5c, 0xed, 0x5d, 0x46, 0xf1, 0x3f, 0x11, 0x1c, 0xd4, 0xed, 0xbd, 0xe3, 0x6c, 0x63,
+	0x57, 0x37, 0x2b, 0x9e, 0xae, 0x09, 0x85, 0x5e, 0xaa, 0xb2, 0x8c, 0xc9, 0x05, 0x8d, 0x26, 0xad,
+	0xe9, 0x7e, 0x52, 0xa9, 0x48, 0x7f, 0x65, 0x6d, 0x1e, 0x72, 0x39, 0x19, 0x9f, 0xb4, 0x69, 0x1e,
+	0xea, 0x44, 0x91, 0x9c, 0x40, 0x5f, 0x48, 0xcb, 0xf5, 0x96, 0x6d, 0x5c, 0xa1, 0x83, 0xf3, 0x0f,
+	0xbe, 0x6f, 0x3f, 0x17, 0x9a, 0x21, 0xd9, 0xa4, 0xf6, 0x93, 0x29, 0xf4, 0xac, 0xc8, 0xb8, 0x2a,
+	0x7c, 0xe5, 0x6f, 0xa1, 0x95, 0x1b, 0x59, 0x69, 0x6e, 0xb5, 0xe0, 0xc6, 0x75, 0x61, 0x94, 0x54,
+	0x2a, 0xf9, 0x16, 0x06, 0x7e, 0x37, 0xb8, 0x16, 0x6a, 0x41, 0x7b, 0xef, 0xe6, 0x69, 0x42, 0xe2,
+	0x3f, 0xe1, 0xcb, 0xba, 0x72, 0x57, 0x73, 0xae, 0x84, 0xb4, 0x3b, 0xc4, 0xa3, 0xff, 0x21, 0x7e,
+	0x04, 0xa0, 0x79, 0xae, 0x8c, 0xb0, 0x4a, 0x97, 0xa1, 0x21, 0x0d, 0x0b, 0xd2, 0xf5, 0xc7, 0x67,
+	0x68, 0xcb, 0x37, 0x31, 0xa8, 0xf1, 0x5f, 0x1d, 0xd8, 0xaf, 0x5f, 0xc7, 0xf9, 0x88, 0x8c, 0x2d,
+	0xab, 0xc5, 0xf3, 0x8a, 0xdb, 0x1f, 0xb3, 0xfa, 0x85, 0x57, 0x99, 0x83, 0x46, 0x62, 0x18, 0xe2,
+	0x2c, 0x84, 0xbd, 0x97, 0x0f, 0x56, 0xf9, 0xae, 0xf7, 0x93, 0x1d, 0x1b, 0x39, 0x81, 0x16, 0x97,
+	0x5b, 0xda, 0x76, 0x1b, 0x4b, 0x7d, 0x01, 0xf5, 0x7b, 0xb3, 0x5b, 0xb9, 0xf5, 0xbb, 0x8a, 0x20,
+	0x72, 0xf1, 0xc2, 0xb2, 0xe3, 0xf0, 0x5f, 0xbf, 0xc6, 0xff, 0xe1, 0xdd, 0x61, 0xbf, 0x03, 0x18,
+	0xf9, 0x65, 0x78, 0x46, 0x38, 0x0b, 0x2c, 0x2e, 0x68, 0xe4, 0x14, 0xfa, 0xd2, 0x1f, 0x87, 0xa1,
+	0x3d, 0x97, 0xf0, 0xd3, 0x9b, 0x93, 0x49, 0x6a, 0x08, 0xb9, 0xc6, 0xcf, 0x40, 0xe3, 0xae, 0x69,
+	0x7f, 0x12, 0xbd, 0x43, 0x62, 0xe7, 0xf6, 0x93, 0xdd, 0x10, 0xa4, 0xc2, 0x9f, 0x73, 0x65, 0x38,
+	0x05, 0x4f, 0xc5, 0x6b, 0x38, 0xa0, 0xbc, 0x30, 0xab, 0xd0, 0xa8, 0x81, 0x6b, 0x54, 0xc3, 0x42,
+	0x7e, 0x84, 0xc1, 0xca, 0x2d, 0x7d, 0x8a, 0x0b, 0x40, 0x87, 0xee, 0xe5, 0xf1, 0xab, 0x97, 0x1b,
+	0x67, 0x91, 0x34, 0xe1, 0xe4, 0x7b, 0x80, 0xb4, 0x5e, 0x1c, 0x3a, 0x72, 0xc1, 0x5f, 0xbd, 0x0a,
+	0x7e, 0xd9, 0xac, 0xa4, 0x01, 0x26, 0x27, 0xd0, 0xe5, 0x4b, 0xcd, 0x8d, 0xa1, 0x1f, 0x5c, 0x18,
+	0xf1, 0x61, 0xb7, 0xce, 0x16, 0x6a, 0x0c, 0x88, 0xf1, 0x05, 0xf4, 0xab, 0x81, 0x7d, 0xce, 0x6d,
+	0x8f, 0xef, 0x60, 0xd8, 0x1c, 0xdc, 0x3b, 0xb1, 0x71, 0x33, 0x76, 0x70, 0x3e, 0xf4, 0x24, 0x7c,
+	0x50, 0x23, 0xd3, 0xf5, 0xf1, 0x63, 0xbc, 0x14, 0x76, 0x55, 0xcc, 0x67, 0xa9, 0xca, 0xce, 0x10,
+	0x74, 0x2a, 0xd4, 0x59, 0xaa, 0x34, 0x3f, 0x73, 0x7f, 0x95, 0x1f, 0xd0, 0x34, 0xef, 0x3a, 0xf9,
+	0xbb, 0xff, 0x06, 0x00, 0x2b, 0xac, 0xd5, 0x94, 0xad, 0x06, 0x00, 0x00,
 }
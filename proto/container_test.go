@@ -0,0 +1,74 @@
+package sonm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainerHealthCheckUnwrapNil(t *testing.T) {
+	var healthcheck *ContainerHealthCheck
+	assert.NoError(t, healthcheck.Validate())
+	assert.Nil(t, healthcheck.Unwrap())
+}
+
+func TestContainerHealthCheckUnwrapCommand(t *testing.T) {
+	healthcheck := &ContainerHealthCheck{
+		Command:  []string{"pg_isready", "-U", "postgres"},
+		Interval: &Duration{Nanoseconds: int64(10 * time.Second)},
+		Retries:  3,
+	}
+	require.NoError(t, healthcheck.Validate())
+
+	config := healthcheck.Unwrap()
+	require.NotNil(t, config)
+	assert.Equal(t, []string{"CMD", "pg_isready", "-U", "postgres"}, config.Test)
+	assert.Equal(t, 10*time.Second, config.Interval)
+	assert.Equal(t, time.Duration(0), config.Timeout)
+	assert.Equal(t, 3, config.Retries)
+}
+
+func TestContainerHealthCheckUnwrapHTTP(t *testing.T) {
+	healthcheck := &ContainerHealthCheck{Http: "http://localhost:8080/health"}
+	require.NoError(t, healthcheck.Validate())
+
+	assert.Equal(t, []string{"CMD-SHELL", "curl -fsS -o /dev/null 'http://localhost:8080/health' || wget -q -O /dev/null 'http://localhost:8080/health'"},
+		healthcheck.Unwrap().Test)
+}
+
+func TestContainerHealthCheckUnwrapTCP(t *testing.T) {
+	healthcheck := &ContainerHealthCheck{Tcp: "localhost:5432"}
+	require.NoError(t, healthcheck.Validate())
+
+	assert.Equal(t, []string{"CMD-SHELL", "nc -z 'localhost' '5432'"}, healthcheck.Unwrap().Test)
+}
+
+func TestContainerHealthCheckValidate(t *testing.T) {
+	assert.Error(t, (&ContainerHealthCheck{}).Validate())
+	assert.Error(t, (&ContainerHealthCheck{Tcp: "localhost"}).Validate())
+	assert.Error(t, (&ContainerHealthCheck{Command: []string{"true"}, Tcp: "localhost:80"}).Validate())
+	assert.Error(t, (&ContainerHealthCheck{Command: []string{"true"}, Timeout: &Duration{Nanoseconds: -1}}).Validate())
+}
+
+func TestContainerCheckpointValidate(t *testing.T) {
+	var checkpoint *ContainerCheckpoint
+	assert.NoError(t, checkpoint.Validate())
+
+	assert.Error(t, (&ContainerCheckpoint{}).Validate())
+	assert.Error(t, (&ContainerCheckpoint{Interval: &Duration{Nanoseconds: int64(time.Second)}}).Validate())
+	assert.NoError(t, (&ContainerCheckpoint{Interval: &Duration{Nanoseconds: int64(time.Hour)}}).Validate())
+}
+
+func TestRegistryValidate(t *testing.T) {
+	var registry *Registry
+	assert.NoError(t, registry.Validate())
+
+	assert.NoError(t, (&Registry{Username: "user", Password: "secret"}).Validate())
+	assert.NoError(t, (&Registry{RegistryToken: "token"}).Validate())
+	assert.NoError(t, (&Registry{Credential: "private"}).Validate())
+	assert.Error(t, (&Registry{Password: "secret", IdentityToken: "token"}).Validate())
+	assert.Error(t, (&Registry{Credential: "private", Username: "user"}).Validate())
+	assert.Error(t, (&Registry{Credential: "private", RegistryToken: "token"}).Validate())
+}
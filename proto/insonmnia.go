@@ -214,5 +214,79 @@ func (m *StartTaskRequest) Validate() error {
 }
 
 func (m *TaskSpec) Validate() error {
+	if err := m.GetRegistry().Validate(); err != nil {
+		return fmt.Errorf("invalid registry: %v", err)
+	}
+	if err := m.GetPushRegistry().Validate(); err != nil {
+		return fmt.Errorf("invalid push registry: %v", err)
+	}
+
 	return m.GetContainer().Validate()
 }
+
+// PushAuth returns the encoded registry credentials used for pushing task
+// images, falling back to the pull ones.
+func (m *TaskSpec) PushAuth() string {
+	if m.GetPushRegistry() != nil {
+		return m.GetPushRegistry().Auth()
+	}
+
+	return m.GetRegistry().Auth()
+}
+
+func (m *RestoreTaskRequest) Validate() error {
+	if m.GetDealID().IsZero() {
+		return errors.New("non-zero deal id is required for restore task request")
+	}
+	if m.GetCheckpoint() == "" {
+		return errors.New("checkpoint image is required for restore task request")
+	}
+	return m.GetSpec().Validate()
+}
+
+func (m *StartTaskGroupRequest) Validate() error {
+	if m.GetDealID().IsZero() {
+		return errors.New("non-zero deal id is required for start task group request")
+	}
+	return m.GetSpec().Validate()
+}
+
+func (m *TaskGroupSpec) Validate() error {
+	if len(m.GetTasks()) == 0 {
+		return errors.New("task group must contain at least one task")
+	}
+
+	for id, task := range m.GetTasks() {
+		if err := task.Validate(); err != nil {
+			return fmt.Errorf("invalid task #%d: %v", id, err)
+		}
+
+		container := task.GetContainer()
+		for name := range container.GetVolumes() {
+			if _, ok := m.GetVolumes()[name]; ok {
+				return fmt.Errorf("invalid task #%d: volume %s conflicts with the shared one", id, name)
+			}
+		}
+
+		// Tasks except the first one join its network namespace, which makes
+		// them unable to have own network settings.
+		if id > 0 && (len(container.GetExpose()) > 0 || len(container.GetNetworks()) > 0) {
+			return fmt.Errorf("invalid task #%d: only the first task in the group can expose ports and join networks", id)
+		}
+	}
+
+	return nil
+}
+
+func (m *TaskMetricsRequest) Validate() error {
+	if m.GetId() == "" {
+		return errors.New("task id is required for task metrics request")
+	}
+	if m.GetStep().GetNanoseconds() < 0 {
+		return errors.New("step must not be negative")
+	}
+	if m.GetFrom() != nil && m.GetTo() != nil && m.GetFrom().Unix().After(m.GetTo().Unix()) {
+		return errors.New("time range must not be reversed")
+	}
+	return nil
+}
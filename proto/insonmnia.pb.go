@@ -305,6 +305,9 @@ type ResourceUsage struct {
 	Cpu     *CPUUsage                `protobuf:"bytes,1,opt,name=cpu" json:"cpu,omitempty"`
 	Memory  *MemoryUsage             `protobuf:"bytes,2,opt,name=memory" json:"memory,omitempty"`
 	Network map[string]*NetworkUsage `protobuf:"bytes,3,rep,name=network" json:"network,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// EgressViolations is the number of outbound packets dropped because of
+	// egress policies.
+	EgressViolations uint64 `protobuf:"varint,4,opt,name=egressViolations" json:"egressViolations,omitempty"`
 }
 
 func (m *ResourceUsage) Reset()                    { *m = ResourceUsage{} }
@@ -333,6 +336,13 @@ func (m *ResourceUsage) GetNetwork() map[string]*NetworkUsage {
 	return nil
 }
 
+func (m *ResourceUsage) GetEgressViolations() uint64 {
+	if m != nil {
+		return m.EgressViolations
+	}
+	return 0
+}
+
 type TaskLogsRequest struct {
 	Type          TaskLogsRequest_Type `protobuf:"varint,1,opt,name=type,enum=sonm.TaskLogsRequest_Type" json:"type,omitempty"`
 	Id            string               `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
@@ -559,58 +569,59 @@ func init() {
 func init() { proto.RegisterFile("insonmnia.proto", fileDescriptor9) }
 
 var fileDescriptor9 = []byte{
-	// 838 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0xdd, 0x6e, 0x2b, 0x35,
-	0x10, 0xc7, 0xc9, 0xe6, 0xa3, 0x9b, 0x49, 0xda, 0x06, 0xab, 0x42, 0x51, 0xf8, 0x50, 0x64, 0x2a,
-	0x94, 0x22, 0x48, 0xa5, 0x1e, 0x2e, 0xd0, 0x41, 0x42, 0xa2, 0x4d, 0x10, 0x95, 0xa0, 0x27, 0x72,
-	0xd3, 0x1b, 0xee, 0x9c, 0x5d, 0x2b, 0xb5, 0xb2, 0xbb, 0x5e, 0x6c, 0x2f, 0x27, 0x39, 0x0f, 0xc2,
-	0x0b, 0xf0, 0x72, 0x3c, 0x06, 0xf2, 0x57, 0xba, 0x5b, 0x24, 0xee, 0x66, 0xfe, 0xff, 0xdf, 0xc6,
-	0x63, 0x6b, 0x66, 0x02, 0xe7, 0xbc, 0x50, 0xa2, 0xc8, 0x0b, 0x4e, 0xe7, 0xa5, 0x14, 0x5a, 0xa0,
-	0x8e, 0x49, 0x27, 0xc3, 0x0d, 0xdf, 0xf2, 0x42, 0x3b, 0x6d, 0x82, 0x12, 0x5a, 0xd2, 0x0d, 0xcf,
-	0xb8, 0xe6, 0x4c, 0x79, 0xed, 0x5c, 0xf3, 0x9c, 0x29, 0x4d, 0xf3, 0xd2, 0x09, 0xf8, 0x04, 0xba,
-	0xcb, 0xbc, 0xd4, 0x07, 0x7c, 0x01, 0xd1, 0xfd, 0x02, 0x9d, 0x41, 0xc4, 0xd3, 0x71, 0x6b, 0xda,
-	0x9a, 0xf5, 0x49, 0xc4, 0x53, 0xfc, 0x29, 0xf4, 0x1f, 0xaa, 0x9c, 0x49, 0x9e, 0x34, 0xcc, 0x8e,
-	0x35, 0xaf, 0xa0, 0xbb, 0xd4, 0xcf, 0xf7, 0x0b, 0x34, 0x3d, 0x1a, 0x83, 0x9b, 0xd1, 0xdc, 0x94,
-	0x32, 0x5f, 0xea, 0xe7, 0x9f, 0xd2, 0x54, 0x32, 0xa5, 0x2c, 0xfa, 0x23, 0xf4, 0xd6, 0x54, 0xed,
-	0xfe, 0x7b, 0x02, 0xba, 0x84, 0x5e, 0xca, 0x68, 0x76, 0xbf, 0x18, 0x47, 0xf6, 0xfb, 0xa1, 0xfb,
-	0xfe, 0x96, 0x6f, 0xef, 0x0b, 0x4d, 0xbc, 0x87, 0x3f, 0x87, 0xee, 0x9d, 0xa8, 0x0a, 0x8d, 0x2e,
-	0xa0, 0x9b, 0x98, 0xc0, 0x97, 0xe1, 0x12, 0x3c, 0x85, 0xf8, 0x6e, 0xf5, 0xf4, 0xa4, 0xe8, 0x96,
-	0x19, 0x42, 0x0b, 0x4d, 0xb3, 0x40, 0xd8, 0x04, 0x5f, 0xc1, 0xe0, 0x37, 0x96, 0x0b, 0x79, 0x70,
-	0xd0, 0x04, 0xe2, 0x9c, 0xee, 0x6d, 0xec, 0xb9, 0x63, 0x8e, 0xff, 0xea, 0xc0, 0xf0, 0x81, 0xe9,
-	0xf7, 0x42, 0xee, 0x1c, 0x3c, 0x86, 0x13, 0xbd, 0xbf, 0x3d, 0x68, 0xa6, 0x3c, 0x1b, 0x52, 0xe3,
-	0x48, 0xef, 0x44, 0xce, 0xf1, 0x29, 0xfa, 0x0c, 0xfa, 0x7a, 0xbf, 0xa2, 0xc9, 0x8e, 0x69, 0x35,
-	0x6e, 0x5b, 0xef, 0x45, 0x30, 0xae, 0x3c, 0xba, 0x1d, 0xe7, 0x1e, 0x05, 0x53, 0x9c, 0xde, 0x2f,
-	0xa5, 0x14, 0x52, 0x8d, 0xbb, 0xae, 0xb8, 0x90, 0x1b, 0x4f, 0x06, 0xaf, 0xe7, 0xbc, 0x90, 0xbb,
-	0x33, 0x17, 0x52, 0x94, 0x25, 0x4b, 0xc7, 0x27, 0xe1, 0x4c, 0x2f, 0xb8, 0x33, 0x83, 0x1b, 0x87,
-	0x33, 0x83, 0x3b, 0x85, 0x81, 0xbf, 0x14, 0xa1, 0x9a, 0x8d, 0xfb, 0xd3, 0xd6, 0xac, 0x45, 0xea,
-	0x92, 0x21, 0x64, 0x8d, 0x00, 0x47, 0xd4, 0x24, 0x74, 0x09, 0xa7, 0xc7, 0x2b, 0x5a, 0x66, 0x60,
-	0x99, 0xa6, 0x68, 0x28, 0xd9, 0xa0, 0x86, 0x8e, 0x6a, 0x88, 0x08, 0xc3, 0x30, 0xdc, 0xd9, 0x42,
-	0xa7, 0x16, 0x6a, 0x68, 0x86, 0x91, 0x75, 0xe6, 0xcc, 0x31, 0x75, 0xcd, 0xd5, 0xe4, 0x2f, 0x69,
-	0xa1, 0xf3, 0x50, 0x53, 0x4d, 0x74, 0x35, 0xd5, 0xa9, 0x51, 0xa8, 0xa9, 0x26, 0xe2, 0x7f, 0x5a,
-	0x70, 0x4a, 0x98, 0x12, 0x95, 0x4c, 0x98, 0xeb, 0x8c, 0x29, 0xb4, 0x93, 0xb2, 0xf2, 0x9d, 0x7f,
-	0xe6, 0x3a, 0x37, 0x34, 0x22, 0x31, 0x16, 0xba, 0x82, 0x5e, 0x6e, 0xfb, 0xce, 0xb7, 0xf7, 0xc7,
-	0x0e, 0xaa, 0xf5, 0x22, 0xf1, 0x00, 0x7a, 0x0b, 0x27, 0x85, 0x6b, 0xbb, 0x71, 0x7b, 0xda, 0x9e,
-	0x0d, 0x6e, 0xa6, 0x8e, 0x6d, 0x1c, 0x39, 0xf7, 0x9d, 0xb9, 0x2c, 0xb4, 0x3c, 0x90, 0xf0, 0xc1,
-	0xe4, 0xe1, 0xd8, 0xb2, 0xd6, 0x40, 0x23, 0x68, 0xef, 0xd8, 0xc1, 0x8f, 0x99, 0x09, 0xd1, 0x0c,
-	0xba, 0x7f, 0xd2, 0xac, 0x62, 0xbe, 0x0e, 0xe4, 0x7e, 0xbb, 0xde, 0xe7, 0xc4, 0x01, 0x6f, 0xa3,
-	0xef, 0x5b, 0xf8, 0xef, 0x08, 0xce, 0xcd, 0xc0, 0xfe, 0x2a, 0xb6, 0x8a, 0xb0, 0x3f, 0x2a, 0xa6,
-	0x34, 0x9a, 0x43, 0x47, 0x1f, 0x4a, 0x37, 0x2f, 0x67, 0x37, 0x13, 0xf7, 0x03, 0xaf, 0xa0, 0xf9,
-	0xfa, 0x50, 0x32, 0x62, 0x39, 0x3f, 0xe9, 0xd1, 0x71, 0xd2, 0x2f, 0xa0, 0xab, 0x78, 0x91, 0x30,
-	0x3b, 0x0e, 0x7d, 0xe2, 0x12, 0xf3, 0xf4, 0x34, 0x4d, 0xd7, 0x61, 0x2d, 0xb9, 0x71, 0x88, 0x49,
-	0x53, 0x44, 0x9f, 0x40, 0xef, 0x67, 0x91, 0x65, 0xe2, 0xbd, 0x1d, 0x88, 0x98, 0xf8, 0x0c, 0x21,
-	0xe8, 0xac, 0x29, 0xcf, 0xec, 0x28, 0xf4, 0x89, 0x8d, 0xcd, 0x50, 0x2e, 0x98, 0xa6, 0x3c, 0x53,
-	0x76, 0x08, 0x62, 0x12, 0xd2, 0xda, 0xae, 0x89, 0xff, 0x67, 0xd7, 0xcc, 0xa0, 0x63, 0x6e, 0x81,
-	0x00, 0x7a, 0x8f, 0xeb, 0xc5, 0xbb, 0xa7, 0xf5, 0xe8, 0x23, 0x1f, 0x2f, 0x09, 0x19, 0xb5, 0x50,
-	0x0c, 0x9d, 0xdb, 0x77, 0xeb, 0x5f, 0x46, 0x11, 0xfe, 0x12, 0x4e, 0xc3, 0xfd, 0xef, 0x9e, 0xab,
-	0x62, 0x67, 0xca, 0x49, 0xa9, 0xa6, 0xf6, 0x89, 0x86, 0xc4, 0xc6, 0x76, 0x75, 0x59, 0xd3, 0xac,
-	0x2e, 0x13, 0x78, 0xd7, 0x25, 0xf8, 0x0b, 0x88, 0x57, 0x52, 0x6c, 0xcd, 0xa6, 0x34, 0x9f, 0x2b,
-	0xfe, 0xc1, 0xbd, 0x70, 0x9b, 0xd8, 0x18, 0x7f, 0x03, 0xf1, 0xa2, 0x92, 0x54, 0x73, 0x51, 0x98,
-	0x11, 0x2c, 0x68, 0x21, 0x14, 0x4b, 0x44, 0x91, 0x2a, 0x8f, 0xd5, 0x25, 0xfc, 0x15, 0xc0, 0xcb,
-	0xe6, 0x35, 0x2f, 0x41, 0x5d, 0xe8, 0xcf, 0x0c, 0xa9, 0x59, 0x98, 0x0b, 0xaa, 0xe9, 0x23, 0xff,
-	0x60, 0x17, 0xe6, 0xa6, 0xb6, 0xdc, 0x5c, 0x82, 0xbf, 0x83, 0x61, 0x20, 0xc2, 0x88, 0x6c, 0xb8,
-	0x56, 0x2b, 0x26, 0x1f, 0xed, 0x59, 0x9e, 0x6e, 0x8a, 0xf8, 0x0d, 0x74, 0x57, 0x92, 0x27, 0x0c,
-	0x7d, 0x0d, 0xfd, 0xb2, 0x81, 0xbe, 0x7e, 0xed, 0x17, 0xfb, 0xf6, 0xf2, 0x77, 0xbc, 0xe5, 0xfa,
-	0xb9, 0xda, 0xcc, 0x13, 0x91, 0x5f, 0x1b, 0xe8, 0x5b, 0x2e, 0xae, 0x13, 0x21, 0xd9, 0xb5, 0xfd,
-	0x93, 0xfa, 0xc1, 0x48, 0x9b, 0x9e, 0x8d, 0xdf, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x97,
-	0x76, 0xb6, 0xfc, 0x06, 0x00, 0x00,
+	// 851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0x6d, 0x6b, 0xe3, 0x46,
+	0x10, 0xc7, 0x6b, 0xf9, 0x21, 0xf2, 0xd8, 0x49, 0xdc, 0x25, 0x14, 0xe3, 0x3e, 0x60, 0xb6, 0xa1,
+	0x38, 0x47, 0xeb, 0x40, 0xae, 0x2f, 0xca, 0x15, 0x0a, 0x4d, 0xec, 0xd2, 0x40, 0x9b, 0x33, 0x1b,
+	0xa7, 0x2f, 0xfa, 0x6e, 0x2d, 0x2d, 0xce, 0x62, 0x49, 0xab, 0xee, 0xae, 0x7a, 0xf1, 0x7d, 0x8e,
+	0xd2, 0x2f, 0xd0, 0x2f, 0x5a, 0xf6, 0xc9, 0x91, 0x2e, 0x70, 0xef, 0x66, 0xfe, 0xff, 0x9f, 0xbc,
+	0xb3, 0xd2, 0xcc, 0x18, 0x4e, 0x79, 0xa1, 0x44, 0x91, 0x17, 0x9c, 0xce, 0x4b, 0x29, 0xb4, 0x40,
+	0x1d, 0x93, 0x4e, 0x86, 0x1b, 0xbe, 0xe5, 0x85, 0x76, 0xda, 0x04, 0x25, 0xb4, 0xa4, 0x1b, 0x9e,
+	0x71, 0xcd, 0x99, 0xf2, 0xda, 0xa9, 0xe6, 0x39, 0x53, 0x9a, 0xe6, 0xa5, 0x13, 0xf0, 0x11, 0x74,
+	0x97, 0x79, 0xa9, 0xf7, 0xf8, 0x0c, 0xa2, 0xdb, 0x05, 0x3a, 0x81, 0x88, 0xa7, 0xe3, 0xd6, 0xb4,
+	0x35, 0xeb, 0x93, 0x88, 0xa7, 0xf8, 0x73, 0xe8, 0xdf, 0x55, 0x39, 0x93, 0x3c, 0x69, 0x98, 0x1d,
+	0x6b, 0x5e, 0x40, 0x77, 0xa9, 0x1f, 0x6f, 0x17, 0x68, 0x7a, 0x30, 0x06, 0x57, 0xa3, 0xb9, 0x29,
+	0x65, 0xbe, 0xd4, 0x8f, 0x3f, 0xa7, 0xa9, 0x64, 0x4a, 0x59, 0xf4, 0x27, 0xe8, 0xad, 0xa9, 0xda,
+	0xbd, 0x3c, 0x01, 0x9d, 0x43, 0x2f, 0x65, 0x34, 0xbb, 0x5d, 0x8c, 0x23, 0xfb, 0xfc, 0xd0, 0x3d,
+	0x7f, 0xcd, 0xb7, 0xb7, 0x85, 0x26, 0xde, 0xc3, 0x5f, 0x42, 0xf7, 0x46, 0x54, 0x85, 0x46, 0x67,
+	0xd0, 0x4d, 0x4c, 0xe0, 0xcb, 0x70, 0x09, 0x9e, 0x42, 0x7c, 0xb3, 0x7a, 0x78, 0x50, 0x74, 0xcb,
+	0x0c, 0xa1, 0x85, 0xa6, 0x59, 0x20, 0x6c, 0x82, 0x2f, 0x60, 0xf0, 0x3b, 0xcb, 0x85, 0xdc, 0x3b,
+	0x68, 0x02, 0x71, 0x4e, 0x9f, 0x6c, 0xec, 0xb9, 0x43, 0x8e, 0xff, 0xed, 0xc0, 0xf0, 0x8e, 0xe9,
+	0x77, 0x42, 0xee, 0x1c, 0x3c, 0x86, 0x23, 0xfd, 0x74, 0xbd, 0xd7, 0x4c, 0x79, 0x36, 0xa4, 0xc6,
+	0x91, 0xde, 0x89, 0x9c, 0xe3, 0x53, 0xf4, 0x05, 0xf4, 0xf5, 0xd3, 0x8a, 0x26, 0x3b, 0xa6, 0xd5,
+	0xb8, 0x6d, 0xbd, 0x67, 0xc1, 0xb8, 0xf2, 0xe0, 0x76, 0x9c, 0x7b, 0x10, 0x4c, 0x71, 0xfa, 0x69,
+	0x29, 0xa5, 0x90, 0x6a, 0xdc, 0x75, 0xc5, 0x85, 0xdc, 0x78, 0x32, 0x78, 0x3d, 0xe7, 0x85, 0xdc,
+	0x9d, 0xb9, 0x90, 0xa2, 0x2c, 0x59, 0x3a, 0x3e, 0x0a, 0x67, 0x7a, 0xc1, 0x9d, 0x19, 0xdc, 0x38,
+	0x9c, 0x19, 0xdc, 0x29, 0x0c, 0xfc, 0xa5, 0x08, 0xd5, 0x6c, 0xdc, 0x9f, 0xb6, 0x66, 0x2d, 0x52,
+	0x97, 0x0c, 0x21, 0x6b, 0x04, 0x38, 0xa2, 0x26, 0xa1, 0x73, 0x38, 0x3e, 0x5c, 0xd1, 0x32, 0x03,
+	0xcb, 0x34, 0x45, 0x43, 0xc9, 0x06, 0x35, 0x74, 0x54, 0x43, 0x44, 0x18, 0x86, 0xe1, 0xce, 0x16,
+	0x3a, 0xb6, 0x50, 0x43, 0x33, 0x8c, 0xac, 0x33, 0x27, 0x8e, 0xa9, 0x6b, 0xae, 0x26, 0x7f, 0x49,
+	0x0b, 0x9d, 0x86, 0x9a, 0x6a, 0xa2, 0xab, 0xa9, 0x4e, 0x8d, 0x42, 0x4d, 0x35, 0x11, 0xff, 0x13,
+	0xc1, 0x31, 0x61, 0x4a, 0x54, 0x32, 0x61, 0xae, 0x33, 0xa6, 0xd0, 0x4e, 0xca, 0xca, 0x77, 0xfe,
+	0x89, 0xeb, 0xdc, 0xd0, 0x88, 0xc4, 0x58, 0xe8, 0x02, 0x7a, 0xb9, 0xed, 0x3b, 0xdf, 0xde, 0x9f,
+	0x3a, 0xa8, 0xd6, 0x8b, 0xc4, 0x03, 0xe8, 0x0d, 0x1c, 0x15, 0xae, 0xed, 0xc6, 0xed, 0x69, 0x7b,
+	0x36, 0xb8, 0x9a, 0x3a, 0xb6, 0x71, 0xe4, 0xdc, 0x77, 0xe6, 0xb2, 0xd0, 0x72, 0x4f, 0xc2, 0x03,
+	0xe8, 0x15, 0x8c, 0xd8, 0xd6, 0x4c, 0xdb, 0x1f, 0x5c, 0x64, 0x54, 0x73, 0x51, 0x84, 0xbe, 0x7a,
+	0xa1, 0x4f, 0xee, 0x60, 0x58, 0xff, 0x11, 0x34, 0x82, 0xf6, 0x8e, 0xed, 0xfd, 0x48, 0x9a, 0x10,
+	0xcd, 0xa0, 0xfb, 0x37, 0xcd, 0x2a, 0xe6, 0x6b, 0x46, 0xae, 0x8e, 0xfa, 0x4c, 0x10, 0x07, 0xbc,
+	0x89, 0x7e, 0x68, 0xe1, 0xff, 0x22, 0x38, 0x35, 0xc3, 0xfd, 0x9b, 0xd8, 0x2a, 0xc2, 0xfe, 0xaa,
+	0x98, 0xd2, 0x68, 0x0e, 0x1d, 0xbd, 0x2f, 0xdd, 0x6c, 0x9d, 0x5c, 0x4d, 0xdc, 0x0f, 0x7c, 0x00,
+	0xcd, 0xd7, 0xfb, 0x92, 0x11, 0xcb, 0xf9, 0xad, 0x10, 0x1d, 0xb6, 0xc2, 0x19, 0x74, 0x15, 0x2f,
+	0x12, 0x66, 0x47, 0xa7, 0x4f, 0x5c, 0x62, 0x3e, 0x13, 0x4d, 0xd3, 0x75, 0x58, 0x61, 0xee, 0x8a,
+	0x31, 0x69, 0x8a, 0xe8, 0x33, 0xe8, 0xfd, 0x22, 0xb2, 0x4c, 0xbc, 0xb3, 0xc3, 0x13, 0x13, 0x9f,
+	0x21, 0x04, 0x9d, 0x35, 0xe5, 0x99, 0x1d, 0x9b, 0x3e, 0xb1, 0xb1, 0x19, 0xe0, 0x05, 0xd3, 0x94,
+	0x67, 0xca, 0x0e, 0x4c, 0x4c, 0x42, 0x5a, 0xdb, 0x4b, 0xf1, 0x47, 0xf6, 0xd2, 0x0c, 0x3a, 0xe6,
+	0x16, 0x08, 0xa0, 0x77, 0xbf, 0x5e, 0xbc, 0x7d, 0x58, 0x8f, 0x3e, 0xf1, 0xf1, 0x92, 0x90, 0x51,
+	0x0b, 0xc5, 0xd0, 0xb9, 0x7e, 0xbb, 0xfe, 0x75, 0x14, 0xe1, 0xaf, 0xe1, 0x38, 0xdc, 0xff, 0xe6,
+	0xb1, 0x2a, 0x76, 0xa6, 0x9c, 0x94, 0x6a, 0x6a, 0x5f, 0xd1, 0x90, 0xd8, 0xd8, 0xae, 0x39, 0x6b,
+	0x9a, 0x35, 0x67, 0x02, 0xef, 0xba, 0x04, 0x7f, 0x05, 0xf1, 0x4a, 0x0a, 0xfb, 0x3d, 0xcd, 0xe3,
+	0x8a, 0xbf, 0x77, 0x6f, 0xb8, 0x4d, 0x6c, 0x8c, 0xbf, 0x85, 0x78, 0x51, 0x49, 0xfb, 0x99, 0xcd,
+	0xb8, 0x16, 0xb4, 0x10, 0x8a, 0x25, 0xa2, 0x48, 0x95, 0xc7, 0xea, 0x12, 0xfe, 0x06, 0xe0, 0x79,
+	0x4b, 0x9b, 0x37, 0x41, 0x5d, 0xe8, 0xcf, 0x0c, 0xa9, 0x59, 0xae, 0x0b, 0xaa, 0xe9, 0x3d, 0x7f,
+	0x6f, 0x97, 0xeb, 0xa6, 0xb6, 0x08, 0x5d, 0x82, 0xbf, 0x87, 0x61, 0x20, 0xc2, 0x38, 0x6d, 0xb8,
+	0x56, 0x2b, 0x26, 0xef, 0xed, 0x59, 0x9e, 0x6e, 0x8a, 0xf8, 0x35, 0x74, 0x57, 0x92, 0x27, 0x0c,
+	0xbd, 0x82, 0x7e, 0xd9, 0x40, 0x3f, 0x7c, 0xdb, 0xcf, 0xf6, 0xf5, 0xf9, 0x9f, 0x78, 0xcb, 0xf5,
+	0x63, 0xb5, 0x99, 0x27, 0x22, 0xbf, 0x34, 0xd0, 0x77, 0x5c, 0x5c, 0x26, 0x42, 0xb2, 0x4b, 0xfb,
+	0x87, 0xf6, 0xa3, 0x91, 0x36, 0x3d, 0x1b, 0xbf, 0xfe, 0x7f, 0x00, 0x1b, 0xd9, 0xfe, 0xd4, 0x28,
+	0x07, 0x00, 0x00,
 }
@@ -3,6 +3,7 @@ package sonm
 import (
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
 
 	"github.com/sonm-io/core/util/netutil"
@@ -55,6 +56,11 @@ func (m *Addr) IsPrivate() bool {
 	return m.Addr.IsPrivate()
 }
 
+// IsIPv6 returns true if this is an IPv6 address.
+func (m *Addr) IsIPv6() bool {
+	return m.GetAddr().IsIPv6()
+}
+
 func NewSocketAddr(endpoint string) (*SocketAddr, error) {
 	host, port, err := netutil.SplitHostPort(endpoint)
 	if err != nil {
@@ -72,18 +78,30 @@ func (m *SocketAddr) IsPrivate() bool {
 	return netutil.IsPrivateIP(net.ParseIP(m.Addr))
 }
 
+// IsIPv6 returns true if this is an IPv6 address.
+func (m *SocketAddr) IsIPv6() bool {
+	ip := net.ParseIP(m.GetAddr())
+	return ip != nil && ip.To4() == nil
+}
+
+// HostPort formats this address as "host:port", enclosing IPv6 hosts in
+// brackets.
+func (m *SocketAddr) HostPort() string {
+	return net.JoinHostPort(m.GetAddr(), strconv.FormatUint(uint64(m.GetPort()), 10))
+}
+
 func (m *SocketAddr) IntoTCP() (net.Addr, error) {
-	return net.ResolveTCPAddr("tcp", fmt.Sprintf("%s:%d", m.Addr, m.Port))
+	return net.ResolveTCPAddr("tcp", m.HostPort())
 }
 
 func (m *SocketAddr) IntoUDP() (*net.UDPAddr, error) {
-	return net.ResolveUDPAddr("udp", fmt.Sprintf("%s:%d", m.Addr, m.Port))
+	return net.ResolveUDPAddr("udp", m.HostPort())
 }
 
 func FormatAddrs(addrs ...*Addr) string {
 	formatted := make([]string, len(addrs))
 	for id, addr := range addrs {
-		formatted[id] = fmt.Sprintf("%s:%d", addr.GetAddr().GetAddr(), addr.GetAddr().GetPort())
+		formatted[id] = addr.GetAddr().HostPort()
 	}
 
 	return fmt.Sprintf("[%s]", strings.Join(formatted, ", "))
@@ -0,0 +1,27 @@
+package sonm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSocketAddrIPv6(t *testing.T) {
+	addr, err := NewSocketAddr("[2001:db8::1]:8080")
+	require.NoError(t, err)
+
+	assert.True(t, addr.IsIPv6())
+	assert.False(t, addr.IsPrivate())
+	assert.Equal(t, "[2001:db8::1]:8080", addr.HostPort())
+
+	tcpAddr, err := addr.IntoTCP()
+	require.NoError(t, err)
+	assert.Equal(t, "[2001:db8::1]:8080", tcpAddr.String())
+
+	addr, err = NewSocketAddr("1.2.3.4:8080")
+	require.NoError(t, err)
+
+	assert.False(t, addr.IsIPv6())
+	assert.Equal(t, "1.2.3.4:8080", addr.HostPort())
+}
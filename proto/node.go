@@ -12,3 +12,22 @@ func (m *TokenTransferRequest) Validate() error {
 
 	return nil
 }
+
+func (m *RegistryCredential) Validate() error {
+	if len(m.GetName()) == 0 {
+		return fmt.Errorf("credential name is required")
+	}
+
+	return m.Registry().Validate()
+}
+
+// Registry returns registry settings to be sent to the worker, i.e. with the
+// credential resolved.
+func (m *RegistryCredential) Registry() *Registry {
+	return &Registry{
+		Username:      m.GetUsername(),
+		Password:      m.GetPassword(),
+		IdentityToken: m.GetIdentityToken(),
+		RegistryToken: m.GetRegistryToken(),
+	}
+}
@@ -290,6 +290,84 @@ func (m *TokenTransferRequest) GetAmount() *BigInt {
 	return nil
 }
 
+type RegistryCredential struct {
+	// Name is used to reference the credential from task specs. Required.
+	Name     string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password" json:"password,omitempty"`
+	// IdentityToken is a refresh token that is exchanged by Docker for
+	// short-lived access tokens.
+	IdentityToken string `protobuf:"bytes,4,opt,name=identityToken" json:"identityToken,omitempty"`
+	// RegistryToken is a bearer token sent to the registry as is.
+	RegistryToken string `protobuf:"bytes,5,opt,name=registryToken" json:"registryToken,omitempty"`
+	// ExpiresAt is the time the registry token expires at, if known. Tasks
+	// can't reference expired credentials.
+	ExpiresAt *Timestamp `protobuf:"bytes,6,opt,name=expiresAt" json:"expiresAt,omitempty"`
+}
+
+func (m *RegistryCredential) Reset()                    { *m = RegistryCredential{} }
+func (m *RegistryCredential) String() string            { return proto.CompactTextString(m) }
+func (*RegistryCredential) ProtoMessage()               {}
+func (*RegistryCredential) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{12} }
+
+func (m *RegistryCredential) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RegistryCredential) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *RegistryCredential) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *RegistryCredential) GetIdentityToken() string {
+	if m != nil {
+		return m.IdentityToken
+	}
+	return ""
+}
+
+func (m *RegistryCredential) GetRegistryToken() string {
+	if m != nil {
+		return m.RegistryToken
+	}
+	return ""
+}
+
+func (m *RegistryCredential) GetExpiresAt() *Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+type RegistryCredentialsReply struct {
+	Credentials []*RegistryCredential `protobuf:"bytes,1,rep,name=credentials" json:"credentials,omitempty"`
+}
+
+func (m *RegistryCredentialsReply) Reset()                    { *m = RegistryCredentialsReply{} }
+func (m *RegistryCredentialsReply) String() string            { return proto.CompactTextString(m) }
+func (*RegistryCredentialsReply) ProtoMessage()               {}
+func (*RegistryCredentialsReply) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{13} }
+
+func (m *RegistryCredentialsReply) GetCredentials() []*RegistryCredential {
+	if m != nil {
+		return m.Credentials
+	}
+	return nil
+}
+
 type NPPMetricsReply struct {
 	Metrics map[string]*NamedMetrics `protobuf:"bytes,1,rep,name=metrics" json:"metrics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
@@ -297,7 +375,7 @@ type NPPMetricsReply struct {
 func (m *NPPMetricsReply) Reset()                    { *m = NPPMetricsReply{} }
 func (m *NPPMetricsReply) String() string            { return proto.CompactTextString(m) }
 func (*NPPMetricsReply) ProtoMessage()               {}
-func (*NPPMetricsReply) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{12} }
+func (*NPPMetricsReply) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{14} }
 
 func (m *NPPMetricsReply) GetMetrics() map[string]*NamedMetrics {
 	if m != nil {
@@ -313,7 +391,7 @@ type NamedMetrics struct {
 func (m *NamedMetrics) Reset()                    { *m = NamedMetrics{} }
 func (m *NamedMetrics) String() string            { return proto.CompactTextString(m) }
 func (*NamedMetrics) ProtoMessage()               {}
-func (*NamedMetrics) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{13} }
+func (*NamedMetrics) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{15} }
 
 func (m *NamedMetrics) GetMetrics() []*NamedMetric {
 	if m != nil {
@@ -330,7 +408,7 @@ type NamedMetric struct {
 func (m *NamedMetric) Reset()                    { *m = NamedMetric{} }
 func (m *NamedMetric) String() string            { return proto.CompactTextString(m) }
 func (*NamedMetric) ProtoMessage()               {}
-func (*NamedMetric) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{14} }
+func (*NamedMetric) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{16} }
 
 func (m *NamedMetric) GetName() string {
 	if m != nil {
@@ -359,6 +437,8 @@ func init() {
 	proto.RegisterType((*WorkerListReply)(nil), "sonm.WorkerListReply")
 	proto.RegisterType((*BalanceReply)(nil), "sonm.BalanceReply")
 	proto.RegisterType((*TokenTransferRequest)(nil), "sonm.TokenTransferRequest")
+	proto.RegisterType((*RegistryCredential)(nil), "sonm.RegistryCredential")
+	proto.RegisterType((*RegistryCredentialsReply)(nil), "sonm.RegistryCredentialsReply")
 	proto.RegisterType((*NPPMetricsReply)(nil), "sonm.NPPMetricsReply")
 	proto.RegisterType((*NamedMetrics)(nil), "sonm.NamedMetrics")
 	proto.RegisterType((*NamedMetric)(nil), "sonm.NamedMetric")
@@ -1884,6 +1964,144 @@ var _Profiles_serviceDesc = grpc.ServiceDesc{
 	Metadata: "node.proto",
 }
 
+// Client API for RegistryCredentials service
+
+type RegistryCredentialsClient interface {
+	// List returns stored credentials with secrets omitted.
+	List(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RegistryCredentialsReply, error)
+	// Set stores the given credential, replacing existing one with the same
+	// name.
+	Set(ctx context.Context, in *RegistryCredential, opts ...grpc.CallOption) (*Empty, error)
+	// Remove removes the credential with the given name.
+	Remove(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type registryCredentialsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRegistryCredentialsClient(cc *grpc.ClientConn) RegistryCredentialsClient {
+	return &registryCredentialsClient{cc}
+}
+
+func (c *registryCredentialsClient) List(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RegistryCredentialsReply, error) {
+	out := new(RegistryCredentialsReply)
+	err := grpc.Invoke(ctx, "/sonm.RegistryCredentials/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryCredentialsClient) Set(ctx context.Context, in *RegistryCredential, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/sonm.RegistryCredentials/Set", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryCredentialsClient) Remove(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/sonm.RegistryCredentials/Remove", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for RegistryCredentials service
+
+type RegistryCredentialsServer interface {
+	// List returns stored credentials with secrets omitted.
+	List(context.Context, *Empty) (*RegistryCredentialsReply, error)
+	// Set stores the given credential, replacing existing one with the same
+	// name.
+	Set(context.Context, *RegistryCredential) (*Empty, error)
+	// Remove removes the credential with the given name.
+	Remove(context.Context, *ID) (*Empty, error)
+}
+
+func RegisterRegistryCredentialsServer(s *grpc.Server, srv RegistryCredentialsServer) {
+	s.RegisterService(&_RegistryCredentials_serviceDesc, srv)
+}
+
+func _RegistryCredentials_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryCredentialsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.RegistryCredentials/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryCredentialsServer).List(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryCredentials_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistryCredential)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryCredentialsServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.RegistryCredentials/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryCredentialsServer).Set(ctx, req.(*RegistryCredential))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistryCredentials_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryCredentialsServer).Remove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.RegistryCredentials/Remove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryCredentialsServer).Remove(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RegistryCredentials_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sonm.RegistryCredentials",
+	HandlerType: (*RegistryCredentialsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _RegistryCredentials_List_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _RegistryCredentials_Set_Handler,
+		},
+		{
+			MethodName: "Remove",
+			Handler:    _RegistryCredentials_Remove_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "node.proto",
+}
+
 // Client API for Monitoring service
 
 type MonitoringClient interface {
@@ -1951,89 +2169,99 @@ var _Monitoring_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("node.proto", fileDescriptor13) }
 
 var fileDescriptor13 = []byte{
-	// 1334 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xdd, 0x6e, 0x1b, 0xc5,
-	0x17, 0xf7, 0x3a, 0x8e, 0xe3, 0x1c, 0xbb, 0x71, 0x3a, 0x49, 0xff, 0xff, 0xb0, 0x2a, 0x28, 0x5a,
-	0x2a, 0xea, 0xd2, 0xd6, 0x89, 0xdc, 0x42, 0x43, 0x5b, 0x21, 0x92, 0x38, 0x88, 0xa0, 0x26, 0x35,
-	0x9b, 0x48, 0x41, 0x5c, 0x80, 0x36, 0xf6, 0xc4, 0x1e, 0x79, 0x77, 0xc6, 0xec, 0xcc, 0x26, 0xf2,
-	0x93, 0xc0, 0x1d, 0x37, 0x5c, 0x73, 0xcd, 0x93, 0xf0, 0x06, 0xbc, 0x07, 0x9a, 0x2f, 0x7b, 0x77,
-	0xbd, 0x69, 0xe1, 0xce, 0x7b, 0xce, 0xef, 0x7c, 0xfd, 0xce, 0xcc, 0x39, 0x63, 0x00, 0xca, 0x06,
-	0xb8, 0x3d, 0x89, 0x99, 0x60, 0xa8, 0xc2, 0x19, 0x8d, 0xdc, 0xc6, 0x25, 0x19, 0x12, 0x2a, 0xb4,
-	0xcc, 0x6d, 0xf6, 0x19, 0x15, 0x01, 0xa1, 0x38, 0x36, 0x82, 0xd5, 0xc1, 0xcd, 0xc8, 0xea, 0x08,
-	0x95, 0x16, 0x94, 0x04, 0x46, 0x70, 0x37, 0x0a, 0xe2, 0x31, 0x16, 0x93, 0x30, 0xe8, 0x1b, 0x9f,
-	0x6e, 0xe3, 0x86, 0xc5, 0xe3, 0x99, 0xf1, 0xee, 0x90, 0x88, 0x51, 0x72, 0xd9, 0xee, 0xb3, 0x68,
-	0x67, 0x12, 0xb3, 0x08, 0x8b, 0x11, 0x4e, 0xf8, 0x4e, 0x3f, 0x24, 0x98, 0x8a, 0x9f, 0x22, 0x36,
-	0xc0, 0xe1, 0x4e, 0x84, 0x45, 0x4c, 0xfa, 0x5c, 0x5b, 0x78, 0xdf, 0x03, 0xfa, 0x96, 0x11, 0x7a,
-	0x8a, 0x85, 0x74, 0xe4, 0xe3, 0x9f, 0x13, 0xcc, 0x05, 0x7a, 0x00, 0x55, 0x11, 0xf0, 0xf1, 0x71,
-	0x77, 0xcb, 0xd9, 0x76, 0x5a, 0xf5, 0x4e, 0xa3, 0x2d, 0x13, 0x69, 0x9f, 0x2b, 0x99, 0x6f, 0x74,
-	0xe8, 0x3e, 0xac, 0x1a, 0xbb, 0xe3, 0xee, 0x56, 0x79, 0xdb, 0x69, 0xad, 0xfa, 0x73, 0x81, 0xf7,
-	0x02, 0x9a, 0x12, 0xff, 0x86, 0x70, 0x91, 0x72, 0x3b, 0xc0, 0x41, 0x98, 0x77, 0x7b, 0x40, 0x86,
-	0xc7, 0x54, 0xf8, 0x46, 0xe7, 0xdd, 0x40, 0xf3, 0xbb, 0x84, 0xf4, 0xc7, 0x07, 0xc9, 0xd4, 0x1a,
-	0x7a, 0xb0, 0x5c, 0x90, 0x8e, 0xb1, 0xd3, 0x2a, 0xf4, 0x29, 0xd4, 0x06, 0x49, 0x1c, 0x08, 0xc2,
-	0xa8, 0x4a, 0xa6, 0xde, 0x59, 0xd3, 0xb0, 0xae, 0x91, 0xfa, 0x33, 0x3d, 0xda, 0x84, 0xe5, 0x2b,
-	0x16, 0xf7, 0xf1, 0xd6, 0xd2, 0xb6, 0xd3, 0xaa, 0xf9, 0xfa, 0xc3, 0x0b, 0xe1, 0x6e, 0x17, 0x07,
-	0xe1, 0xd7, 0x84, 0x12, 0x3e, 0xb2, 0xa1, 0xef, 0x43, 0x99, 0x0c, 0x0a, 0xe3, 0x96, 0xc9, 0x00,
-	0x7d, 0x01, 0x77, 0x2e, 0xc3, 0xa0, 0x3f, 0x0e, 0x09, 0x17, 0xe7, 0xd3, 0x09, 0x56, 0x91, 0xd7,
-	0x3a, 0x1b, 0x06, 0x98, 0x56, 0xf9, 0x59, 0xa4, 0x77, 0x0c, 0x48, 0x46, 0xe3, 0xd9, 0x70, 0xcf,
-	0xa0, 0xa6, 0x68, 0xa0, 0x57, 0x6c, 0xcb, 0xd9, 0x5e, 0x6a, 0xd5, 0x3b, 0xff, 0x37, 0x55, 0xe4,
-	0x33, 0xf3, 0x67, 0x40, 0xef, 0x54, 0x27, 0xce, 0x7b, 0x49, 0x3c, 0xc4, 0xd6, 0xd3, 0x42, 0x6a,
-	0xce, 0xbf, 0x4e, 0xed, 0x09, 0x80, 0xf2, 0xe7, 0xe3, 0x49, 0x38, 0x45, 0x1f, 0x41, 0x45, 0x46,
-	0x32, 0xe9, 0xc0, 0x3c, 0x1d, 0x5f, 0xc9, 0x3d, 0x06, 0xcd, 0xb7, 0x13, 0x4c, 0x95, 0x64, 0xde,
-	0xaf, 0x4b, 0x32, 0xb8, 0xad, 0x5f, 0x4a, 0x35, 0xef, 0x69, 0xf9, 0xf6, 0x9e, 0x16, 0xf7, 0x89,
-	0xc0, 0xc6, 0x85, 0x3a, 0xf5, 0x3e, 0x8e, 0xd8, 0xf5, 0xac, 0xe0, 0x16, 0x54, 0xa3, 0x80, 0x0b,
-	0x1c, 0x9b, 0xa8, 0xeb, 0xda, 0xe3, 0x91, 0x18, 0xed, 0x0f, 0x06, 0x31, 0xe6, 0xdc, 0x37, 0x7a,
-	0x89, 0xd4, 0xd7, 0xc6, 0xc4, 0x2e, 0x40, 0x6a, 0xbd, 0xf7, 0x1a, 0x9a, 0x3a, 0x94, 0x3e, 0xc6,
-	0x92, 0x8e, 0x47, 0xb0, 0xa2, 0x95, 0xdc, 0x30, 0xd2, 0x34, 0x8c, 0x5c, 0x7c, 0x63, 0xb2, 0xb2,
-	0x7a, 0xef, 0x77, 0x07, 0x1a, 0x07, 0x41, 0x18, 0xd0, 0x3e, 0xd6, 0xb6, 0x6d, 0xa8, 0x87, 0xe4,
-	0x1a, 0x1b, 0x59, 0x21, 0x3b, 0x69, 0x80, 0xc4, 0x73, 0x32, 0x98, 0xe1, 0x8b, 0x98, 0x4a, 0x03,
-	0xd0, 0x73, 0x58, 0x93, 0xe6, 0x47, 0x62, 0x64, 0x4d, 0x96, 0x0a, 0x4c, 0x72, 0x18, 0xef, 0x47,
-	0xd8, 0x3c, 0x67, 0x63, 0x4c, 0xcf, 0xe3, 0x80, 0xf2, 0x2b, 0x49, 0xab, 0x26, 0x74, 0x1b, 0xca,
-	0x82, 0xdd, 0x4a, 0x66, 0x59, 0x30, 0x79, 0xa1, 0x83, 0x88, 0x25, 0x54, 0x14, 0xa6, 0x66, 0x74,
-	0xde, 0x6f, 0x0e, 0x34, 0x4f, 0x7b, 0xbd, 0x13, 0x3d, 0x78, 0x34, 0x13, 0xaf, 0x61, 0xc5, 0x0c,
-	0x22, 0xc3, 0xa2, 0xa7, 0x4d, 0x73, 0xb8, 0xb6, 0xf9, 0x38, 0xa2, 0x22, 0x9e, 0xfa, 0xd6, 0xc4,
-	0x3d, 0x85, 0x46, 0x5a, 0x81, 0xd6, 0x61, 0x69, 0x8c, 0xa7, 0x2a, 0xd5, 0x55, 0x5f, 0xfe, 0x44,
-	0x2d, 0x58, 0xbe, 0x0e, 0xc2, 0xc4, 0x72, 0x86, 0x8c, 0xf7, 0x20, 0xc2, 0x03, 0xeb, 0x5f, 0x03,
-	0x5e, 0x96, 0xf7, 0x1c, 0xef, 0x15, 0x34, 0xd2, 0x2a, 0xf4, 0x38, 0x9f, 0xdd, 0xdd, 0x05, 0xfb,
-	0x59, 0x32, 0xde, 0x05, 0xd4, 0x53, 0x72, 0x84, 0xa0, 0x42, 0x83, 0x08, 0x9b, 0x64, 0xd4, 0x6f,
-	0xf4, 0x1c, 0xaa, 0x1a, 0x6d, 0xd2, 0xb9, 0xdf, 0x26, 0xac, 0x3d, 0x1f, 0xd0, 0x6d, 0x3d, 0xa0,
-	0x4d, 0xb1, 0xbe, 0xc1, 0x76, 0xfe, 0x58, 0x82, 0x35, 0x39, 0x42, 0x4f, 0x02, 0x1a, 0x0c, 0x71,
-	0x84, 0xa9, 0x40, 0xcf, 0xa1, 0x22, 0x4f, 0x22, 0xba, 0x37, 0x1f, 0xc8, 0xa9, 0x01, 0xeb, 0x6e,
-	0xe4, 0xc5, 0x93, 0x70, 0xea, 0x95, 0xd0, 0x53, 0xa8, 0xf5, 0x12, 0x3e, 0x92, 0x62, 0x54, 0xd7,
-	0x90, 0xc3, 0x51, 0x42, 0xc7, 0xae, 0x99, 0x90, 0xbd, 0x98, 0x0d, 0x65, 0x4f, 0xbd, 0x52, 0xcb,
-	0xd9, 0x75, 0xd0, 0x0b, 0x58, 0x3e, 0x13, 0x41, 0x2c, 0xd0, 0xff, 0xb4, 0x5a, 0x7d, 0x48, 0x63,
-	0x1b, 0x66, 0x73, 0x41, 0xae, 0xe3, 0xbc, 0x86, 0x7a, 0x6a, 0x99, 0xa0, 0x2d, 0x0d, 0x5b, 0xdc,
-	0x2f, 0xae, 0xa5, 0x53, 0x4b, 0xcf, 0x26, 0xb8, 0xef, 0x95, 0xd0, 0x0e, 0x54, 0xcf, 0x44, 0x20,
-	0x12, 0x8e, 0x32, 0xeb, 0xc6, 0x4d, 0xd5, 0xaa, 0xf5, 0x36, 0xdc, 0xe7, 0x50, 0x79, 0xc3, 0x86,
-	0x3c, 0x43, 0x06, 0x1b, 0xf2, 0x22, 0x32, 0xd8, 0x90, 0xab, 0x8a, 0xbd, 0xd2, 0xae, 0x83, 0x3e,
-	0x86, 0xca, 0x99, 0x60, 0x93, 0x5c, 0x18, 0x43, 0xcc, 0x51, 0x34, 0x11, 0xd2, 0x79, 0x47, 0x72,
-	0x16, 0x86, 0x8a, 0x33, 0x13, 0xc0, 0x7e, 0xdb, 0x00, 0x69, 0x2a, 0xa5, 0xe3, 0xce, 0xdf, 0x15,
-	0x58, 0x93, 0x63, 0x30, 0xd5, 0xb0, 0x87, 0xa6, 0x61, 0x16, 0x2b, 0x2f, 0x84, 0xbb, 0x3e, 0x9f,
-	0xa1, 0x7c, 0xde, 0xa3, 0x5c, 0xf5, 0xfa, 0x12, 0xd9, 0x2a, 0xba, 0x66, 0xd6, 0x5b, 0xf8, 0x2e,
-	0x54, 0xf5, 0x36, 0x40, 0xb7, 0xed, 0x87, 0x7c, 0x41, 0x2f, 0xa1, 0xae, 0xf5, 0x2a, 0xac, 0x6d,
-	0xce, 0xe2, 0x0a, 0x72, 0xcd, 0x3c, 0x3b, 0x8a, 0x63, 0x16, 0x1f, 0x4c, 0x8f, 0xbb, 0x5e, 0x09,
-	0xed, 0x01, 0xa8, 0xdd, 0xa2, 0x4d, 0x53, 0x11, 0x33, 0x2b, 0xa7, 0xc8, 0xf2, 0x31, 0x54, 0xe4,
-	0x72, 0xb0, 0x14, 0xe6, 0x16, 0x85, 0x9b, 0xda, 0x26, 0x5e, 0x09, 0x1d, 0x02, 0x3a, 0x1c, 0x05,
-	0x74, 0xe6, 0x90, 0x2b, 0xea, 0xb2, 0x7c, 0x7c, 0x38, 0xb7, 0xc8, 0x62, 0x2d, 0x33, 0x5f, 0xc2,
-	0xc6, 0x61, 0x8c, 0x03, 0x81, 0x33, 0xea, 0x74, 0xd2, 0x19, 0x85, 0x9b, 0x71, 0xef, 0x95, 0xd0,
-	0x33, 0xd8, 0xdc, 0x9f, 0x4c, 0x62, 0x76, 0x9d, 0x73, 0x90, 0x4d, 0x63, 0xe1, 0xb4, 0x6c, 0x1c,
-	0xca, 0x59, 0x1a, 0xfe, 0x07, 0x9b, 0x3d, 0xa8, 0xd9, 0x77, 0x8e, 0xa5, 0x27, 0xf7, 0xee, 0xb9,
-	0xa5, 0xf9, 0x9d, 0x3f, 0x1d, 0x58, 0x3f, 0x51, 0xab, 0x2c, 0x75, 0xd2, 0xf6, 0xa0, 0xae, 0xf7,
-	0x8f, 0x66, 0x6d, 0x61, 0x60, 0xdb, 0x7b, 0x94, 0xdb, 0x67, 0xea, 0x2c, 0xdd, 0xd1, 0xc2, 0x43,
-	0x46, 0xaf, 0x48, 0x1c, 0x15, 0xd8, 0x2e, 0xa4, 0xde, 0x48, 0x6f, 0x60, 0xf4, 0x41, 0xda, 0x75,
-	0x66, 0x2b, 0xe7, 0x2c, 0x3b, 0x7f, 0x95, 0xa1, 0xa9, 0x96, 0x4d, 0x2a, 0xf3, 0x16, 0xc0, 0x39,
-	0xe6, 0x42, 0x89, 0x39, 0x4a, 0x1b, 0xe4, 0xe3, 0x3e, 0x81, 0x15, 0xbb, 0xea, 0x32, 0x30, 0x33,
-	0xde, 0xd3, 0xbb, 0x56, 0x75, 0x72, 0xd5, 0x48, 0xde, 0x5e, 0x15, 0xd4, 0x54, 0x6c, 0xf4, 0x09,
-	0xac, 0x74, 0xf1, 0x84, 0x71, 0xf2, 0x9e, 0xee, 0x3d, 0x84, 0xda, 0x05, 0x11, 0xa3, 0x41, 0x1c,
-	0xdc, 0xbc, 0x1b, 0xd8, 0x86, 0xe6, 0x89, 0x7a, 0xb6, 0xef, 0x87, 0x21, 0xbb, 0x59, 0xcc, 0x3d,
-	0x7f, 0xfe, 0x3e, 0x83, 0x9a, 0x5d, 0xc4, 0xc8, 0x35, 0x13, 0xaa, 0x60, 0x3b, 0xe7, 0x89, 0xfd,
-	0xc5, 0x81, 0xd5, 0xd9, 0xa3, 0x0e, 0xed, 0x9a, 0xb1, 0xb3, 0x58, 0xf5, 0x66, 0xee, 0xfd, 0x67,
-	0xeb, 0x7e, 0x04, 0x55, 0xd3, 0xcc, 0xf7, 0x76, 0xff, 0x29, 0x2c, 0xab, 0x6b, 0x9f, 0xad, 0xe3,
-	0x5e, 0xe6, 0xf2, 0x9f, 0x89, 0x98, 0xd0, 0xa1, 0x1c, 0x01, 0x9d, 0x5f, 0x1d, 0xa8, 0xf5, 0x62,
-	0x76, 0x45, 0x42, 0xcc, 0xf3, 0x0b, 0xcc, 0xca, 0x73, 0x07, 0x7e, 0x2e, 0xb6, 0x4d, 0xb1, 0xc3,
-	0xb1, 0x3e, 0x4b, 0xee, 0xb8, 0xeb, 0xde, 0xc9, 0xa0, 0x35, 0xd7, 0xba, 0x88, 0x7d, 0x21, 0x62,
-	0x72, 0x99, 0x08, 0xfc, 0xce, 0xde, 0x74, 0xbe, 0x02, 0x38, 0x61, 0x94, 0x08, 0x26, 0x93, 0x45,
-	0x1d, 0x00, 0xf3, 0x00, 0x38, 0xed, 0xf5, 0x0a, 0x8b, 0xcb, 0xbd, 0x4e, 0xbc, 0xd2, 0xc1, 0x83,
-	0x1f, 0xbc, 0xd4, 0x7f, 0x2e, 0x09, 0x7a, 0x4a, 0xd8, 0x4e, 0x9f, 0xc5, 0x78, 0x47, 0xfd, 0xc1,
-	0x7a, 0x25, 0x45, 0x97, 0x55, 0xf5, 0xfb, 0xd9, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x16, 0x4c,
-	0xcb, 0x91, 0x0e, 0x0e, 0x00, 0x00,
+	// 1490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0xb7, 0x1c, 0xc7, 0xb1, 0x9f, 0x93, 0x38, 0xdd, 0xa4, 0x60, 0x3c, 0xa5, 0x13, 0x44, 0x87,
+	0xba, 0xb4, 0x71, 0x32, 0x6e, 0x4b, 0x43, 0xdb, 0x61, 0xc8, 0x3f, 0x86, 0x30, 0x4d, 0x6a, 0x94,
+	0x0c, 0x61, 0x38, 0xc0, 0x28, 0xd6, 0xc6, 0xde, 0xb1, 0xa4, 0x15, 0xbb, 0xeb, 0x04, 0x7f, 0x12,
+	0xb8, 0xf5, 0xc2, 0x99, 0x33, 0x9f, 0x84, 0x0b, 0x67, 0xbe, 0x07, 0xb3, 0xda, 0x5d, 0x5b, 0x92,
+	0xe5, 0x16, 0x6e, 0xd6, 0x7b, 0xbf, 0xf7, 0xef, 0xf7, 0x76, 0xf7, 0x3d, 0x03, 0x84, 0xd4, 0xc3,
+	0xed, 0x88, 0x51, 0x41, 0x51, 0x89, 0xd3, 0x30, 0x68, 0x2e, 0x5f, 0x92, 0x3e, 0x09, 0x85, 0x92,
+	0x35, 0xeb, 0x3d, 0x1a, 0x0a, 0x97, 0x84, 0x98, 0x69, 0x41, 0xd5, 0xbb, 0x19, 0x18, 0x1d, 0x09,
+	0xa5, 0x45, 0x48, 0x5c, 0x2d, 0xb8, 0x15, 0xb8, 0x6c, 0x88, 0x45, 0xe4, 0xbb, 0x3d, 0x6c, 0x30,
+	0x82, 0x04, 0x98, 0x0b, 0x37, 0x88, 0xb4, 0x60, 0xf9, 0x86, 0xb2, 0xe1, 0xc4, 0xdb, 0x4e, 0x9f,
+	0x88, 0xc1, 0xe8, 0xb2, 0xdd, 0xa3, 0xc1, 0x76, 0xc4, 0x68, 0x80, 0xc5, 0x00, 0x8f, 0xf8, 0x76,
+	0xcf, 0x27, 0x38, 0x14, 0x3f, 0x05, 0xd4, 0xc3, 0xfe, 0x76, 0x80, 0x05, 0x23, 0x3d, 0xae, 0x2c,
+	0xec, 0xef, 0x01, 0x7d, 0x43, 0x49, 0x78, 0x8a, 0x85, 0x74, 0xe4, 0xe0, 0x9f, 0x47, 0x98, 0x0b,
+	0x74, 0x0f, 0xca, 0xc2, 0xe5, 0xc3, 0xe3, 0xc3, 0x86, 0xb5, 0x69, 0xb5, 0x6a, 0x9d, 0xe5, 0xb6,
+	0xcc, 0xac, 0x7d, 0x1e, 0xcb, 0x1c, 0xad, 0x43, 0x77, 0xa0, 0xaa, 0xed, 0x8e, 0x0f, 0x1b, 0xc5,
+	0x4d, 0xab, 0x55, 0x75, 0xa6, 0x02, 0xfb, 0x19, 0xd4, 0x25, 0xfe, 0x15, 0xe1, 0x22, 0xe1, 0xd6,
+	0xc3, 0xae, 0x9f, 0x75, 0xbb, 0x4f, 0xfa, 0xc7, 0xa1, 0x70, 0xb4, 0xce, 0xbe, 0x81, 0xfa, 0xb7,
+	0x23, 0xd2, 0x1b, 0xee, 0x8f, 0xc6, 0xc6, 0xd0, 0x86, 0xc5, 0x9c, 0x74, 0xb4, 0x9d, 0x52, 0xa1,
+	0x4f, 0xa1, 0xe2, 0x8d, 0x98, 0x2b, 0x08, 0x0d, 0xe3, 0x64, 0x6a, 0x9d, 0x55, 0x05, 0x3b, 0xd4,
+	0x52, 0x67, 0xa2, 0x47, 0x1b, 0xb0, 0x78, 0x45, 0x59, 0x0f, 0x37, 0x16, 0x36, 0xad, 0x56, 0xc5,
+	0x51, 0x1f, 0xb6, 0x0f, 0xb7, 0x0e, 0xb1, 0xeb, 0x7f, 0x45, 0x42, 0xc2, 0x07, 0x26, 0xf4, 0x1d,
+	0x28, 0x12, 0x2f, 0x37, 0x6e, 0x91, 0x78, 0xe8, 0x73, 0x58, 0xb9, 0xf4, 0xdd, 0xde, 0xd0, 0x27,
+	0x5c, 0x9c, 0x8f, 0x23, 0x1c, 0x47, 0x5e, 0xed, 0xac, 0x6b, 0x60, 0x52, 0xe5, 0xa4, 0x91, 0xf6,
+	0x31, 0x20, 0x19, 0x8d, 0xa7, 0xc3, 0x3d, 0x86, 0x4a, 0x4c, 0x43, 0x78, 0x45, 0x1b, 0xd6, 0xe6,
+	0x42, 0xab, 0xd6, 0x79, 0x5f, 0x57, 0x91, 0xcd, 0xcc, 0x99, 0x00, 0xed, 0x53, 0x95, 0x38, 0xef,
+	0x8e, 0x58, 0x1f, 0x1b, 0x4f, 0x33, 0xa9, 0x59, 0xff, 0x39, 0xb5, 0x47, 0x00, 0xb1, 0x3f, 0x07,
+	0x47, 0xfe, 0x18, 0xdd, 0x85, 0x92, 0x8c, 0xa4, 0xd3, 0x81, 0x69, 0x3a, 0x4e, 0x2c, 0xb7, 0x29,
+	0xd4, 0x5f, 0x47, 0x38, 0x8c, 0x25, 0xd3, 0x7e, 0x5d, 0x12, 0x6f, 0x5e, 0xbf, 0x62, 0xd5, 0xb4,
+	0xa7, 0xc5, 0xf9, 0x3d, 0xcd, 0xef, 0x13, 0x81, 0xf5, 0x8b, 0xf8, 0xd4, 0x3b, 0x38, 0xa0, 0xd7,
+	0x93, 0x82, 0x5b, 0x50, 0x0e, 0x5c, 0x2e, 0x30, 0xd3, 0x51, 0xd7, 0x94, 0xc7, 0x23, 0x31, 0xd8,
+	0xf3, 0x3c, 0x86, 0x39, 0x77, 0xb4, 0x5e, 0x22, 0xd5, 0xb5, 0x69, 0x14, 0xe7, 0x21, 0x95, 0xde,
+	0x7e, 0x09, 0x75, 0x15, 0x4a, 0x1d, 0x63, 0x49, 0xc7, 0x03, 0x58, 0x52, 0x4a, 0xae, 0x19, 0xa9,
+	0x6b, 0x46, 0x2e, 0xbe, 0xd6, 0x59, 0x19, 0xbd, 0xfd, 0xbb, 0x05, 0xcb, 0xfb, 0xae, 0xef, 0x86,
+	0x3d, 0xac, 0x6c, 0xdb, 0x50, 0xf3, 0xc9, 0x35, 0xd6, 0xb2, 0x5c, 0x76, 0x92, 0x00, 0x89, 0xe7,
+	0xc4, 0x9b, 0xe0, 0xf3, 0x98, 0x4a, 0x02, 0xd0, 0x13, 0x58, 0x95, 0xe6, 0x47, 0x62, 0x60, 0x4c,
+	0x16, 0x72, 0x4c, 0x32, 0x18, 0xfb, 0x47, 0xd8, 0x38, 0xa7, 0x43, 0x1c, 0x9e, 0x33, 0x37, 0xe4,
+	0x57, 0x98, 0x19, 0x42, 0x37, 0xa1, 0x28, 0xe8, 0x5c, 0x32, 0x8b, 0x82, 0xca, 0x0b, 0xed, 0x06,
+	0x74, 0x14, 0x8a, 0xdc, 0xd4, 0xb4, 0xce, 0xfe, 0xdb, 0x02, 0xe4, 0xe0, 0x3e, 0xe1, 0x82, 0x8d,
+	0x0f, 0x18, 0xf6, 0x70, 0x28, 0x88, 0xeb, 0x23, 0x04, 0xa5, 0xd0, 0x0d, 0x14, 0x0b, 0x55, 0x27,
+	0xfe, 0x8d, 0x9a, 0x50, 0x19, 0x71, 0xcc, 0x62, 0xb9, 0x7a, 0x51, 0x26, 0xdf, 0x52, 0x17, 0xb9,
+	0x9c, 0xdf, 0x50, 0xe6, 0xc5, 0x65, 0x55, 0x9d, 0xc9, 0x37, 0xba, 0x07, 0x2b, 0x24, 0xf6, 0x2b,
+	0xc6, 0x71, 0x29, 0x8d, 0x52, 0x0c, 0x48, 0x0b, 0x25, 0x8a, 0xe9, 0x3c, 0x14, 0x6a, 0x51, 0xa1,
+	0x52, 0x42, 0xb4, 0x05, 0x55, 0xfc, 0x4b, 0x44, 0x18, 0xe6, 0x7b, 0xa2, 0x51, 0xde, 0xb4, 0xa6,
+	0x2d, 0x3e, 0x37, 0x8f, 0xaf, 0x33, 0x45, 0xd8, 0xdf, 0x41, 0x63, 0xb6, 0x38, 0x7d, 0x75, 0x9e,
+	0x43, 0xad, 0x37, 0x95, 0xe9, 0xf3, 0xd2, 0x50, 0xce, 0x66, 0x8d, 0x9c, 0x24, 0xd8, 0x7e, 0x63,
+	0x41, 0xfd, 0xb4, 0xdb, 0x3d, 0x51, 0xcf, 0xb5, 0xf2, 0xf7, 0x12, 0x96, 0xf4, 0xf3, 0xad, 0x7d,
+	0xd9, 0xca, 0x57, 0x06, 0xd7, 0xd6, 0x1f, 0x47, 0xa1, 0x60, 0x63, 0xc7, 0x98, 0x34, 0x4f, 0x61,
+	0x39, 0xa9, 0x40, 0x6b, 0xb0, 0x30, 0xc4, 0x63, 0xcd, 0xbf, 0xfc, 0x89, 0x5a, 0xb0, 0x78, 0xed,
+	0xfa, 0x23, 0x73, 0xd2, 0x90, 0xf6, 0xee, 0x06, 0xd8, 0x33, 0xfe, 0x15, 0xe0, 0x79, 0x71, 0xd7,
+	0xb2, 0x5f, 0xc0, 0x72, 0x52, 0x85, 0x1e, 0x66, 0xb3, 0xbb, 0x35, 0x63, 0x3f, 0x49, 0xc6, 0xbe,
+	0x80, 0x5a, 0x42, 0x9e, 0x7b, 0x18, 0x9e, 0x40, 0x59, 0xa1, 0x75, 0x3a, 0x77, 0xda, 0x84, 0xb6,
+	0xa7, 0x63, 0xad, 0xad, 0xc6, 0x9a, 0x2e, 0xd6, 0xd1, 0xd8, 0xce, 0x1f, 0x0b, 0xb0, 0x2a, 0x07,
+	0xcf, 0x89, 0x1b, 0xba, 0x7d, 0x1c, 0xe0, 0x50, 0xa0, 0x27, 0x50, 0x92, 0xf7, 0x17, 0xdd, 0x9e,
+	0x8e, 0xb1, 0xc4, 0x58, 0x6a, 0xae, 0x67, 0xc5, 0x91, 0x3f, 0xb6, 0x0b, 0x68, 0x0b, 0x2a, 0xdd,
+	0x11, 0x1f, 0x48, 0x31, 0xaa, 0x29, 0xc8, 0xc1, 0x60, 0x14, 0x0e, 0x9b, 0x7a, 0xae, 0x74, 0x19,
+	0xed, 0xcb, 0x9b, 0x60, 0x17, 0x5a, 0xd6, 0x8e, 0x85, 0x9e, 0xc1, 0xe2, 0x99, 0x70, 0x99, 0x40,
+	0xef, 0x29, 0x75, 0xfc, 0x21, 0x8d, 0x4d, 0x98, 0x8d, 0x19, 0xb9, 0x8a, 0xf3, 0x12, 0x6a, 0x89,
+	0x11, 0x8c, 0xf4, 0xf1, 0x98, 0x9d, 0xca, 0x4d, 0x43, 0xa7, 0x92, 0x9e, 0x45, 0xb8, 0x67, 0x17,
+	0xd0, 0x36, 0x94, 0xcf, 0x84, 0x2b, 0x46, 0x1c, 0xa5, 0x86, 0x74, 0x33, 0x51, 0xab, 0xd2, 0x9b,
+	0x70, 0x9f, 0x41, 0xe9, 0x15, 0xed, 0xf3, 0x14, 0x19, 0xb4, 0xcf, 0xf3, 0xc8, 0xa0, 0x7d, 0x1e,
+	0x57, 0x6c, 0x17, 0x76, 0x2c, 0xf4, 0x31, 0x94, 0xce, 0x04, 0x8d, 0x32, 0x61, 0x34, 0x31, 0x47,
+	0x41, 0x24, 0xa4, 0xf3, 0x8e, 0xe4, 0xcc, 0xf7, 0x63, 0xce, 0x74, 0x00, 0xf3, 0x6d, 0x02, 0x24,
+	0xa9, 0x94, 0x8e, 0x3b, 0xff, 0x94, 0x60, 0x55, 0x0e, 0x8f, 0x44, 0xc3, 0xee, 0xeb, 0x86, 0x19,
+	0xac, 0x7c, 0x46, 0x9a, 0x6b, 0xd3, 0xc9, 0xc3, 0xa7, 0x3d, 0xca, 0x54, 0xaf, 0x9e, 0x9e, 0xe6,
+	0xfa, 0x14, 0x2b, 0x27, 0xa4, 0x81, 0xef, 0x40, 0x59, 0xcd, 0x50, 0x34, 0x6f, 0xaa, 0x66, 0x0b,
+	0x7a, 0x0e, 0x35, 0xa5, 0x8f, 0xc3, 0x9a, 0xe6, 0xcc, 0x0e, 0xee, 0xa6, 0x7e, 0x22, 0x8e, 0x18,
+	0xa3, 0x6c, 0x7f, 0x7c, 0x7c, 0x68, 0x17, 0xd0, 0x2e, 0x40, 0x3c, 0x91, 0x95, 0x69, 0x22, 0x62,
+	0x6a, 0x50, 0xe7, 0x59, 0x3e, 0x84, 0x92, 0x1c, 0xa9, 0x86, 0xc2, 0xcc, 0x78, 0x6d, 0x26, 0x66,
+	0xb0, 0x5d, 0x40, 0x07, 0x80, 0x0e, 0x06, 0x6e, 0x38, 0x71, 0xc8, 0x63, 0xea, 0xd2, 0x7c, 0x7c,
+	0x38, 0xb5, 0x48, 0x63, 0x0d, 0x33, 0x5f, 0xc0, 0xfa, 0x01, 0xc3, 0xae, 0xc0, 0x29, 0x75, 0x32,
+	0xe9, 0x94, 0xa2, 0x99, 0x72, 0x6f, 0x17, 0xd0, 0x63, 0xd8, 0xd8, 0x8b, 0x22, 0x46, 0xaf, 0x33,
+	0x0e, 0xd2, 0x69, 0xcc, 0x9c, 0x96, 0xf5, 0x03, 0x39, 0x81, 0xfc, 0xff, 0x61, 0xb3, 0x0b, 0x15,
+	0xb3, 0x1d, 0x1a, 0x7a, 0x32, 0xdb, 0xe2, 0x9c, 0xe6, 0x77, 0xfe, 0xb4, 0x60, 0xed, 0x24, 0x5e,
+	0x00, 0x12, 0x27, 0x6d, 0x17, 0x6a, 0x6a, 0x6a, 0x2b, 0xd6, 0x66, 0xc6, 0x9c, 0xb9, 0x47, 0x99,
+	0x2d, 0x20, 0x3e, 0x4b, 0x2b, 0x4a, 0x78, 0x40, 0xc3, 0x2b, 0xc2, 0x82, 0x1c, 0xdb, 0x99, 0xd4,
+	0x97, 0x93, 0x7b, 0x0b, 0xfa, 0x20, 0xe9, 0x3a, 0xb5, 0xcb, 0x64, 0x2c, 0x3b, 0x7f, 0x15, 0xa1,
+	0x1e, 0x0f, 0xa7, 0x44, 0xe6, 0x2d, 0x80, 0x73, 0xcc, 0x45, 0x2c, 0xe6, 0x28, 0x69, 0x90, 0x8d,
+	0xfb, 0x08, 0x96, 0xcc, 0x82, 0x90, 0x82, 0xe9, 0xe7, 0x3d, 0xb9, 0xa1, 0xc4, 0x9d, 0xac, 0x6a,
+	0xc9, 0xeb, 0xab, 0x9c, 0x9a, 0xf2, 0x8d, 0x3e, 0x81, 0xa5, 0x43, 0x1c, 0x51, 0x4e, 0xde, 0xd1,
+	0xbd, 0xfb, 0x50, 0xb9, 0x20, 0x62, 0xe0, 0x31, 0xf7, 0xe6, 0xed, 0xc0, 0x36, 0xd4, 0x4f, 0xe2,
+	0x7f, 0x3f, 0x7b, 0xbe, 0x4f, 0x6f, 0x66, 0x73, 0xcf, 0x9e, 0xbf, 0xa7, 0x50, 0x31, 0xeb, 0x0b,
+	0x6a, 0xea, 0x17, 0x2a, 0x67, 0xa7, 0xc9, 0x12, 0xfb, 0xab, 0x05, 0xd5, 0xc9, 0x2a, 0x8c, 0x76,
+	0xf4, 0xb3, 0x33, 0x5b, 0xf5, 0x46, 0x66, 0x6b, 0x36, 0x75, 0x3f, 0x80, 0xb2, 0x6e, 0xe6, 0x3b,
+	0xbb, 0xbf, 0x05, 0x8b, 0xf1, 0xb5, 0x4f, 0xd7, 0x71, 0x3b, 0x75, 0xf9, 0xcf, 0x04, 0x23, 0x61,
+	0x5f, 0x3e, 0x01, 0x9d, 0xdf, 0x2c, 0xa8, 0x74, 0x19, 0xbd, 0x22, 0x3e, 0xe6, 0xd9, 0x01, 0x66,
+	0xe4, 0x99, 0x03, 0x3f, 0x15, 0x9b, 0xa6, 0x98, 0xc7, 0xb1, 0x36, 0x49, 0xee, 0xf8, 0xb0, 0xb9,
+	0x92, 0x42, 0x2b, 0xae, 0x55, 0x11, 0x7b, 0x42, 0x30, 0x72, 0x39, 0x12, 0xf8, 0xad, 0xbd, 0xe9,
+	0xbc, 0xb1, 0x60, 0x3d, 0x67, 0xe5, 0x41, 0x4f, 0xd3, 0xaf, 0xb6, 0x2a, 0xf0, 0xee, 0xbc, 0x6d,
+	0x67, 0x92, 0x66, 0x1b, 0x16, 0xce, 0xb0, 0x40, 0x73, 0xd7, 0xa2, 0x2c, 0x91, 0x1f, 0x4d, 0x38,
+	0xaf, 0x28, 0xc5, 0xcc, 0x18, 0xea, 0x7c, 0x09, 0x70, 0x42, 0x43, 0x22, 0xa8, 0xa4, 0x13, 0x75,
+	0x00, 0xf4, 0x8a, 0x72, 0xda, 0xed, 0xe6, 0xd2, 0x9f, 0xd9, 0x9f, 0xec, 0xc2, 0xfe, 0xbd, 0x1f,
+	0xec, 0xc4, 0x7f, 0x69, 0x09, 0xda, 0x22, 0x74, 0xbb, 0x47, 0x19, 0xde, 0x8e, 0xff, 0x38, 0xbf,
+	0x90, 0xa2, 0xcb, 0x72, 0xfc, 0xfb, 0xf1, 0xbf, 0x03, 0x00, 0x20, 0x68, 0xc9, 0x58, 0xf7, 0x0f,
+	0x00, 0x00,
 }
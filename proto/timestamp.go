@@ -19,6 +19,14 @@ func CurrentTimestamp() *Timestamp {
 	}
 }
 
+// NewTimestamp converts the given time into a Timestamp.
+func NewTimestamp(t time.Time) *Timestamp {
+	return &Timestamp{
+		Seconds: t.Unix(),
+		Nanos:   int32(t.Nanosecond()),
+	}
+}
+
 func (m Timestamp) MarshalText() (text []byte, err error) {
 	return m.Unix().MarshalText()
 }
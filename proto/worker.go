@@ -1,7 +1,17 @@
 package sonm
 
 import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
 	"unicode/utf8"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sonm-io/core/util/cron"
 )
 
 const (
@@ -16,6 +26,10 @@ const (
 	MetricsKeyGPUTemperature  = "temp"
 	MetricsKeyGPUFan          = "fan"
 	MetricsKeyGPUPower        = "power"
+	// Traffic of all ask plans within their current quota periods.
+	MetricsKeyNetworkBytesIn   = "net_bytes_in"
+	MetricsKeyNetworkBytesOut  = "net_bytes_out"
+	MetricsKeyNetworkThrottled = "net_throttled"
 )
 
 func (m *TaskTag) MarshalYAML() (interface{}, error) {
@@ -57,3 +71,183 @@ func (m *WorkerMetricsResponse) Append(x ...map[string]float64) *WorkerMetricsRe
 func IsTaskStatusTerminated(status TaskStatusReply_Status) bool {
 	return status == TaskStatusReply_FINISHED || status == TaskStatusReply_BROKEN || status == TaskStatusReply_KILLED_OOM
 }
+
+// IsTaskStatusRunning returns true if the task is running, regardless of its
+// health check state. Tasks waiting for restart are considered running too,
+// because their containers are brought back unless stopped.
+func IsTaskStatusRunning(status TaskStatusReply_Status) bool {
+	switch status {
+	case TaskStatusReply_RUNNING, TaskStatusReply_HEALTHY, TaskStatusReply_UNHEALTHY, TaskStatusReply_RESTARTING:
+		return true
+	default:
+		return false
+	}
+}
+
+func (m *PrefetchImagesRequest) Validate() error {
+	if len(m.GetImages()) == 0 {
+		return errors.New("at least one image is required")
+	}
+
+	return m.GetRegistry().Validate()
+}
+
+func (m *MaintenanceWindow) Validate() error {
+	if m.GetDuration().Unwrap() < 0 {
+		return errors.New("duration must not be negative")
+	}
+
+	if len(m.GetSchedule()) == 0 {
+		if m.GetStart() == nil {
+			return errors.New("either start or schedule is required")
+		}
+
+		return nil
+	}
+
+	if m.GetStart() != nil {
+		return errors.New("start and schedule are mutually exclusive")
+	}
+
+	if m.GetDuration().Unwrap() == 0 {
+		return errors.New("recurring window requires duration")
+	}
+
+	if _, err := cron.Parse(m.GetSchedule()); err != nil {
+		return fmt.Errorf("invalid schedule: %v", err)
+	}
+
+	return nil
+}
+
+// Occurrence returns the occurrence of the window that is either active at
+// the given time or the upcoming one. The end is zero for windows lasting
+// until cancelled. Returns false when the window will never occur again.
+func (m *MaintenanceWindow) Occurrence(now time.Time) (time.Time, time.Time, bool) {
+	duration := m.GetDuration().Unwrap()
+
+	if len(m.GetSchedule()) == 0 {
+		start := m.GetStart().Unix()
+		if duration == 0 {
+			return start, time.Time{}, true
+		}
+
+		end := start.Add(duration)
+		return start, end, end.After(now)
+	}
+
+	schedule, err := cron.Parse(m.GetSchedule())
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	// The first occurrence ending after now is either active or upcoming.
+	start := schedule.Next(now.Add(-duration))
+	if start.IsZero() {
+		return time.Time{}, time.Time{}, false
+	}
+
+	return start, start.Add(duration), true
+}
+
+// benchmarkReportDomain prefixes the encoding of benchmark reports to
+// separate their signatures from other data signed by worker keys.
+const benchmarkReportDomain = "sonm-benchmark-report-v1"
+
+// Sign signs the report with the given key, setting the worker address.
+func (m *BenchmarkReportReply) Sign(key *ecdsa.PrivateKey) error {
+	m.Worker = NewEthAddress(crypto.PubkeyToAddress(key.PublicKey))
+
+	signature, err := crypto.Sign(m.hash(), key)
+	if err != nil {
+		return err
+	}
+
+	m.Signature = signature
+	return nil
+}
+
+// Verify checks that the report is signed by the worker it claims to be
+// made by.
+func (m *BenchmarkReportReply) Verify() error {
+	if m.GetWorker() == nil {
+		return errors.New("worker address is required")
+	}
+	if len(m.GetSignature()) == 0 {
+		return errors.New("signature is required")
+	}
+
+	publicKey, err := crypto.SigToPub(m.hash(), m.GetSignature())
+	if err != nil {
+		return fmt.Errorf("invalid signature: %v", err)
+	}
+
+	if crypto.PubkeyToAddress(*publicKey) != m.GetWorker().Unwrap() {
+		return errors.New("report is not signed by the worker")
+	}
+
+	return nil
+}
+
+// hash returns the Keccak256 hash of the canonical encoding of the report,
+// so signatures can be verified without relying on protobuf marshaling to
+// be deterministic. The report is encoded as
+//
+//	domain || worker || str(fingerprint) || ts(timestamp) ||
+//	u32(len(results)) || result...
+//
+// where worker is 20 bytes of the address and results are sorted by ID,
+// device and runner, each encoded as
+//
+//	u64(ID) || str(code) || u32(type) || str(device) || str(fingerprint) ||
+//	u64(result) || str(runner) || ts(timestamp)
+//
+// Integers are big-endian, str is u32 length followed by UTF-8 bytes and ts
+// is i64 seconds followed by i32 nanoseconds, zero if unset. The signature
+// is not encoded.
+func (m *BenchmarkReportReply) hash() []byte {
+	results := append([]*BenchmarkResult{}, m.GetResults()...)
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.GetID() != b.GetID() {
+			return a.GetID() < b.GetID()
+		}
+		if a.GetDevice() != b.GetDevice() {
+			return a.GetDevice() < b.GetDevice()
+		}
+		return a.GetRunner() < b.GetRunner()
+	})
+
+	buf := &bytes.Buffer{}
+	writeInt := func(value interface{}) {
+		// Writes to the buffer never fail.
+		binary.Write(buf, binary.BigEndian, value)
+	}
+	writeString := func(value string) {
+		writeInt(uint32(len(value)))
+		buf.WriteString(value)
+	}
+	writeTimestamp := func(value *Timestamp) {
+		writeInt(value.GetSeconds())
+		writeInt(value.GetNanos())
+	}
+
+	buf.WriteString(benchmarkReportDomain)
+	buf.Write(m.GetWorker().Unwrap().Bytes())
+	writeString(m.GetFingerprint())
+	writeTimestamp(m.GetTimestamp())
+	writeInt(uint32(len(results)))
+
+	for _, result := range results {
+		writeInt(result.GetID())
+		writeString(result.GetCode())
+		writeInt(uint32(result.GetType()))
+		writeString(result.GetDevice())
+		writeString(result.GetFingerprint())
+		writeInt(result.GetResult())
+		writeString(result.GetRunner())
+		writeTimestamp(result.GetTimestamp())
+	}
+
+	return crypto.Keccak256(buf.Bytes())
+}
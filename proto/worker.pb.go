@@ -51,6 +51,14 @@ const (
 	TaskStatusReply_FINISHED   TaskStatusReply_Status = 4
 	TaskStatusReply_BROKEN     TaskStatusReply_Status = 5
 	TaskStatusReply_KILLED_OOM TaskStatusReply_Status = 6
+	// HEALTHY means that the task is running and its health check passes.
+	TaskStatusReply_HEALTHY TaskStatusReply_Status = 7
+	// UNHEALTHY means that the task is running, but its health check
+	// fails.
+	TaskStatusReply_UNHEALTHY TaskStatusReply_Status = 8
+	// RESTARTING means that the task has exited and is going to be
+	// restarted according to its restart policy after a backoff delay.
+	TaskStatusReply_RESTARTING TaskStatusReply_Status = 9
 )
 
 var TaskStatusReply_Status_name = map[int32]string{
@@ -61,6 +69,9 @@ var TaskStatusReply_Status_name = map[int32]string{
 	4: "FINISHED",
 	5: "BROKEN",
 	6: "KILLED_OOM",
+	7: "HEALTHY",
+	8: "UNHEALTHY",
+	9: "RESTARTING",
 }
 var TaskStatusReply_Status_value = map[string]int32{
 	"UNKNOWN":    0,
@@ -70,12 +81,15 @@ var TaskStatusReply_Status_value = map[string]int32{
 	"FINISHED":   4,
 	"BROKEN":     5,
 	"KILLED_OOM": 6,
+	"HEALTHY":    7,
+	"UNHEALTHY":  8,
+	"RESTARTING": 9,
 }
 
 func (x TaskStatusReply_Status) String() string {
 	return proto.EnumName(TaskStatusReply_Status_name, int32(x))
 }
-func (TaskStatusReply_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor21, []int{11, 0} }
+func (TaskStatusReply_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor21, []int{23, 0} }
 
 type TaskTag struct {
 	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
@@ -99,6 +113,9 @@ type TaskSpec struct {
 	Registry  *Registry         `protobuf:"bytes,2,opt,name=registry" json:"registry,omitempty"`
 	Resources *AskPlanResources `protobuf:"bytes,3,opt,name=resources" json:"resources,omitempty"`
 	Tag       *TaskTag          `protobuf:"bytes,4,opt,name=tag" json:"tag,omitempty"`
+	// PushRegistry contains credentials used for pushing images of the task,
+	// i.e. on stop and for checkpoints. Pull registry ones are used if empty.
+	PushRegistry *Registry `protobuf:"bytes,5,opt,name=pushRegistry" json:"pushRegistry,omitempty"`
 }
 
 func (m *TaskSpec) Reset()                    { *m = TaskSpec{} }
@@ -134,6 +151,13 @@ func (m *TaskSpec) GetTag() *TaskTag {
 	return nil
 }
 
+func (m *TaskSpec) GetPushRegistry() *Registry {
+	if m != nil {
+		return m.PushRegistry
+	}
+	return nil
+}
+
 type StartTaskRequest struct {
 	// Deal points to the deal associated with workers where the task should be
 	// started.
@@ -160,6 +184,41 @@ func (m *StartTaskRequest) GetSpec() *TaskSpec {
 	return nil
 }
 
+type RestoreTaskRequest struct {
+	DealID *BigInt `protobuf:"bytes,1,opt,name=dealID" json:"dealID,omitempty"`
+	// Spec describes the task to be restored. Its image is replaced with the
+	// checkpoint one.
+	Spec *TaskSpec `protobuf:"bytes,2,opt,name=spec" json:"spec,omitempty"`
+	// Checkpoint is the reference of the checkpoint image.
+	Checkpoint string `protobuf:"bytes,3,opt,name=checkpoint" json:"checkpoint,omitempty"`
+}
+
+func (m *RestoreTaskRequest) Reset()                    { *m = RestoreTaskRequest{} }
+func (m *RestoreTaskRequest) String() string            { return proto.CompactTextString(m) }
+func (*RestoreTaskRequest) ProtoMessage()               {}
+func (*RestoreTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{3} }
+
+func (m *RestoreTaskRequest) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *RestoreTaskRequest) GetSpec() *TaskSpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *RestoreTaskRequest) GetCheckpoint() string {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return ""
+}
+
 type WorkerJoinNetworkRequest struct {
 	TaskID    string `protobuf:"bytes,1,opt,name=taskID" json:"taskID,omitempty"`
 	NetworkID string `protobuf:"bytes,2,opt,name=networkID" json:"networkID,omitempty"`
@@ -168,7 +227,7 @@ type WorkerJoinNetworkRequest struct {
 func (m *WorkerJoinNetworkRequest) Reset()                    { *m = WorkerJoinNetworkRequest{} }
 func (m *WorkerJoinNetworkRequest) String() string            { return proto.CompactTextString(m) }
 func (*WorkerJoinNetworkRequest) ProtoMessage()               {}
-func (*WorkerJoinNetworkRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{3} }
+func (*WorkerJoinNetworkRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{4} }
 
 func (m *WorkerJoinNetworkRequest) GetTaskID() string {
 	if m != nil {
@@ -188,12 +247,14 @@ type StartTaskReply struct {
 	Id         string                `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	PortMap    map[string]*Endpoints `protobuf:"bytes,2,rep,name=portMap" json:"portMap,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	NetworkIDs []string              `protobuf:"bytes,3,rep,name=networkIDs" json:"networkIDs,omitempty"`
+	// PortForwarding describes ports published through NPP, if any.
+	PortForwarding *PortForwarding `protobuf:"bytes,4,opt,name=portForwarding" json:"portForwarding,omitempty"`
 }
 
 func (m *StartTaskReply) Reset()                    { *m = StartTaskReply{} }
 func (m *StartTaskReply) String() string            { return proto.CompactTextString(m) }
 func (*StartTaskReply) ProtoMessage()               {}
-func (*StartTaskReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{4} }
+func (*StartTaskReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{5} }
 
 func (m *StartTaskReply) GetId() string {
 	if m != nil {
@@ -216,6 +277,158 @@ func (m *StartTaskReply) GetNetworkIDs() []string {
 	return nil
 }
 
+func (m *StartTaskReply) GetPortForwarding() *PortForwarding {
+	if m != nil {
+		return m.PortForwarding
+	}
+	return nil
+}
+
+// PortForwarding describes exposed ports of a task published through the
+// worker's port forwarding service, which is reachable through NPP.
+type PortForwarding struct {
+	// Addr is the ETH address the service is published under.
+	Addr *EthAddress `protobuf:"bytes,1,opt,name=addr" json:"addr,omitempty"`
+	// Ports are exposed ports reachable through the service.
+	Ports []string `protobuf:"bytes,2,rep,name=ports" json:"ports,omitempty"`
+}
+
+func (m *PortForwarding) Reset()                    { *m = PortForwarding{} }
+func (m *PortForwarding) String() string            { return proto.CompactTextString(m) }
+func (*PortForwarding) ProtoMessage()               {}
+func (*PortForwarding) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{6} }
+
+func (m *PortForwarding) GetAddr() *EthAddress {
+	if m != nil {
+		return m.Addr
+	}
+	return nil
+}
+
+func (m *PortForwarding) GetPorts() []string {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+// TaskGroupSpec describes several tasks that are started together under the
+// same ask-plan.
+//
+// All tasks share the network namespace of the first task, so only the first
+// task is allowed to expose ports and join overlay networks.
+type TaskGroupSpec struct {
+	// Tasks are started in the specified order and stopped in the reverse
+	// one. If a task has a health check specified, the next task is started
+	// only after the previous one becomes healthy.
+	Tasks []*TaskSpec `protobuf:"bytes,1,rep,name=tasks" json:"tasks,omitempty"`
+	// Volumes shared between all tasks of the group. Tasks mount them by name
+	// using their "mounts" section.
+	Volumes map[string]*Volume `protobuf:"bytes,2,rep,name=volumes" json:"volumes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *TaskGroupSpec) Reset()                    { *m = TaskGroupSpec{} }
+func (m *TaskGroupSpec) String() string            { return proto.CompactTextString(m) }
+func (*TaskGroupSpec) ProtoMessage()               {}
+func (*TaskGroupSpec) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{7} }
+
+func (m *TaskGroupSpec) GetTasks() []*TaskSpec {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+func (m *TaskGroupSpec) GetVolumes() map[string]*Volume {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+type StartTaskGroupRequest struct {
+	DealID *BigInt        `protobuf:"bytes,1,opt,name=dealID" json:"dealID,omitempty"`
+	Spec   *TaskGroupSpec `protobuf:"bytes,2,opt,name=spec" json:"spec,omitempty"`
+}
+
+func (m *StartTaskGroupRequest) Reset()                    { *m = StartTaskGroupRequest{} }
+func (m *StartTaskGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*StartTaskGroupRequest) ProtoMessage()               {}
+func (*StartTaskGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{8} }
+
+func (m *StartTaskGroupRequest) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *StartTaskGroupRequest) GetSpec() *TaskGroupSpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+type StartTaskGroupReply struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Tasks contains start replies in the same order as tasks in the spec.
+	Tasks []*StartTaskReply `protobuf:"bytes,2,rep,name=tasks" json:"tasks,omitempty"`
+}
+
+func (m *StartTaskGroupReply) Reset()                    { *m = StartTaskGroupReply{} }
+func (m *StartTaskGroupReply) String() string            { return proto.CompactTextString(m) }
+func (*StartTaskGroupReply) ProtoMessage()               {}
+func (*StartTaskGroupReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{9} }
+
+func (m *StartTaskGroupReply) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StartTaskGroupReply) GetTasks() []*StartTaskReply {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+type TaskGroupStatusReply struct {
+	// Status is the aggregated status of all tasks in the group.
+	Status TaskStatusReply_Status `protobuf:"varint,1,opt,name=status,enum=sonm.TaskStatusReply_Status" json:"status,omitempty"`
+	// TaskIDs contains the group's task IDs in the start order.
+	TaskIDs []string                    `protobuf:"bytes,2,rep,name=taskIDs" json:"taskIDs,omitempty"`
+	Tasks   map[string]*TaskStatusReply `protobuf:"bytes,3,rep,name=tasks" json:"tasks,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *TaskGroupStatusReply) Reset()                    { *m = TaskGroupStatusReply{} }
+func (m *TaskGroupStatusReply) String() string            { return proto.CompactTextString(m) }
+func (*TaskGroupStatusReply) ProtoMessage()               {}
+func (*TaskGroupStatusReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{10} }
+
+func (m *TaskGroupStatusReply) GetStatus() TaskStatusReply_Status {
+	if m != nil {
+		return m.Status
+	}
+	return TaskStatusReply_UNKNOWN
+}
+
+func (m *TaskGroupStatusReply) GetTaskIDs() []string {
+	if m != nil {
+		return m.TaskIDs
+	}
+	return nil
+}
+
+func (m *TaskGroupStatusReply) GetTasks() map[string]*TaskStatusReply {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
 type StatusReply struct {
 	Uptime              uint64      `protobuf:"varint,1,opt,name=uptime" json:"uptime,omitempty"`
 	Version             string      `protobuf:"bytes,2,opt,name=version" json:"version,omitempty"`
@@ -229,12 +442,21 @@ type StatusReply struct {
 	IsMasterConfirmed   bool        `protobuf:"varint,10,opt,name=isMasterConfirmed" json:"isMasterConfirmed,omitempty"`
 	IsBenchmarkFinished bool        `protobuf:"varint,11,opt,name=isBenchmarkFinished" json:"isBenchmarkFinished,omitempty"`
 	Geo                 *GeoIP      `protobuf:"bytes,12,opt,name=geo" json:"geo,omitempty"`
+	// IsStorageQuotaEnforced shows whether disk space taken by tasks is
+	// limited according to storage resources of their ask plans.
+	IsStorageQuotaEnforced bool `protobuf:"varint,13,opt,name=isStorageQuotaEnforced" json:"isStorageQuotaEnforced,omitempty"`
+	// StorageQuotaStatus describes how storage quotas are enforced or the
+	// reason they are not.
+	StorageQuotaStatus string `protobuf:"bytes,14,opt,name=storageQuotaStatus" json:"storageQuotaStatus,omitempty"`
+	// Drain describes the drain progress, it is set only while the worker
+	// is drained.
+	Drain *DrainStatus `protobuf:"bytes,15,opt,name=drain" json:"drain,omitempty"`
 }
 
 func (m *StatusReply) Reset()                    { *m = StatusReply{} }
 func (m *StatusReply) String() string            { return proto.CompactTextString(m) }
 func (*StatusReply) ProtoMessage()               {}
-func (*StatusReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{5} }
+func (*StatusReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{11} }
 
 func (m *StatusReply) GetUptime() uint64 {
 	if m != nil {
@@ -320,6 +542,80 @@ func (m *StatusReply) GetGeo() *GeoIP {
 	return nil
 }
 
+func (m *StatusReply) GetIsStorageQuotaEnforced() bool {
+	if m != nil {
+		return m.IsStorageQuotaEnforced
+	}
+	return false
+}
+
+func (m *StatusReply) GetStorageQuotaStatus() string {
+	if m != nil {
+		return m.StorageQuotaStatus
+	}
+	return ""
+}
+
+func (m *StatusReply) GetDrain() *DrainStatus {
+	if m != nil {
+		return m.Drain
+	}
+	return nil
+}
+
+type DrainStatus struct {
+	// Since is the time the drain has been requested.
+	Since *Timestamp `protobuf:"bytes,1,opt,name=since" json:"since,omitempty"`
+	// Orders is the number of orders that are not cancelled yet.
+	Orders uint32 `protobuf:"varint,2,opt,name=orders" json:"orders,omitempty"`
+	// ForwardDeals is the number of forward deals running to their term.
+	ForwardDeals uint32 `protobuf:"varint,3,opt,name=forwardDeals" json:"forwardDeals,omitempty"`
+	// SpotDeals is the number of spot deals waiting to be closed.
+	SpotDeals uint32 `protobuf:"varint,4,opt,name=spotDeals" json:"spotDeals,omitempty"`
+	// Done is set when there are no orders and deals left.
+	Done bool `protobuf:"varint,5,opt,name=done" json:"done,omitempty"`
+}
+
+func (m *DrainStatus) Reset()                    { *m = DrainStatus{} }
+func (m *DrainStatus) String() string            { return proto.CompactTextString(m) }
+func (*DrainStatus) ProtoMessage()               {}
+func (*DrainStatus) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{12} }
+
+func (m *DrainStatus) GetSince() *Timestamp {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *DrainStatus) GetOrders() uint32 {
+	if m != nil {
+		return m.Orders
+	}
+	return 0
+}
+
+func (m *DrainStatus) GetForwardDeals() uint32 {
+	if m != nil {
+		return m.ForwardDeals
+	}
+	return 0
+}
+
+func (m *DrainStatus) GetSpotDeals() uint32 {
+	if m != nil {
+		return m.SpotDeals
+	}
+	return 0
+}
+
+func (m *DrainStatus) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
 type AskPlansReply struct {
 	AskPlans map[string]*AskPlan `protobuf:"bytes,1,rep,name=askPlans" json:"askPlans,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
@@ -327,7 +623,7 @@ type AskPlansReply struct {
 func (m *AskPlansReply) Reset()                    { *m = AskPlansReply{} }
 func (m *AskPlansReply) String() string            { return proto.CompactTextString(m) }
 func (*AskPlansReply) ProtoMessage()               {}
-func (*AskPlansReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{6} }
+func (*AskPlansReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{13} }
 
 func (m *AskPlansReply) GetAskPlans() map[string]*AskPlan {
 	if m != nil {
@@ -343,7 +639,7 @@ type TaskListReply struct {
 func (m *TaskListReply) Reset()                    { *m = TaskListReply{} }
 func (m *TaskListReply) String() string            { return proto.CompactTextString(m) }
 func (*TaskListReply) ProtoMessage()               {}
-func (*TaskListReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{7} }
+func (*TaskListReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{14} }
 
 func (m *TaskListReply) GetInfo() map[string]*TaskStatusReply {
 	if m != nil {
@@ -352,18 +648,155 @@ func (m *TaskListReply) GetInfo() map[string]*TaskStatusReply {
 	return nil
 }
 
+// BenchmarkResult is the value of the benchmark measured on the device.
+type BenchmarkResult struct {
+	ID   uint64     `protobuf:"varint,1,opt,name=ID" json:"ID,omitempty"`
+	Code string     `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+	Type DeviceType `protobuf:"varint,3,opt,name=type,enum=sonm.DeviceType" json:"type,omitempty"`
+	// Device identifies the device on the worker.
+	Device string `protobuf:"bytes,4,opt,name=device" json:"device,omitempty"`
+	// Fingerprint is the hash of the device hardware the value has been
+	// measured on.
+	Fingerprint string `protobuf:"bytes,5,opt,name=fingerprint" json:"fingerprint,omitempty"`
+	Result      uint64 `protobuf:"varint,6,opt,name=result" json:"result,omitempty"`
+	// Runner is the name of the runner that has measured the value, e.g.
+	// "docker" or "native".
+	Runner string `protobuf:"bytes,7,opt,name=runner" json:"runner,omitempty"`
+	// Timestamp is the time the value has been measured. Values of the host,
+	// like the number of CPU cores, are measured on each worker start.
+	Timestamp *Timestamp `protobuf:"bytes,8,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *BenchmarkResult) Reset()                    { *m = BenchmarkResult{} }
+func (m *BenchmarkResult) String() string            { return proto.CompactTextString(m) }
+func (*BenchmarkResult) ProtoMessage()               {}
+func (*BenchmarkResult) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{15} }
+
+func (m *BenchmarkResult) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *BenchmarkResult) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *BenchmarkResult) GetType() DeviceType {
+	if m != nil {
+		return m.Type
+	}
+	return DeviceType_DEV_UNKNOWN
+}
+
+func (m *BenchmarkResult) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
+func (m *BenchmarkResult) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+func (m *BenchmarkResult) GetResult() uint64 {
+	if m != nil {
+		return m.Result
+	}
+	return 0
+}
+
+func (m *BenchmarkResult) GetRunner() string {
+	if m != nil {
+		return m.Runner
+	}
+	return ""
+}
+
+func (m *BenchmarkResult) GetTimestamp() *Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type BenchmarkReportReply struct {
+	Worker *EthAddress `protobuf:"bytes,1,opt,name=worker" json:"worker,omitempty"`
+	// Fingerprint is the hash of the whole worker hardware.
+	Fingerprint string `protobuf:"bytes,2,opt,name=fingerprint" json:"fingerprint,omitempty"`
+	// Timestamp is the time the report has been signed.
+	Timestamp *Timestamp         `protobuf:"bytes,3,opt,name=timestamp" json:"timestamp,omitempty"`
+	Results   []*BenchmarkResult `protobuf:"bytes,4,rep,name=results" json:"results,omitempty"`
+	// Signature is made by the worker's ETH key over the Keccak256 hash of
+	// the canonical encoding of the report, which does not depend on the
+	// order of results, see BenchmarkReportReply.hash in the Go package.
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *BenchmarkReportReply) Reset()                    { *m = BenchmarkReportReply{} }
+func (m *BenchmarkReportReply) String() string            { return proto.CompactTextString(m) }
+func (*BenchmarkReportReply) ProtoMessage()               {}
+func (*BenchmarkReportReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{16} }
+
+func (m *BenchmarkReportReply) GetWorker() *EthAddress {
+	if m != nil {
+		return m.Worker
+	}
+	return nil
+}
+
+func (m *BenchmarkReportReply) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+func (m *BenchmarkReportReply) GetTimestamp() *Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *BenchmarkReportReply) GetResults() []*BenchmarkResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *BenchmarkReportReply) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 type DevicesReply struct {
 	CPU     *CPU     `protobuf:"bytes,1,opt,name=CPU" json:"CPU,omitempty"`
 	GPUs    []*GPU   `protobuf:"bytes,2,rep,name=GPUs" json:"GPUs,omitempty"`
 	RAM     *RAM     `protobuf:"bytes,3,opt,name=RAM" json:"RAM,omitempty"`
 	Network *Network `protobuf:"bytes,4,opt,name=network" json:"network,omitempty"`
 	Storage *Storage `protobuf:"bytes,5,opt,name=storage" json:"storage,omitempty"`
+	// FaultyGPUs maps hashes of GPU devices that are considered faulty by
+	// the health monitor to fault reasons. Ask plans including such devices
+	// are not placed on the market.
+	FaultyGPUs map[string]string `protobuf:"bytes,6,rep,name=faultyGPUs" json:"faultyGPUs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *DevicesReply) Reset()                    { *m = DevicesReply{} }
 func (m *DevicesReply) String() string            { return proto.CompactTextString(m) }
 func (*DevicesReply) ProtoMessage()               {}
-func (*DevicesReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{8} }
+func (*DevicesReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{17} }
 
 func (m *DevicesReply) GetCPU() *CPU {
 	if m != nil {
@@ -400,147 +833,1010 @@ func (m *DevicesReply) GetStorage() *Storage {
 	return nil
 }
 
-type PullTaskRequest struct {
-	DealId string `protobuf:"bytes,1,opt,name=dealId" json:"dealId,omitempty"`
-	TaskId string `protobuf:"bytes,2,opt,name=taskId" json:"taskId,omitempty"`
+func (m *DevicesReply) GetFaultyGPUs() map[string]string {
+	if m != nil {
+		return m.FaultyGPUs
+	}
+	return nil
 }
 
-func (m *PullTaskRequest) Reset()                    { *m = PullTaskRequest{} }
-func (m *PullTaskRequest) String() string            { return proto.CompactTextString(m) }
-func (*PullTaskRequest) ProtoMessage()               {}
-func (*PullTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{9} }
-
-func (m *PullTaskRequest) GetDealId() string {
+// MaintenanceWindow describes a period the worker does not serve deals in,
+// e.g. to reboot or upgrade the host. Spot deals are closed and no orders are
+// placed during the window, while forward orders that would overlap it are
+// not placed at all.
+type MaintenanceWindow struct {
+	// ID is assigned by the worker.
+	ID string `protobuf:"bytes,1,opt,name=ID" json:"ID,omitempty"`
+	// Start is the beginning of the one-off window.
+	Start *Timestamp `protobuf:"bytes,2,opt,name=start" json:"start,omitempty"`
+	// Schedule is the cron expression of beginnings of the recurring window,
+	// like "0 3 * * sun", evaluated in UTC. Either start or schedule must be
+	// specified.
+	Schedule string `protobuf:"bytes,3,opt,name=schedule" json:"schedule,omitempty"`
+	// Duration of the window. One-off windows with zero duration last until
+	// cancelled, while recurring windows require non-zero duration.
+	Duration *Duration `protobuf:"bytes,4,opt,name=duration" json:"duration,omitempty"`
+	// NextStart is the beginning of the current or the upcoming occurrence
+	// of the window, filled by the worker.
+	NextStart *Timestamp `protobuf:"bytes,5,opt,name=nextStart" json:"nextStart,omitempty"`
+	// NextEnd is the end of the current or the upcoming occurrence of the
+	// window, filled by the worker unless the window lasts until cancelled.
+	NextEnd *Timestamp `protobuf:"bytes,6,opt,name=nextEnd" json:"nextEnd,omitempty"`
+}
+
+func (m *MaintenanceWindow) Reset()                    { *m = MaintenanceWindow{} }
+func (m *MaintenanceWindow) String() string            { return proto.CompactTextString(m) }
+func (*MaintenanceWindow) ProtoMessage()               {}
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{18} }
+
+func (m *MaintenanceWindow) GetID() string {
 	if m != nil {
-		return m.DealId
+		return m.ID
 	}
 	return ""
 }
 
-func (m *PullTaskRequest) GetTaskId() string {
+func (m *MaintenanceWindow) GetStart() *Timestamp {
 	if m != nil {
-		return m.TaskId
+		return m.Start
 	}
-	return ""
+	return nil
 }
 
-type DealInfoReply struct {
-	Deal *Deal `protobuf:"bytes,1,opt,name=deal" json:"deal,omitempty"`
-	// List of currently running tasks.
-	Running map[string]*TaskStatusReply `protobuf:"bytes,2,rep,name=running" json:"running,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// List of completed tasks.
-	Completed map[string]*TaskStatusReply `protobuf:"bytes,3,rep,name=completed" json:"completed,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Resources is a real resources (cores, ram bytes, GPU devices, etc)
-	// allocated on a worker for this deal.
-	Resources *AskPlanResources `protobuf:"bytes,4,opt,name=resources" json:"resources,omitempty"`
-	PublicIPs []string          `protobuf:"bytes,5,rep,name=publicIPs" json:"publicIPs,omitempty"`
+func (m *MaintenanceWindow) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
 }
 
-func (m *DealInfoReply) Reset()                    { *m = DealInfoReply{} }
-func (m *DealInfoReply) String() string            { return proto.CompactTextString(m) }
-func (*DealInfoReply) ProtoMessage()               {}
-func (*DealInfoReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{10} }
-
-func (m *DealInfoReply) GetDeal() *Deal {
+func (m *MaintenanceWindow) GetDuration() *Duration {
 	if m != nil {
-		return m.Deal
+		return m.Duration
 	}
 	return nil
 }
 
-func (m *DealInfoReply) GetRunning() map[string]*TaskStatusReply {
+func (m *MaintenanceWindow) GetNextStart() *Timestamp {
 	if m != nil {
-		return m.Running
+		return m.NextStart
 	}
 	return nil
 }
 
-func (m *DealInfoReply) GetCompleted() map[string]*TaskStatusReply {
+func (m *MaintenanceWindow) GetNextEnd() *Timestamp {
 	if m != nil {
-		return m.Completed
+		return m.NextEnd
 	}
 	return nil
 }
 
-func (m *DealInfoReply) GetResources() *AskPlanResources {
+type MaintenanceReply struct {
+	Windows []*MaintenanceWindow `protobuf:"bytes,1,rep,name=windows" json:"windows,omitempty"`
+}
+
+func (m *MaintenanceReply) Reset()                    { *m = MaintenanceReply{} }
+func (m *MaintenanceReply) String() string            { return proto.CompactTextString(m) }
+func (*MaintenanceReply) ProtoMessage()               {}
+func (*MaintenanceReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{19} }
+
+func (m *MaintenanceReply) GetWindows() []*MaintenanceWindow {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+type AskPlanHistoryReply struct {
+	Changes []*AskPlanPriceChange `protobuf:"bytes,1,rep,name=changes" json:"changes,omitempty"`
+}
+
+func (m *AskPlanHistoryReply) Reset()                    { *m = AskPlanHistoryReply{} }
+func (m *AskPlanHistoryReply) String() string            { return proto.CompactTextString(m) }
+func (*AskPlanHistoryReply) ProtoMessage()               {}
+func (*AskPlanHistoryReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{20} }
+
+func (m *AskPlanHistoryReply) GetChanges() []*AskPlanPriceChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type PullTaskRequest struct {
+	DealId string `protobuf:"bytes,1,opt,name=dealId" json:"dealId,omitempty"`
+	TaskId string `protobuf:"bytes,2,opt,name=taskId" json:"taskId,omitempty"`
+	// Checkpoint points whether the latest checkpoint should be pulled
+	// instead of the image committed on stop.
+	Checkpoint bool `protobuf:"varint,3,opt,name=checkpoint" json:"checkpoint,omitempty"`
+}
+
+func (m *PullTaskRequest) Reset()                    { *m = PullTaskRequest{} }
+func (m *PullTaskRequest) String() string            { return proto.CompactTextString(m) }
+func (*PullTaskRequest) ProtoMessage()               {}
+func (*PullTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{21} }
+
+func (m *PullTaskRequest) GetDealId() string {
+	if m != nil {
+		return m.DealId
+	}
+	return ""
+}
+
+func (m *PullTaskRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *PullTaskRequest) GetCheckpoint() bool {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return false
+}
+
+type DealInfoReply struct {
+	Deal *Deal `protobuf:"bytes,1,opt,name=deal" json:"deal,omitempty"`
+	// List of currently running tasks.
+	Running map[string]*TaskStatusReply `protobuf:"bytes,2,rep,name=running" json:"running,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// List of completed tasks.
+	Completed map[string]*TaskStatusReply `protobuf:"bytes,3,rep,name=completed" json:"completed,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Resources is a real resources (cores, ram bytes, GPU devices, etc)
+	// allocated on a worker for this deal.
+	Resources *AskPlanResources `protobuf:"bytes,4,opt,name=resources" json:"resources,omitempty"`
+	PublicIPs []string          `protobuf:"bytes,5,rep,name=publicIPs" json:"publicIPs,omitempty"`
+	// Traffic transferred by the deal's tasks.
+	Traffic *NetworkTraffic `protobuf:"bytes,6,opt,name=traffic" json:"traffic,omitempty"`
+}
+
+func (m *DealInfoReply) Reset()                    { *m = DealInfoReply{} }
+func (m *DealInfoReply) String() string            { return proto.CompactTextString(m) }
+func (*DealInfoReply) ProtoMessage()               {}
+func (*DealInfoReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{22} }
+
+func (m *DealInfoReply) GetDeal() *Deal {
+	if m != nil {
+		return m.Deal
+	}
+	return nil
+}
+
+func (m *DealInfoReply) GetRunning() map[string]*TaskStatusReply {
+	if m != nil {
+		return m.Running
+	}
+	return nil
+}
+
+func (m *DealInfoReply) GetCompleted() map[string]*TaskStatusReply {
+	if m != nil {
+		return m.Completed
+	}
+	return nil
+}
+
+func (m *DealInfoReply) GetResources() *AskPlanResources {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *DealInfoReply) GetPublicIPs() []string {
+	if m != nil {
+		return m.PublicIPs
+	}
+	return nil
+}
+
+func (m *DealInfoReply) GetTraffic() *NetworkTraffic {
+	if m != nil {
+		return m.Traffic
+	}
+	return nil
+}
+
+type TaskStatusReply struct {
+	Status    TaskStatusReply_Status `protobuf:"varint,1,opt,name=status,enum=sonm.TaskStatusReply_Status" json:"status,omitempty"`
+	ImageName string                 `protobuf:"bytes,2,opt,name=imageName" json:"imageName,omitempty"`
+	PortMap   map[string]*Endpoints  `protobuf:"bytes,3,rep,name=portMap" json:"portMap,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Uptime    uint64                 `protobuf:"varint,4,opt,name=uptime" json:"uptime,omitempty"`
+	Usage     *ResourceUsage         `protobuf:"bytes,5,opt,name=usage" json:"usage,omitempty"`
+	// TODO: looks like we need to use TaskSpec here
+	AllocatedResources *AskPlanResources `protobuf:"bytes,6,opt,name=allocatedResources" json:"allocatedResources,omitempty"`
+	Tag                *TaskTag          `protobuf:"bytes,7,opt,name=tag" json:"tag,omitempty"`
+	// HealthLog contains the most recent health check probe results.
+	HealthLog []*TaskHealthProbe `protobuf:"bytes,8,rep,name=healthLog" json:"healthLog,omitempty"`
+	// GroupID is the ID of the task group this task belongs to, if any.
+	GroupID string `protobuf:"bytes,9,opt,name=groupID" json:"groupID,omitempty"`
+	// LastCheckpoint describes the most recent checkpoint of the task.
+	LastCheckpoint *TaskCheckpoint `protobuf:"bytes,10,opt,name=lastCheckpoint" json:"lastCheckpoint,omitempty"`
+	// Restarts is the number of times the worker has restarted the task.
+	Restarts uint32 `protobuf:"varint,11,opt,name=restarts" json:"restarts,omitempty"`
+	// LastExit describes the most recent exit of the task, if any.
+	LastExit *TaskExit `protobuf:"bytes,12,opt,name=lastExit" json:"lastExit,omitempty"`
+	// SpoolProgress describes the progress of the image pull while the
+	// task is in SPOOLING status.
+	SpoolProgress *ImagePullProgress `protobuf:"bytes,13,opt,name=spoolProgress" json:"spoolProgress,omitempty"`
+	// PortForwarding describes ports published through NPP, if any.
+	PortForwarding *PortForwarding `protobuf:"bytes,14,opt,name=portForwarding" json:"portForwarding,omitempty"`
+}
+
+func (m *TaskStatusReply) Reset()                    { *m = TaskStatusReply{} }
+func (m *TaskStatusReply) String() string            { return proto.CompactTextString(m) }
+func (*TaskStatusReply) ProtoMessage()               {}
+func (*TaskStatusReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{23} }
+
+func (m *TaskStatusReply) GetStatus() TaskStatusReply_Status {
+	if m != nil {
+		return m.Status
+	}
+	return TaskStatusReply_UNKNOWN
+}
+
+func (m *TaskStatusReply) GetImageName() string {
+	if m != nil {
+		return m.ImageName
+	}
+	return ""
+}
+
+func (m *TaskStatusReply) GetPortMap() map[string]*Endpoints {
+	if m != nil {
+		return m.PortMap
+	}
+	return nil
+}
+
+func (m *TaskStatusReply) GetUptime() uint64 {
+	if m != nil {
+		return m.Uptime
+	}
+	return 0
+}
+
+func (m *TaskStatusReply) GetUsage() *ResourceUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+func (m *TaskStatusReply) GetAllocatedResources() *AskPlanResources {
+	if m != nil {
+		return m.AllocatedResources
+	}
+	return nil
+}
+
+func (m *TaskStatusReply) GetTag() *TaskTag {
+	if m != nil {
+		return m.Tag
+	}
+	return nil
+}
+
+func (m *TaskStatusReply) GetHealthLog() []*TaskHealthProbe {
+	if m != nil {
+		return m.HealthLog
+	}
+	return nil
+}
+
+func (m *TaskStatusReply) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *TaskStatusReply) GetLastCheckpoint() *TaskCheckpoint {
+	if m != nil {
+		return m.LastCheckpoint
+	}
+	return nil
+}
+
+func (m *TaskStatusReply) GetRestarts() uint32 {
+	if m != nil {
+		return m.Restarts
+	}
+	return 0
+}
+
+func (m *TaskStatusReply) GetLastExit() *TaskExit {
+	if m != nil {
+		return m.LastExit
+	}
+	return nil
+}
+
+func (m *TaskStatusReply) GetSpoolProgress() *ImagePullProgress {
+	if m != nil {
+		return m.SpoolProgress
+	}
+	return nil
+}
+
+func (m *TaskStatusReply) GetPortForwarding() *PortForwarding {
+	if m != nil {
+		return m.PortForwarding
+	}
+	return nil
+}
+
+type ImagePullProgress struct {
+	// Current is the number of bytes downloaded.
+	Current uint64 `protobuf:"varint,1,opt,name=current" json:"current,omitempty"`
+	// Total is the number of bytes to download, known so far.
+	Total      uint64 `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+	Layers     uint32 `protobuf:"varint,3,opt,name=layers" json:"layers,omitempty"`
+	LayersDone uint32 `protobuf:"varint,4,opt,name=layersDone" json:"layersDone,omitempty"`
+}
+
+func (m *ImagePullProgress) Reset()                    { *m = ImagePullProgress{} }
+func (m *ImagePullProgress) String() string            { return proto.CompactTextString(m) }
+func (*ImagePullProgress) ProtoMessage()               {}
+func (*ImagePullProgress) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{24} }
+
+func (m *ImagePullProgress) GetCurrent() uint64 {
+	if m != nil {
+		return m.Current
+	}
+	return 0
+}
+
+func (m *ImagePullProgress) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ImagePullProgress) GetLayers() uint32 {
+	if m != nil {
+		return m.Layers
+	}
+	return 0
+}
+
+func (m *ImagePullProgress) GetLayersDone() uint32 {
+	if m != nil {
+		return m.LayersDone
+	}
+	return 0
+}
+
+type PrefetchImagesRequest struct {
+	Images   []string  `protobuf:"bytes,1,rep,name=images" json:"images,omitempty"`
+	Registry *Registry `protobuf:"bytes,2,opt,name=registry" json:"registry,omitempty"`
+	// Pin prevents the images from being removed from the image cache.
+	Pin bool `protobuf:"varint,3,opt,name=pin" json:"pin,omitempty"`
+}
+
+func (m *PrefetchImagesRequest) Reset()                    { *m = PrefetchImagesRequest{} }
+func (m *PrefetchImagesRequest) String() string            { return proto.CompactTextString(m) }
+func (*PrefetchImagesRequest) ProtoMessage()               {}
+func (*PrefetchImagesRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{25} }
+
+func (m *PrefetchImagesRequest) GetImages() []string {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
+func (m *PrefetchImagesRequest) GetRegistry() *Registry {
+	if m != nil {
+		return m.Registry
+	}
+	return nil
+}
+
+func (m *PrefetchImagesRequest) GetPin() bool {
+	if m != nil {
+		return m.Pin
+	}
+	return false
+}
+
+type CachedImage struct {
+	Image string `protobuf:"bytes,1,opt,name=image" json:"image,omitempty"`
+	// Size is the disk space taken by the image excluding layers shared
+	// with other images.
+	Size     uint64     `protobuf:"varint,2,opt,name=size" json:"size,omitempty"`
+	LastUsed *Timestamp `protobuf:"bytes,3,opt,name=lastUsed" json:"lastUsed,omitempty"`
+	Pinned   bool       `protobuf:"varint,4,opt,name=pinned" json:"pinned,omitempty"`
+	// Progress is set while the image is being pulled.
+	Progress *ImagePullProgress `protobuf:"bytes,5,opt,name=progress" json:"progress,omitempty"`
+	// Error describes the reason of the last failed pull, if any.
+	Error string `protobuf:"bytes,6,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *CachedImage) Reset()                    { *m = CachedImage{} }
+func (m *CachedImage) String() string            { return proto.CompactTextString(m) }
+func (*CachedImage) ProtoMessage()               {}
+func (*CachedImage) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{26} }
+
+func (m *CachedImage) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *CachedImage) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *CachedImage) GetLastUsed() *Timestamp {
+	if m != nil {
+		return m.LastUsed
+	}
+	return nil
+}
+
+func (m *CachedImage) GetPinned() bool {
+	if m != nil {
+		return m.Pinned
+	}
+	return false
+}
+
+func (m *CachedImage) GetProgress() *ImagePullProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+func (m *CachedImage) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ImagesReply struct {
+	Images []*CachedImage `protobuf:"bytes,1,rep,name=images" json:"images,omitempty"`
+	// DiskBudget is the disk space Docker images are allowed to take before
+	// least recently used images pulled by the worker are removed. Zero means
+	// unlimited.
+	DiskBudget uint64 `protobuf:"varint,2,opt,name=diskBudget" json:"diskBudget,omitempty"`
+	// DiskUsage is the disk space taken by Docker images.
+	DiskUsage uint64 `protobuf:"varint,3,opt,name=diskUsage" json:"diskUsage,omitempty"`
+}
+
+func (m *ImagesReply) Reset()                    { *m = ImagesReply{} }
+func (m *ImagesReply) String() string            { return proto.CompactTextString(m) }
+func (*ImagesReply) ProtoMessage()               {}
+func (*ImagesReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{27} }
+
+func (m *ImagesReply) GetImages() []*CachedImage {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
+func (m *ImagesReply) GetDiskBudget() uint64 {
+	if m != nil {
+		return m.DiskBudget
+	}
+	return 0
+}
+
+func (m *ImagesReply) GetDiskUsage() uint64 {
+	if m != nil {
+		return m.DiskUsage
+	}
+	return 0
+}
+
+type DealVolume struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// Size is the disk space taken by the volume.
+	Size uint64 `protobuf:"varint,2,opt,name=size" json:"size,omitempty"`
+}
+
+func (m *DealVolume) Reset()                    { *m = DealVolume{} }
+func (m *DealVolume) String() string            { return proto.CompactTextString(m) }
+func (*DealVolume) ProtoMessage()               {}
+func (*DealVolume) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{28} }
+
+func (m *DealVolume) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DealVolume) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type DealVolumesReply struct {
+	Volumes []*DealVolume `protobuf:"bytes,1,rep,name=volumes" json:"volumes,omitempty"`
+	// Quota is the storage quota of the deal volumes are counted against.
+	// Zero means unlimited.
+	Quota uint64 `protobuf:"varint,2,opt,name=quota" json:"quota,omitempty"`
+}
+
+func (m *DealVolumesReply) Reset()                    { *m = DealVolumesReply{} }
+func (m *DealVolumesReply) String() string            { return proto.CompactTextString(m) }
+func (*DealVolumesReply) ProtoMessage()               {}
+func (*DealVolumesReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{29} }
+
+func (m *DealVolumesReply) GetVolumes() []*DealVolume {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+func (m *DealVolumesReply) GetQuota() uint64 {
+	if m != nil {
+		return m.Quota
+	}
+	return 0
+}
+
+type TaskCheckpoint struct {
+	// Image is the reference of the checkpoint image.
+	Image     string     `protobuf:"bytes,1,opt,name=image" json:"image,omitempty"`
+	Timestamp *Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *TaskCheckpoint) Reset()                    { *m = TaskCheckpoint{} }
+func (m *TaskCheckpoint) String() string            { return proto.CompactTextString(m) }
+func (*TaskCheckpoint) ProtoMessage()               {}
+func (*TaskCheckpoint) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{30} }
+
+func (m *TaskCheckpoint) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *TaskCheckpoint) GetTimestamp() *Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type TaskExit struct {
+	ExitCode int32 `protobuf:"varint,1,opt,name=exitCode" json:"exitCode,omitempty"`
+	// OomKilled is set when the task has been killed by the OOM killer.
+	OomKilled  bool       `protobuf:"varint,2,opt,name=oomKilled" json:"oomKilled,omitempty"`
+	FinishedAt *Timestamp `protobuf:"bytes,3,opt,name=finishedAt" json:"finishedAt,omitempty"`
+	// CrashLoop is set when the worker has given up restarting the task,
+	// because it kept crashing shortly after each start.
+	CrashLoop bool `protobuf:"varint,4,opt,name=crashLoop" json:"crashLoop,omitempty"`
+}
+
+func (m *TaskExit) Reset()                    { *m = TaskExit{} }
+func (m *TaskExit) String() string            { return proto.CompactTextString(m) }
+func (*TaskExit) ProtoMessage()               {}
+func (*TaskExit) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{31} }
+
+func (m *TaskExit) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *TaskExit) GetOomKilled() bool {
+	if m != nil {
+		return m.OomKilled
+	}
+	return false
+}
+
+func (m *TaskExit) GetFinishedAt() *Timestamp {
+	if m != nil {
+		return m.FinishedAt
+	}
+	return nil
+}
+
+func (m *TaskExit) GetCrashLoop() bool {
+	if m != nil {
+		return m.CrashLoop
+	}
+	return false
+}
+
+type TaskExecRequest struct {
+	// Id is the task ID. Required in the first request only.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Cmd is the command to execute. Required in the first request only.
+	Cmd []string `protobuf:"bytes,2,rep,name=cmd" json:"cmd,omitempty"`
+	// Env are additional environment variables in "KEY=VALUE" form.
+	Env []string `protobuf:"bytes,3,rep,name=env" json:"env,omitempty"`
+	// Tty allocates a pseudo-terminal for the command. Stdout and stderr are
+	// merged in this case.
+	Tty   bool   `protobuf:"varint,4,opt,name=tty" json:"tty,omitempty"`
+	Stdin []byte `protobuf:"bytes,5,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	// CloseStdin closes the command standard input after writing stdin data.
+	CloseStdin bool `protobuf:"varint,6,opt,name=closeStdin" json:"closeStdin,omitempty"`
+	// Window is the new terminal size, if changed.
+	Window *TaskExecWindow `protobuf:"bytes,7,opt,name=window" json:"window,omitempty"`
+}
+
+func (m *TaskExecRequest) Reset()                    { *m = TaskExecRequest{} }
+func (m *TaskExecRequest) String() string            { return proto.CompactTextString(m) }
+func (*TaskExecRequest) ProtoMessage()               {}
+func (*TaskExecRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{32} }
+
+func (m *TaskExecRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *TaskExecRequest) GetCmd() []string {
+	if m != nil {
+		return m.Cmd
+	}
+	return nil
+}
+
+func (m *TaskExecRequest) GetEnv() []string {
+	if m != nil {
+		return m.Env
+	}
+	return nil
+}
+
+func (m *TaskExecRequest) GetTty() bool {
+	if m != nil {
+		return m.Tty
+	}
+	return false
+}
+
+func (m *TaskExecRequest) GetStdin() []byte {
+	if m != nil {
+		return m.Stdin
+	}
+	return nil
+}
+
+func (m *TaskExecRequest) GetCloseStdin() bool {
+	if m != nil {
+		return m.CloseStdin
+	}
+	return false
+}
+
+func (m *TaskExecRequest) GetWindow() *TaskExecWindow {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+type TaskExecWindow struct {
+	Width  uint32 `protobuf:"varint,1,opt,name=width" json:"width,omitempty"`
+	Height uint32 `protobuf:"varint,2,opt,name=height" json:"height,omitempty"`
+}
+
+func (m *TaskExecWindow) Reset()                    { *m = TaskExecWindow{} }
+func (m *TaskExecWindow) String() string            { return proto.CompactTextString(m) }
+func (*TaskExecWindow) ProtoMessage()               {}
+func (*TaskExecWindow) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{33} }
+
+func (m *TaskExecWindow) GetWidth() uint32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *TaskExecWindow) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type TaskExecReply struct {
+	Stdout []byte `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr []byte `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	// Exited is set in the last reply of the stream only.
+	Exited   bool  `protobuf:"varint,3,opt,name=exited" json:"exited,omitempty"`
+	ExitCode int32 `protobuf:"varint,4,opt,name=exitCode" json:"exitCode,omitempty"`
+}
+
+func (m *TaskExecReply) Reset()                    { *m = TaskExecReply{} }
+func (m *TaskExecReply) String() string            { return proto.CompactTextString(m) }
+func (*TaskExecReply) ProtoMessage()               {}
+func (*TaskExecReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{34} }
+
+func (m *TaskExecReply) GetStdout() []byte {
+	if m != nil {
+		return m.Stdout
+	}
+	return nil
+}
+
+func (m *TaskExecReply) GetStderr() []byte {
+	if m != nil {
+		return m.Stderr
+	}
+	return nil
+}
+
+func (m *TaskExecReply) GetExited() bool {
+	if m != nil {
+		return m.Exited
+	}
+	return false
+}
+
+func (m *TaskExecReply) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+type TaskCopyToRequest struct {
+	// Id is the task ID. Required in the first request only.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Path is the directory inside the task to extract the archive into.
+	// Required in the first request only.
+	Path string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	// Chunk is the next part of the tar archive.
+	Chunk []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (m *TaskCopyToRequest) Reset()                    { *m = TaskCopyToRequest{} }
+func (m *TaskCopyToRequest) String() string            { return proto.CompactTextString(m) }
+func (*TaskCopyToRequest) ProtoMessage()               {}
+func (*TaskCopyToRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{35} }
+
+func (m *TaskCopyToRequest) GetId() string {
 	if m != nil {
-		return m.Resources
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-func (m *DealInfoReply) GetPublicIPs() []string {
+func (m *TaskCopyToRequest) GetPath() string {
 	if m != nil {
-		return m.PublicIPs
+		return m.Path
+	}
+	return ""
+}
+
+func (m *TaskCopyToRequest) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
 	}
 	return nil
 }
 
-type TaskStatusReply struct {
-	Status    TaskStatusReply_Status `protobuf:"varint,1,opt,name=status,enum=sonm.TaskStatusReply_Status" json:"status,omitempty"`
-	ImageName string                 `protobuf:"bytes,2,opt,name=imageName" json:"imageName,omitempty"`
-	PortMap   map[string]*Endpoints  `protobuf:"bytes,3,rep,name=portMap" json:"portMap,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Uptime    uint64                 `protobuf:"varint,4,opt,name=uptime" json:"uptime,omitempty"`
-	Usage     *ResourceUsage         `protobuf:"bytes,5,opt,name=usage" json:"usage,omitempty"`
-	// TODO: looks like we need to use TaskSpec here
-	AllocatedResources *AskPlanResources `protobuf:"bytes,6,opt,name=allocatedResources" json:"allocatedResources,omitempty"`
-	Tag                *TaskTag          `protobuf:"bytes,7,opt,name=tag" json:"tag,omitempty"`
+type TaskCopyFromRequest struct {
+	// Id is the task ID.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Path is the file or directory inside the task to copy.
+	Path string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
 }
 
-func (m *TaskStatusReply) Reset()                    { *m = TaskStatusReply{} }
-func (m *TaskStatusReply) String() string            { return proto.CompactTextString(m) }
-func (*TaskStatusReply) ProtoMessage()               {}
-func (*TaskStatusReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{11} }
+func (m *TaskCopyFromRequest) Reset()                    { *m = TaskCopyFromRequest{} }
+func (m *TaskCopyFromRequest) String() string            { return proto.CompactTextString(m) }
+func (*TaskCopyFromRequest) ProtoMessage()               {}
+func (*TaskCopyFromRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{36} }
 
-func (m *TaskStatusReply) GetStatus() TaskStatusReply_Status {
+func (m *TaskCopyFromRequest) GetId() string {
 	if m != nil {
-		return m.Status
+		return m.Id
 	}
-	return TaskStatusReply_UNKNOWN
+	return ""
 }
 
-func (m *TaskStatusReply) GetImageName() string {
+func (m *TaskCopyFromRequest) GetPath() string {
 	if m != nil {
-		return m.ImageName
+		return m.Path
 	}
 	return ""
 }
 
-func (m *TaskStatusReply) GetPortMap() map[string]*Endpoints {
+type TaskMetricsRequest struct {
+	// Id is the task ID.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// From is the beginning of the time range. Defaults to the oldest
+	// collected sample.
+	From *Timestamp `protobuf:"bytes,2,opt,name=from" json:"from,omitempty"`
+	// To is the end of the time range. Defaults to now.
+	To *Timestamp `protobuf:"bytes,3,opt,name=to" json:"to,omitempty"`
+	// Step is the resolution of the returned series, samples within a step
+	// are averaged. Defaults to the collection interval.
+	Step *Duration `protobuf:"bytes,4,opt,name=step" json:"step,omitempty"`
+}
+
+func (m *TaskMetricsRequest) Reset()                    { *m = TaskMetricsRequest{} }
+func (m *TaskMetricsRequest) String() string            { return proto.CompactTextString(m) }
+func (*TaskMetricsRequest) ProtoMessage()               {}
+func (*TaskMetricsRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{37} }
+
+func (m *TaskMetricsRequest) GetId() string {
 	if m != nil {
-		return m.PortMap
+		return m.Id
+	}
+	return ""
+}
+
+func (m *TaskMetricsRequest) GetFrom() *Timestamp {
+	if m != nil {
+		return m.From
 	}
 	return nil
 }
 
-func (m *TaskStatusReply) GetUptime() uint64 {
+func (m *TaskMetricsRequest) GetTo() *Timestamp {
 	if m != nil {
-		return m.Uptime
+		return m.To
+	}
+	return nil
+}
+
+func (m *TaskMetricsRequest) GetStep() *Duration {
+	if m != nil {
+		return m.Step
+	}
+	return nil
+}
+
+type TaskMetricsSample struct {
+	Timestamp *Timestamp `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	// CPU is the CPU usage in percents of a single core.
+	Cpu float64 `protobuf:"fixed64,2,opt,name=cpu" json:"cpu,omitempty"`
+	// Memory is the memory usage in bytes.
+	Memory uint64 `protobuf:"varint,3,opt,name=memory" json:"memory,omitempty"`
+	// RxBytesRate and TxBytesRate are network traffic rates in bytes per
+	// second, summed over all networks.
+	RxBytesRate float64 `protobuf:"fixed64,4,opt,name=rxBytesRate" json:"rxBytesRate,omitempty"`
+	TxBytesRate float64 `protobuf:"fixed64,5,opt,name=txBytesRate" json:"txBytesRate,omitempty"`
+	// BlockReadRate and BlockWriteRate are block IO rates in bytes per
+	// second.
+	BlockReadRate  float64 `protobuf:"fixed64,6,opt,name=blockReadRate" json:"blockReadRate,omitempty"`
+	BlockWriteRate float64 `protobuf:"fixed64,7,opt,name=blockWriteRate" json:"blockWriteRate,omitempty"`
+	// GPU is the average utilization of GPUs allocated to the task in
+	// percents.
+	Gpu float64 `protobuf:"fixed64,8,opt,name=gpu" json:"gpu,omitempty"`
+}
+
+func (m *TaskMetricsSample) Reset()                    { *m = TaskMetricsSample{} }
+func (m *TaskMetricsSample) String() string            { return proto.CompactTextString(m) }
+func (*TaskMetricsSample) ProtoMessage()               {}
+func (*TaskMetricsSample) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{38} }
+
+func (m *TaskMetricsSample) GetTimestamp() *Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *TaskMetricsSample) GetCpu() float64 {
+	if m != nil {
+		return m.Cpu
 	}
 	return 0
 }
 
-func (m *TaskStatusReply) GetUsage() *ResourceUsage {
+func (m *TaskMetricsSample) GetMemory() uint64 {
 	if m != nil {
-		return m.Usage
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *TaskMetricsSample) GetRxBytesRate() float64 {
+	if m != nil {
+		return m.RxBytesRate
+	}
+	return 0
+}
+
+func (m *TaskMetricsSample) GetTxBytesRate() float64 {
+	if m != nil {
+		return m.TxBytesRate
+	}
+	return 0
+}
+
+func (m *TaskMetricsSample) GetBlockReadRate() float64 {
+	if m != nil {
+		return m.BlockReadRate
+	}
+	return 0
+}
+
+func (m *TaskMetricsSample) GetBlockWriteRate() float64 {
+	if m != nil {
+		return m.BlockWriteRate
+	}
+	return 0
+}
+
+func (m *TaskMetricsSample) GetGpu() float64 {
+	if m != nil {
+		return m.Gpu
+	}
+	return 0
+}
+
+type TaskMetricsReply struct {
+	Samples []*TaskMetricsSample `protobuf:"bytes,1,rep,name=samples" json:"samples,omitempty"`
+}
+
+func (m *TaskMetricsReply) Reset()                    { *m = TaskMetricsReply{} }
+func (m *TaskMetricsReply) String() string            { return proto.CompactTextString(m) }
+func (*TaskMetricsReply) ProtoMessage()               {}
+func (*TaskMetricsReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{39} }
+
+func (m *TaskMetricsReply) GetSamples() []*TaskMetricsSample {
+	if m != nil {
+		return m.Samples
 	}
 	return nil
 }
 
-func (m *TaskStatusReply) GetAllocatedResources() *AskPlanResources {
+type TaskHealthProbe struct {
+	Start    *Timestamp `protobuf:"bytes,1,opt,name=start" json:"start,omitempty"`
+	End      *Timestamp `protobuf:"bytes,2,opt,name=end" json:"end,omitempty"`
+	ExitCode int32      `protobuf:"varint,3,opt,name=exitCode" json:"exitCode,omitempty"`
+	Output   string     `protobuf:"bytes,4,opt,name=output" json:"output,omitempty"`
+}
+
+func (m *TaskHealthProbe) Reset()                    { *m = TaskHealthProbe{} }
+func (m *TaskHealthProbe) String() string            { return proto.CompactTextString(m) }
+func (*TaskHealthProbe) ProtoMessage()               {}
+func (*TaskHealthProbe) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{40} }
+
+func (m *TaskHealthProbe) GetStart() *Timestamp {
 	if m != nil {
-		return m.AllocatedResources
+		return m.Start
 	}
 	return nil
 }
 
-func (m *TaskStatusReply) GetTag() *TaskTag {
+func (m *TaskHealthProbe) GetEnd() *Timestamp {
 	if m != nil {
-		return m.Tag
+		return m.End
 	}
 	return nil
 }
 
+func (m *TaskHealthProbe) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *TaskHealthProbe) GetOutput() string {
+	if m != nil {
+		return m.Output
+	}
+	return ""
+}
+
 type TaskPool struct {
 	All  *AskPlanResources            `protobuf:"bytes,1,opt,name=all" json:"all,omitempty"`
 	Used map[string]*AskPlanResources `protobuf:"bytes,2,rep,name=used" json:"used,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -549,7 +1845,7 @@ type TaskPool struct {
 func (m *TaskPool) Reset()                    { *m = TaskPool{} }
 func (m *TaskPool) String() string            { return proto.CompactTextString(m) }
 func (*TaskPool) ProtoMessage()               {}
-func (*TaskPool) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{12} }
+func (*TaskPool) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{41} }
 
 func (m *TaskPool) GetAll() *AskPlanResources {
 	if m != nil {
@@ -577,7 +1873,7 @@ type AskPlanPool struct {
 func (m *AskPlanPool) Reset()                    { *m = AskPlanPool{} }
 func (m *AskPlanPool) String() string            { return proto.CompactTextString(m) }
 func (*AskPlanPool) ProtoMessage()               {}
-func (*AskPlanPool) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{13} }
+func (*AskPlanPool) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{42} }
 
 func (m *AskPlanPool) GetAll() *AskPlanResources {
 	if m != nil {
@@ -630,7 +1926,7 @@ type SchedulerData struct {
 func (m *SchedulerData) Reset()                    { *m = SchedulerData{} }
 func (m *SchedulerData) String() string            { return proto.CompactTextString(m) }
 func (*SchedulerData) ProtoMessage()               {}
-func (*SchedulerData) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{14} }
+func (*SchedulerData) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{43} }
 
 func (m *SchedulerData) GetTaskToAskPlan() map[string]string {
 	if m != nil {
@@ -662,7 +1958,7 @@ type SalesmanData struct {
 func (m *SalesmanData) Reset()                    { *m = SalesmanData{} }
 func (m *SalesmanData) String() string            { return proto.CompactTextString(m) }
 func (*SalesmanData) ProtoMessage()               {}
-func (*SalesmanData) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{15} }
+func (*SalesmanData) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{44} }
 
 func (m *SalesmanData) GetAskPlanCGroups() map[string]string {
 	if m != nil {
@@ -693,7 +1989,7 @@ type DebugStateReply struct {
 func (m *DebugStateReply) Reset()                    { *m = DebugStateReply{} }
 func (m *DebugStateReply) String() string            { return proto.CompactTextString(m) }
 func (*DebugStateReply) ProtoMessage()               {}
-func (*DebugStateReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{16} }
+func (*DebugStateReply) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{45} }
 
 func (m *DebugStateReply) GetSchedulerData() *SchedulerData {
 	if m != nil {
@@ -716,7 +2012,7 @@ type PurgeTasksRequest struct {
 func (m *PurgeTasksRequest) Reset()                    { *m = PurgeTasksRequest{} }
 func (m *PurgeTasksRequest) String() string            { return proto.CompactTextString(m) }
 func (*PurgeTasksRequest) ProtoMessage()               {}
-func (*PurgeTasksRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{17} }
+func (*PurgeTasksRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{46} }
 
 func (m *PurgeTasksRequest) GetDealID() *BigInt {
 	if m != nil {
@@ -731,7 +2027,7 @@ type WorkerMetricsRequest struct {
 func (m *WorkerMetricsRequest) Reset()                    { *m = WorkerMetricsRequest{} }
 func (m *WorkerMetricsRequest) String() string            { return proto.CompactTextString(m) }
 func (*WorkerMetricsRequest) ProtoMessage()               {}
-func (*WorkerMetricsRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{18} }
+func (*WorkerMetricsRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{47} }
 
 type WorkerMetricsResponse struct {
 	Metrics map[string]float64 `protobuf:"bytes,1,rep,name=metrics" json:"metrics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
@@ -740,7 +2036,7 @@ type WorkerMetricsResponse struct {
 func (m *WorkerMetricsResponse) Reset()                    { *m = WorkerMetricsResponse{} }
 func (m *WorkerMetricsResponse) String() string            { return proto.CompactTextString(m) }
 func (*WorkerMetricsResponse) ProtoMessage()               {}
-func (*WorkerMetricsResponse) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{19} }
+func (*WorkerMetricsResponse) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{48} }
 
 func (m *WorkerMetricsResponse) GetMetrics() map[string]float64 {
 	if m != nil {
@@ -761,7 +2057,7 @@ type WorkerAddCapabilityRequest struct {
 func (m *WorkerAddCapabilityRequest) Reset()                    { *m = WorkerAddCapabilityRequest{} }
 func (m *WorkerAddCapabilityRequest) String() string            { return proto.CompactTextString(m) }
 func (*WorkerAddCapabilityRequest) ProtoMessage()               {}
-func (*WorkerAddCapabilityRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{20} }
+func (*WorkerAddCapabilityRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{49} }
 
 func (m *WorkerAddCapabilityRequest) GetSubject() *EthAddress {
 	if m != nil {
@@ -790,7 +2086,7 @@ type WorkerAddCapabilityResponse struct {
 func (m *WorkerAddCapabilityResponse) Reset()                    { *m = WorkerAddCapabilityResponse{} }
 func (m *WorkerAddCapabilityResponse) String() string            { return proto.CompactTextString(m) }
 func (*WorkerAddCapabilityResponse) ProtoMessage()               {}
-func (*WorkerAddCapabilityResponse) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{21} }
+func (*WorkerAddCapabilityResponse) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{50} }
 
 type WorkerRemoveCapabilityRequest struct {
 	// Subject is the ETH address of a subject whose capabilities are removed.
@@ -802,7 +2098,7 @@ type WorkerRemoveCapabilityRequest struct {
 func (m *WorkerRemoveCapabilityRequest) Reset()                    { *m = WorkerRemoveCapabilityRequest{} }
 func (m *WorkerRemoveCapabilityRequest) String() string            { return proto.CompactTextString(m) }
 func (*WorkerRemoveCapabilityRequest) ProtoMessage()               {}
-func (*WorkerRemoveCapabilityRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{22} }
+func (*WorkerRemoveCapabilityRequest) Descriptor() ([]byte, []int) { return fileDescriptor21, []int{51} }
 
 func (m *WorkerRemoveCapabilityRequest) GetSubject() *EthAddress {
 	if m != nil {
@@ -825,22 +2121,51 @@ func (m *WorkerRemoveCapabilityResponse) Reset()         { *m = WorkerRemoveCapa
 func (m *WorkerRemoveCapabilityResponse) String() string { return proto.CompactTextString(m) }
 func (*WorkerRemoveCapabilityResponse) ProtoMessage()    {}
 func (*WorkerRemoveCapabilityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor21, []int{23}
+	return fileDescriptor21, []int{52}
 }
 
 func init() {
 	proto.RegisterType((*TaskTag)(nil), "sonm.TaskTag")
 	proto.RegisterType((*TaskSpec)(nil), "sonm.TaskSpec")
 	proto.RegisterType((*StartTaskRequest)(nil), "sonm.StartTaskRequest")
+	proto.RegisterType((*RestoreTaskRequest)(nil), "sonm.RestoreTaskRequest")
 	proto.RegisterType((*WorkerJoinNetworkRequest)(nil), "sonm.WorkerJoinNetworkRequest")
 	proto.RegisterType((*StartTaskReply)(nil), "sonm.StartTaskReply")
+	proto.RegisterType((*PortForwarding)(nil), "sonm.PortForwarding")
+	proto.RegisterType((*TaskGroupSpec)(nil), "sonm.TaskGroupSpec")
+	proto.RegisterType((*StartTaskGroupRequest)(nil), "sonm.StartTaskGroupRequest")
+	proto.RegisterType((*StartTaskGroupReply)(nil), "sonm.StartTaskGroupReply")
+	proto.RegisterType((*TaskGroupStatusReply)(nil), "sonm.TaskGroupStatusReply")
 	proto.RegisterType((*StatusReply)(nil), "sonm.StatusReply")
+	proto.RegisterType((*DrainStatus)(nil), "sonm.DrainStatus")
 	proto.RegisterType((*AskPlansReply)(nil), "sonm.AskPlansReply")
 	proto.RegisterType((*TaskListReply)(nil), "sonm.TaskListReply")
+	proto.RegisterType((*BenchmarkResult)(nil), "sonm.BenchmarkResult")
+	proto.RegisterType((*BenchmarkReportReply)(nil), "sonm.BenchmarkReportReply")
 	proto.RegisterType((*DevicesReply)(nil), "sonm.DevicesReply")
+	proto.RegisterType((*MaintenanceWindow)(nil), "sonm.MaintenanceWindow")
+	proto.RegisterType((*MaintenanceReply)(nil), "sonm.MaintenanceReply")
+	proto.RegisterType((*AskPlanHistoryReply)(nil), "sonm.AskPlanHistoryReply")
 	proto.RegisterType((*PullTaskRequest)(nil), "sonm.PullTaskRequest")
 	proto.RegisterType((*DealInfoReply)(nil), "sonm.DealInfoReply")
 	proto.RegisterType((*TaskStatusReply)(nil), "sonm.TaskStatusReply")
+	proto.RegisterType((*ImagePullProgress)(nil), "sonm.ImagePullProgress")
+	proto.RegisterType((*PrefetchImagesRequest)(nil), "sonm.PrefetchImagesRequest")
+	proto.RegisterType((*CachedImage)(nil), "sonm.CachedImage")
+	proto.RegisterType((*ImagesReply)(nil), "sonm.ImagesReply")
+	proto.RegisterType((*DealVolume)(nil), "sonm.DealVolume")
+	proto.RegisterType((*DealVolumesReply)(nil), "sonm.DealVolumesReply")
+	proto.RegisterType((*TaskCheckpoint)(nil), "sonm.TaskCheckpoint")
+	proto.RegisterType((*TaskExit)(nil), "sonm.TaskExit")
+	proto.RegisterType((*TaskExecRequest)(nil), "sonm.TaskExecRequest")
+	proto.RegisterType((*TaskExecWindow)(nil), "sonm.TaskExecWindow")
+	proto.RegisterType((*TaskExecReply)(nil), "sonm.TaskExecReply")
+	proto.RegisterType((*TaskCopyToRequest)(nil), "sonm.TaskCopyToRequest")
+	proto.RegisterType((*TaskCopyFromRequest)(nil), "sonm.TaskCopyFromRequest")
+	proto.RegisterType((*TaskMetricsRequest)(nil), "sonm.TaskMetricsRequest")
+	proto.RegisterType((*TaskMetricsSample)(nil), "sonm.TaskMetricsSample")
+	proto.RegisterType((*TaskMetricsReply)(nil), "sonm.TaskMetricsReply")
+	proto.RegisterType((*TaskHealthProbe)(nil), "sonm.TaskHealthProbe")
 	proto.RegisterType((*TaskPool)(nil), "sonm.TaskPool")
 	proto.RegisterType((*AskPlanPool)(nil), "sonm.AskPlanPool")
 	proto.RegisterType((*SchedulerData)(nil), "sonm.SchedulerData")
@@ -893,9 +2218,23 @@ type WorkerManagementClient interface {
 	// PurgeAsksPlansDetailed removes all ask-plans in sync manner and returns error for each ask-plan (if it's present)
 	PurgeAskPlansDetailed(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ErrorByStringID, error)
 	// Schedule maintenance for worker: it will attempt to close all deals till that time point
+	// Deprecated: use AddMaintenance instead, which allows to limit the maintenance duration.
 	ScheduleMaintenance(ctx context.Context, in *Timestamp, opts ...grpc.CallOption) (*Empty, error)
 	// Get next planned maintenance
 	NextMaintenance(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Timestamp, error)
+	// AddMaintenance queues the maintenance window, returning its ID.
+	AddMaintenance(ctx context.Context, in *MaintenanceWindow, opts ...grpc.CallOption) (*ID, error)
+	// CancelMaintenance removes the maintenance window by its ID.
+	CancelMaintenance(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error)
+	// Maintenance lists queued maintenance windows.
+	Maintenance(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MaintenanceReply, error)
+	// Drain makes the worker finish its deals without taking new ones:
+	// active orders are cancelled, forward deals run to their term, while
+	// tasks of spot deals receive SIGTERM and the deals are closed after the
+	// notice period. The progress is reported by Status.
+	Drain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	// Undrain makes the drained worker take new deals again.
+	Undrain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 	// Get useful debugging info - scheduler state and salesman state
 	DebugState(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DebugStateReply, error)
 	// Remove benchmark cached value by specified benchmark ID
@@ -908,6 +2247,13 @@ type WorkerManagementClient interface {
 	AddCapability(ctx context.Context, in *WorkerAddCapabilityRequest, opts ...grpc.CallOption) (*WorkerAddCapabilityResponse, error)
 	// RemoveCapability allows to revoke provided admin capabilities from another subject.
 	RemoveCapability(ctx context.Context, in *WorkerRemoveCapabilityRequest, opts ...grpc.CallOption) (*WorkerRemoveCapabilityResponse, error)
+	// PrefetchImages schedules pulling of the specified images in the
+	// background, so tasks using them start without waiting for the pull.
+	PrefetchImages(ctx context.Context, in *PrefetchImagesRequest, opts ...grpc.CallOption) (*ErrorByStringID, error)
+	// Images returns the state of the worker's image cache.
+	Images(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ImagesReply, error)
+	// AskPlanHistory returns price changes of the specified ask plan.
+	AskPlanHistory(ctx context.Context, in *ID, opts ...grpc.CallOption) (*AskPlanHistoryReply, error)
 }
 
 type workerManagementClient struct {
@@ -1017,6 +2363,51 @@ func (c *workerManagementClient) NextMaintenance(ctx context.Context, in *Empty,
 	return out, nil
 }
 
+func (c *workerManagementClient) AddMaintenance(ctx context.Context, in *MaintenanceWindow, opts ...grpc.CallOption) (*ID, error) {
+	out := new(ID)
+	err := grpc.Invoke(ctx, "/sonm.WorkerManagement/AddMaintenance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerManagementClient) CancelMaintenance(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/sonm.WorkerManagement/CancelMaintenance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerManagementClient) Maintenance(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MaintenanceReply, error) {
+	out := new(MaintenanceReply)
+	err := grpc.Invoke(ctx, "/sonm.WorkerManagement/Maintenance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerManagementClient) Drain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/sonm.WorkerManagement/Drain", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerManagementClient) Undrain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/sonm.WorkerManagement/Undrain", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workerManagementClient) DebugState(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DebugStateReply, error) {
 	out := new(DebugStateReply)
 	err := grpc.Invoke(ctx, "/sonm.WorkerManagement/DebugState", in, out, c.cc, opts...)
@@ -1071,6 +2462,33 @@ func (c *workerManagementClient) RemoveCapability(ctx context.Context, in *Worke
 	return out, nil
 }
 
+func (c *workerManagementClient) PrefetchImages(ctx context.Context, in *PrefetchImagesRequest, opts ...grpc.CallOption) (*ErrorByStringID, error) {
+	out := new(ErrorByStringID)
+	err := grpc.Invoke(ctx, "/sonm.WorkerManagement/PrefetchImages", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerManagementClient) Images(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ImagesReply, error) {
+	out := new(ImagesReply)
+	err := grpc.Invoke(ctx, "/sonm.WorkerManagement/Images", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerManagementClient) AskPlanHistory(ctx context.Context, in *ID, opts ...grpc.CallOption) (*AskPlanHistoryReply, error) {
+	out := new(AskPlanHistoryReply)
+	err := grpc.Invoke(ctx, "/sonm.WorkerManagement/AskPlanHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for WorkerManagement service
 
 type WorkerManagementServer interface {
@@ -1099,9 +2517,23 @@ type WorkerManagementServer interface {
 	// PurgeAsksPlansDetailed removes all ask-plans in sync manner and returns error for each ask-plan (if it's present)
 	PurgeAskPlansDetailed(context.Context, *Empty) (*ErrorByStringID, error)
 	// Schedule maintenance for worker: it will attempt to close all deals till that time point
+	// Deprecated: use AddMaintenance instead, which allows to limit the maintenance duration.
 	ScheduleMaintenance(context.Context, *Timestamp) (*Empty, error)
 	// Get next planned maintenance
 	NextMaintenance(context.Context, *Empty) (*Timestamp, error)
+	// AddMaintenance queues the maintenance window, returning its ID.
+	AddMaintenance(context.Context, *MaintenanceWindow) (*ID, error)
+	// CancelMaintenance removes the maintenance window by its ID.
+	CancelMaintenance(context.Context, *ID) (*Empty, error)
+	// Maintenance lists queued maintenance windows.
+	Maintenance(context.Context, *Empty) (*MaintenanceReply, error)
+	// Drain makes the worker finish its deals without taking new ones:
+	// active orders are cancelled, forward deals run to their term, while
+	// tasks of spot deals receive SIGTERM and the deals are closed after the
+	// notice period. The progress is reported by Status.
+	Drain(context.Context, *Empty) (*Empty, error)
+	// Undrain makes the drained worker take new deals again.
+	Undrain(context.Context, *Empty) (*Empty, error)
 	// Get useful debugging info - scheduler state and salesman state
 	DebugState(context.Context, *Empty) (*DebugStateReply, error)
 	// Remove benchmark cached value by specified benchmark ID
@@ -1114,6 +2546,13 @@ type WorkerManagementServer interface {
 	AddCapability(context.Context, *WorkerAddCapabilityRequest) (*WorkerAddCapabilityResponse, error)
 	// RemoveCapability allows to revoke provided admin capabilities from another subject.
 	RemoveCapability(context.Context, *WorkerRemoveCapabilityRequest) (*WorkerRemoveCapabilityResponse, error)
+	// PrefetchImages schedules pulling of the specified images in the
+	// background, so tasks using them start without waiting for the pull.
+	PrefetchImages(context.Context, *PrefetchImagesRequest) (*ErrorByStringID, error)
+	// Images returns the state of the worker's image cache.
+	Images(context.Context, *Empty) (*ImagesReply, error)
+	// AskPlanHistory returns price changes of the specified ask plan.
+	AskPlanHistory(context.Context, *ID) (*AskPlanHistoryReply, error)
 }
 
 func RegisterWorkerManagementServer(s *grpc.Server, srv WorkerManagementServer) {
@@ -1205,115 +2644,205 @@ func _WorkerManagement_AskPlans_Handler(srv interface{}, ctx context.Context, de
 		FullMethod: "/sonm.WorkerManagement/AskPlans",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerManagementServer).AskPlans(ctx, req.(*Empty))
+		return srv.(WorkerManagementServer).AskPlans(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerManagement_CreateAskPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AskPlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerManagementServer).CreateAskPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.WorkerManagement/CreateAskPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerManagementServer).CreateAskPlan(ctx, req.(*AskPlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerManagement_RemoveAskPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerManagementServer).RemoveAskPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.WorkerManagement/RemoveAskPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerManagementServer).RemoveAskPlan(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerManagement_PurgeAskPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerManagementServer).PurgeAskPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.WorkerManagement/PurgeAskPlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerManagementServer).PurgeAskPlans(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerManagement_PurgeAskPlansDetailed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerManagementServer).PurgeAskPlansDetailed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.WorkerManagement/PurgeAskPlansDetailed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerManagementServer).PurgeAskPlansDetailed(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerManagement_ScheduleMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Timestamp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerManagementServer).ScheduleMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.WorkerManagement/ScheduleMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerManagementServer).ScheduleMaintenance(ctx, req.(*Timestamp))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkerManagement_CreateAskPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AskPlan)
+func _WorkerManagement_NextMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerManagementServer).CreateAskPlan(ctx, in)
+		return srv.(WorkerManagementServer).NextMaintenance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sonm.WorkerManagement/CreateAskPlan",
+		FullMethod: "/sonm.WorkerManagement/NextMaintenance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerManagementServer).CreateAskPlan(ctx, req.(*AskPlan))
+		return srv.(WorkerManagementServer).NextMaintenance(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkerManagement_RemoveAskPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ID)
+func _WorkerManagement_AddMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaintenanceWindow)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerManagementServer).RemoveAskPlan(ctx, in)
+		return srv.(WorkerManagementServer).AddMaintenance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sonm.WorkerManagement/RemoveAskPlan",
+		FullMethod: "/sonm.WorkerManagement/AddMaintenance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerManagementServer).RemoveAskPlan(ctx, req.(*ID))
+		return srv.(WorkerManagementServer).AddMaintenance(ctx, req.(*MaintenanceWindow))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkerManagement_PurgeAskPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _WorkerManagement_CancelMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerManagementServer).PurgeAskPlans(ctx, in)
+		return srv.(WorkerManagementServer).CancelMaintenance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sonm.WorkerManagement/PurgeAskPlans",
+		FullMethod: "/sonm.WorkerManagement/CancelMaintenance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerManagementServer).PurgeAskPlans(ctx, req.(*Empty))
+		return srv.(WorkerManagementServer).CancelMaintenance(ctx, req.(*ID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkerManagement_PurgeAskPlansDetailed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _WorkerManagement_Maintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerManagementServer).PurgeAskPlansDetailed(ctx, in)
+		return srv.(WorkerManagementServer).Maintenance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sonm.WorkerManagement/PurgeAskPlansDetailed",
+		FullMethod: "/sonm.WorkerManagement/Maintenance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerManagementServer).PurgeAskPlansDetailed(ctx, req.(*Empty))
+		return srv.(WorkerManagementServer).Maintenance(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkerManagement_ScheduleMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Timestamp)
+func _WorkerManagement_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerManagementServer).ScheduleMaintenance(ctx, in)
+		return srv.(WorkerManagementServer).Drain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sonm.WorkerManagement/ScheduleMaintenance",
+		FullMethod: "/sonm.WorkerManagement/Drain",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerManagementServer).ScheduleMaintenance(ctx, req.(*Timestamp))
+		return srv.(WorkerManagementServer).Drain(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkerManagement_NextMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _WorkerManagement_Undrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerManagementServer).NextMaintenance(ctx, in)
+		return srv.(WorkerManagementServer).Undrain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sonm.WorkerManagement/NextMaintenance",
+		FullMethod: "/sonm.WorkerManagement/Undrain",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerManagementServer).NextMaintenance(ctx, req.(*Empty))
+		return srv.(WorkerManagementServer).Undrain(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1426,6 +2955,60 @@ func _WorkerManagement_RemoveCapability_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkerManagement_PrefetchImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrefetchImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerManagementServer).PrefetchImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.WorkerManagement/PrefetchImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerManagementServer).PrefetchImages(ctx, req.(*PrefetchImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerManagement_Images_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerManagementServer).Images(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.WorkerManagement/Images",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerManagementServer).Images(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerManagement_AskPlanHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerManagementServer).AskPlanHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.WorkerManagement/AskPlanHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerManagementServer).AskPlanHistory(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WorkerManagement_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sonm.WorkerManagement",
 	HandlerType: (*WorkerManagementServer)(nil),
@@ -1474,6 +3057,26 @@ var _WorkerManagement_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NextMaintenance",
 			Handler:    _WorkerManagement_NextMaintenance_Handler,
 		},
+		{
+			MethodName: "AddMaintenance",
+			Handler:    _WorkerManagement_AddMaintenance_Handler,
+		},
+		{
+			MethodName: "CancelMaintenance",
+			Handler:    _WorkerManagement_CancelMaintenance_Handler,
+		},
+		{
+			MethodName: "Maintenance",
+			Handler:    _WorkerManagement_Maintenance_Handler,
+		},
+		{
+			MethodName: "Drain",
+			Handler:    _WorkerManagement_Drain_Handler,
+		},
+		{
+			MethodName: "Undrain",
+			Handler:    _WorkerManagement_Undrain_Handler,
+		},
 		{
 			MethodName: "DebugState",
 			Handler:    _WorkerManagement_DebugState_Handler,
@@ -1498,6 +3101,18 @@ var _WorkerManagement_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCapability",
 			Handler:    _WorkerManagement_RemoveCapability_Handler,
 		},
+		{
+			MethodName: "PrefetchImages",
+			Handler:    _WorkerManagement_PrefetchImages_Handler,
+		},
+		{
+			MethodName: "Images",
+			Handler:    _WorkerManagement_Images_Handler,
+		},
+		{
+			MethodName: "AskPlanHistory",
+			Handler:    _WorkerManagement_AskPlanHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "worker.proto",
@@ -1510,48 +3125,263 @@ type WorkerClient interface {
 	PullTask(ctx context.Context, in *PullTaskRequest, opts ...grpc.CallOption) (Worker_PullTaskClient, error)
 	// StartTask schedules the task associcated with a deal.
 	StartTask(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskReply, error)
+	// RestoreTask starts the task associated with a deal from a checkpoint
+	// made previously, possibly on another worker.
+	RestoreTask(ctx context.Context, in *RestoreTaskRequest, opts ...grpc.CallOption) (*StartTaskReply, error)
 	StopTask(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error)
 	// PurgeTasks stops all tasks by given deal
 	PurgeTasks(ctx context.Context, in *PurgeTasksRequest, opts ...grpc.CallOption) (*ErrorByStringID, error)
 	TaskStatus(ctx context.Context, in *ID, opts ...grpc.CallOption) (*TaskStatusReply, error)
 	JoinNetwork(ctx context.Context, in *WorkerJoinNetworkRequest, opts ...grpc.CallOption) (*NetworkSpec, error)
 	TaskLogs(ctx context.Context, in *TaskLogsRequest, opts ...grpc.CallOption) (Worker_TaskLogsClient, error)
+	// TaskMetrics returns the resource usage time series of the task.
+	TaskMetrics(ctx context.Context, in *TaskMetricsRequest, opts ...grpc.CallOption) (*TaskMetricsReply, error)
+	// ExecTask executes a command inside the running task, streaming its
+	// input and output. The first request must specify the task and the
+	// command, subsequent ones carry stdin data and terminal resizes.
+	ExecTask(ctx context.Context, opts ...grpc.CallOption) (Worker_ExecTaskClient, error)
+	// CopyToTask extracts a tar archive into the directory of the running
+	// task. The first request must specify the task and the directory.
+	CopyToTask(ctx context.Context, opts ...grpc.CallOption) (Worker_CopyToTaskClient, error)
+	// CopyFromTask streams a tar archive of the path inside the task.
+	CopyFromTask(ctx context.Context, in *TaskCopyFromRequest, opts ...grpc.CallOption) (Worker_CopyFromTaskClient, error)
+	// StartTaskGroup atomically starts several tasks associated with a deal,
+	// sharing network namespace and volumes.
+	StartTaskGroup(ctx context.Context, in *StartTaskGroupRequest, opts ...grpc.CallOption) (*StartTaskGroupReply, error)
+	// StopTaskGroup stops all tasks of the group in reverse order.
+	StopTaskGroup(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error)
+	TaskGroupStatus(ctx context.Context, in *ID, opts ...grpc.CallOption) (*TaskGroupStatusReply, error)
+	// DealVolumes returns local volumes of the deal, that persist between its
+	// tasks until the deal is closed.
+	DealVolumes(ctx context.Context, in *ID, opts ...grpc.CallOption) (*DealVolumesReply, error)
 	// Note: currently used for testing pusposes.
 	GetDealInfo(ctx context.Context, in *ID, opts ...grpc.CallOption) (*DealInfoReply, error)
+	// BenchmarkReport returns benchmark results of the worker signed by its
+	// ETH key, allowing to verify claimed values before a deal.
+	BenchmarkReport(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BenchmarkReportReply, error)
+}
+
+type workerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWorkerClient(cc *grpc.ClientConn) WorkerClient {
+	return &workerClient{cc}
+}
+
+func (c *workerClient) PushTask(ctx context.Context, opts ...grpc.CallOption) (Worker_PushTaskClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[0], c.cc, "/sonm.Worker/PushTask", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workerPushTaskClient{stream}
+	return x, nil
+}
+
+type Worker_PushTaskClient interface {
+	Send(*Chunk) error
+	Recv() (*Progress, error)
+	grpc.ClientStream
+}
+
+type workerPushTaskClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerPushTaskClient) Send(m *Chunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *workerPushTaskClient) Recv() (*Progress, error) {
+	m := new(Progress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workerClient) PullTask(ctx context.Context, in *PullTaskRequest, opts ...grpc.CallOption) (Worker_PullTaskClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[1], c.cc, "/sonm.Worker/PullTask", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workerPullTaskClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Worker_PullTaskClient interface {
+	Recv() (*Chunk, error)
+	grpc.ClientStream
+}
+
+type workerPullTaskClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerPullTaskClient) Recv() (*Chunk, error) {
+	m := new(Chunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workerClient) StartTask(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskReply, error) {
+	out := new(StartTaskReply)
+	err := grpc.Invoke(ctx, "/sonm.Worker/StartTask", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) RestoreTask(ctx context.Context, in *RestoreTaskRequest, opts ...grpc.CallOption) (*StartTaskReply, error) {
+	out := new(StartTaskReply)
+	err := grpc.Invoke(ctx, "/sonm.Worker/RestoreTask", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) StopTask(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/sonm.Worker/StopTask", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) PurgeTasks(ctx context.Context, in *PurgeTasksRequest, opts ...grpc.CallOption) (*ErrorByStringID, error) {
+	out := new(ErrorByStringID)
+	err := grpc.Invoke(ctx, "/sonm.Worker/PurgeTasks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) TaskStatus(ctx context.Context, in *ID, opts ...grpc.CallOption) (*TaskStatusReply, error) {
+	out := new(TaskStatusReply)
+	err := grpc.Invoke(ctx, "/sonm.Worker/TaskStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) JoinNetwork(ctx context.Context, in *WorkerJoinNetworkRequest, opts ...grpc.CallOption) (*NetworkSpec, error) {
+	out := new(NetworkSpec)
+	err := grpc.Invoke(ctx, "/sonm.Worker/JoinNetwork", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) TaskLogs(ctx context.Context, in *TaskLogsRequest, opts ...grpc.CallOption) (Worker_TaskLogsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[2], c.cc, "/sonm.Worker/TaskLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workerTaskLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Worker_TaskLogsClient interface {
+	Recv() (*TaskLogsChunk, error)
+	grpc.ClientStream
+}
+
+type workerTaskLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerTaskLogsClient) Recv() (*TaskLogsChunk, error) {
+	m := new(TaskLogsChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workerClient) TaskMetrics(ctx context.Context, in *TaskMetricsRequest, opts ...grpc.CallOption) (*TaskMetricsReply, error) {
+	out := new(TaskMetricsReply)
+	err := grpc.Invoke(ctx, "/sonm.Worker/TaskMetrics", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) ExecTask(ctx context.Context, opts ...grpc.CallOption) (Worker_ExecTaskClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[3], c.cc, "/sonm.Worker/ExecTask", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workerExecTaskClient{stream}
+	return x, nil
 }
 
-type workerClient struct {
-	cc *grpc.ClientConn
+type Worker_ExecTaskClient interface {
+	Send(*TaskExecRequest) error
+	Recv() (*TaskExecReply, error)
+	grpc.ClientStream
 }
 
-func NewWorkerClient(cc *grpc.ClientConn) WorkerClient {
-	return &workerClient{cc}
+type workerExecTaskClient struct {
+	grpc.ClientStream
 }
 
-func (c *workerClient) PushTask(ctx context.Context, opts ...grpc.CallOption) (Worker_PushTaskClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[0], c.cc, "/sonm.Worker/PushTask", opts...)
+func (x *workerExecTaskClient) Send(m *TaskExecRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *workerExecTaskClient) Recv() (*TaskExecReply, error) {
+	m := new(TaskExecReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workerClient) CopyToTask(ctx context.Context, opts ...grpc.CallOption) (Worker_CopyToTaskClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[4], c.cc, "/sonm.Worker/CopyToTask", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &workerPushTaskClient{stream}
+	x := &workerCopyToTaskClient{stream}
 	return x, nil
 }
 
-type Worker_PushTaskClient interface {
-	Send(*Chunk) error
+type Worker_CopyToTaskClient interface {
+	Send(*TaskCopyToRequest) error
 	Recv() (*Progress, error)
 	grpc.ClientStream
 }
 
-type workerPushTaskClient struct {
+type workerCopyToTaskClient struct {
 	grpc.ClientStream
 }
 
-func (x *workerPushTaskClient) Send(m *Chunk) error {
+func (x *workerCopyToTaskClient) Send(m *TaskCopyToRequest) error {
 	return x.ClientStream.SendMsg(m)
 }
 
-func (x *workerPushTaskClient) Recv() (*Progress, error) {
+func (x *workerCopyToTaskClient) Recv() (*Progress, error) {
 	m := new(Progress)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
@@ -1559,12 +3389,12 @@ func (x *workerPushTaskClient) Recv() (*Progress, error) {
 	return m, nil
 }
 
-func (c *workerClient) PullTask(ctx context.Context, in *PullTaskRequest, opts ...grpc.CallOption) (Worker_PullTaskClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[1], c.cc, "/sonm.Worker/PullTask", opts...)
+func (c *workerClient) CopyFromTask(ctx context.Context, in *TaskCopyFromRequest, opts ...grpc.CallOption) (Worker_CopyFromTaskClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[5], c.cc, "/sonm.Worker/CopyFromTask", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &workerPullTaskClient{stream}
+	x := &workerCopyFromTaskClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -1574,16 +3404,16 @@ func (c *workerClient) PullTask(ctx context.Context, in *PullTaskRequest, opts .
 	return x, nil
 }
 
-type Worker_PullTaskClient interface {
+type Worker_CopyFromTaskClient interface {
 	Recv() (*Chunk, error)
 	grpc.ClientStream
 }
 
-type workerPullTaskClient struct {
+type workerCopyFromTaskClient struct {
 	grpc.ClientStream
 }
 
-func (x *workerPullTaskClient) Recv() (*Chunk, error) {
+func (x *workerCopyFromTaskClient) Recv() (*Chunk, error) {
 	m := new(Chunk)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
@@ -1591,86 +3421,54 @@ func (x *workerPullTaskClient) Recv() (*Chunk, error) {
 	return m, nil
 }
 
-func (c *workerClient) StartTask(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskReply, error) {
-	out := new(StartTaskReply)
-	err := grpc.Invoke(ctx, "/sonm.Worker/StartTask", in, out, c.cc, opts...)
+func (c *workerClient) StartTaskGroup(ctx context.Context, in *StartTaskGroupRequest, opts ...grpc.CallOption) (*StartTaskGroupReply, error) {
+	out := new(StartTaskGroupReply)
+	err := grpc.Invoke(ctx, "/sonm.Worker/StartTaskGroup", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workerClient) StopTask(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error) {
+func (c *workerClient) StopTaskGroup(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
-	err := grpc.Invoke(ctx, "/sonm.Worker/StopTask", in, out, c.cc, opts...)
+	err := grpc.Invoke(ctx, "/sonm.Worker/StopTaskGroup", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workerClient) PurgeTasks(ctx context.Context, in *PurgeTasksRequest, opts ...grpc.CallOption) (*ErrorByStringID, error) {
-	out := new(ErrorByStringID)
-	err := grpc.Invoke(ctx, "/sonm.Worker/PurgeTasks", in, out, c.cc, opts...)
+func (c *workerClient) TaskGroupStatus(ctx context.Context, in *ID, opts ...grpc.CallOption) (*TaskGroupStatusReply, error) {
+	out := new(TaskGroupStatusReply)
+	err := grpc.Invoke(ctx, "/sonm.Worker/TaskGroupStatus", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workerClient) TaskStatus(ctx context.Context, in *ID, opts ...grpc.CallOption) (*TaskStatusReply, error) {
-	out := new(TaskStatusReply)
-	err := grpc.Invoke(ctx, "/sonm.Worker/TaskStatus", in, out, c.cc, opts...)
+func (c *workerClient) DealVolumes(ctx context.Context, in *ID, opts ...grpc.CallOption) (*DealVolumesReply, error) {
+	out := new(DealVolumesReply)
+	err := grpc.Invoke(ctx, "/sonm.Worker/DealVolumes", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workerClient) JoinNetwork(ctx context.Context, in *WorkerJoinNetworkRequest, opts ...grpc.CallOption) (*NetworkSpec, error) {
-	out := new(NetworkSpec)
-	err := grpc.Invoke(ctx, "/sonm.Worker/JoinNetwork", in, out, c.cc, opts...)
+func (c *workerClient) GetDealInfo(ctx context.Context, in *ID, opts ...grpc.CallOption) (*DealInfoReply, error) {
+	out := new(DealInfoReply)
+	err := grpc.Invoke(ctx, "/sonm.Worker/GetDealInfo", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workerClient) TaskLogs(ctx context.Context, in *TaskLogsRequest, opts ...grpc.CallOption) (Worker_TaskLogsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[2], c.cc, "/sonm.Worker/TaskLogs", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &workerTaskLogsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type Worker_TaskLogsClient interface {
-	Recv() (*TaskLogsChunk, error)
-	grpc.ClientStream
-}
-
-type workerTaskLogsClient struct {
-	grpc.ClientStream
-}
-
-func (x *workerTaskLogsClient) Recv() (*TaskLogsChunk, error) {
-	m := new(TaskLogsChunk)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func (c *workerClient) GetDealInfo(ctx context.Context, in *ID, opts ...grpc.CallOption) (*DealInfoReply, error) {
-	out := new(DealInfoReply)
-	err := grpc.Invoke(ctx, "/sonm.Worker/GetDealInfo", in, out, c.cc, opts...)
+func (c *workerClient) BenchmarkReport(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BenchmarkReportReply, error) {
+	out := new(BenchmarkReportReply)
+	err := grpc.Invoke(ctx, "/sonm.Worker/BenchmarkReport", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1684,14 +3482,40 @@ type WorkerServer interface {
 	PullTask(*PullTaskRequest, Worker_PullTaskServer) error
 	// StartTask schedules the task associcated with a deal.
 	StartTask(context.Context, *StartTaskRequest) (*StartTaskReply, error)
+	// RestoreTask starts the task associated with a deal from a checkpoint
+	// made previously, possibly on another worker.
+	RestoreTask(context.Context, *RestoreTaskRequest) (*StartTaskReply, error)
 	StopTask(context.Context, *ID) (*Empty, error)
 	// PurgeTasks stops all tasks by given deal
 	PurgeTasks(context.Context, *PurgeTasksRequest) (*ErrorByStringID, error)
 	TaskStatus(context.Context, *ID) (*TaskStatusReply, error)
 	JoinNetwork(context.Context, *WorkerJoinNetworkRequest) (*NetworkSpec, error)
 	TaskLogs(*TaskLogsRequest, Worker_TaskLogsServer) error
+	// TaskMetrics returns the resource usage time series of the task.
+	TaskMetrics(context.Context, *TaskMetricsRequest) (*TaskMetricsReply, error)
+	// ExecTask executes a command inside the running task, streaming its
+	// input and output. The first request must specify the task and the
+	// command, subsequent ones carry stdin data and terminal resizes.
+	ExecTask(Worker_ExecTaskServer) error
+	// CopyToTask extracts a tar archive into the directory of the running
+	// task. The first request must specify the task and the directory.
+	CopyToTask(Worker_CopyToTaskServer) error
+	// CopyFromTask streams a tar archive of the path inside the task.
+	CopyFromTask(*TaskCopyFromRequest, Worker_CopyFromTaskServer) error
+	// StartTaskGroup atomically starts several tasks associated with a deal,
+	// sharing network namespace and volumes.
+	StartTaskGroup(context.Context, *StartTaskGroupRequest) (*StartTaskGroupReply, error)
+	// StopTaskGroup stops all tasks of the group in reverse order.
+	StopTaskGroup(context.Context, *ID) (*Empty, error)
+	TaskGroupStatus(context.Context, *ID) (*TaskGroupStatusReply, error)
+	// DealVolumes returns local volumes of the deal, that persist between its
+	// tasks until the deal is closed.
+	DealVolumes(context.Context, *ID) (*DealVolumesReply, error)
 	// Note: currently used for testing pusposes.
 	GetDealInfo(context.Context, *ID) (*DealInfoReply, error)
+	// BenchmarkReport returns benchmark results of the worker signed by its
+	// ETH key, allowing to verify claimed values before a deal.
+	BenchmarkReport(context.Context, *Empty) (*BenchmarkReportReply, error)
 }
 
 func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
@@ -1763,6 +3587,24 @@ func _Worker_StartTask_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Worker_RestoreTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).RestoreTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Worker/RestoreTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).RestoreTask(ctx, req.(*RestoreTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Worker_StopTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ID)
 	if err := dec(in); err != nil {
@@ -1856,6 +3698,169 @@ func (x *workerTaskLogsServer) Send(m *TaskLogsChunk) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Worker_TaskMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).TaskMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Worker/TaskMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).TaskMetrics(ctx, req.(*TaskMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_ExecTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkerServer).ExecTask(&workerExecTaskServer{stream})
+}
+
+type Worker_ExecTaskServer interface {
+	Send(*TaskExecReply) error
+	Recv() (*TaskExecRequest, error)
+	grpc.ServerStream
+}
+
+type workerExecTaskServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerExecTaskServer) Send(m *TaskExecReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *workerExecTaskServer) Recv() (*TaskExecRequest, error) {
+	m := new(TaskExecRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Worker_CopyToTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkerServer).CopyToTask(&workerCopyToTaskServer{stream})
+}
+
+type Worker_CopyToTaskServer interface {
+	Send(*Progress) error
+	Recv() (*TaskCopyToRequest, error)
+	grpc.ServerStream
+}
+
+type workerCopyToTaskServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerCopyToTaskServer) Send(m *Progress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *workerCopyToTaskServer) Recv() (*TaskCopyToRequest, error) {
+	m := new(TaskCopyToRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Worker_CopyFromTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TaskCopyFromRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkerServer).CopyFromTask(m, &workerCopyFromTaskServer{stream})
+}
+
+type Worker_CopyFromTaskServer interface {
+	Send(*Chunk) error
+	grpc.ServerStream
+}
+
+type workerCopyFromTaskServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerCopyFromTaskServer) Send(m *Chunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Worker_StartTaskGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTaskGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).StartTaskGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Worker/StartTaskGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).StartTaskGroup(ctx, req.(*StartTaskGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_StopTaskGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).StopTaskGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Worker/StopTaskGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).StopTaskGroup(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_TaskGroupStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).TaskGroupStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Worker/TaskGroupStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).TaskGroupStatus(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_DealVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).DealVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Worker/DealVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).DealVolumes(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Worker_GetDealInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ID)
 	if err := dec(in); err != nil {
@@ -1874,6 +3879,24 @@ func _Worker_GetDealInfo_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Worker_BenchmarkReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).BenchmarkReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Worker/BenchmarkReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).BenchmarkReport(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Worker_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sonm.Worker",
 	HandlerType: (*WorkerServer)(nil),
@@ -1882,6 +3905,10 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StartTask",
 			Handler:    _Worker_StartTask_Handler,
 		},
+		{
+			MethodName: "RestoreTask",
+			Handler:    _Worker_RestoreTask_Handler,
+		},
 		{
 			MethodName: "StopTask",
 			Handler:    _Worker_StopTask_Handler,
@@ -1898,10 +3925,34 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 			MethodName: "JoinNetwork",
 			Handler:    _Worker_JoinNetwork_Handler,
 		},
+		{
+			MethodName: "TaskMetrics",
+			Handler:    _Worker_TaskMetrics_Handler,
+		},
+		{
+			MethodName: "StartTaskGroup",
+			Handler:    _Worker_StartTaskGroup_Handler,
+		},
+		{
+			MethodName: "StopTaskGroup",
+			Handler:    _Worker_StopTaskGroup_Handler,
+		},
+		{
+			MethodName: "TaskGroupStatus",
+			Handler:    _Worker_TaskGroupStatus_Handler,
+		},
+		{
+			MethodName: "DealVolumes",
+			Handler:    _Worker_DealVolumes_Handler,
+		},
 		{
 			MethodName: "GetDealInfo",
 			Handler:    _Worker_GetDealInfo_Handler,
 		},
+		{
+			MethodName: "BenchmarkReport",
+			Handler:    _Worker_BenchmarkReport_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1920,6 +3971,23 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Worker_TaskLogs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExecTask",
+			Handler:       _Worker_ExecTask_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "CopyToTask",
+			Handler:       _Worker_CopyToTask_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "CopyFromTask",
+			Handler:       _Worker_CopyFromTask_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "worker.proto",
 }
@@ -1927,148 +3995,261 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("worker.proto", fileDescriptor21) }
 
 var fileDescriptor21 = []byte{
-	// 2281 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xdb, 0x6e, 0x1b, 0xc9,
-	0xd1, 0x26, 0x45, 0x8a, 0x87, 0xe2, 0x51, 0x2d, 0x5b, 0x3f, 0xff, 0xb1, 0xe5, 0x95, 0xc7, 0xde,
-	0x8d, 0xe2, 0xb5, 0x69, 0xaf, 0xf6, 0x80, 0xac, 0x9d, 0x0d, 0x96, 0x22, 0x25, 0x99, 0xb6, 0x44,
-	0x32, 0x43, 0x29, 0x46, 0x82, 0x00, 0x46, 0x8b, 0x6c, 0x53, 0x13, 0x91, 0x33, 0x93, 0xe9, 0xa6,
-	0xbd, 0xca, 0x75, 0x90, 0xdc, 0xe7, 0x26, 0x40, 0x90, 0x17, 0xc8, 0x4d, 0x6e, 0x72, 0x15, 0x60,
-	0x9f, 0x20, 0x0f, 0x13, 0xe4, 0x11, 0x82, 0x3e, 0xcd, 0x81, 0x1c, 0x3a, 0x31, 0x6c, 0xe4, 0x8e,
-	0x5d, 0xf5, 0x7d, 0xd5, 0xd5, 0xd5, 0xd5, 0x55, 0x3d, 0x4d, 0x28, 0xbf, 0x71, 0xfd, 0x4b, 0xe2,
-	0x37, 0x3d, 0xdf, 0x65, 0x2e, 0xca, 0x52, 0xd7, 0x99, 0x19, 0x55, 0x4c, 0x2f, 0x5f, 0x7a, 0x53,
-	0xec, 0x48, 0xa9, 0x51, 0x3e, 0xb7, 0x27, 0xb6, 0xc3, 0xd4, 0x08, 0x8d, 0xb0, 0x87, 0xcf, 0xed,
-	0xa9, 0xcd, 0x6c, 0x42, 0x95, 0xac, 0x36, 0x72, 0x1d, 0x86, 0x6d, 0x47, 0x1b, 0x32, 0x4a, 0x13,
-	0xe2, 0xda, 0x9e, 0xd6, 0xda, 0x0e, 0xb7, 0xeb, 0xd8, 0x58, 0x09, 0x36, 0x66, 0xd8, 0xbf, 0x24,
-	0xcc, 0x9b, 0xe2, 0x11, 0x51, 0xa2, 0xa2, 0x43, 0xf4, 0x04, 0x35, 0x66, 0xcf, 0x08, 0x65, 0x78,
-	0xa6, 0xf8, 0xe6, 0x36, 0xe4, 0x4f, 0x31, 0xbd, 0x3c, 0xc5, 0x13, 0x84, 0x20, 0x3b, 0xc6, 0x0c,
-	0x37, 0xd2, 0x3b, 0xe9, 0xdd, 0xb2, 0x25, 0x7e, 0x9b, 0xdf, 0xa7, 0xa1, 0xc0, 0xf5, 0x43, 0x8f,
-	0x8c, 0xd0, 0x03, 0x28, 0x06, 0xbe, 0x08, 0x54, 0x69, 0xaf, 0xd6, 0xe4, 0xb3, 0x37, 0xdb, 0x5a,
-	0x6c, 0x85, 0x08, 0x74, 0x0f, 0x0a, 0x3e, 0x99, 0xd8, 0x94, 0xf9, 0x57, 0x8d, 0x35, 0x81, 0xae,
-	0x4a, 0xb4, 0xa5, 0xa4, 0x56, 0xa0, 0x47, 0x5f, 0x40, 0xd1, 0x27, 0xd4, 0x9d, 0xfb, 0x23, 0x42,
-	0x1b, 0x19, 0x01, 0xde, 0x92, 0xe0, 0x16, 0xbd, 0x1c, 0x4c, 0xb1, 0x63, 0x69, 0xad, 0x15, 0x02,
-	0xd1, 0x47, 0x90, 0x61, 0x78, 0xd2, 0xc8, 0x0a, 0x7c, 0x45, 0xe2, 0xd5, 0x6a, 0x2c, 0xae, 0x31,
-	0x7f, 0x09, 0xf5, 0x21, 0xc3, 0x3e, 0xe3, 0x42, 0x8b, 0xfc, 0x7a, 0x4e, 0x28, 0x43, 0x77, 0x21,
-	0x37, 0x26, 0x78, 0xda, 0xed, 0xa8, 0x25, 0x94, 0x25, 0x6f, 0xdf, 0x9e, 0x74, 0x1d, 0x66, 0x29,
-	0x1d, 0x32, 0x21, 0x4b, 0x3d, 0x32, 0x8a, 0x3b, 0xae, 0x23, 0x61, 0x09, 0x9d, 0x39, 0x80, 0xc6,
-	0x0b, 0xb1, 0xc3, 0xcf, 0x5c, 0xdb, 0xe9, 0x11, 0xc6, 0xb7, 0x5b, 0xcf, 0xb2, 0x05, 0x39, 0x86,
-	0xe9, 0xa5, 0x9a, 0xa5, 0x68, 0xa9, 0x11, 0xba, 0x09, 0x7c, 0x37, 0x38, 0xb2, 0xdb, 0x11, 0xc6,
-	0x8b, 0x56, 0x28, 0x30, 0xff, 0x91, 0x86, 0x6a, 0xc4, 0x61, 0x6f, 0x7a, 0x85, 0xaa, 0xb0, 0x66,
-	0x8f, 0x95, 0x91, 0x35, 0x7b, 0x8c, 0x9e, 0x40, 0xde, 0x73, 0x7d, 0x76, 0x82, 0xbd, 0xc6, 0xda,
-	0x4e, 0x66, 0xb7, 0xb4, 0x77, 0x5b, 0xfa, 0x16, 0xa7, 0x35, 0x07, 0x12, 0x73, 0xe0, 0xf0, 0x38,
-	0x6b, 0x06, 0xba, 0x05, 0x10, 0x4c, 0xc6, 0xe3, 0x9c, 0xd9, 0x2d, 0x5a, 0x11, 0x89, 0xf1, 0x1c,
-	0xca, 0x51, 0x22, 0xaa, 0x43, 0xe6, 0x92, 0x5c, 0xa9, 0xd9, 0xf9, 0x4f, 0xf4, 0x31, 0xac, 0xbf,
-	0xc6, 0xd3, 0x39, 0x51, 0x81, 0x51, 0xfb, 0x7f, 0xe0, 0x8c, 0x3d, 0xd7, 0x76, 0x18, 0xb5, 0xa4,
-	0xf6, 0xf1, 0xda, 0x8f, 0xd2, 0xe6, 0x5f, 0x33, 0x50, 0x1a, 0x32, 0xcc, 0xe6, 0x54, 0xae, 0x64,
-	0x0b, 0x72, 0x73, 0x8f, 0xe7, 0x9f, 0xb0, 0x97, 0xb5, 0xd4, 0x08, 0x35, 0x20, 0xff, 0x9a, 0xf8,
-	0xd4, 0x76, 0x1d, 0x15, 0x10, 0x3d, 0x44, 0x06, 0x14, 0xbc, 0x29, 0x66, 0xaf, 0x5c, 0x7f, 0x26,
-	0x92, 0xa2, 0x68, 0x05, 0x63, 0xce, 0x22, 0xec, 0xa2, 0x35, 0x1e, 0xfb, 0x62, 0xff, 0x8b, 0x96,
-	0x1e, 0xf2, 0x10, 0xf3, 0x60, 0xb7, 0xdd, 0xb9, 0xc3, 0x1a, 0xeb, 0x3b, 0xe9, 0xdd, 0x8a, 0x15,
-	0x0a, 0xb8, 0xb6, 0xf3, 0xe2, 0xa9, 0xf4, 0xab, 0x91, 0x93, 0x1b, 0x10, 0x08, 0xd0, 0x3d, 0xa8,
-	0xfb, 0xc4, 0x19, 0x93, 0xdf, 0xbc, 0x76, 0xe7, 0x54, 0x81, 0xf2, 0x02, 0xb4, 0x24, 0x47, 0xbb,
-	0x90, 0x9b, 0x61, 0xca, 0x88, 0xdf, 0x28, 0x88, 0x58, 0xd4, 0x55, 0x2c, 0xa4, 0x1b, 0x84, 0x52,
-	0x4b, 0xe9, 0xd1, 0x27, 0xb0, 0x8e, 0xc7, 0x33, 0xdb, 0x69, 0x14, 0x57, 0x00, 0xa5, 0x1a, 0xdd,
-	0x87, 0x0d, 0x9b, 0x9e, 0x08, 0x4e, 0xdb, 0x75, 0x5e, 0xd9, 0xfe, 0x8c, 0x8c, 0x1b, 0xb0, 0x93,
-	0xde, 0x2d, 0x58, 0xcb, 0x0a, 0xf4, 0x08, 0x36, 0x6d, 0xba, 0x4f, 0x9c, 0xd1, 0x05, 0x3f, 0xf2,
-	0x87, 0xb6, 0x63, 0xd3, 0x0b, 0x32, 0x6e, 0x94, 0x04, 0x3e, 0x49, 0x85, 0xb6, 0x21, 0x33, 0x21,
-	0x6e, 0xa3, 0x2c, 0xbc, 0x28, 0x49, 0x2f, 0x8e, 0x88, 0xdb, 0x1d, 0x58, 0x5c, 0x6e, 0xfe, 0x29,
-	0x0d, 0x15, 0x75, 0xdc, 0xd4, 0x96, 0x7d, 0x03, 0x05, 0xac, 0x04, 0x8d, 0x74, 0x34, 0xdb, 0x62,
-	0xb0, 0x60, 0x24, 0xb3, 0x2d, 0xa0, 0x18, 0xcf, 0x42, 0x7b, 0xab, 0xf2, 0xe9, 0x4e, 0x3c, 0x9f,
-	0x2a, 0xf1, 0x43, 0x1f, 0xc9, 0xa6, 0x3f, 0xa4, 0xa1, 0xc2, 0xd3, 0xfb, 0xd8, 0xa6, 0x4c, 0x3a,
-	0xf7, 0x19, 0x64, 0x6d, 0xe7, 0x95, 0xab, 0x1c, 0xdb, 0x0e, 0x8f, 0x68, 0x00, 0x69, 0x76, 0x9d,
-	0x57, 0xae, 0x74, 0x4a, 0x40, 0x8d, 0x1e, 0x14, 0x03, 0x51, 0x82, 0x33, 0x9f, 0xc6, 0x9d, 0xb9,
-	0x1e, 0x39, 0xf5, 0x61, 0x1e, 0x47, 0x9d, 0xfa, 0x7b, 0x1a, 0xca, 0x1d, 0xf2, 0xda, 0xe6, 0x75,
-	0x49, 0xf8, 0x74, 0x03, 0x32, 0xed, 0xc1, 0x99, 0xaa, 0x2c, 0x45, 0x55, 0x1c, 0x07, 0x67, 0x16,
-	0x97, 0xa2, 0x6d, 0xc8, 0x1e, 0x0d, 0xce, 0xa8, 0x3a, 0xb7, 0x4a, 0x7b, 0x34, 0x38, 0xb3, 0x84,
-	0x98, 0x73, 0xad, 0xd6, 0x89, 0xaa, 0x7e, 0x4a, 0x6b, 0xb5, 0x4e, 0x2c, 0x2e, 0x45, 0x3f, 0x80,
-	0xbc, 0x3a, 0xa7, 0xf1, 0x72, 0xa7, 0xcb, 0x8e, 0xd6, 0x72, 0x20, 0x65, 0xae, 0x8f, 0x27, 0x44,
-	0xe4, 0x7e, 0x00, 0x1c, 0x4a, 0xa1, 0xa5, 0xb5, 0x66, 0x0b, 0x6a, 0x83, 0xf9, 0x74, 0x1a, 0x2d,
-	0x8d, 0x5b, 0xaa, 0x34, 0xea, 0x7a, 0xa3, 0x46, 0x41, 0x31, 0x1b, 0xab, 0x03, 0xaa, 0x46, 0xe6,
-	0x5f, 0x32, 0x50, 0xe9, 0x70, 0x88, 0xf3, 0xca, 0x95, 0xeb, 0xbf, 0x05, 0x59, 0xce, 0x51, 0x01,
-	0x00, 0x39, 0x35, 0x87, 0x58, 0x42, 0x8e, 0x1e, 0x43, 0xde, 0x9f, 0x3b, 0x8e, 0xed, 0x4c, 0x54,
-	0x14, 0x76, 0x42, 0x48, 0x60, 0xa5, 0x69, 0x49, 0x88, 0x2a, 0x5e, 0x8a, 0x80, 0xbe, 0xe5, 0xed,
-	0x67, 0xe6, 0x4d, 0x09, 0x23, 0x63, 0x51, 0xbb, 0x4a, 0x7b, 0x66, 0x12, 0xbb, 0xad, 0x41, 0x92,
-	0x1f, 0x92, 0xe2, 0x5d, 0x26, 0xfb, 0xdf, 0x76, 0x99, 0x9b, 0x50, 0xf4, 0xe6, 0xe7, 0x53, 0x7b,
-	0xd4, 0x1d, 0xd0, 0xc6, 0xba, 0xa8, 0x99, 0xa1, 0xc0, 0xf8, 0x29, 0x94, 0xa3, 0xee, 0x7e, 0x80,
-	0xac, 0x32, 0x86, 0x50, 0x8d, 0xaf, 0xe1, 0x43, 0xa4, 0xea, 0xef, 0xb2, 0x50, 0x5b, 0x50, 0xa3,
-	0x2f, 0x20, 0x47, 0x65, 0x8d, 0xe3, 0x96, 0xab, 0x7b, 0x37, 0x13, 0xad, 0x34, 0xd5, 0x6f, 0x85,
-	0xe5, 0xf1, 0xb0, 0x67, 0x78, 0x42, 0x7a, 0x78, 0x46, 0x74, 0x0b, 0x0b, 0x04, 0xe8, 0xc7, 0x61,
-	0x7f, 0x8a, 0xed, 0xd1, 0xa2, 0xd1, 0xe4, 0x06, 0x15, 0xf6, 0x88, 0x6c, 0xac, 0x47, 0xfc, 0x10,
-	0xd6, 0xe7, 0x34, 0xcc, 0xe9, 0x4d, 0x7d, 0x91, 0x90, 0x7b, 0x74, 0xc6, 0x55, 0x96, 0x44, 0xa0,
-	0x43, 0x40, 0x78, 0x3a, 0x75, 0x47, 0x98, 0x91, 0x71, 0xb0, 0x9f, 0xa2, 0xd2, 0xaf, 0xde, 0xed,
-	0x04, 0x86, 0xbe, 0x5c, 0xe4, 0x57, 0x5d, 0x2e, 0x3e, 0x6c, 0xb3, 0xbc, 0x80, 0x9c, 0x6a, 0x2b,
-	0x25, 0xc8, 0x9f, 0xf5, 0x9e, 0xf7, 0xfa, 0x2f, 0x7a, 0xf5, 0x14, 0x2a, 0x43, 0x61, 0x38, 0xe8,
-	0xf7, 0x8f, 0xbb, 0xbd, 0xa3, 0x7a, 0x5a, 0x8e, 0x5a, 0x2f, 0x7a, 0x7c, 0xb4, 0xc6, 0x81, 0xd6,
-	0x59, 0x4f, 0x0c, 0x32, 0x5c, 0x75, 0xd8, 0xed, 0x75, 0x87, 0x4f, 0x0f, 0x3a, 0xf5, 0x2c, 0x02,
-	0xc8, 0xed, 0x5b, 0xfd, 0xe7, 0x07, 0xbd, 0xfa, 0x3a, 0xaa, 0x02, 0x3c, 0xef, 0x1e, 0x1f, 0x1f,
-	0x74, 0x5e, 0xf6, 0xfb, 0x27, 0xf5, 0x9c, 0xf9, 0x37, 0x75, 0xa5, 0x1b, 0xb8, 0xee, 0x14, 0xed,
-	0x42, 0x06, 0x4f, 0xf5, 0x71, 0x5d, 0x15, 0x1d, 0x0e, 0x41, 0xf7, 0x21, 0x3b, 0xa7, 0x64, 0xac,
-	0x8e, 0x6d, 0x23, 0x8c, 0x07, 0xb7, 0xd3, 0x3c, 0xa3, 0xfa, 0xb8, 0x09, 0x94, 0xd1, 0x87, 0x62,
-	0x20, 0x4a, 0x08, 0xcc, 0xfd, 0x78, 0x60, 0x56, 0x4d, 0x1c, 0x89, 0xcf, 0x6f, 0x73, 0x50, 0x52,
-	0xfa, 0x77, 0x74, 0xfc, 0x09, 0x14, 0xb8, 0x4b, 0x43, 0xcf, 0x65, 0xca, 0xf9, 0x8f, 0x62, 0xf0,
-	0xc0, 0x7f, 0x8e, 0x50, 0x1d, 0x4c, 0x13, 0xd0, 0x97, 0x90, 0xe3, 0xbf, 0x0f, 0xdf, 0xa8, 0x64,
-	0xde, 0x4e, 0xa6, 0x1e, 0xbe, 0x91, 0x44, 0x05, 0x46, 0x47, 0x50, 0x1e, 0xb9, 0xb3, 0x99, 0xcd,
-	0xd4, 0xbc, 0x59, 0x41, 0xbe, 0xb3, 0x4c, 0x6e, 0x47, 0x50, 0xd2, 0x44, 0x8c, 0x88, 0x5a, 0x00,
-	0x7a, 0x7c, 0xf8, 0x46, 0x14, 0x9f, 0xc5, 0x16, 0x1c, 0x33, 0xa3, 0xfd, 0x88, 0x90, 0xb8, 0x2f,
-	0xe4, 0x57, 0x64, 0xc4, 0xc8, 0x58, 0xf6, 0xf1, 0xdc, 0x2a, 0x5f, 0x0e, 0x22, 0x28, 0xe5, 0x4b,
-	0x94, 0xc8, 0xbb, 0x79, 0x2c, 0x4c, 0xef, 0xd1, 0xcd, 0x8d, 0xa7, 0x50, 0x8a, 0xc4, 0xed, 0x7d,
-	0x2c, 0xf5, 0x60, 0x63, 0x29, 0x88, 0xef, 0x63, 0xef, 0x18, 0x6a, 0x0b, 0xd1, 0x7c, 0x4f, 0xef,
-	0x96, 0xc2, 0xfa, 0x3e, 0xb7, 0xa0, 0x7f, 0xad, 0x41, 0x65, 0x38, 0xba, 0x20, 0xe3, 0xf9, 0x94,
-	0xf8, 0x1d, 0xcc, 0x30, 0x3a, 0x86, 0x0a, 0xef, 0xc6, 0xa7, 0xae, 0x42, 0xab, 0xeb, 0xd0, 0x27,
-	0xaa, 0xeb, 0x47, 0xb1, 0xb2, 0x7c, 0x69, 0xa0, 0xdc, 0xe2, 0x38, 0x19, 0x75, 0xa1, 0x8c, 0xc3,
-	0x94, 0xa0, 0x2a, 0xeb, 0x3f, 0x4e, 0x32, 0x16, 0x49, 0x1d, 0x9d, 0x2e, 0x51, 0x2a, 0x7a, 0x20,
-	0x2e, 0xef, 0x62, 0xa0, 0x7a, 0xed, 0xc6, 0x52, 0xce, 0x59, 0x01, 0xc4, 0xf8, 0x16, 0xd0, 0xb2,
-	0x7b, 0x09, 0xa1, 0xba, 0x16, 0x0d, 0x55, 0x31, 0x1a, 0xeb, 0x3e, 0x6c, 0x2c, 0xf9, 0x94, 0x60,
-	0xe0, 0x6e, 0x3c, 0xd6, 0xd5, 0x78, 0x25, 0x8b, 0x18, 0x7c, 0x96, 0x2d, 0xac, 0xd5, 0x33, 0xe6,
-	0x9f, 0x33, 0x50, 0x1e, 0xe2, 0x29, 0xa1, 0x33, 0xec, 0x88, 0x88, 0xf7, 0xa0, 0xaa, 0x16, 0xda,
-	0x3e, 0xf2, 0xdd, 0xb9, 0xa7, 0x2f, 0x74, 0x3a, 0xe4, 0x11, 0xac, 0x5e, 0xab, 0x02, 0xca, 0x30,
-	0x2d, 0xb0, 0xd1, 0xe7, 0xb0, 0xce, 0xef, 0x46, 0x34, 0x5e, 0x62, 0x62, 0x66, 0xf8, 0x05, 0x47,
-	0xb1, 0x25, 0x16, 0x7d, 0x05, 0x39, 0xd7, 0x1f, 0x13, 0x9f, 0xaa, 0xda, 0x72, 0x2b, 0x81, 0xd5,
-	0x17, 0x00, 0x55, 0x99, 0x24, 0xda, 0x68, 0xc1, 0x66, 0x82, 0x4f, 0xef, 0x14, 0xe7, 0x0e, 0x40,
-	0xe8, 0x4f, 0x02, 0x73, 0x27, 0x1e, 0xe0, 0xe8, 0x25, 0x30, 0x62, 0xe5, 0x10, 0x4a, 0x11, 0xff,
-	0x12, 0xcc, 0xdc, 0x8e, 0x9b, 0x51, 0x9f, 0x2b, 0x82, 0x13, 0x6b, 0x0c, 0x69, 0xa8, 0x75, 0xc8,
-	0xf9, 0x7c, 0xc2, 0xdb, 0x27, 0x91, 0xf7, 0x9a, 0xaf, 0xa1, 0x42, 0xa3, 0xb9, 0xaa, 0xda, 0xc4,
-	0x66, 0x42, 0x1a, 0x5b, 0x71, 0x24, 0xfa, 0x0a, 0xca, 0x34, 0x12, 0x43, 0x35, 0x39, 0x5a, 0x8e,
-	0xae, 0x15, 0xc3, 0x99, 0x5f, 0xc3, 0xc6, 0x60, 0xee, 0x4f, 0x08, 0xcf, 0x23, 0xfa, 0x4e, 0x4f,
-	0x0d, 0xe6, 0x16, 0x5c, 0x93, 0xcf, 0x08, 0x27, 0x84, 0xf9, 0xf6, 0x48, 0xb3, 0xcd, 0x3f, 0xa6,
-	0xe1, 0xfa, 0x82, 0x82, 0x7a, 0xae, 0x43, 0x09, 0xda, 0x87, 0xfc, 0x4c, 0x8a, 0xd4, 0x69, 0xdf,
-	0x95, 0x86, 0x13, 0xd1, 0x4d, 0x35, 0x56, 0x37, 0x2d, 0x45, 0x34, 0x1e, 0x43, 0x39, 0xaa, 0xf8,
-	0x4f, 0x19, 0x90, 0x8e, 0xc6, 0xfc, 0xf7, 0x69, 0x30, 0xe4, 0x5c, 0xad, 0xf1, 0xb8, 0xad, 0x9f,
-	0xac, 0xae, 0xf4, 0xb2, 0xef, 0x41, 0x9e, 0xce, 0xcf, 0x79, 0xd9, 0x53, 0xeb, 0x5e, 0xfe, 0xe0,
-	0xd5, 0x00, 0x7e, 0x8f, 0xa5, 0x23, 0xd7, 0x93, 0x93, 0x54, 0xf5, 0x3d, 0x36, 0xb4, 0x39, 0xe4,
-	0x4a, 0x4b, 0x62, 0xb8, 0x8f, 0x8c, 0x4d, 0xc5, 0x17, 0x52, 0xc5, 0xe2, 0x3f, 0xcd, 0x6d, 0xb8,
-	0x91, 0xe8, 0x88, 0x5c, 0xba, 0xf9, 0x1d, 0x6c, 0x4b, 0xb5, 0x45, 0x66, 0xee, 0x6b, 0xf2, 0xbf,
-	0x73, 0xd5, 0xdc, 0x81, 0x5b, 0xab, 0x66, 0x96, 0xbe, 0xdd, 0x3b, 0x83, 0xda, 0x02, 0x17, 0x6d,
-	0x42, 0xad, 0xdd, 0x1a, 0xb4, 0xf6, 0xbb, 0xc7, 0xdd, 0xd3, 0x9f, 0xbf, 0xec, 0xf5, 0x7b, 0x07,
-	0xf5, 0x14, 0x42, 0x50, 0x8d, 0x08, 0x87, 0xc3, 0xa7, 0xf5, 0x34, 0xfa, 0x7f, 0xb8, 0x1e, 0x91,
-	0x75, 0x7b, 0xc3, 0xc1, 0x41, 0xfb, 0xb4, 0xdb, 0xef, 0xd5, 0xd7, 0xf6, 0xbe, 0xcf, 0x43, 0x5d,
-	0xe5, 0x01, 0x76, 0xf0, 0x84, 0xcc, 0x88, 0xc3, 0x97, 0x19, 0xdc, 0x2e, 0xd5, 0xfa, 0x66, 0x1e,
-	0xbb, 0x32, 0x36, 0x82, 0xa7, 0x23, 0x7d, 0x2d, 0x37, 0x53, 0xe8, 0x3e, 0xe4, 0xd5, 0x27, 0x6d,
-	0x1c, 0x8c, 0xf4, 0x39, 0x0e, 0x3f, 0x77, 0xcd, 0x14, 0x7a, 0x04, 0xa5, 0x43, 0x9f, 0x90, 0x77,
-	0x60, 0x7c, 0x0a, 0xeb, 0xe2, 0x90, 0xc4, 0xb1, 0x9b, 0x09, 0x9f, 0xef, 0x66, 0x0a, 0x35, 0xa1,
-	0xa0, 0x5f, 0x10, 0x12, 0xf1, 0xb1, 0x77, 0x08, 0x33, 0x85, 0xee, 0x41, 0xa5, 0xed, 0x13, 0xcc,
-	0x88, 0x6e, 0x68, 0xf1, 0x56, 0x6a, 0x14, 0xe4, 0xb0, 0xdb, 0x31, 0x53, 0x68, 0x17, 0x2a, 0x72,
-	0x73, 0x34, 0x36, 0x50, 0x1a, 0xd1, 0xa9, 0x84, 0xcb, 0x15, 0x71, 0xb8, 0x93, 0x5d, 0x59, 0x00,
-	0x7f, 0x03, 0xd7, 0x63, 0xe0, 0x0e, 0x61, 0xd8, 0x9e, 0x92, 0x71, 0x9c, 0xa4, 0xb2, 0xe7, 0xc0,
-	0xf7, 0x5d, 0x7f, 0xff, 0x6a, 0xc8, 0x7c, 0xdb, 0x99, 0x08, 0xaf, 0xbe, 0x84, 0x4d, 0x5d, 0xa0,
-	0x4e, 0xb0, 0xed, 0x30, 0xe2, 0x60, 0x67, 0x44, 0x90, 0xfa, 0x76, 0x38, 0xd5, 0xcf, 0xb7, 0x8b,
-	0xb3, 0x7e, 0x06, 0xb5, 0x1e, 0xf9, 0x8e, 0x45, 0x29, 0xb1, 0xf9, 0x16, 0xf9, 0x66, 0x0a, 0xed,
-	0xf1, 0x3a, 0xae, 0x0b, 0x67, 0xa2, 0x77, 0x0b, 0x75, 0x55, 0x4e, 0x23, 0x63, 0x16, 0x3c, 0x2e,
-	0x69, 0xcf, 0x7a, 0xf3, 0x19, 0xf1, 0xed, 0xd1, 0x72, 0xf0, 0x1e, 0x40, 0x4d, 0xc4, 0x23, 0x60,
-	0xbc, 0x3d, 0x7c, 0x1d, 0xc8, 0xab, 0xba, 0x84, 0x8c, 0xc4, 0xaa, 0x26, 0x0e, 0xae, 0x71, 0xe3,
-	0x2d, 0x15, 0xcf, 0x4c, 0xa1, 0x9f, 0x41, 0x25, 0x56, 0x11, 0xd0, 0x4e, 0x14, 0x9f, 0x54, 0xb5,
-	0x8c, 0xdb, 0x6f, 0x41, 0x04, 0x76, 0x5f, 0x42, 0x7d, 0xf1, 0x40, 0xa3, 0x3b, 0x51, 0xe2, 0x8a,
-	0x42, 0x63, 0xdc, 0x7d, 0x3b, 0x48, 0x4f, 0xb0, 0xf7, 0xcf, 0x0c, 0xe4, 0x24, 0x88, 0x5f, 0xa0,
-	0x06, 0x73, 0x7a, 0xc1, 0x8f, 0x84, 0x8e, 0x58, 0xfb, 0x62, 0xee, 0x5c, 0x1a, 0xea, 0xca, 0x32,
-	0xf0, 0xdd, 0x09, 0xaf, 0x50, 0x66, 0x6a, 0x37, 0xfd, 0x28, 0x8d, 0xf6, 0x38, 0x5c, 0xbe, 0xe7,
-	0x20, 0xb5, 0x7f, 0x0b, 0xef, 0x3b, 0x46, 0xd4, 0x8a, 0x99, 0x7a, 0x94, 0x46, 0x4f, 0xa0, 0x18,
-	0xbc, 0x1b, 0xa3, 0xad, 0xa5, 0x87, 0x64, 0xc9, 0xba, 0x96, 0xf4, 0xc0, 0x6c, 0xa6, 0xd0, 0x1d,
-	0x28, 0x0c, 0x99, 0xeb, 0x09, 0xee, 0xca, 0xa3, 0xf3, 0x13, 0x80, 0xb0, 0x2f, 0xa2, 0xff, 0xd3,
-	0x7e, 0x2d, 0x74, 0xca, 0xd5, 0xc7, 0xe1, 0x21, 0x40, 0xf8, 0x72, 0x10, 0x99, 0x26, 0xf9, 0xc1,
-	0xc3, 0x4c, 0xa1, 0x7d, 0x28, 0x45, 0x9e, 0xe3, 0xd1, 0xad, 0x68, 0xdc, 0x97, 0xdf, 0xe9, 0x75,
-	0x09, 0x54, 0xd2, 0xa1, 0x47, 0x46, 0x66, 0x0a, 0x3d, 0x96, 0x5f, 0xc8, 0xc7, 0xee, 0x84, 0xa2,
-	0xc8, 0x44, 0x7c, 0xac, 0x79, 0x9b, 0x71, 0x71, 0x18, 0xd2, 0x26, 0x94, 0x8e, 0x08, 0xd3, 0x2f,
-	0x52, 0x11, 0x8f, 0x37, 0x13, 0xde, 0xaa, 0xcc, 0xd4, 0xfe, 0xdd, 0x5f, 0x98, 0x13, 0x9b, 0x5d,
-	0xcc, 0xcf, 0x9b, 0x23, 0x77, 0xf6, 0x90, 0x43, 0x1e, 0xd8, 0xee, 0xc3, 0x91, 0xeb, 0x93, 0x87,
-	0xe2, 0x1f, 0x9a, 0x27, 0x5c, 0x74, 0x9e, 0x13, 0xbf, 0x3f, 0xff, 0x77, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x7f, 0x48, 0x86, 0xd7, 0x53, 0x1a, 0x00, 0x00,
+	// 4085 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5b, 0x4b, 0x73, 0x1c, 0x47,
+	0x72, 0xc6, 0x3c, 0x30, 0x8f, 0x9c, 0x07, 0x06, 0x05, 0x12, 0x6e, 0x8d, 0x44, 0x8a, 0x6a, 0x52,
+	0x12, 0x44, 0x51, 0xa0, 0x96, 0xd2, 0xca, 0x96, 0x28, 0xad, 0x17, 0x4f, 0x62, 0x44, 0x70, 0x30,
+	0x2a, 0x00, 0xcb, 0x58, 0x87, 0x23, 0x14, 0x8d, 0xe9, 0xc2, 0xa0, 0x8d, 0x99, 0xee, 0xde, 0xee,
+	0x1a, 0x92, 0x58, 0x47, 0xf8, 0x64, 0x87, 0x7d, 0xb2, 0x1d, 0xb6, 0x23, 0x1c, 0xe1, 0xf0, 0xc9,
+	0x7f, 0xc1, 0x47, 0x3b, 0xc2, 0x47, 0x1f, 0x7c, 0xf1, 0xd5, 0x37, 0xff, 0x00, 0x1f, 0xf6, 0xb0,
+	0x3f, 0xc0, 0x91, 0xf5, 0xe8, 0xae, 0x9e, 0xe9, 0x21, 0x4d, 0x53, 0xf6, 0xde, 0xa6, 0x32, 0xbf,
+	0xac, 0xca, 0xca, 0xca, 0xca, 0xca, 0xca, 0xea, 0x81, 0xe6, 0xf3, 0x20, 0xba, 0x64, 0xd1, 0x66,
+	0x18, 0x05, 0x3c, 0x20, 0xe5, 0x38, 0xf0, 0x27, 0xdd, 0xb6, 0x13, 0x5f, 0x7e, 0x1f, 0x8e, 0x1d,
+	0x5f, 0x52, 0xbb, 0x9d, 0x33, 0xe6, 0x0f, 0x2f, 0x26, 0x4e, 0x74, 0x19, 0x2b, 0x4a, 0xf3, 0xcc,
+	0x1b, 0x79, 0x3e, 0x57, 0x2d, 0x32, 0x74, 0x42, 0xe7, 0xcc, 0x1b, 0x7b, 0xdc, 0x63, 0x1a, 0xb1,
+	0x32, 0x0c, 0x7c, 0xee, 0x78, 0xbe, 0xee, 0xba, 0xdb, 0x18, 0xb1, 0xc0, 0x0b, 0x35, 0xd7, 0xf3,
+	0x71, 0x24, 0xdf, 0x73, 0x14, 0x61, 0x15, 0x7b, 0x67, 0x3c, 0x1c, 0x3b, 0x43, 0xa6, 0x48, 0x75,
+	0x9f, 0xe9, 0x01, 0x56, 0xb8, 0x37, 0x61, 0x31, 0x77, 0x26, 0x5a, 0xbe, 0xf9, 0x2c, 0x18, 0x4f,
+	0x27, 0x0a, 0x69, 0xdf, 0x80, 0xea, 0x89, 0x13, 0x5f, 0x9e, 0x38, 0x23, 0x42, 0xa0, 0xec, 0x3a,
+	0xdc, 0xb1, 0x0a, 0xb7, 0x0a, 0x1b, 0x4d, 0x2a, 0x7e, 0xdb, 0xbf, 0x2a, 0x40, 0x0d, 0xf9, 0xc7,
+	0x21, 0x1b, 0x92, 0x4f, 0xa0, 0x9e, 0x68, 0x26, 0x50, 0x8d, 0x07, 0x2b, 0x9b, 0xa8, 0xcb, 0xe6,
+	0x8e, 0x26, 0xd3, 0x14, 0x41, 0xee, 0x42, 0x2d, 0x62, 0x23, 0x2f, 0xe6, 0xd1, 0x95, 0x55, 0x14,
+	0xe8, 0xb6, 0x44, 0x53, 0x45, 0xa5, 0x09, 0x9f, 0x7c, 0x0e, 0xf5, 0x88, 0xc5, 0xc1, 0x34, 0x1a,
+	0xb2, 0xd8, 0x2a, 0x09, 0xf0, 0xba, 0x04, 0x6f, 0xc5, 0x97, 0x83, 0xb1, 0xe3, 0x53, 0xcd, 0xa5,
+	0x29, 0x90, 0xbc, 0x0b, 0x25, 0xee, 0x8c, 0xac, 0xb2, 0xc0, 0xb7, 0x24, 0x5e, 0xcd, 0x86, 0x22,
+	0x87, 0x3c, 0x80, 0x66, 0x38, 0x8d, 0x2f, 0xf4, 0x80, 0xd6, 0x72, 0xae, 0x1a, 0x19, 0x8c, 0xfd,
+	0xfb, 0xd0, 0x39, 0xe6, 0x4e, 0xc4, 0xb1, 0x23, 0xca, 0x7e, 0x31, 0x65, 0x31, 0x27, 0x77, 0xa0,
+	0xe2, 0x32, 0x67, 0xdc, 0xdb, 0x55, 0xd3, 0x6e, 0xca, 0x1e, 0xb6, 0xbd, 0x51, 0xcf, 0xe7, 0x54,
+	0xf1, 0x88, 0x0d, 0xe5, 0x38, 0x64, 0xc3, 0xec, 0x64, 0xb5, 0xf5, 0xa8, 0xe0, 0xd9, 0x7f, 0x04,
+	0x84, 0xb2, 0x98, 0x07, 0x11, 0xfb, 0x3f, 0xe9, 0x9f, 0xdc, 0x04, 0x18, 0x5e, 0xb0, 0xe1, 0x65,
+	0x18, 0x78, 0x3e, 0x17, 0x96, 0xac, 0x53, 0x83, 0x62, 0x0f, 0xc0, 0x7a, 0x2a, 0xbc, 0xf6, 0xdb,
+	0xc0, 0xf3, 0xfb, 0x8c, 0xa3, 0x0b, 0x6b, 0x2d, 0xd6, 0xa1, 0xc2, 0x9d, 0xf8, 0x52, 0x69, 0x51,
+	0xa7, 0xaa, 0x45, 0xde, 0x81, 0xba, 0x2f, 0x91, 0xbd, 0x5d, 0x31, 0x78, 0x9d, 0xa6, 0x04, 0xfb,
+	0xcf, 0x8a, 0xd0, 0x36, 0x0c, 0x16, 0x8e, 0xaf, 0x48, 0x1b, 0x8a, 0x9e, 0xab, 0x3a, 0x29, 0x7a,
+	0x2e, 0x79, 0x08, 0xd5, 0x30, 0x88, 0xf8, 0x13, 0x27, 0xb4, 0x8a, 0xb7, 0x4a, 0x1b, 0x8d, 0x07,
+	0xef, 0x49, 0xdd, 0xb3, 0x62, 0x9b, 0x03, 0x89, 0xd9, 0xf3, 0x71, 0x51, 0xb4, 0x04, 0xce, 0x28,
+	0x19, 0x0c, 0x7d, 0xa3, 0x84, 0x33, 0x4a, 0x29, 0xe4, 0x6b, 0x68, 0x23, 0x74, 0x3f, 0x88, 0x9e,
+	0x3b, 0x91, 0xeb, 0xf9, 0xda, 0x1f, 0xae, 0xc9, 0x31, 0x06, 0x19, 0x1e, 0x9d, 0xc1, 0x76, 0x1f,
+	0x43, 0xd3, 0x1c, 0x96, 0x74, 0xa0, 0x74, 0xc9, 0xae, 0x94, 0xee, 0xf8, 0x93, 0xbc, 0x0f, 0xcb,
+	0xcf, 0x9c, 0xf1, 0x94, 0x59, 0x45, 0xd3, 0xe3, 0xf7, 0x7c, 0x57, 0x18, 0x34, 0xa6, 0x92, 0xfb,
+	0x55, 0xf1, 0x77, 0x0a, 0xf6, 0x21, 0xb4, 0xb3, 0xc3, 0x91, 0x3b, 0x50, 0x76, 0x5c, 0x57, 0xef,
+	0x96, 0x8e, 0x92, 0xe5, 0x17, 0x5b, 0xae, 0x1b, 0xb1, 0x38, 0xa6, 0x82, 0x4b, 0xae, 0xc1, 0x32,
+	0xaa, 0x15, 0x0b, 0xeb, 0xd4, 0xa9, 0x6c, 0xd8, 0xff, 0x54, 0x80, 0x16, 0x1a, 0xe7, 0x51, 0x14,
+	0x4c, 0x43, 0xb1, 0x01, 0xef, 0xc0, 0x32, 0x2e, 0x49, 0x6c, 0x15, 0x6e, 0x95, 0x72, 0x3c, 0x40,
+	0x32, 0xc9, 0x57, 0x50, 0x95, 0x5b, 0x3c, 0x56, 0xd6, 0xbe, 0x95, 0xe2, 0x92, 0xbe, 0x36, 0x7f,
+	0x26, 0x21, 0xca, 0xd8, 0x4a, 0xa0, 0x7b, 0x00, 0x4d, 0x93, 0x91, 0x63, 0x0e, 0x3b, 0x6b, 0x0e,
+	0xe5, 0xa9, 0x52, 0xc8, 0xb4, 0xc5, 0x39, 0x5c, 0x4f, 0x96, 0x57, 0x8c, 0xfa, 0x7a, 0xbe, 0xfe,
+	0x61, 0xc6, 0xd7, 0xd7, 0x72, 0x66, 0xa0, 0x36, 0xd4, 0x77, 0xb0, 0x36, 0x3b, 0x4e, 0x9e, 0x0b,
+	0xde, 0xd5, 0xa6, 0x93, 0x26, 0xb9, 0x96, 0xe7, 0x80, 0xca, 0x80, 0xf6, 0xaf, 0x0b, 0x70, 0x2d,
+	0x1d, 0x8a, 0x3b, 0x7c, 0x1a, 0xcb, 0x4e, 0x3f, 0x87, 0x4a, 0x2c, 0x9a, 0xa2, 0xe3, 0xf6, 0x83,
+	0x77, 0x8c, 0x05, 0x48, 0x61, 0x9b, 0xea, 0xb7, 0xc2, 0x12, 0x0b, 0xaa, 0x72, 0x23, 0xe9, 0xf5,
+	0xd5, 0x4d, 0xf2, 0x50, 0x2b, 0x55, 0x12, 0x4a, 0xbd, 0x3f, 0x3b, 0x4b, 0xa3, 0x4f, 0x24, 0xaa,
+	0xc5, 0x92, 0x32, 0xdd, 0x23, 0x80, 0x94, 0x98, 0xb3, 0x50, 0x1f, 0x67, 0x17, 0xea, 0x7a, 0xae,
+	0xae, 0xe6, 0x8a, 0xfd, 0x4b, 0x19, 0x1a, 0xe6, 0x6c, 0xd7, 0xa1, 0x32, 0x0d, 0xf1, 0xf4, 0x10,
+	0xbd, 0x96, 0xa9, 0x6a, 0xe1, 0x7c, 0x9e, 0xb1, 0x28, 0xf6, 0x02, 0x5f, 0x05, 0x03, 0xdd, 0x24,
+	0x5d, 0xa8, 0x85, 0x63, 0x87, 0x9f, 0x07, 0xd1, 0x44, 0x85, 0x9e, 0xa4, 0x8d, 0x52, 0x4c, 0xfa,
+	0xbd, 0xd8, 0x9f, 0x75, 0xaa, 0x9b, 0x18, 0x5e, 0x70, 0x46, 0x3b, 0xc1, 0xd4, 0xe7, 0x22, 0x42,
+	0xb7, 0x68, 0x4a, 0x40, 0xee, 0xee, 0xd3, 0x03, 0xa9, 0x97, 0x55, 0x91, 0xc1, 0x27, 0x21, 0x90,
+	0xbb, 0xd0, 0x89, 0x98, 0xef, 0xb2, 0x5f, 0x3e, 0x0b, 0xa6, 0xb1, 0x02, 0x55, 0x05, 0x68, 0x8e,
+	0x4e, 0x36, 0xa0, 0x32, 0x71, 0x62, 0xce, 0x22, 0xab, 0xb6, 0x60, 0x37, 0x2a, 0x3e, 0xf9, 0x00,
+	0x96, 0x1d, 0x77, 0xe2, 0xf9, 0x56, 0x7d, 0x01, 0x50, 0xb2, 0xc9, 0x3d, 0x58, 0xf5, 0xe2, 0x27,
+	0x42, 0x66, 0x27, 0xf0, 0xcf, 0xbd, 0x68, 0xc2, 0x5c, 0x0b, 0x6e, 0x15, 0x36, 0x6a, 0x74, 0x9e,
+	0x41, 0x3e, 0x85, 0x35, 0x2f, 0xde, 0xd6, 0xe9, 0xc0, 0xbe, 0xe7, 0x7b, 0xf1, 0x05, 0x73, 0xad,
+	0x86, 0xc0, 0xe7, 0xb1, 0xc8, 0x0d, 0x28, 0x8d, 0x58, 0x60, 0x35, 0x85, 0x16, 0x0d, 0xa9, 0xc5,
+	0x23, 0x16, 0xf4, 0x06, 0x14, 0xe9, 0xe4, 0x0b, 0x58, 0xf7, 0xe2, 0x63, 0x1e, 0x44, 0xce, 0x88,
+	0x7d, 0x37, 0x0d, 0xb8, 0xb3, 0xe7, 0x9f, 0x07, 0xd1, 0x90, 0xb9, 0x56, 0x4b, 0xf4, 0xb9, 0x80,
+	0x4b, 0x36, 0x81, 0xc4, 0x06, 0x5d, 0x99, 0xad, 0x2d, 0xcc, 0x96, 0xc3, 0x21, 0x1f, 0xc2, 0xb2,
+	0x1b, 0x39, 0x9e, 0x6f, 0xad, 0x08, 0x45, 0x56, 0xa5, 0x22, 0xbb, 0x48, 0x52, 0xfe, 0x22, 0xf9,
+	0xf6, 0x3f, 0x14, 0xa0, 0x61, 0x90, 0x31, 0x74, 0xc6, 0x9e, 0x3f, 0x64, 0xd9, 0x64, 0xe1, 0x44,
+	0x27, 0x24, 0x54, 0x72, 0xd1, 0xd1, 0x82, 0xc8, 0x65, 0x51, 0x2c, 0xfc, 0xa9, 0x45, 0x55, 0x8b,
+	0xd8, 0xd0, 0x3c, 0x97, 0xa1, 0x74, 0x97, 0x39, 0x63, 0x99, 0x17, 0xb4, 0x68, 0x86, 0x86, 0xee,
+	0x11, 0x87, 0x01, 0x97, 0x80, 0xb2, 0x74, 0x9e, 0x84, 0x20, 0x52, 0x9a, 0xc0, 0x67, 0xc2, 0xab,
+	0x6a, 0x54, 0xfc, 0xb6, 0xff, 0xae, 0x00, 0x2d, 0x95, 0x54, 0x28, 0x47, 0xff, 0x06, 0x6a, 0x8e,
+	0x22, 0x58, 0x05, 0xf3, 0x7c, 0xca, 0xc0, 0x92, 0x96, 0xdc, 0x85, 0x89, 0x48, 0xf7, 0x5b, 0x68,
+	0x65, 0x58, 0x39, 0x7b, 0xf1, 0x76, 0x76, 0x2f, 0xb6, 0x32, 0xdd, 0x9b, 0x7b, 0xf0, 0xaf, 0x54,
+	0xcc, 0x3f, 0xf4, 0x62, 0x2e, 0x95, 0xfb, 0x11, 0x94, 0x3d, 0xff, 0x3c, 0x50, 0x8a, 0xdd, 0x48,
+	0x77, 0x71, 0x02, 0xd9, 0xec, 0xf9, 0xe7, 0x81, 0x54, 0x4a, 0x40, 0xbb, 0x7d, 0xa8, 0x27, 0xa4,
+	0x1f, 0x22, 0x30, 0xfc, 0xba, 0x00, 0x2b, 0x89, 0x73, 0x52, 0x16, 0x4f, 0xc7, 0x1c, 0xe3, 0xab,
+	0x8a, 0xe0, 0x65, 0x5a, 0xec, 0xed, 0xa2, 0xa5, 0x87, 0x81, 0xcb, 0x54, 0x44, 0x10, 0xbf, 0xf1,
+	0xf0, 0xe3, 0x57, 0x21, 0x13, 0xeb, 0xd6, 0xd6, 0xbb, 0x68, 0x97, 0x3d, 0xf3, 0x86, 0xec, 0xe4,
+	0x2a, 0x64, 0x54, 0x70, 0x71, 0xf5, 0x5d, 0x41, 0x53, 0x71, 0x41, 0xb5, 0xc8, 0x2d, 0x68, 0x9c,
+	0x7b, 0xfe, 0x88, 0x45, 0x61, 0xe4, 0xa9, 0xc0, 0x50, 0xa7, 0x26, 0x09, 0x25, 0x23, 0xa1, 0x8d,
+	0x88, 0x0b, 0x65, 0xaa, 0x5a, 0x82, 0x3e, 0xf5, 0x31, 0x49, 0x95, 0xa1, 0x40, 0xb5, 0x30, 0x7f,
+	0x4d, 0x92, 0x61, 0xab, 0x96, 0xef, 0x92, 0x29, 0xc2, 0xfe, 0xcf, 0x02, 0x5c, 0x33, 0xa6, 0x8d,
+	0x87, 0xb2, 0x5c, 0x92, 0x0d, 0xa8, 0xc8, 0xcc, 0x7f, 0xe1, 0xb1, 0xae, 0xf8, 0xb3, 0x73, 0x28,
+	0xce, 0xcf, 0x21, 0xa3, 0x53, 0xe9, 0x55, 0x3a, 0x91, 0xfb, 0x50, 0x95, 0x93, 0x44, 0x67, 0x2f,
+	0xa5, 0xab, 0x37, 0xb3, 0x3c, 0x54, 0xa3, 0xc4, 0xfe, 0xf0, 0x46, 0xbe, 0xc3, 0xa7, 0x91, 0xdc,
+	0x06, 0x4d, 0x9a, 0x12, 0xec, 0x7f, 0x2e, 0x42, 0x53, 0x2e, 0x88, 0xda, 0x0a, 0x6f, 0x43, 0x69,
+	0x67, 0x70, 0xaa, 0xe6, 0x55, 0x57, 0xc9, 0xfd, 0xe0, 0x94, 0x22, 0x95, 0xdc, 0x80, 0xf2, 0xa3,
+	0xc1, 0xa9, 0x3e, 0x42, 0x15, 0xf7, 0xd1, 0xe0, 0x94, 0x0a, 0x32, 0xca, 0xd2, 0xad, 0x27, 0x6a,
+	0x12, 0x8a, 0x4b, 0xb7, 0x9e, 0x50, 0xa4, 0x92, 0x0f, 0xa1, 0xaa, 0x72, 0xb6, 0x6c, 0xba, 0xae,
+	0x53, 0x50, 0xcd, 0x45, 0xa0, 0x0a, 0x41, 0xd6, 0xb2, 0x09, 0x54, 0x91, 0x8c, 0x6a, 0x2e, 0xd9,
+	0x06, 0x38, 0x77, 0xa6, 0x63, 0x7e, 0x25, 0x74, 0xaa, 0x08, 0x9d, 0x6c, 0xd3, 0xc7, 0xd4, 0xb6,
+	0xdd, 0x4f, 0x40, 0x72, 0x8f, 0x18, 0x52, 0xdd, 0x6f, 0x60, 0x65, 0x86, 0x9d, 0xb3, 0x5f, 0xae,
+	0x99, 0xfb, 0xa5, 0x6e, 0x6e, 0x8c, 0xff, 0x2a, 0xc0, 0xea, 0x13, 0xc7, 0xf3, 0x39, 0xf3, 0x1d,
+	0x7f, 0xc8, 0x9e, 0x7a, 0xbe, 0x1b, 0x3c, 0x37, 0xb6, 0x46, 0x5d, 0x6c, 0x0d, 0x8c, 0x82, 0xdc,
+	0x89, 0xb8, 0x55, 0xcc, 0x5f, 0x5e, 0xc9, 0xc5, 0xc3, 0x33, 0x1e, 0x5e, 0x30, 0x77, 0x3a, 0x66,
+	0xfa, 0xf0, 0xd4, 0x6d, 0xbc, 0x4a, 0xb9, 0xd3, 0xc8, 0xe1, 0x78, 0xe6, 0x96, 0xcd, 0xec, 0x7f,
+	0x57, 0x51, 0x69, 0xc2, 0x47, 0x8f, 0xf2, 0xd9, 0x0b, 0x2e, 0x52, 0x1b, 0x6b, 0x39, 0x7f, 0xc8,
+	0x14, 0x41, 0x3e, 0xc2, 0x85, 0x79, 0xc1, 0xf7, 0x7c, 0xd7, 0xaa, 0xe4, 0x83, 0x35, 0xdf, 0xde,
+	0x83, 0x8e, 0x31, 0x5b, 0x1d, 0x9e, 0xaa, 0xcf, 0xc5, 0xb4, 0x75, 0xe8, 0xfc, 0x2d, 0x29, 0x3e,
+	0x67, 0x16, 0xaa, 0x71, 0x76, 0x0f, 0xd6, 0x54, 0xe4, 0x3b, 0xf0, 0x70, 0x31, 0xaf, 0x64, 0x4f,
+	0x0f, 0xa0, 0x3a, 0xbc, 0x70, 0xfc, 0x11, 0xd3, 0x3d, 0x59, 0x99, 0x28, 0x39, 0x88, 0xbc, 0x21,
+	0xdb, 0x11, 0x00, 0xaa, 0x81, 0xb6, 0x03, 0x2b, 0x83, 0xe9, 0x78, 0x6c, 0x5e, 0xa5, 0xd6, 0x55,
+	0x7a, 0xa9, 0x93, 0x3f, 0xd5, 0x4a, 0x2e, 0x37, 0xae, 0x5a, 0x46, 0xd5, 0xca, 0xb9, 0x30, 0xd5,
+	0x32, 0x17, 0xa6, 0xff, 0x28, 0x41, 0x0b, 0x0f, 0x13, 0x8c, 0xa8, 0x52, 0xd1, 0x9b, 0x50, 0xc6,
+	0x3e, 0xd5, 0x26, 0x01, 0xed, 0x72, 0xce, 0x98, 0x0a, 0x3a, 0xe6, 0xdf, 0x18, 0x70, 0xf0, 0x26,
+	0x92, 0xc9, 0xbf, 0x33, 0xbd, 0x6c, 0x52, 0x09, 0x51, 0xf9, 0xb7, 0x12, 0x20, 0x3f, 0xc5, 0x2b,
+	0xf6, 0x24, 0x1c, 0x33, 0xce, 0x5c, 0xab, 0x94, 0xf5, 0x69, 0x53, 0x7a, 0x47, 0x83, 0xa4, 0x7c,
+	0x2a, 0x94, 0xbd, 0x49, 0x97, 0xff, 0xa7, 0x37, 0xe9, 0x77, 0xa0, 0x1e, 0x4e, 0xcf, 0xc6, 0xde,
+	0xb0, 0x37, 0x88, 0xad, 0x65, 0x91, 0xa5, 0xa6, 0x04, 0xb2, 0x09, 0x55, 0x1e, 0x39, 0xe7, 0xe7,
+	0xde, 0x50, 0xf9, 0xc8, 0xb5, 0xcc, 0xe6, 0x3d, 0x91, 0x3c, 0xaa, 0x41, 0xdd, 0xef, 0xa0, 0x69,
+	0x4e, 0xef, 0x07, 0x38, 0x83, 0xba, 0xc7, 0xd0, 0xce, 0xce, 0xf9, 0x87, 0x38, 0xd8, 0xfe, 0xa4,
+	0x0a, 0x2b, 0x33, 0xec, 0xff, 0x65, 0x8e, 0xff, 0x0e, 0xd4, 0xbd, 0x89, 0x33, 0x62, 0x7d, 0x67,
+	0xa2, 0xe3, 0x44, 0x4a, 0x20, 0x5f, 0xa7, 0xf7, 0xdf, 0xcc, 0x9a, 0xce, 0x76, 0x9a, 0x7f, 0x01,
+	0x4e, 0xf3, 0xf0, 0x72, 0x26, 0x0f, 0xff, 0x08, 0x96, 0xa7, 0x71, 0x1a, 0x27, 0xd7, 0x74, 0x55,
+	0x43, 0xae, 0xe9, 0x29, 0xb2, 0xa8, 0x44, 0x90, 0x7d, 0x20, 0xce, 0x78, 0x1c, 0x0c, 0x1d, 0xce,
+	0x5c, 0x9a, 0x78, 0x47, 0xe5, 0xa5, 0xde, 0x91, 0x23, 0xa1, 0x0b, 0x2e, 0xd5, 0x85, 0x05, 0x97,
+	0xcf, 0xa0, 0x7e, 0xc1, 0x9c, 0x31, 0xbf, 0x38, 0x0c, 0x46, 0x56, 0xcd, 0x3c, 0xa1, 0x10, 0x76,
+	0x20, 0x58, 0x83, 0x28, 0x38, 0x63, 0x34, 0xc5, 0xe1, 0xd5, 0x60, 0x84, 0xf7, 0x9d, 0xde, 0xae,
+	0x48, 0xb8, 0xeb, 0x54, 0x37, 0xf1, 0x6e, 0x3f, 0x76, 0x62, 0xbe, 0x93, 0x6e, 0x50, 0x30, 0xfd,
+	0x0f, 0xfb, 0x4c, 0x79, 0x74, 0x06, 0x8b, 0x11, 0x35, 0x62, 0x22, 0xb8, 0xc6, 0x22, 0xcb, 0x6e,
+	0xd1, 0xa4, 0x8d, 0x11, 0x15, 0xd1, 0x7b, 0x2f, 0x3c, 0x6e, 0x35, 0xcd, 0x88, 0x8a, 0x7d, 0x22,
+	0x95, 0x26, 0x7c, 0xf2, 0x0d, 0xb4, 0xe2, 0x30, 0x08, 0xc6, 0x83, 0x28, 0x18, 0xe1, 0xf1, 0x2e,
+	0xd2, 0xeb, 0x24, 0xd2, 0xf5, 0x70, 0x99, 0x31, 0x0a, 0x69, 0x36, 0xcd, 0xa2, 0x73, 0x0a, 0x14,
+	0xed, 0xdf, 0x54, 0x81, 0xe2, 0x6f, 0x0a, 0x50, 0x51, 0xb9, 0x79, 0x03, 0xaa, 0xa7, 0xfd, 0xc7,
+	0xfd, 0xa3, 0xa7, 0xfd, 0xce, 0x12, 0x69, 0x42, 0xed, 0x78, 0x70, 0x74, 0x74, 0xd8, 0xeb, 0x3f,
+	0xea, 0x14, 0x64, 0x6b, 0xeb, 0x69, 0x1f, 0x5b, 0x45, 0x04, 0xd2, 0xd3, 0xbe, 0x68, 0x94, 0x90,
+	0xb5, 0xdf, 0xeb, 0xf7, 0x8e, 0x0f, 0xf6, 0x76, 0x3b, 0x65, 0x02, 0x50, 0xd9, 0xa6, 0x47, 0x8f,
+	0xf7, 0xfa, 0x9d, 0x65, 0xd2, 0x06, 0x78, 0xdc, 0x3b, 0x3c, 0xdc, 0xdb, 0xfd, 0xfe, 0xe8, 0xe8,
+	0x49, 0xa7, 0x82, 0x62, 0x07, 0x7b, 0x5b, 0x87, 0x27, 0x07, 0x3f, 0xef, 0x54, 0x49, 0x0b, 0xea,
+	0xa7, 0x7d, 0xdd, 0xac, 0x21, 0x96, 0xee, 0x1d, 0x9f, 0x6c, 0xd1, 0x13, 0xec, 0xb5, 0x6e, 0xff,
+	0x21, 0xac, 0xce, 0x59, 0x11, 0xbd, 0x62, 0x38, 0x8d, 0x22, 0xe6, 0x73, 0x95, 0x66, 0xea, 0x26,
+	0x1e, 0xc8, 0x3c, 0xe0, 0xce, 0x58, 0x4c, 0xb8, 0x4c, 0x65, 0x03, 0xb7, 0xc9, 0xd8, 0xb9, 0xc2,
+	0x5b, 0x84, 0xbc, 0x27, 0xa8, 0x16, 0x06, 0x78, 0xf9, 0x6b, 0x37, 0xf0, 0xe5, 0x16, 0x6a, 0x51,
+	0x83, 0x62, 0x4f, 0xe0, 0xfa, 0x20, 0x62, 0xe7, 0x8c, 0x0f, 0x2f, 0x84, 0x12, 0xb1, 0x71, 0x92,
+	0x88, 0x2d, 0x2c, 0xcf, 0xa3, 0x3a, 0x55, 0xad, 0xd7, 0xaa, 0x6b, 0x76, 0xa0, 0x14, 0x7a, 0xbe,
+	0x3a, 0x56, 0xf0, 0xa7, 0xfd, 0xaf, 0x05, 0x68, 0xec, 0x38, 0x78, 0xb0, 0x8b, 0xd1, 0x70, 0x32,
+	0xa2, 0x5f, 0xb5, 0xa2, 0xb2, 0x81, 0xe9, 0x74, 0xec, 0xfd, 0x92, 0xa9, 0x19, 0x8a, 0xdf, 0xe4,
+	0x63, 0xe9, 0xb2, 0xa7, 0xb1, 0x38, 0x1a, 0x72, 0x8f, 0xea, 0x04, 0x80, 0xca, 0x87, 0x9e, 0xef,
+	0x33, 0x57, 0xcc, 0xb8, 0x46, 0x55, 0x8b, 0x7c, 0x06, 0xb5, 0x50, 0xbb, 0xf1, 0xf2, 0xcb, 0xdd,
+	0x38, 0x01, 0xa2, 0x8e, 0x2c, 0x8a, 0x82, 0x48, 0xdd, 0xbf, 0x65, 0xc3, 0x7e, 0x06, 0x0d, 0x6d,
+	0x30, 0x0c, 0x9c, 0x1f, 0x65, 0xcc, 0x95, 0x5c, 0x13, 0x8d, 0xb9, 0x26, 0x16, 0xbc, 0x09, 0xe0,
+	0x7a, 0xf1, 0xe5, 0xf6, 0xd4, 0x1d, 0x31, 0xae, 0xe6, 0x68, 0x50, 0x30, 0x9a, 0x62, 0x4b, 0x84,
+	0x30, 0x31, 0xd5, 0x32, 0x4d, 0x09, 0xf6, 0xe7, 0x00, 0x78, 0x18, 0xca, 0x92, 0x13, 0x5a, 0xca,
+	0x77, 0x26, 0xda, 0x7c, 0xe2, 0x77, 0x9e, 0xf5, 0xec, 0x13, 0xe8, 0xa4, 0x52, 0x4a, 0xe5, 0xbb,
+	0x69, 0xa5, 0x4c, 0xea, 0xdc, 0x49, 0xcf, 0x5a, 0x09, 0x4c, 0x2a, 0x63, 0x68, 0x83, 0x5f, 0xe0,
+	0x9d, 0x58, 0x3b, 0x9d, 0x68, 0xd8, 0xa7, 0xd0, 0xce, 0x06, 0xa1, 0x05, 0xeb, 0x99, 0x49, 0xf3,
+	0x8b, 0xaf, 0xbc, 0x7a, 0xfc, 0xb5, 0x2a, 0xbb, 0x8b, 0xf0, 0xd3, 0x85, 0x1a, 0x7b, 0xe1, 0xf1,
+	0x9d, 0xc0, 0x95, 0x9d, 0x2e, 0xd3, 0xa4, 0x8d, 0x96, 0x0a, 0x82, 0xc9, 0x63, 0x6f, 0x3c, 0x66,
+	0x32, 0xb1, 0xa9, 0xd1, 0x94, 0x40, 0xee, 0x03, 0x9c, 0xab, 0x5a, 0xc2, 0x16, 0x5f, 0xe4, 0x33,
+	0x06, 0x04, 0xbb, 0x1b, 0x46, 0x4e, 0x7c, 0x71, 0x18, 0x04, 0xa1, 0x72, 0x9c, 0x94, 0x80, 0x05,
+	0xc9, 0x15, 0xa9, 0x15, 0x1b, 0xea, 0x4d, 0x32, 0x5b, 0x67, 0xeb, 0x40, 0x69, 0x38, 0x71, 0x55,
+	0xa1, 0x0b, 0x7f, 0x22, 0x85, 0xf9, 0xcf, 0x54, 0xe1, 0x16, 0x7f, 0x22, 0x85, 0xf3, 0x2b, 0xd5,
+	0x3f, 0xfe, 0x44, 0xa3, 0xc5, 0xdc, 0xf5, 0x7c, 0x75, 0x43, 0x91, 0x0d, 0x91, 0x9a, 0x8d, 0x83,
+	0x98, 0x1d, 0x0b, 0x56, 0x45, 0xa5, 0x66, 0x09, 0x85, 0xdc, 0x83, 0x8a, 0xcc, 0x29, 0xad, 0xaa,
+	0x19, 0x50, 0xb5, 0x8a, 0x2a, 0xef, 0x54, 0x18, 0xfb, 0x27, 0xd0, 0xce, 0x72, 0x70, 0xd4, 0xe7,
+	0x9e, 0xcb, 0x2f, 0x84, 0xfa, 0x2d, 0x2a, 0x1b, 0xb8, 0x73, 0x2e, 0x98, 0x37, 0xba, 0xe0, 0xba,
+	0x1a, 0x21, 0x5b, 0x76, 0x0c, 0x2d, 0x2d, 0x9f, 0xd4, 0xc7, 0x62, 0xee, 0x06, 0x53, 0xae, 0x5e,
+	0x4c, 0x54, 0x4b, 0xd1, 0x59, 0x14, 0x59, 0xc5, 0x84, 0xce, 0xa2, 0x08, 0xe9, 0xb8, 0x6e, 0x6a,
+	0xf7, 0xd6, 0xa8, 0x6a, 0x65, 0xd6, 0xb7, 0x9c, 0x5d, 0x5f, 0xfb, 0x09, 0xac, 0x0a, 0xff, 0x0a,
+	0xc2, 0xab, 0x93, 0x60, 0x91, 0xcd, 0x09, 0x94, 0x43, 0x87, 0x5f, 0xe8, 0xbb, 0x37, 0xfe, 0xc6,
+	0xb9, 0x0d, 0x2f, 0xa6, 0xfe, 0xa5, 0x18, 0xab, 0x49, 0x65, 0xc3, 0xfe, 0x12, 0xd6, 0x74, 0x77,
+	0xfb, 0x51, 0x30, 0x79, 0x8d, 0x0e, 0xed, 0x3f, 0x2f, 0x00, 0x41, 0xd9, 0x27, 0x8c, 0x47, 0xde,
+	0x30, 0x5e, 0x24, 0x7a, 0x1b, 0xca, 0xe7, 0x51, 0x30, 0x59, 0xe4, 0xe3, 0x82, 0x49, 0xde, 0x85,
+	0x22, 0x0f, 0x16, 0xf9, 0x63, 0x91, 0x07, 0xe2, 0xa5, 0x83, 0xb3, 0x70, 0xc1, 0x5d, 0x47, 0xf0,
+	0xec, 0xbf, 0x2c, 0xc2, 0xaa, 0xa1, 0xd0, 0xb1, 0x83, 0xd9, 0x61, 0x76, 0xa3, 0x15, 0x5e, 0x79,
+	0x9f, 0x46, 0x77, 0x0d, 0xa7, 0x42, 0xdb, 0x02, 0xc5, 0x9f, 0xb8, 0x4a, 0x13, 0x36, 0x09, 0xa2,
+	0x2b, 0x15, 0x78, 0x54, 0x0b, 0xaf, 0xf2, 0xd1, 0x8b, 0xed, 0x2b, 0xce, 0x62, 0xea, 0x70, 0xb9,
+	0x50, 0x05, 0x6a, 0x92, 0x10, 0xc1, 0x0d, 0xc4, 0xb2, 0x44, 0x18, 0x24, 0x72, 0x07, 0x5a, 0x67,
+	0xe3, 0x60, 0x78, 0x49, 0x99, 0xe3, 0x0a, 0x4c, 0x45, 0x60, 0xb2, 0x44, 0xf2, 0x01, 0xb4, 0x05,
+	0xe1, 0x69, 0xe4, 0x71, 0x26, 0x60, 0x55, 0x01, 0x9b, 0xa1, 0xa2, 0xee, 0xa3, 0x70, 0x2a, 0x0a,
+	0x19, 0x05, 0x8a, 0x3f, 0xf1, 0x82, 0x96, 0x59, 0x22, 0x75, 0x41, 0x8b, 0x85, 0x69, 0x66, 0x2e,
+	0x68, 0x73, 0xa6, 0xa3, 0x1a, 0x67, 0xff, 0x85, 0xda, 0xe7, 0x46, 0xba, 0x96, 0x5e, 0x62, 0x0b,
+	0x2f, 0xbd, 0xc4, 0xbe, 0x87, 0x9b, 0xdd, 0x5d, 0xb4, 0xfa, 0xc8, 0xcb, 0xb8, 0x7b, 0x69, 0x26,
+	0x9c, 0x61, 0x25, 0x70, 0xca, 0xc3, 0x29, 0xd7, 0xb5, 0x20, 0xd9, 0xb2, 0xff, 0x51, 0xc5, 0xc3,
+	0x41, 0x10, 0x8c, 0xc9, 0x06, 0x94, 0x9c, 0xb1, 0xbe, 0x7e, 0x2d, 0xca, 0x5e, 0x11, 0x42, 0xee,
+	0x41, 0x79, 0x1a, 0x33, 0xd7, 0x2a, 0x9a, 0xf7, 0x49, 0xdd, 0xcf, 0x26, 0x9e, 0x93, 0xaa, 0x6c,
+	0x86, 0xa8, 0xee, 0x11, 0xd4, 0x13, 0x52, 0x4e, 0x9a, 0x75, 0x2f, 0x9b, 0x66, 0x2d, 0x1a, 0xd8,
+	0xc8, 0xb6, 0xfe, 0xb8, 0x02, 0x0d, 0x7d, 0x7b, 0x7d, 0x3d, 0xc5, 0x1f, 0x42, 0x0d, 0x55, 0x3a,
+	0x0e, 0x03, 0xae, 0x94, 0x7f, 0x37, 0x7b, 0x19, 0xd6, 0xfa, 0x23, 0x42, 0xd5, 0x23, 0xb5, 0x00,
+	0xf9, 0x31, 0x54, 0xf0, 0xf7, 0xfe, 0x73, 0xab, 0x64, 0xd6, 0x0c, 0x67, 0x45, 0xf7, 0x9f, 0x4b,
+	0x41, 0x05, 0x26, 0x8f, 0xa0, 0x39, 0x0c, 0x26, 0x13, 0x8f, 0xcb, 0x6e, 0x54, 0x7d, 0xe9, 0xf6,
+	0xbc, 0xf0, 0x8e, 0x81, 0x92, 0x5d, 0x64, 0x04, 0xc9, 0x16, 0x80, 0x6e, 0xef, 0x3f, 0xb7, 0x96,
+	0x73, 0x0a, 0xaa, 0x99, 0x6e, 0xb4, 0x1e, 0x86, 0x10, 0xea, 0xc2, 0xfe, 0x80, 0x0d, 0x39, 0x73,
+	0x65, 0x55, 0xb6, 0xb2, 0x48, 0x97, 0x3d, 0x03, 0xa5, 0x74, 0x31, 0x05, 0xb1, 0x36, 0x9b, 0x31,
+	0xd3, 0x1b, 0xd4, 0x66, 0xbb, 0x07, 0xd0, 0x30, 0xec, 0xf6, 0x26, 0x3d, 0xf5, 0x61, 0x75, 0xce,
+	0x88, 0x6f, 0xd2, 0xdf, 0x21, 0xac, 0xcc, 0x58, 0xf3, 0x0d, 0xb5, 0x9b, 0x33, 0xeb, 0x9b, 0xd4,
+	0xb4, 0x7f, 0x55, 0x84, 0xd6, 0xb1, 0xaa, 0x64, 0x45, 0xbb, 0x0e, 0x77, 0xc8, 0x21, 0xb4, 0x38,
+	0xde, 0x1a, 0x03, 0x85, 0x56, 0x91, 0xe9, 0x03, 0x55, 0xe9, 0x33, 0xb1, 0x9b, 0x27, 0x26, 0x50,
+	0x2e, 0x71, 0x56, 0x98, 0xf4, 0xa0, 0xe9, 0xa4, 0x2e, 0x31, 0xf3, 0x98, 0x96, 0xed, 0xcc, 0x70,
+	0x1d, 0xed, 0x2e, 0xa6, 0x28, 0xf9, 0x44, 0x3c, 0x60, 0x89, 0x86, 0x3a, 0x7b, 0x56, 0xe7, 0x7c,
+	0x8e, 0x26, 0x90, 0xee, 0x4f, 0xe5, 0x91, 0x98, 0x55, 0xef, 0x75, 0x2a, 0x88, 0xdd, 0x23, 0x58,
+	0x9d, 0xd3, 0x29, 0xa7, 0x83, 0x3b, 0x59, 0x5b, 0xb7, 0xb3, 0x91, 0xcc, 0xe8, 0xf0, 0xdb, 0x72,
+	0xad, 0xd8, 0x29, 0xd9, 0x7f, 0x5f, 0x82, 0xe6, 0xb1, 0x33, 0x66, 0xf1, 0xc4, 0xf1, 0x85, 0xc5,
+	0xfb, 0xd0, 0x56, 0x13, 0xdd, 0x11, 0x4f, 0x8b, 0xba, 0x88, 0xab, 0x4d, 0x6e, 0x60, 0x37, 0xb7,
+	0x32, 0x40, 0x69, 0xa6, 0x19, 0x69, 0xf2, 0x19, 0x2c, 0xbb, 0xea, 0x4d, 0xc6, 0x08, 0x31, 0x99,
+	0x6e, 0xc4, 0x0b, 0x8c, 0x94, 0x96, 0x58, 0xf2, 0x45, 0xf2, 0xce, 0x23, 0x63, 0xcb, 0xcd, 0x1c,
+	0xa9, 0x23, 0x01, 0x50, 0x91, 0x49, 0xa2, 0xbb, 0x5b, 0x49, 0xc1, 0xd0, 0xd4, 0xe9, 0xb5, 0xec,
+	0xbc, 0x2b, 0xef, 0x0c, 0x0b, 0x25, 0x6f, 0x65, 0x0d, 0x6c, 0x16, 0xf5, 0x8c, 0x5e, 0xf6, 0xa1,
+	0x61, 0xe8, 0x97, 0xd3, 0xcd, 0x7b, 0xd9, 0x6e, 0xd4, 0x93, 0x9d, 0x90, 0xc9, 0x1c, 0x0c, 0x05,
+	0x58, 0xd9, 0x65, 0x67, 0xd3, 0x11, 0xde, 0xc5, 0x55, 0x21, 0xf5, 0x4b, 0x68, 0xc5, 0xa6, 0xaf,
+	0x5a, 0x05, 0xb3, 0xaa, 0x93, 0x71, 0x63, 0x9a, 0x45, 0x92, 0x2f, 0xa0, 0x19, 0x1b, 0x36, 0x54,
+	0x83, 0x93, 0x79, 0xeb, 0xd2, 0x0c, 0xce, 0xfe, 0x12, 0x56, 0x07, 0xd3, 0x68, 0x24, 0xbe, 0x44,
+	0x89, 0x5f, 0xeb, 0x79, 0xde, 0x5e, 0x87, 0x6b, 0xf2, 0x33, 0x92, 0x6c, 0x3a, 0x68, 0xff, 0x6d,
+	0x01, 0xae, 0xcf, 0x30, 0xe2, 0x30, 0xf0, 0x63, 0x2c, 0xd7, 0x57, 0x27, 0x92, 0xa4, 0x76, 0xfb,
+	0x86, 0xec, 0x38, 0x17, 0xbd, 0xa9, 0xda, 0xaa, 0x12, 0xa6, 0x04, 0xbb, 0x5f, 0x41, 0xd3, 0x64,
+	0xbc, 0xca, 0x03, 0x0a, 0xa6, 0xcd, 0xff, 0xb4, 0x00, 0x5d, 0x39, 0xd6, 0x96, 0xeb, 0xee, 0xe8,
+	0x8f, 0xae, 0xae, 0xf4, 0xb4, 0xef, 0x42, 0x35, 0x9e, 0x9e, 0x61, 0xd8, 0x5b, 0xf8, 0xa8, 0xa3,
+	0x01, 0x58, 0x67, 0x8c, 0x87, 0x41, 0x28, 0x07, 0x69, 0xeb, 0x02, 0x57, 0xda, 0xe7, 0x31, 0x32,
+	0xa9, 0xc4, 0xc8, 0xcb, 0xce, 0x58, 0xd5, 0x24, 0xf0, 0xa7, 0x7d, 0x03, 0xde, 0xce, 0x55, 0x44,
+	0x4e, 0xdd, 0x7e, 0x01, 0x37, 0x24, 0x9b, 0xb2, 0x49, 0xf0, 0x8c, 0xfd, 0xff, 0xa9, 0x6a, 0xdf,
+	0x82, 0x9b, 0x8b, 0x46, 0x96, 0xba, 0xdd, 0x3d, 0x85, 0x95, 0x19, 0x59, 0xb2, 0x06, 0x2b, 0x3b,
+	0x5b, 0x83, 0xad, 0xed, 0xde, 0x61, 0xef, 0xe4, 0xe7, 0xdf, 0xf7, 0x8f, 0xfa, 0x7b, 0x9d, 0x25,
+	0x42, 0xa0, 0x6d, 0x10, 0x8f, 0x8f, 0x0f, 0x3a, 0x05, 0xf2, 0x16, 0x5c, 0x37, 0x68, 0xbd, 0xfe,
+	0xf1, 0x60, 0x6f, 0xe7, 0xa4, 0x77, 0xd4, 0xef, 0x14, 0x1f, 0xfc, 0x3b, 0x40, 0x47, 0xf9, 0x81,
+	0xe3, 0x3b, 0x23, 0x36, 0x61, 0x3e, 0x4e, 0x33, 0x29, 0x55, 0xa9, 0xf9, 0x4d, 0x42, 0x7e, 0xd5,
+	0x5d, 0x4d, 0xbe, 0xdc, 0xd0, 0x65, 0x53, 0x7b, 0x89, 0xdc, 0x83, 0xaa, 0x7a, 0xf3, 0xc9, 0x82,
+	0xc9, 0xfc, 0x7b, 0x90, 0xbd, 0x44, 0x3e, 0x85, 0xc6, 0x7e, 0xc4, 0xd8, 0x6b, 0x48, 0x7c, 0x0c,
+	0xcb, 0x62, 0x93, 0x64, 0xb1, 0x6b, 0x39, 0x8f, 0xb1, 0xf6, 0x12, 0xd9, 0x84, 0x9a, 0x7e, 0x0f,
+	0xce, 0xc5, 0x67, 0x5e, 0x95, 0xed, 0x25, 0x72, 0x17, 0x5a, 0x3b, 0x11, 0x73, 0x38, 0x53, 0x0c,
+	0x92, 0x3d, 0x4a, 0xbb, 0x35, 0xd9, 0xec, 0xed, 0xda, 0x4b, 0x64, 0x03, 0x5a, 0x72, 0x71, 0x34,
+	0x36, 0x61, 0x76, 0xcd, 0xa1, 0x84, 0xca, 0x2d, 0xb1, 0xb9, 0xf3, 0x55, 0x99, 0x01, 0x7f, 0x03,
+	0xd7, 0x33, 0xe0, 0x5d, 0xc6, 0x1d, 0x0f, 0x2b, 0x08, 0x19, 0x21, 0xe5, 0x3d, 0x7b, 0x58, 0xfd,
+	0xd9, 0xbe, 0x3a, 0xe6, 0x91, 0xe7, 0x8f, 0x84, 0x56, 0x3f, 0x86, 0x35, 0x1d, 0xa0, 0x8c, 0x77,
+	0x1f, 0x32, 0x9b, 0xff, 0xcf, 0x8e, 0xfa, 0x23, 0x58, 0xe9, 0xb3, 0x17, 0xdc, 0x14, 0xc9, 0x8c,
+	0x37, 0x2b, 0x2f, 0x46, 0x6a, 0x6f, 0xb9, 0xae, 0x29, 0xb1, 0xe8, 0xbd, 0x29, 0x63, 0xb6, 0x7b,
+	0xb0, 0xba, 0x83, 0xac, 0xb1, 0x29, 0xb9, 0xd0, 0x74, 0x9f, 0x43, 0x63, 0xa1, 0x4e, 0xeb, 0x73,
+	0xc3, 0xe9, 0x65, 0xbc, 0x0d, 0xcb, 0xe2, 0xdb, 0x87, 0x97, 0x1a, 0xfa, 0x7d, 0xa8, 0x9e, 0xfa,
+	0xee, 0x2b, 0x61, 0x0f, 0x00, 0xd2, 0xf3, 0x21, 0x77, 0x11, 0x66, 0x8e, 0x0f, 0x69, 0x4d, 0xe9,
+	0x1a, 0xc9, 0x5b, 0xb0, 0x5e, 0x80, 0xfe, 0x74, 0xc2, 0x22, 0x6f, 0x38, 0x3f, 0xd1, 0x4f, 0xf0,
+	0xf9, 0x2c, 0x1a, 0xa5, 0x12, 0x2f, 0xf7, 0x92, 0x5d, 0xa8, 0xaa, 0xf0, 0x4b, 0xba, 0xb9, 0xc1,
+	0x5b, 0xc4, 0xa7, 0xee, 0xdb, 0x2f, 0x09, 0xec, 0xf6, 0x12, 0xf9, 0x19, 0xb4, 0x32, 0x81, 0x8f,
+	0xdc, 0x32, 0xf1, 0x79, 0xc1, 0xb9, 0xfb, 0xde, 0x4b, 0x10, 0x49, 0xbf, 0xdf, 0x43, 0x67, 0x36,
+	0x6e, 0x91, 0xdb, 0xa6, 0xe0, 0x82, 0x78, 0xda, 0xbd, 0xf3, 0x72, 0x50, 0x32, 0xc0, 0x3e, 0xb4,
+	0xb3, 0x85, 0x62, 0xa2, 0x66, 0x9a, 0x5b, 0x3e, 0x5e, 0xbc, 0x5b, 0xee, 0x42, 0x45, 0xc9, 0xe7,
+	0x05, 0x36, 0xa3, 0xa4, 0x6a, 0x2f, 0x91, 0xdf, 0x86, 0x76, 0xf6, 0xad, 0xd4, 0xf0, 0xda, 0xb7,
+	0x32, 0x61, 0xc2, 0x7c, 0x4b, 0xb5, 0x97, 0x1e, 0xfc, 0x5b, 0x0d, 0x2a, 0x72, 0x46, 0x98, 0xd4,
+	0x0e, 0xa6, 0xf1, 0x05, 0x86, 0x29, 0x3d, 0xe2, 0x0e, 0x56, 0x83, 0xba, 0x6d, 0xad, 0xbe, 0x2c,
+	0xf3, 0xda, 0x4b, 0x1b, 0x85, 0x4f, 0x0b, 0xe4, 0x01, 0xc2, 0xe5, 0x9b, 0x2a, 0x51, 0x73, 0x98,
+	0x79, 0x63, 0xed, 0x9a, 0xbd, 0xd8, 0x4b, 0x9f, 0x16, 0xc8, 0x43, 0xa8, 0x27, 0x9f, 0xd2, 0x91,
+	0xf5, 0xb9, 0x6f, 0xeb, 0xa4, 0x54, 0xee, 0x37, 0x77, 0xf6, 0x12, 0xf9, 0x5d, 0x68, 0x18, 0x9f,
+	0xc4, 0x12, 0x2b, 0x79, 0xc7, 0x9a, 0xf9, 0x4a, 0x76, 0x61, 0x07, 0xb7, 0xa1, 0x76, 0xcc, 0x83,
+	0x50, 0x48, 0x2f, 0xdc, 0xd4, 0x3f, 0x01, 0x48, 0x93, 0x1d, 0x1d, 0x35, 0xe6, 0xd2, 0x9f, 0xc5,
+	0xab, 0x76, 0x5f, 0x7e, 0x6e, 0xa7, 0x8e, 0xa4, 0x74, 0x98, 0xfc, 0x57, 0x46, 0x7b, 0x89, 0x6c,
+	0x43, 0xc3, 0xf8, 0xc6, 0x96, 0xdc, 0x34, 0xbd, 0x6c, 0xfe, 0xe3, 0x5b, 0xbd, 0xfc, 0x8a, 0x8a,
+	0x1f, 0x38, 0xda, 0x4b, 0xe4, 0x2b, 0x59, 0xf6, 0x38, 0x0c, 0x46, 0x31, 0x31, 0x06, 0xc2, 0xb6,
+	0x96, 0x5b, 0xcb, 0x92, 0xd3, 0x35, 0xd9, 0x82, 0x86, 0x51, 0xe3, 0x21, 0xd6, 0x5c, 0xd9, 0x47,
+	0xf7, 0xb0, 0x9e, 0xc3, 0x91, 0x53, 0xf8, 0x1a, 0x6a, 0x58, 0xee, 0x34, 0x5d, 0x61, 0xa6, 0xfe,
+	0xdb, 0x5d, 0x9b, 0x25, 0x0b, 0x49, 0xe1, 0x48, 0x0f, 0x01, 0x64, 0xdd, 0x52, 0xc8, 0x1b, 0x65,
+	0xa7, 0x4c, 0x35, 0x73, 0x81, 0x17, 0x7e, 0x05, 0x4d, 0x5d, 0xa5, 0x14, 0xe2, 0x6f, 0x65, 0xc5,
+	0x8d, 0xea, 0xe5, 0xbc, 0x37, 0x7e, 0x6b, 0x7c, 0x90, 0x2c, 0x2e, 0x0c, 0x7a, 0xa3, 0xe6, 0x7e,
+	0x90, 0xda, 0x7d, 0x2b, 0x9f, 0x29, 0x4d, 0xb0, 0x01, 0x2d, 0xed, 0x5b, 0xb2, 0xab, 0x85, 0x0e,
+	0xf6, 0x25, 0xac, 0x24, 0xa8, 0x39, 0x2f, 0xe9, 0x2e, 0xfe, 0xb4, 0x53, 0x84, 0xee, 0x86, 0xf1,
+	0x36, 0x61, 0x88, 0xad, 0xcf, 0xbe, 0x47, 0xc4, 0x69, 0x92, 0xd1, 0x78, 0xc4, 0xb8, 0xfe, 0x28,
+	0xc0, 0x10, 0x59, 0xcb, 0xf9, 0x5c, 0x40, 0x2c, 0xe5, 0xca, 0xcc, 0xb7, 0x4c, 0xd9, 0xe8, 0xd3,
+	0x9d, 0xfb, 0x8e, 0x28, 0xf9, 0xde, 0xc9, 0x5e, 0xda, 0xbe, 0xf3, 0x7b, 0xf6, 0xc8, 0xe3, 0x17,
+	0xd3, 0xb3, 0xcd, 0x61, 0x30, 0xb9, 0x8f, 0xc8, 0x4f, 0xbc, 0xe0, 0xfe, 0x30, 0x88, 0xd8, 0x7d,
+	0xf1, 0x37, 0x82, 0x87, 0x48, 0x3a, 0xab, 0x88, 0xdf, 0x9f, 0xfd, 0xf7, 0x00, 0xe4, 0x3f, 0xd2,
+	0x3b, 0x18, 0x31, 0x00, 0x00,
 }
@@ -2,8 +2,11 @@ package sonm
 
 import (
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v2"
 )
 
@@ -67,3 +70,83 @@ func TestMetricsAppend(t *testing.T) {
 	m.Append(x1, x2).Append(x3)
 	assert.Len(t, m.Metrics, 3)
 }
+
+func TestMaintenanceWindowValidate(t *testing.T) {
+	start := NewTimestamp(time.Now())
+	hour := &Duration{Nanoseconds: int64(time.Hour)}
+
+	assert.NoError(t, (&MaintenanceWindow{Start: start}).Validate())
+	assert.NoError(t, (&MaintenanceWindow{Start: start, Duration: hour}).Validate())
+	assert.NoError(t, (&MaintenanceWindow{Schedule: "0 3 * * sun", Duration: hour}).Validate())
+
+	assert.Error(t, (&MaintenanceWindow{}).Validate())
+	assert.Error(t, (&MaintenanceWindow{Start: start, Duration: &Duration{Nanoseconds: -1}}).Validate())
+	assert.Error(t, (&MaintenanceWindow{Start: start, Schedule: "0 3 * * sun", Duration: hour}).Validate())
+	assert.Error(t, (&MaintenanceWindow{Schedule: "0 3 * * sun"}).Validate())
+	assert.Error(t, (&MaintenanceWindow{Schedule: "0 3 * *", Duration: hour}).Validate())
+}
+
+func TestMaintenanceWindowOccurrence(t *testing.T) {
+	now := time.Date(2019, time.March, 5, 10, 30, 0, 0, time.UTC)
+	hour := &Duration{Nanoseconds: int64(time.Hour)}
+
+	window := &MaintenanceWindow{Start: NewTimestamp(now.Add(-2 * time.Hour))}
+	start, end, ok := window.Occurrence(now)
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(-2*time.Hour), start)
+	assert.True(t, end.IsZero())
+
+	window.Duration = hour
+	_, _, ok = window.Occurrence(now)
+	assert.False(t, ok)
+
+	window.Start = NewTimestamp(now.Add(-30 * time.Minute))
+	start, end, ok = window.Occurrence(now)
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(-30*time.Minute), start)
+	assert.Equal(t, now.Add(30*time.Minute), end)
+
+	// The active occurrence of the recurring window.
+	window = &MaintenanceWindow{Schedule: "0 10 * * *", Duration: hour}
+	start, end, ok = window.Occurrence(now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2019, time.March, 5, 10, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2019, time.March, 5, 11, 0, 0, 0, time.UTC), end)
+
+	// The upcoming occurrence once the previous one has ended.
+	start, _, ok = window.Occurrence(now.Add(time.Hour))
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2019, time.March, 6, 10, 0, 0, 0, time.UTC), start)
+}
+
+func TestBenchmarkReportSignature(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	report := &BenchmarkReportReply{
+		Fingerprint: "fingerprint",
+		Timestamp:   NewTimestamp(time.Date(2019, time.March, 5, 10, 0, 0, 0, time.UTC)),
+		Results: []*BenchmarkResult{
+			{ID: 0, Code: "cpu-sysbench-multi", Type: DeviceType_DEV_CPU, Result: 1000, Runner: "native"},
+		},
+	}
+
+	require.NoError(t, report.Sign(key))
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), report.GetWorker().Unwrap())
+	assert.NoError(t, report.Verify())
+
+	report.Results[0].Result = 2000
+	assert.Error(t, report.Verify())
+	report.Results[0].Result = 1000
+
+	// The signature does not depend on the order of results.
+	report.Results = append(report.Results, &BenchmarkResult{ID: 1, Code: "cpu-sysbench-single", Type: DeviceType_DEV_CPU, Result: 100})
+	require.NoError(t, report.Sign(key))
+	report.Results[0], report.Results[1] = report.Results[1], report.Results[0]
+	assert.NoError(t, report.Verify())
+
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	report.Worker = NewEthAddress(crypto.PubkeyToAddress(other.PublicKey))
+	assert.Error(t, report.Verify())
+}
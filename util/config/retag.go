@@ -68,8 +68,15 @@ func SnakeToLower(m map[interface{}]interface{}) {
 			k = strings.Replace(k, "_", "", -1)
 			m[k] = v
 		}
-		if v, ok := v.(map[interface{}]interface{}); ok {
+		switch v := v.(type) {
+		case map[interface{}]interface{}:
 			SnakeToLower(v)
+		case []interface{}:
+			for _, item := range v {
+				if item, ok := item.(map[interface{}]interface{}); ok {
+					SnakeToLower(item)
+				}
+			}
 		}
 	}
 }
@@ -0,0 +1,221 @@
+// Package cron implements schedules defined by cron expressions.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// searchLimit bounds the search of the next activation, so that expressions
+// that never fire, like "0 0 30 2 *", do not loop forever.
+const searchLimit = 5 * 366 * 24 * time.Hour
+
+type bounds struct {
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	minuteBounds = bounds{min: 0, max: 59}
+	hourBounds   = bounds{min: 0, max: 23}
+	domBounds    = bounds{min: 1, max: 31}
+	monthBounds  = bounds{min: 1, max: 12, names: map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Both 0 and 7 stand for Sunday.
+	dowBounds = bounds{min: 0, max: 7, names: map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// Schedule is a parsed cron expression consisting of five fields: minute,
+// hour, day of month, month and day of week. Each field is a comma-separated
+// list of values, ranges like "1-5" or "*", optionally followed by a step
+// like "*/15". Months and days of week can also be specified by their
+// three-letter English names.
+//
+// As in the classic cron, when both day of month and day of week are
+// restricted, a day matches when either of them matches.
+//
+// Schedules are evaluated in UTC.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+	// domAny and dowAny are set for unrestricted day fields.
+	domAny, dowAny bool
+}
+
+// Parse parses the cron expression.
+func Parse(expr string) (*Schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields in cron expression, got %d", len(fields))
+	}
+
+	var err error
+	s := &Schedule{
+		domAny: fields[2] == "*",
+		dowAny: fields[4] == "*",
+	}
+
+	if s.minute, err = parseField(fields[0], minuteBounds); err != nil {
+		return nil, fmt.Errorf("invalid minute: %v", err)
+	}
+	if s.hour, err = parseField(fields[1], hourBounds); err != nil {
+		return nil, fmt.Errorf("invalid hour: %v", err)
+	}
+	if s.dom, err = parseField(fields[2], domBounds); err != nil {
+		return nil, fmt.Errorf("invalid day of month: %v", err)
+	}
+	if s.month, err = parseField(fields[3], monthBounds); err != nil {
+		return nil, fmt.Errorf("invalid month: %v", err)
+	}
+	if s.dow, err = parseField(fields[4], dowBounds); err != nil {
+		return nil, fmt.Errorf("invalid day of week: %v", err)
+	}
+
+	// Fold Sunday specified as 7.
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+
+	return s, nil
+}
+
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		itemBits, err := parseItem(item, b)
+		if err != nil {
+			return 0, err
+		}
+
+		bits |= itemBits
+	}
+
+	return bits, nil
+}
+
+func parseItem(item string, b bounds) (uint64, error) {
+	rangePart := item
+	step := uint(1)
+
+	if idx := strings.IndexByte(item, '/'); idx >= 0 {
+		v, err := strconv.ParseUint(item[idx+1:], 10, 8)
+		if err != nil || v == 0 {
+			return 0, fmt.Errorf("invalid step in `%s`", item)
+		}
+
+		rangePart = item[:idx]
+		step = uint(v)
+	}
+
+	var from, to uint
+	switch {
+	case rangePart == "*":
+		from, to = b.min, b.max
+	case strings.IndexByte(rangePart, '-') >= 0:
+		parts := strings.SplitN(rangePart, "-", 2)
+
+		var err error
+		if from, err = parseValue(parts[0], b); err != nil {
+			return 0, err
+		}
+		if to, err = parseValue(parts[1], b); err != nil {
+			return 0, err
+		}
+		if from > to {
+			return 0, fmt.Errorf("invalid range `%s`", rangePart)
+		}
+	default:
+		v, err := parseValue(rangePart, b)
+		if err != nil {
+			return 0, err
+		}
+
+		from, to = v, v
+		// A single value with a step, like "5/10", ranges up to the max.
+		if step > 1 {
+			to = b.max
+		}
+	}
+
+	var bits uint64
+	for v := from; v <= to; v += step {
+		bits |= 1 << v
+	}
+
+	return bits, nil
+}
+
+func parseValue(value string, b bounds) (uint, error) {
+	if v, ok := b.names[strings.ToLower(value)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.ParseUint(value, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value `%s`", value)
+	}
+
+	if uint(v) < b.min || uint(v) > b.max {
+		return 0, fmt.Errorf("value %d is out of range [%d, %d]", v, b.min, b.max)
+	}
+
+	return uint(v), nil
+}
+
+func matches(bits uint64, v int) bool {
+	return bits&(1<<uint(v)) != 0
+}
+
+func (m *Schedule) matchesDay(t time.Time) bool {
+	domMatches := matches(m.dom, t.Day())
+	dowMatches := matches(m.dow, int(t.Weekday()))
+
+	switch {
+	case m.domAny && m.dowAny:
+		return true
+	case m.domAny:
+		return dowMatches
+	case m.dowAny:
+		return domMatches
+	default:
+		return domMatches || dowMatches
+	}
+}
+
+// Next returns the first activation time strictly after the given one. Zero
+// time is returned when the schedule never fires.
+func (m *Schedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(searchLimit)
+
+	for t.Before(limit) {
+		if !matches(m.month, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !m.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !matches(m.hour, t.Hour()) {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+
+		if !matches(m.minute, t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
@@ -0,0 +1,75 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day, hour, min int) time.Time {
+	return time.Date(year, month, day, hour, min, 0, 0, time.UTC)
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"* * * foo *",
+	} {
+		_, err := Parse(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestNext(t *testing.T) {
+	// 2019-03-05 is Tuesday.
+	now := date(2019, time.March, 5, 10, 30)
+
+	for _, tc := range []struct {
+		expr     string
+		expected time.Time
+	}{
+		{"* * * * *", date(2019, time.March, 5, 10, 31)},
+		{"0 * * * *", date(2019, time.March, 5, 11, 0)},
+		{"30 10 * * *", date(2019, time.March, 6, 10, 30)},
+		{"*/15 * * * *", date(2019, time.March, 5, 10, 45)},
+		{"5/20 * * * *", date(2019, time.March, 5, 10, 45)},
+		{"0 3 * * sun", date(2019, time.March, 10, 3, 0)},
+		{"0 3 * * 7", date(2019, time.March, 10, 3, 0)},
+		{"0 3 * * MON-FRI", date(2019, time.March, 6, 3, 0)},
+		{"0 0 1 * *", date(2019, time.April, 1, 0, 0)},
+		{"0 0 1,15 jan,jul *", date(2019, time.July, 1, 0, 0)},
+		{"0 0 29 2 *", date(2020, time.February, 29, 0, 0)},
+		// Either day of month or day of week matches.
+		{"0 0 20 * mon", date(2019, time.March, 11, 0, 0)},
+	} {
+		schedule, err := Parse(tc.expr)
+		require.NoError(t, err, tc.expr)
+		assert.Equal(t, tc.expected, schedule.Next(now), tc.expr)
+	}
+}
+
+func TestNextTimezone(t *testing.T) {
+	schedule, err := Parse("0 3 * * *")
+	require.NoError(t, err)
+
+	now := time.Date(2019, time.March, 5, 5, 0, 0, 0, time.FixedZone("UTC+3", 3*3600))
+	assert.Equal(t, date(2019, time.March, 5, 3, 0), schedule.Next(now))
+}
+
+func TestNextNever(t *testing.T) {
+	schedule, err := Parse("0 0 30 2 *")
+	require.NoError(t, err)
+	assert.True(t, schedule.Next(date(2019, time.March, 5, 10, 30)).IsZero())
+}
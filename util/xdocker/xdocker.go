@@ -9,8 +9,112 @@ import (
 )
 
 type spoolResponseProtocol struct {
-	Error  string `json:"error"`
-	Status string `json:"status"`
+	Error          string `json:"error"`
+	Status         string `json:"status"`
+	ID             string `json:"id"`
+	ProgressDetail struct {
+		Current uint64 `json:"current"`
+		Total   uint64 `json:"total"`
+	} `json:"progressDetail"`
+}
+
+// PullProgress describes the progress of an image pulling process
+// summarized over all image layers.
+type PullProgress struct {
+	// Current is the number of bytes downloaded.
+	Current uint64
+	// Total is the number of bytes to download. Layers whose size is not
+	// reported yet are not counted.
+	Total uint64
+	// Layers is the number of image layers seen so far.
+	Layers int
+	// Done is the number of layers that are already pulled.
+	Done int
+}
+
+type layerProgress struct {
+	current uint64
+	total   uint64
+	done    bool
+}
+
+// pullTracker accumulates per-layer statuses reported by Docker.
+type pullTracker struct {
+	order  []string
+	layers map[string]*layerProgress
+}
+
+func newPullTracker() *pullTracker {
+	return &pullTracker{
+		layers: map[string]*layerProgress{},
+	}
+}
+
+// Update applies the given status message, returning true if the progress
+// has changed.
+func (m *pullTracker) Update(resp *spoolResponseProtocol) bool {
+	if len(resp.ID) == 0 {
+		return false
+	}
+
+	layer, ok := m.layers[resp.ID]
+	switch resp.Status {
+	case "Pulling fs layer", "Waiting":
+	case "Downloading":
+		if !ok {
+			break
+		}
+		layer.current = resp.ProgressDetail.Current
+		layer.total = resp.ProgressDetail.Total
+		return true
+	case "Verifying Checksum", "Download complete":
+		if !ok {
+			break
+		}
+		layer.current = layer.total
+		return true
+	case "Pull complete", "Already exists":
+		if !ok {
+			layer = m.add(resp.ID)
+		}
+		layer.current = layer.total
+		layer.done = true
+		return true
+	default:
+		return false
+	}
+
+	if !ok {
+		m.add(resp.ID)
+		return true
+	}
+
+	return false
+}
+
+func (m *pullTracker) add(id string) *layerProgress {
+	layer := &layerProgress{}
+	m.order = append(m.order, id)
+	m.layers[id] = layer
+
+	return layer
+}
+
+func (m *pullTracker) Progress() PullProgress {
+	progress := PullProgress{
+		Layers: len(m.order),
+	}
+
+	for _, id := range m.order {
+		layer := m.layers[id]
+		progress.Current += layer.current
+		progress.Total += layer.total
+		if layer.done {
+			progress.Done++
+		}
+	}
+
+	return progress
 }
 
 // DecodeImagePull detects Error of an image pulling process
@@ -21,6 +125,13 @@ type spoolResponseProtocol struct {
 // {"Status": "OK"}\n{"Status": "OK"}
 // {"Status": "OK"}{"Error": "error"}
 func DecodeImagePull(r io.Reader) error {
+	return DecodeImagePullProgress(r, nil)
+}
+
+// DecodeImagePullProgress acts like DecodeImagePull, additionally calling
+// the given function each time the pull progress changes.
+func DecodeImagePullProgress(r io.Reader, fn func(PullProgress)) error {
+	tracker := newPullTracker()
 	more := true
 
 	rd := bufio.NewReader(r)
@@ -46,17 +157,17 @@ func DecodeImagePull(r io.Reader) error {
 			line = line[:len(line)-1]
 		}
 
-		if err = decodePullLine(line); err != nil {
+		if err = decodePullLine(line, tracker, fn); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func decodePullLine(line []byte) error {
-	var resp spoolResponseProtocol
+func decodePullLine(line []byte, tracker *pullTracker, fn func(PullProgress)) error {
 	decoder := json.NewDecoder(bytes.NewReader(line))
 	for {
+		var resp spoolResponseProtocol
 		if err := decoder.Decode(&resp); err != nil {
 			if err == io.EOF {
 				return nil
@@ -68,5 +179,9 @@ func decodePullLine(line []byte) error {
 		if len(resp.Error) != 0 {
 			return fmt.Errorf(resp.Error)
 		}
+
+		if tracker.Update(&resp) && fn != nil {
+			fn(tracker.Progress())
+		}
 	}
 }
@@ -34,6 +34,34 @@ func TestImagePullFromMock(t *testing.T) {
 	}
 }
 
+func TestImagePullProgressFromMock(t *testing.T) {
+	body := []byte(`{"status":"Pulling from library/alpine","id":"latest"}
+{"status":"Pulling fs layer","progressDetail":{},"id":"a"}
+{"status":"Already exists","progressDetail":{},"id":"b"}
+{"status":"Downloading","progressDetail":{"current":10,"total":100},"id":"a"}
+{"status":"Downloading","progressDetail":{"current":60,"total":100},"id":"a"}
+{"status":"Download complete","progressDetail":{},"id":"a"}
+{"status":"Extracting","progressDetail":{"current":100,"total":100},"id":"a"}
+{"status":"Pull complete","progressDetail":{},"id":"a"}
+{"status":"Digest: sha256:0000"}
+`)
+
+	var progress []PullProgress
+	err := DecodeImagePullProgress(bytes.NewReader(body), func(p PullProgress) {
+		progress = append(progress, p)
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []PullProgress{
+		{Layers: 1},
+		{Layers: 2, Done: 1},
+		{Current: 10, Total: 100, Layers: 2, Done: 1},
+		{Current: 60, Total: 100, Layers: 2, Done: 1},
+		{Current: 100, Total: 100, Layers: 2, Done: 1},
+		{Current: 100, Total: 100, Layers: 2, Done: 2},
+	}, progress)
+}
+
 func TestImagePull(t *testing.T) {
 	dockclient, err := client.NewEnvClient()
 	if err != nil {